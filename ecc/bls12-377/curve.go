@@ -0,0 +1,326 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// curve implements ecc.Curve for BLS12-377
+type curve struct{}
+
+func init() {
+	ecc.RegisterCurve(curve{})
+}
+
+// g1Point implements ecc.Point for G1
+type g1Point G1Affine
+
+// g2Point implements ecc.Point for G2
+type g2Point G2Affine
+
+// gtElement implements ecc.GTElement for GT
+type gtElement GT
+
+func (curve) ID() ecc.ID {
+	return ID
+}
+
+func (curve) BaseFieldModulus() *big.Int {
+	return fp.Modulus()
+}
+
+func (curve) ScalarFieldModulus() *big.Int {
+	return fr.Modulus()
+}
+
+func (curve) NewG1() ecc.Point {
+	return new(g1Point)
+}
+
+func (curve) NewG2() ecc.Point {
+	return new(g2Point)
+}
+
+func (curve) NewGT() ecc.GTElement {
+	var res GT
+	res.SetOne()
+	return (*gtElement)(&res)
+}
+
+func (curve) G1Generator() ecc.Point {
+	res := g1Point(g1GenAff)
+	return &res
+}
+
+func (curve) G2Generator() ecc.Point {
+	res := g2Point(g2GenAff)
+	return &res
+}
+
+func (curve) Pair(P, Q []ecc.Point) (ecc.GTElement, error) {
+	res, err := Pair(toG1Affine(P), toG2Affine(Q))
+	if err != nil {
+		return nil, err
+	}
+	return (*gtElement)(&res), nil
+}
+
+func (curve) PairingCheck(P, Q []ecc.Point) (bool, error) {
+	return PairingCheck(toG1Affine(P), toG2Affine(Q))
+}
+
+func (curve) SizeOfG1AffineCompressed() int {
+	return SizeOfG1AffineCompressed
+}
+
+func (curve) SizeOfG1AffineUncompressed() int {
+	return SizeOfG1AffineUncompressed
+}
+
+func (curve) SizeOfG2AffineCompressed() int {
+	return SizeOfG2AffineCompressed
+}
+
+func (curve) SizeOfG2AffineUncompressed() int {
+	return SizeOfG2AffineUncompressed
+}
+
+func (curve) SizeOfGT() int {
+	return SizeOfGT
+}
+
+// toG1Affine converts points of the runtime API, it panics if one of them is not in G1
+func toG1Affine(points []ecc.Point) []G1Affine {
+	res := make([]G1Affine, len(points))
+	for i := 0; i < len(points); i++ {
+		res[i] = G1Affine(*points[i].(*g1Point))
+	}
+	return res
+}
+
+func (p *g1Point) affine() *G1Affine {
+	return (*G1Affine)(p)
+}
+
+func (p *g1Point) Set(a ecc.Point) ecc.Point {
+	p.affine().Set(a.(*g1Point).affine())
+	return p
+}
+
+func (p *g1Point) Add(a, b ecc.Point) ecc.Point {
+	p.affine().Add(a.(*g1Point).affine(), b.(*g1Point).affine())
+	return p
+}
+
+func (p *g1Point) Sub(a, b ecc.Point) ecc.Point {
+	p.affine().Sub(a.(*g1Point).affine(), b.(*g1Point).affine())
+	return p
+}
+
+func (p *g1Point) Neg(a ecc.Point) ecc.Point {
+	p.affine().Neg(a.(*g1Point).affine())
+	return p
+}
+
+func (p *g1Point) ScalarMultiplication(a ecc.Point, s *big.Int) ecc.Point {
+	p.affine().ScalarMultiplication(a.(*g1Point).affine(), s)
+	return p
+}
+
+func (p *g1Point) MultiExp(points []ecc.Point, scalars []*big.Int, config ecc.MultiExpConfig) (ecc.Point, error) {
+	_scalars := make([]fr.Element, len(scalars))
+	for i := 0; i < len(scalars); i++ {
+		_scalars[i].SetBigInt(scalars[i])
+	}
+	// SetBigInt outputs the scalars in Montgomery form
+	config.ScalarsMont = true
+	if _, err := p.affine().MultiExp(toG1Affine(points), _scalars, config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *g1Point) Equal(a ecc.Point) bool {
+	return p.affine().Equal(a.(*g1Point).affine())
+}
+
+func (p *g1Point) IsInfinity() bool {
+	return p.affine().IsInfinity()
+}
+
+func (p *g1Point) IsOnCurve() bool {
+	return p.affine().IsOnCurve()
+}
+
+func (p *g1Point) IsInSubGroup() bool {
+	return p.affine().IsInSubGroup()
+}
+
+func (p *g1Point) Bytes() []byte {
+	res := p.affine().Bytes()
+	return res[:]
+}
+
+func (p *g1Point) RawBytes() []byte {
+	res := p.affine().RawBytes()
+	return res[:]
+}
+
+func (p *g1Point) SetBytes(buf []byte) (int, error) {
+	return p.affine().SetBytes(buf)
+}
+
+func (p *g1Point) String() string {
+	return p.affine().String()
+}
+
+// toG2Affine converts points of the runtime API, it panics if one of them is not in G2
+func toG2Affine(points []ecc.Point) []G2Affine {
+	res := make([]G2Affine, len(points))
+	for i := 0; i < len(points); i++ {
+		res[i] = G2Affine(*points[i].(*g2Point))
+	}
+	return res
+}
+
+func (p *g2Point) affine() *G2Affine {
+	return (*G2Affine)(p)
+}
+
+func (p *g2Point) Set(a ecc.Point) ecc.Point {
+	p.affine().Set(a.(*g2Point).affine())
+	return p
+}
+
+func (p *g2Point) Add(a, b ecc.Point) ecc.Point {
+	p.affine().Add(a.(*g2Point).affine(), b.(*g2Point).affine())
+	return p
+}
+
+func (p *g2Point) Sub(a, b ecc.Point) ecc.Point {
+	p.affine().Sub(a.(*g2Point).affine(), b.(*g2Point).affine())
+	return p
+}
+
+func (p *g2Point) Neg(a ecc.Point) ecc.Point {
+	p.affine().Neg(a.(*g2Point).affine())
+	return p
+}
+
+func (p *g2Point) ScalarMultiplication(a ecc.Point, s *big.Int) ecc.Point {
+	p.affine().ScalarMultiplication(a.(*g2Point).affine(), s)
+	return p
+}
+
+func (p *g2Point) MultiExp(points []ecc.Point, scalars []*big.Int, config ecc.MultiExpConfig) (ecc.Point, error) {
+	_scalars := make([]fr.Element, len(scalars))
+	for i := 0; i < len(scalars); i++ {
+		_scalars[i].SetBigInt(scalars[i])
+	}
+	// SetBigInt outputs the scalars in Montgomery form
+	config.ScalarsMont = true
+	if _, err := p.affine().MultiExp(toG2Affine(points), _scalars, config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *g2Point) Equal(a ecc.Point) bool {
+	return p.affine().Equal(a.(*g2Point).affine())
+}
+
+func (p *g2Point) IsInfinity() bool {
+	return p.affine().IsInfinity()
+}
+
+func (p *g2Point) IsOnCurve() bool {
+	return p.affine().IsOnCurve()
+}
+
+func (p *g2Point) IsInSubGroup() bool {
+	return p.affine().IsInSubGroup()
+}
+
+func (p *g2Point) Bytes() []byte {
+	res := p.affine().Bytes()
+	return res[:]
+}
+
+func (p *g2Point) RawBytes() []byte {
+	res := p.affine().RawBytes()
+	return res[:]
+}
+
+func (p *g2Point) SetBytes(buf []byte) (int, error) {
+	return p.affine().SetBytes(buf)
+}
+
+func (p *g2Point) String() string {
+	return p.affine().String()
+}
+
+func (z *gtElement) gt() *GT {
+	return (*GT)(z)
+}
+
+func (z *gtElement) Set(a ecc.GTElement) ecc.GTElement {
+	z.gt().Set(a.(*gtElement).gt())
+	return z
+}
+
+func (z *gtElement) Mul(a, b ecc.GTElement) ecc.GTElement {
+	z.gt().Mul(a.(*gtElement).gt(), b.(*gtElement).gt())
+	return z
+}
+
+func (z *gtElement) Inverse(a ecc.GTElement) ecc.GTElement {
+	z.gt().Inverse(a.(*gtElement).gt())
+	return z
+}
+
+func (z *gtElement) Exp(a ecc.GTElement, e *big.Int) ecc.GTElement {
+	z.gt().Exp(a.(*gtElement).gt(), *e)
+	return z
+}
+
+func (z *gtElement) Equal(a ecc.GTElement) bool {
+	return z.gt().Equal(a.(*gtElement).gt())
+}
+
+func (z *gtElement) IsOne() bool {
+	var one GT
+	one.SetOne()
+	return z.gt().Equal(&one)
+}
+
+func (z *gtElement) Bytes() []byte {
+	res := z.gt().Bytes()
+	return res[:]
+}
+
+func (z *gtElement) SetBytes(buf []byte) error {
+	return z.gt().SetBytes(buf)
+}
+
+func (z *gtElement) String() string {
+	return z.gt().String()
+}
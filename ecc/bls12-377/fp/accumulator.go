@@ -0,0 +1,219 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"math/bits"
+)
+
+// WideAccumulator accumulates products of Element on 12 + 1 words, without modular reduction.
+//
+// A sum of products costs one Montgomery reduction (Reduce) instead of one per product:
+//
+//	var acc WideAccumulator
+//	for i := range a {
+//		acc.MulAdd(&a[i], &b[i])
+//	}
+//	res := acc.Reduce()
+//
+// The zero value is an empty accumulator; it can hold up to 2**63 products.
+type WideAccumulator struct {
+	t [13]uint64
+}
+
+// MulAdd sets acc = acc + x * y, without reduction
+func (acc *WideAccumulator) MulAdd(x, y *Element) *WideAccumulator {
+	var p [12]uint64
+	var C uint64
+
+	// p = x * y
+	C, p[0] = bits.Mul64(x[0], y[0])
+	C, p[1] = madd1(x[0], y[1], C)
+	C, p[2] = madd1(x[0], y[2], C)
+	C, p[3] = madd1(x[0], y[3], C)
+	C, p[4] = madd1(x[0], y[4], C)
+	C, p[5] = madd1(x[0], y[5], C)
+	p[6] = C
+	C, p[1] = madd1(x[1], y[0], p[1])
+	C, p[2] = madd2(x[1], y[1], p[2], C)
+	C, p[3] = madd2(x[1], y[2], p[3], C)
+	C, p[4] = madd2(x[1], y[3], p[4], C)
+	C, p[5] = madd2(x[1], y[4], p[5], C)
+	C, p[6] = madd2(x[1], y[5], p[6], C)
+	p[7] = C
+	C, p[2] = madd1(x[2], y[0], p[2])
+	C, p[3] = madd2(x[2], y[1], p[3], C)
+	C, p[4] = madd2(x[2], y[2], p[4], C)
+	C, p[5] = madd2(x[2], y[3], p[5], C)
+	C, p[6] = madd2(x[2], y[4], p[6], C)
+	C, p[7] = madd2(x[2], y[5], p[7], C)
+	p[8] = C
+	C, p[3] = madd1(x[3], y[0], p[3])
+	C, p[4] = madd2(x[3], y[1], p[4], C)
+	C, p[5] = madd2(x[3], y[2], p[5], C)
+	C, p[6] = madd2(x[3], y[3], p[6], C)
+	C, p[7] = madd2(x[3], y[4], p[7], C)
+	C, p[8] = madd2(x[3], y[5], p[8], C)
+	p[9] = C
+	C, p[4] = madd1(x[4], y[0], p[4])
+	C, p[5] = madd2(x[4], y[1], p[5], C)
+	C, p[6] = madd2(x[4], y[2], p[6], C)
+	C, p[7] = madd2(x[4], y[3], p[7], C)
+	C, p[8] = madd2(x[4], y[4], p[8], C)
+	C, p[9] = madd2(x[4], y[5], p[9], C)
+	p[10] = C
+	C, p[5] = madd1(x[5], y[0], p[5])
+	C, p[6] = madd2(x[5], y[1], p[6], C)
+	C, p[7] = madd2(x[5], y[2], p[7], C)
+	C, p[8] = madd2(x[5], y[3], p[8], C)
+	C, p[9] = madd2(x[5], y[4], p[9], C)
+	C, p[10] = madd2(x[5], y[5], p[10], C)
+	p[11] = C
+
+	// acc = acc + p
+	acc.t[0], C = bits.Add64(acc.t[0], p[0], 0)
+	acc.t[1], C = bits.Add64(acc.t[1], p[1], C)
+	acc.t[2], C = bits.Add64(acc.t[2], p[2], C)
+	acc.t[3], C = bits.Add64(acc.t[3], p[3], C)
+	acc.t[4], C = bits.Add64(acc.t[4], p[4], C)
+	acc.t[5], C = bits.Add64(acc.t[5], p[5], C)
+	acc.t[6], C = bits.Add64(acc.t[6], p[6], C)
+	acc.t[7], C = bits.Add64(acc.t[7], p[7], C)
+	acc.t[8], C = bits.Add64(acc.t[8], p[8], C)
+	acc.t[9], C = bits.Add64(acc.t[9], p[9], C)
+	acc.t[10], C = bits.Add64(acc.t[10], p[10], C)
+	acc.t[11], C = bits.Add64(acc.t[11], p[11], C)
+	acc.t[12] += C
+
+	return acc
+}
+
+// Reset empties the accumulator
+func (acc *WideAccumulator) Reset() *WideAccumulator {
+	acc.t = [13]uint64{}
+	return acc
+}
+
+// Reduce returns the sum of the accumulated products, as a reduced Element;
+// the accumulator is left unchanged
+func (acc *WideAccumulator) Reduce() (z Element) {
+	t := acc.t
+
+	// the products are in Montgomery form (x*y*R**2), the montgomery reduction
+	// of t gives v = t * R**-1 mod q on 7 words: v < t / R + q
+	montReduceWide(&t)
+
+	// v = vLo + vHi * R with vLo < R, vHi < 2**64
+	// vLo * R**-1 * (R mod q) < 2q
+	var lo [13]uint64
+	var C uint64
+	C, lo[0] = bits.Mul64(t[6], 202099033278250856)
+	C, lo[1] = madd1(t[6], 5854854902718660529, C)
+	C, lo[2] = madd1(t[6], 11492539364873682930, C)
+	C, lo[3] = madd1(t[6], 8885205928937022213, C)
+	C, lo[4] = madd1(t[6], 5545221690922665192, C)
+	C, lo[5] = madd1(t[6], 39800542322357402, C)
+	lo[6] = C
+	C, lo[1] = madd1(t[7], 202099033278250856, lo[1])
+	C, lo[2] = madd2(t[7], 5854854902718660529, lo[2], C)
+	C, lo[3] = madd2(t[7], 11492539364873682930, lo[3], C)
+	C, lo[4] = madd2(t[7], 8885205928937022213, lo[4], C)
+	C, lo[5] = madd2(t[7], 5545221690922665192, lo[5], C)
+	C, lo[6] = madd2(t[7], 39800542322357402, lo[6], C)
+	lo[7] = C
+	C, lo[2] = madd1(t[8], 202099033278250856, lo[2])
+	C, lo[3] = madd2(t[8], 5854854902718660529, lo[3], C)
+	C, lo[4] = madd2(t[8], 11492539364873682930, lo[4], C)
+	C, lo[5] = madd2(t[8], 8885205928937022213, lo[5], C)
+	C, lo[6] = madd2(t[8], 5545221690922665192, lo[6], C)
+	C, lo[7] = madd2(t[8], 39800542322357402, lo[7], C)
+	lo[8] = C
+	C, lo[3] = madd1(t[9], 202099033278250856, lo[3])
+	C, lo[4] = madd2(t[9], 5854854902718660529, lo[4], C)
+	C, lo[5] = madd2(t[9], 11492539364873682930, lo[5], C)
+	C, lo[6] = madd2(t[9], 8885205928937022213, lo[6], C)
+	C, lo[7] = madd2(t[9], 5545221690922665192, lo[7], C)
+	C, lo[8] = madd2(t[9], 39800542322357402, lo[8], C)
+	lo[9] = C
+	C, lo[4] = madd1(t[10], 202099033278250856, lo[4])
+	C, lo[5] = madd2(t[10], 5854854902718660529, lo[5], C)
+	C, lo[6] = madd2(t[10], 11492539364873682930, lo[6], C)
+	C, lo[7] = madd2(t[10], 8885205928937022213, lo[7], C)
+	C, lo[8] = madd2(t[10], 5545221690922665192, lo[8], C)
+	C, lo[9] = madd2(t[10], 39800542322357402, lo[9], C)
+	lo[10] = C
+	C, lo[5] = madd1(t[11], 202099033278250856, lo[5])
+	C, lo[6] = madd2(t[11], 5854854902718660529, lo[6], C)
+	C, lo[7] = madd2(t[11], 11492539364873682930, lo[7], C)
+	C, lo[8] = madd2(t[11], 8885205928937022213, lo[8], C)
+	C, lo[9] = madd2(t[11], 5545221690922665192, lo[9], C)
+	C, lo[10] = madd2(t[11], 39800542322357402, lo[10], C)
+	lo[11] = C
+	montReduceWide(&lo)
+
+	copy(z[:], lo[6:12])
+	if lo[12] != 0 {
+		// z + 2**384 - q
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 9586122913090633729, 0)
+		z[1], b = bits.Sub64(z[1], 1660523435060625408, b)
+		z[2], b = bits.Sub64(z[2], 2230234197602682880, b)
+		z[3], b = bits.Sub64(z[3], 1883307231910630287, b)
+		z[4], b = bits.Sub64(z[4], 14284016967150029115, b)
+		z[5], b = bits.Sub64(z[5], 121098312706494698, b)
+	} else {
+
+		// if z > q --> z -= q
+		// note: this is NOT constant time
+		if !(z[5] < 121098312706494698 || (z[5] == 121098312706494698 && (z[4] < 14284016967150029115 || (z[4] == 14284016967150029115 && (z[3] < 1883307231910630287 || (z[3] == 1883307231910630287 && (z[2] < 2230234197602682880 || (z[2] == 2230234197602682880 && (z[1] < 1660523435060625408 || (z[1] == 1660523435060625408 && (z[0] < 9586122913090633729))))))))))) {
+			var b uint64
+			z[0], b = bits.Sub64(z[0], 9586122913090633729, 0)
+			z[1], b = bits.Sub64(z[1], 1660523435060625408, b)
+			z[2], b = bits.Sub64(z[2], 2230234197602682880, b)
+			z[3], b = bits.Sub64(z[3], 1883307231910630287, b)
+			z[4], b = bits.Sub64(z[4], 14284016967150029115, b)
+			z[5], _ = bits.Sub64(z[5], 121098312706494698, b)
+		}
+	}
+
+	// vHi * R mod q is the Montgomery form of vHi
+	if t[12] != 0 {
+		var hi Element
+		hi.SetUint64(t[12])
+		z.Add(&z, &hi)
+	}
+
+	return
+}
+
+// montReduceWide sets t[6:] = t * R**-1 mod q, with t[6:] < t / R + q;
+// t must be smaller than 2**768 * 2**63 so that the result fits on 7 words
+func montReduceWide(t *[13]uint64) {
+	for i := 0; i < 6; i++ {
+		// m = t[i]n'[0] mod W
+		m := t[i] * 9586122913090633727
+		C := madd0(m, 9586122913090633729, t[i])
+		C, t[i+1] = madd2(m, 1660523435060625408, t[i+1], C)
+		C, t[i+2] = madd2(m, 2230234197602682880, t[i+2], C)
+		C, t[i+3] = madd2(m, 1883307231910630287, t[i+3], C)
+		C, t[i+4] = madd2(m, 14284016967150029115, t[i+4], C)
+		C, t[i+5] = madd2(m, 121098312706494698, t[i+5], C)
+		for k := i + 6; k < len(t); k++ {
+			t[k], C = bits.Add64(t[k], C, 0)
+		}
+	}
+}
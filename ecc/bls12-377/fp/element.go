@@ -165,6 +165,11 @@ func (z *Element) Div(x, y *Element) *Element {
 	return z
 }
 
+// Butterfly sets z = z + b, b = z - b (mod q); method form of the package level Butterfly
+func (z *Element) Butterfly(b *Element) {
+	Butterfly(z, b)
+}
+
 // Equal returns z == x
 func (z *Element) Equal(x *Element) bool {
 	return (z[5] == x[5]) && (z[4] == x[4]) && (z[3] == x[3]) && (z[2] == x[2]) && (z[1] == x[1]) && (z[0] == x[0])
@@ -845,22 +850,89 @@ func (z *Element) SetString(s string) *Element {
 	return z
 }
 
+const (
+	// the discrete logarithms of the Tonelli-Shanks algorithm are computed in sqrtNbWindowsElement
+	// windows, the least significant one of sqrtFirstWindowElement bits and the others of sqrtWindowElement bits
+	sqrtWindowElement      = 7
+	sqrtNbWindowsElement   = 7
+	sqrtFirstWindowElement = 46 - sqrtWindowElement*(sqrtNbWindowsElement-1)
+)
+
 var (
-	_bLegendreExponentElement *big.Int
-	_bSqrtExponentElement     *big.Int
+	_sqrtTablesElement     sqrtTablesElement
+	_sqrtTablesOnceElement sync.Once
 )
 
-func init() {
-	_bLegendreExponentElement, _ = new(big.Int).SetString("d71d230be28875631d82e03650a49d8d116cf9807a89c78f79b117dd04a4000b85aea2180000004284600000000000", 16)
-	const sqrtExponentElement = "35c748c2f8a21d58c760b80d94292763445b3e601ea271e3de6c45f741290002e16ba88600000010a11"
-	_bSqrtExponentElement, _ = new(big.Int).SetString(sqrtExponentElement, 16)
+// sqrtTablesElement holds the precomputed tables of the Tonelli-Shanks discrete logarithms
+type sqrtTablesElement struct {
+	// g[shift][c] = g^(-c * 2^shift), g = nonResidue^s, for the shifts used by Sqrt
+	g [46][]Element
+
+	// dlog[g^(c * 2^(46-sqrtWindow))] = c
+	dlog map[Element]uint64
+}
+
+// sqrtShiftElement returns the position of the least significant bit of the window i
+func sqrtShiftElement(i int) int {
+	if i == 0 {
+		return 0
+	}
+	return sqrtFirstWindowElement + sqrtWindowElement*(i-1)
+}
+
+func (tables *sqrtTablesElement) init() {
+	const w, n, e = sqrtWindowElement, sqrtNbWindowsElement, 46
+
+	// shifts of the windows, and of the windows raised to 2^(w*r) in Sqrt
+	var shifts [e]bool
+	for i := 0; i < n; i++ {
+		for r := 0; r == 0 || r < n-1-i; r++ {
+			shifts[sqrtShiftElement(i)+w*r] = true
+		}
+	}
+
+	var g = Element{
+		7563926049028936178,
+		2688164645460651601,
+		12112688591437172399,
+		3177973240564633687,
+		14764383749841851163,
+		52487407124055189,
+	}
+
+	// base = g^(-2^shift)
+	var base Element
+	base.Inverse(&g)
+	for shift := 0; shift < e; shift++ {
+		if shifts[shift] {
+			tables.g[shift] = make([]Element, 1<<w)
+			tables.g[shift][0].SetOne()
+			for c := 1; c < 1<<w; c++ {
+				tables.g[shift][c].Mul(&tables.g[shift][c-1], &base)
+			}
+		}
+		base.Square(&base)
+	}
+
+	// zeta = g^(2^(e-w)) is of order 2^w
+	zeta := g
+	for i := 0; i < e-w; i++ {
+		zeta.Square(&zeta)
+	}
+	tables.dlog = make(map[Element]uint64, 1<<w)
+	var a Element
+	a.SetOne()
+	for c := uint64(0); c < 1<<w; c++ {
+		tables.dlog[a] = c
+		a.Mul(&a, &zeta)
+	}
 }
 
 // Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
 func (z *Element) Legendre() int {
 	var l Element
 	// z^((q-1)/2)
-	l.Exp(*z, _bLegendreExponentElement)
+	l.expByLegendreExp(*z)
 
 	if l.IsZero() {
 		return 0
@@ -877,70 +949,76 @@ func (z *Element) Legendre() int {
 // if the square root doesn't exist (x is not a square mod q)
 // Sqrt leaves z unchanged and returns nil
 func (z *Element) Sqrt(x *Element) *Element {
-	// q ≡ 1 (mod 4)
-	// see modSqrtTonelliShanks in math/big/int.go
-	// using https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
+	// q ≡ 1 (mod 4), q - 1 = 2^46 * s with s odd
+	// Tonelli-Shanks, with the discrete logarithm computed from precomputed tables
+	// see P. Sarkar, "Computing square roots faster than the Tonelli-Shanks/Bernstein algorithm"
+	// https://eprint.iacr.org/2020/1407
+	if x.IsZero() {
+		return z.SetZero()
+	}
+	const win, n, win0 = sqrtWindowElement, sqrtNbWindowsElement, sqrtFirstWindowElement
+	_sqrtTablesOnceElement.Do(_sqrtTablesElement.init)
+	tables := &_sqrtTablesElement
 
-	var y, b, t, w Element
+	var y, t, w Element
 	// w = x^((s-1)/2))
-	w.Exp(*x, _bSqrtExponentElement)
+	w.expBySqrtExp(*x)
 
 	// y = x^((s+1)/2)) = w * x
 	y.Mul(x, &w)
 
-	// b = x^s = w * w * x = y * x
-	b.Mul(&w, &y)
-
-	// g = nonResidue ^ s
-	var g = Element{
-		7563926049028936178,
-		2688164645460651601,
-		12112688591437172399,
-		3177973240564633687,
-		14764383749841851163,
-		52487407124055189,
+	// t = x^s = w * y = g^k, with g = nonResidue^s of order 2^46:
+	// x is a square iff k is even, and then y * g^(-k/2) is a square root of x
+	t.Mul(&w, &y)
+
+	// p[i] = t^(2^(win*i))
+	var p [n]Element
+	p[0] = t
+	for i := 1; i < n; i++ {
+		p[i] = p[i-1]
+		for j := 0; j < win; j++ {
+			p[i].Square(&p[i])
+		}
 	}
-	r := uint64(46)
 
-	// compute legendre symbol
-	// t = x^((q-1)/2) = r-1 squaring of x^s
-	t = b
-	for i := uint64(0); i < r-1; i++ {
-		t.Square(&t)
-	}
-	if t.IsZero() {
-		return z.SetZero()
-	}
-	if !((t[5] == 39800542322357402) && (t[4] == 5545221690922665192) && (t[3] == 8885205928937022213) && (t[2] == 11492539364873682930) && (t[1] == 5854854902718660529) && (t[0] == 202099033278250856)) {
-		// t != 1, we don't have a square root
-		return nil
-	}
-	for {
-		var m uint64
-		t = b
-
-		// for t != 1
-		for !((t[5] == 39800542322357402) && (t[4] == 5545221690922665192) && (t[3] == 8885205928937022213) && (t[2] == 11492539364873682930) && (t[1] == 5854854902718660529) && (t[0] == 202099033278250856)) {
-			t.Square(&t)
-			m++
+	// k is computed from the least significant window (win0 bits) to the most significant one (win bits):
+	// (t * g^(-k mod 2^sqrtShift(i)))^(2^(win*(n-1-i))) = p[n-1-i] * g^(-k[j] * 2^(sqrtShift(j)+win*(n-1-i))), j < i
+	// is of order 2^win, and its discrete logarithm gives k[i]
+	var k [n]uint64
+	for i := 0; i < n; i++ {
+		a := p[n-1-i]
+		for j := 0; j < i; j++ {
+			if k[j] != 0 {
+				a.Mul(&a, &tables.g[sqrtShiftElement(j)+win*(n-1-i)][k[j]])
+			}
 		}
+		k[i] = tables.dlog[a]
+		if i == 0 {
+			k[0] >>= win - win0
+			if k[0]&1 == 1 {
+				// k is odd, we don't have a square root
+				return nil
+			}
+		}
+	}
 
-		if m == 0 {
-			return z.Set(&y)
+	// y = y * g^(-k/2); the iterative Tonelli-Shanks (and math/big) returns y * g^(-k/2 mod 2^(46-1)),
+	// that is -y * g^(-k/2) if k != 0
+	isZero := true
+	for j := 0; j < n; j++ {
+		isZero = isZero && k[j] == 0
+		h := k[j] >> 1
+		if j+1 < n {
+			h |= (k[j+1] & 1) << (sqrtShiftElement(j+1) - sqrtShiftElement(j) - 1)
 		}
-		// t = g^(2^(r-m-1)) mod q
-		ge := int(r - m - 1)
-		t = g
-		for ge > 0 {
-			t.Square(&t)
-			ge--
+		if h != 0 {
+			y.Mul(&y, &tables.g[sqrtShiftElement(j)][h])
 		}
-
-		g.Square(&t)
-		y.Mul(&y, &t)
-		b.Mul(&b, &g)
-		r = m
 	}
+	if !isZero {
+		y.Neg(&y)
+	}
+	return z.Set(&y)
 }
 
 // Inverse z = x^-1 mod q
@@ -1154,3 +1232,612 @@ func (z *Element) Inverse(x *Element) *Element {
 	}
 
 }
+
+// expByLegendreExp is equivalent to z.Exp(x, d71d23...000000) (base 16);
+// it uses an addition chain of 373 squarings and 63 multiplications
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// t[i] = x^(2*i+1)
+	var t [16]Element
+	var x2 Element
+	x2.Square(&x)
+	t[0] = x
+	for i := 1; i < len(t); i++ {
+		t[i].Mul(&t[i-1], &x2)
+	}
+
+	z.Set(&t[6])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	z.Square(z)
+	z.Mul(z, &t[0])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[11])
+	for s := 0; s < 2; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[5])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	z.Square(z)
+	z.Mul(z, &t[0])
+	for s := 0; s < 10; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[11])
+	for s := 0; s < 12; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[13])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[13])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[15])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 12; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[9])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[13])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[15])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 19; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[11])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[5])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[11])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 29; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	z.Square(z)
+	z.Mul(z, &t[0])
+	for s := 0; s < 45; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// expBySqrtExp is equivalent to z.Exp(x, 35c748...010a11) (base 16);
+// it uses an addition chain of 327 squarings and 62 multiplications
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// t[i] = x^(2*i+1)
+	var t [16]Element
+	var x2 Element
+	x2.Square(&x)
+	t[0] = x
+	for i := 1; i < len(t); i++ {
+		t[i].Mul(&t[i-1], &x2)
+	}
+
+	z.Set(&t[6])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	z.Square(z)
+	z.Mul(z, &t[0])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[11])
+	for s := 0; s < 2; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[5])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	z.Square(z)
+	z.Mul(z, &t[0])
+	for s := 0; s < 10; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[11])
+	for s := 0; s < 12; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[13])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[13])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[15])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 12; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[9])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[13])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[15])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[14])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 19; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[11])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[5])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[11])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 29; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[8])
+
+	return z
+}
+
+// expBySqrtExpCT is equivalent to z.Exp(x, 35c748...010a11) (base 16);
+// it uses an addition chain of 327 squarings and 62 multiplications
+// (mulCT and squareCT, in constant time)
+func (z *Element) expBySqrtExpCT(x Element) *Element {
+	// t[i] = x^(2*i+1)
+	var t [16]Element
+	var x2 Element
+	x2.squareCT(&x)
+	t[0] = x
+	for i := 1; i < len(t); i++ {
+		t[i].mulCT(&t[i-1], &x2)
+	}
+
+	z.Set(&t[6])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 8; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[14])
+	for s := 0; s < 7; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[8])
+	z.squareCT(z)
+	z.mulCT(z, &t[0])
+	for s := 0; s < 9; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[11])
+	for s := 0; s < 2; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[1])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[0])
+	for s := 0; s < 9; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[14])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[5])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[1])
+	for s := 0; s < 8; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[14])
+	z.squareCT(z)
+	z.mulCT(z, &t[0])
+	for s := 0; s < 10; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[11])
+	for s := 0; s < 12; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[13])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 7; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[4])
+	for s := 0; s < 7; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[14])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[8])
+	for s := 0; s < 3; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 8; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[8])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[13])
+	for s := 0; s < 7; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[15])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[1])
+	for s := 0; s < 12; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 8; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[9])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[8])
+	for s := 0; s < 3; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 7; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 7; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[13])
+	for s := 0; s < 8; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[8])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[15])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[14])
+	for s := 0; s < 9; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[4])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[4])
+	for s := 0; s < 19; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[11])
+	for s := 0; s < 8; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[5])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[11])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[0])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[1])
+	for s := 0; s < 29; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[0])
+	for s := 0; s < 7; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 9; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[8])
+
+	return z
+}
@@ -28,9 +28,47 @@ func (p *Polynomial) Degree() uint64 {
 	return uint64(len(*p) - 1)
 }
 
+// evalChunkSize is the number of coefficients of p summed with a WideAccumulator in Eval
+const evalChunkSize = 64
+
 // Eval evaluates p at v
 // returns a fr.Element
 func (p *Polynomial) Eval(v *fr.Element) fr.Element {
+	if len(*p) < 2*evalChunkSize {
+		return p.evalHorner(v)
+	}
+
+	// p(v) = sum_c (sum_j p[c+j] * v**j) * (v**evalChunkSize)**(c/evalChunkSize): the inner sums
+	// are accumulated without reduction, the outer sum is evaluated with Horner's method
+	var powers [evalChunkSize]fr.Element
+	powers[0].SetOne()
+	for j := 1; j < evalChunkSize; j++ {
+		powers[j].Mul(&powers[j-1], v)
+	}
+	var vChunk fr.Element
+	vChunk.Mul(&powers[evalChunkSize-1], v)
+
+	var res fr.Element
+	var acc fr.WideAccumulator
+	for c := (len(*p) - 1) / evalChunkSize * evalChunkSize; c >= 0; c -= evalChunkSize {
+		chunk := (*p)[c:]
+		if len(chunk) > evalChunkSize {
+			chunk = chunk[:evalChunkSize]
+		}
+		acc.Reset()
+		for j := range chunk {
+			acc.MulAdd(&chunk[j], &powers[j])
+		}
+		sum := acc.Reduce()
+		res.Mul(&res, &vChunk)
+		res.Add(&res, &sum)
+	}
+
+	return res
+}
+
+// evalHorner evaluates p at v with Horner's method
+func (p *Polynomial) evalHorner(v *fr.Element) fr.Element {
 
 	res := (*p)[len(*p)-1]
 	for i := len(*p) - 2; i >= 0; i-- {
@@ -64,9 +102,8 @@ func (p *Polynomial) SubConstantInPlace(c *fr.Element) {
 
 // ScaleInPlace multiplies p by v, modifying p
 func (p *Polynomial) ScaleInPlace(c *fr.Element) {
-	for i := 0; i < len(*p); i++ {
-		(*p)[i].Mul(&(*p)[i], c)
-	}
+	v := fr.Vector(*p)
+	v.ScalarMul(v, c)
 }
 
 // Add adds p1 to p2
@@ -80,25 +117,22 @@ func (p *Polynomial) Add(p1, p2 Polynomial) *Polynomial {
 	}
 
 	if len(*p) == len(bigger) && (&(*p)[0] == &bigger[0]) {
-		for i := 0; i < len(smaller); i++ {
-			(*p)[i].Add(&(*p)[i], &smaller[i])
-		}
+		v := fr.Vector((*p)[:len(smaller)])
+		v.Add(v, fr.Vector(smaller))
 		return p
 	}
 
 	if len(*p) == len(smaller) && (&(*p)[0] == &smaller[0]) {
-		for i := 0; i < len(smaller); i++ {
-			(*p)[i].Add(&(*p)[i], &bigger[i])
-		}
+		v := fr.Vector(*p)
+		v.Add(v, fr.Vector(bigger[:len(smaller)]))
 		*p = append(*p, bigger[len(smaller):]...)
 		return p
 	}
 
 	res := make(Polynomial, len(bigger))
 	copy(res, bigger)
-	for i := 0; i < len(smaller); i++ {
-		res[i].Add(&res[i], &smaller[i])
-	}
+	v := fr.Vector(res[:len(smaller)])
+	v.Add(v, fr.Vector(smaller))
 	*p = res
 	return p
 }
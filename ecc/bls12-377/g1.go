@@ -17,11 +17,9 @@
 package bls12377
 
 import (
-	"math"
 	"math/big"
 	"runtime"
 
-	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/internal/parallel"
@@ -37,7 +35,7 @@ type G1Jac struct {
 	X, Y, Z fp.Element
 }
 
-//  g1JacExtended parameterized jacobian coordinates (x=X/ZZ, y=Y/ZZZ, ZZ**3=ZZZ**2)
+// g1JacExtended parameterized jacobian coordinates (x=X/ZZ, y=Y/ZZZ, ZZ**3=ZZZ**2)
 type g1JacExtended struct {
 	X, Y, ZZ, ZZZ fp.Element
 }
@@ -426,9 +424,8 @@ func (p *G1Jac) phi(a *G1Jac) *G1Jac {
 func (p *G1Jac) mulGLV(a *G1Jac, s *big.Int) *G1Jac {
 
 	var table [15]G1Jac
-	var zero big.Int
 	var res G1Jac
-	var k1, k2 fr.Element
+	var e fr.Element
 
 	res.Set(&g1Infinity)
 
@@ -437,14 +434,13 @@ func (p *G1Jac) mulGLV(a *G1Jac, s *big.Int) *G1Jac {
 	table[3].phi(a)
 
 	// split the scalar, modifies +-a, phi(a) accordingly
-	k := ecc.SplitScalar(s, &glvBasis)
+	e.SetBigInt(s).FromMont()
+	k1, k2, neg1, neg2 := splitScalar((*[fr.Limbs]uint64)(&e))
 
-	if k[0].Cmp(&zero) == -1 {
-		k[0].Neg(&k[0])
+	if neg1 {
 		table[0].Neg(&table[0])
 	}
-	if k[1].Cmp(&zero) == -1 {
-		k[1].Neg(&k[1])
+	if neg2 {
 		table[3].Neg(&table[3])
 	}
 
@@ -464,12 +460,8 @@ func (p *G1Jac) mulGLV(a *G1Jac, s *big.Int) *G1Jac {
 	table[13].Set(&table[11]).AddAssign(&table[1])
 	table[14].Set(&table[11]).AddAssign(&table[2])
 
-	// bounds on the lattice base vectors guarantee that k1, k2 are len(r)/2 bits long max
-	k1.SetBigInt(&k[0]).FromMont()
-	k2.SetBigInt(&k[1]).FromMont()
-
-	// loop starts from len(k1)/2 due to the bounds
-	for i := int(math.Ceil(fr.Limbs/2. - 1)); i >= 0; i-- {
+	// bounds on the lattice base vectors guarantee that k1, k2 are glvLimbs words long max
+	for i := glvLimbs - 1; i >= 0; i-- {
 		mask := uint64(3) << 62
 		for j := 0; j < 32; j++ {
 			res.Double(&res).Double(&res)
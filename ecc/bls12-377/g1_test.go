@@ -418,6 +418,58 @@ func TestG1AffineCofactorCleaning(t *testing.T) {
 			return point.IsOnCurve() && pointCleared.IsInSubGroup() && !pointCleared.Equal(&infinity)
 		},
 	))
+
+	properties.Property("[BLS12-377] IsInSubGroup should match the [r]p == 0 check on random, cofactor-cleared and small torsion points", prop.ForAll(
+		func(s fr.Element) bool {
+			var a, x, b fp.Element
+			a.SetRandom()
+
+			x.Square(&a).Mul(&x, &a).Add(&x, &bCurveCoeff)
+
+			for x.Legendre() != 1 {
+				a.SetRandom()
+
+				x.Square(&a).Mul(&x, &a).Add(&x, &bCurveCoeff)
+
+			}
+
+			b.Sqrt(&x)
+			var point, pointCleared, rp G1Jac
+			point.X.Set(&a)
+			point.Y.Set(&b)
+			point.Z.SetOne()
+			pointCleared.ClearCofactor(&point)
+			r := fr.Modulus()
+			rp.mulWindowed(&point, r)
+			if point.IsInSubGroup() != rp.Z.IsZero() {
+				return false
+			}
+
+			// [k]g1Gen and cleared points are in the r-torsion
+			var k big.Int
+			var kGen G1Jac
+			kGen.mulWindowed(&g1Gen, s.ToBigIntRegular(&k))
+			if !kGen.IsInSubGroup() || !pointCleared.IsInSubGroup() {
+				return false
+			}
+			rp.mulWindowed(&pointCleared, r)
+			if !rp.Z.IsZero() {
+				return false
+			}
+
+			// [r]point is in the torsion of order dividing the cofactor, coprime to r:
+			// it is not in the r-torsion unless it is the infinity, and neither is its sum with kGen
+			torsion := rp
+			torsion.mulWindowed(&point, r)
+			if torsion.Z.IsZero() {
+				return true
+			}
+			var mixed G1Jac
+			mixed.Set(&kGen).AddAssign(&torsion)
+			return !torsion.IsInSubGroup() && !mixed.IsInSubGroup()
+		},
+		GenFr(),
+	))
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
@@ -664,3 +716,116 @@ func fuzzExtendedJacobianG1Affine(p *g1JacExtended, f fp.Element) g1JacExtended
 	res.ZZZ.Mul(&p.ZZZ, &fff)
 	return res
 }
+
+func FuzzG1Jac(f *testing.F) {
+	f.Add([]byte{1}, []byte{2})
+	f.Add([]byte{}, []byte{42})
+	f.Add([]byte{3}, []byte{3})
+	{
+		var r, rMinusOne big.Int
+		r.Set(fr.Modulus())
+		rMinusOne.Sub(&r, big.NewInt(1))
+		f.Add(r.Bytes(), rMinusOne.Bytes())
+	}
+	var b big.Int
+	bCurveCoeff.ToBigIntRegular(&b)
+	curve := newRefCurve(fp.Modulus(), &b)
+
+	toRef := func(p *G1Jac) refPoint {
+		var a G1Affine
+		var res refPoint
+		a.FromJacobian(p)
+		res.inf = a.IsInfinity()
+		a.X.ToBigIntRegular(&res.x)
+		a.Y.ToBigIntRegular(&res.y)
+		return res
+	}
+	refGen := toRef(&g1Gen)
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		var s1, s2, sum big.Int
+		s1.SetBytes(a)
+		s2.SetBytes(b)
+		sum.Add(&s1, &s2)
+
+		var p1, p2, p, q G1Jac
+		p1.ScalarMultiplication(&g1Gen, &s1)
+		p2.ScalarMultiplication(&g1Gen, &s2)
+		if !p1.IsOnCurve() || !p1.IsInSubGroup() {
+			t.Fatal("[s1]G is not in the subgroup")
+		}
+
+		// [s1]G + [s2]G = [s1+s2]G
+		p.Set(&p1).AddAssign(&p2)
+		q.mulWindowed(&g1Gen, &sum)
+		if !p.Equal(&q) {
+			t.Fatal("[s1]G + [s2]G != [s1+s2]G")
+		}
+
+		// GLV and windowed scalar multiplications agree
+		q.mulWindowed(&g1Gen, &s1)
+		if !p1.Equal(&q) {
+			t.Fatal("GLV and windowed scalar multiplications mismatch")
+		}
+
+		// Double and AddAssign agree, SubAssign and Neg agree
+		p.Double(&p1)
+		q.Set(&p1).AddAssign(&p1)
+		if !p.Equal(&q) {
+			t.Fatal("2P != P + P")
+		}
+		p.Set(&p1).SubAssign(&p2)
+		q.Neg(&p2).AddAssign(&p1)
+		if !p.Equal(&q) {
+			t.Fatal("P - Q != -Q + P")
+		}
+
+		// mixed and extended Jacobian additions agree
+		var a2 G1Affine
+		var e g1JacExtended
+		a2.FromJacobian(&p2)
+		p.Set(&p1).AddMixed(&a2)
+		e.setInfinity()
+		e.addMixed(&a2)
+		{
+			var e1 g1JacExtended
+			var a1 G1Affine
+			a1.FromJacobian(&p1)
+			e1.setInfinity()
+			e1.addMixed(&a1)
+			e.add(&e1)
+		}
+		q.fromJacExtended(&e)
+		if !p.Equal(&q) {
+			t.Fatal("AddMixed and extended Jacobian add mismatch")
+		}
+
+		// compare with the math/big reference
+		r1 := curve.scalarMul(&refGen, &s1)
+		r2 := curve.scalarMul(&refGen, &s2)
+		if !curve.isOnCurve(&r1) {
+			t.Fatal("reference point is not on the curve")
+		}
+		check := func(op string, p *G1Jac, expected *refPoint) {
+			t.Helper()
+			res := toRef(p)
+			if !curve.equal(&res, expected) {
+				t.Fatalf("%s doesn't match the math/big reference", op)
+			}
+		}
+		check("ScalarMultiplication", &p1, &r1)
+		check("ScalarMultiplication", &p2, &r2)
+
+		expected := curve.add(&r1, &r2)
+		check("AddAssign", p.Set(&p1).AddAssign(&p2), &expected)
+		check("AddMixed", p.Set(&p1).AddMixed(&a2), &expected)
+
+		expected = curve.double(&r1)
+		check("Double", p.Double(&p1), &expected)
+		check("DoubleAssign", p.Set(&p1).DoubleAssign(), &expected)
+
+		expected = curve.neg(&r2)
+		expected = curve.add(&r1, &expected)
+		check("SubAssign", p.Set(&p1).SubAssign(&p2), &expected)
+	})
+}
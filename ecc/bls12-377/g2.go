@@ -17,11 +17,9 @@
 package bls12377
 
 import (
-	"math"
 	"math/big"
 	"runtime"
 
-	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/internal/fptower"
 	"github.com/consensys/gnark-crypto/internal/parallel"
@@ -37,7 +35,7 @@ type G2Jac struct {
 	X, Y, Z fptower.E2
 }
 
-//  g2JacExtended parameterized jacobian coordinates (x=X/ZZ, y=Y/ZZZ, ZZ**3=ZZZ**2)
+// g2JacExtended parameterized jacobian coordinates (x=X/ZZ, y=Y/ZZZ, ZZ**3=ZZZ**2)
 type g2JacExtended struct {
 	X, Y, ZZ, ZZZ fptower.E2
 }
@@ -436,9 +434,8 @@ func (p *G2Jac) phi(a *G2Jac) *G2Jac {
 func (p *G2Jac) mulGLV(a *G2Jac, s *big.Int) *G2Jac {
 
 	var table [15]G2Jac
-	var zero big.Int
 	var res G2Jac
-	var k1, k2 fr.Element
+	var e fr.Element
 
 	res.Set(&g2Infinity)
 
@@ -447,14 +444,13 @@ func (p *G2Jac) mulGLV(a *G2Jac, s *big.Int) *G2Jac {
 	table[3].phi(a)
 
 	// split the scalar, modifies +-a, phi(a) accordingly
-	k := ecc.SplitScalar(s, &glvBasis)
+	e.SetBigInt(s).FromMont()
+	k1, k2, neg1, neg2 := splitScalar((*[fr.Limbs]uint64)(&e))
 
-	if k[0].Cmp(&zero) == -1 {
-		k[0].Neg(&k[0])
+	if neg1 {
 		table[0].Neg(&table[0])
 	}
-	if k[1].Cmp(&zero) == -1 {
-		k[1].Neg(&k[1])
+	if neg2 {
 		table[3].Neg(&table[3])
 	}
 
@@ -474,12 +470,8 @@ func (p *G2Jac) mulGLV(a *G2Jac, s *big.Int) *G2Jac {
 	table[13].Set(&table[11]).AddAssign(&table[1])
 	table[14].Set(&table[11]).AddAssign(&table[2])
 
-	// bounds on the lattice base vectors guarantee that k1, k2 are len(r)/2 bits long max
-	k1.SetBigInt(&k[0]).FromMont()
-	k2.SetBigInt(&k[1]).FromMont()
-
-	// loop starts from len(k1)/2 due to the bounds
-	for i := int(math.Ceil(fr.Limbs/2. - 1)); i >= 0; i-- {
+	// bounds on the lattice base vectors guarantee that k1, k2 are glvLimbs words long max
+	for i := glvLimbs - 1; i >= 0; i-- {
 		mask := uint64(3) << 62
 		for j := 0; j < 32; j++ {
 			res.Double(&res).Double(&res)
@@ -437,6 +437,55 @@ func TestG2AffineCofactorCleaning(t *testing.T) {
 			return point.IsOnCurve() && pointCleared.IsInSubGroup() && !pointCleared.Equal(&infinity)
 		},
 	))
+
+	properties.Property("[BLS12-377] IsInSubGroup should match the [r]p == 0 check on random, cofactor-cleared and small torsion points", prop.ForAll(
+		func(s fr.Element) bool {
+			var a, x, b fptower.E2
+			a.SetRandom()
+
+			x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+			for x.Legendre() != 1 {
+				a.SetRandom()
+				x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+			}
+
+			b.Sqrt(&x)
+			var point, pointCleared, rp G2Jac
+			point.X.Set(&a)
+			point.Y.Set(&b)
+			point.Z.SetOne()
+			pointCleared.ClearCofactor(&point)
+			r := fr.Modulus()
+			rp.mulWindowed(&point, r)
+			if point.IsInSubGroup() != rp.Z.IsZero() {
+				return false
+			}
+
+			// [k]g2Gen and cleared points are in the r-torsion
+			var k big.Int
+			var kGen G2Jac
+			kGen.mulWindowed(&g2Gen, s.ToBigIntRegular(&k))
+			if !kGen.IsInSubGroup() || !pointCleared.IsInSubGroup() {
+				return false
+			}
+			rp.mulWindowed(&pointCleared, r)
+			if !rp.Z.IsZero() {
+				return false
+			}
+
+			// [r]point is in the torsion of order dividing the cofactor, coprime to r:
+			// it is not in the r-torsion unless it is the infinity, and neither is its sum with kGen
+			torsion := rp
+			torsion.mulWindowed(&point, r)
+			if torsion.Z.IsZero() {
+				return true
+			}
+			var mixed G2Jac
+			mixed.Set(&kGen).AddAssign(&torsion)
+			return !torsion.IsInSubGroup() && !mixed.IsInSubGroup()
+		},
+		GenFr(),
+	))
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
@@ -683,3 +732,74 @@ func fuzzExtendedJacobianG2Affine(p *g2JacExtended, f fptower.E2) g2JacExtended
 	res.ZZZ.Mul(&p.ZZZ, &fff)
 	return res
 }
+
+func FuzzG2Jac(f *testing.F) {
+	f.Add([]byte{1}, []byte{2})
+	f.Add([]byte{}, []byte{42})
+	f.Add([]byte{3}, []byte{3})
+	{
+		var r, rMinusOne big.Int
+		r.Set(fr.Modulus())
+		rMinusOne.Sub(&r, big.NewInt(1))
+		f.Add(r.Bytes(), rMinusOne.Bytes())
+	}
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		var s1, s2, sum big.Int
+		s1.SetBytes(a)
+		s2.SetBytes(b)
+		sum.Add(&s1, &s2)
+
+		var p1, p2, p, q G2Jac
+		p1.ScalarMultiplication(&g2Gen, &s1)
+		p2.ScalarMultiplication(&g2Gen, &s2)
+		if !p1.IsOnCurve() || !p1.IsInSubGroup() {
+			t.Fatal("[s1]G is not in the subgroup")
+		}
+
+		// [s1]G + [s2]G = [s1+s2]G
+		p.Set(&p1).AddAssign(&p2)
+		q.mulWindowed(&g2Gen, &sum)
+		if !p.Equal(&q) {
+			t.Fatal("[s1]G + [s2]G != [s1+s2]G")
+		}
+
+		// GLV and windowed scalar multiplications agree
+		q.mulWindowed(&g2Gen, &s1)
+		if !p1.Equal(&q) {
+			t.Fatal("GLV and windowed scalar multiplications mismatch")
+		}
+
+		// Double and AddAssign agree, SubAssign and Neg agree
+		p.Double(&p1)
+		q.Set(&p1).AddAssign(&p1)
+		if !p.Equal(&q) {
+			t.Fatal("2P != P + P")
+		}
+		p.Set(&p1).SubAssign(&p2)
+		q.Neg(&p2).AddAssign(&p1)
+		if !p.Equal(&q) {
+			t.Fatal("P - Q != -Q + P")
+		}
+
+		// mixed and extended Jacobian additions agree
+		var a2 G2Affine
+		var e g2JacExtended
+		a2.FromJacobian(&p2)
+		p.Set(&p1).AddMixed(&a2)
+		e.setInfinity()
+		e.addMixed(&a2)
+		{
+			var e1 g2JacExtended
+			var a1 G2Affine
+			a1.FromJacobian(&p1)
+			e1.setInfinity()
+			e1.addMixed(&a1)
+			e.add(&e1)
+		}
+		q.fromJacExtended(&e)
+		if !p.Equal(&q) {
+			t.Fatal("AddMixed and extended Jacobian add mismatch")
+		}
+	})
+}
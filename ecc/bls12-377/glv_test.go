@@ -0,0 +1,108 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// glvToBigInt sets res to the signed integer of the limbs k
+func glvToBigInt(k *[glvLimbs]uint64, neg bool, res *big.Int) *big.Int {
+	res.SetUint64(0)
+	for i := glvLimbs - 1; i >= 0; i-- {
+		res.Lsh(res, 64).Add(res, new(big.Int).SetUint64(k[i]))
+	}
+	if neg {
+		res.Neg(res)
+	}
+	return res
+}
+
+func TestSplitScalar(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	properties.Property("[BLS12-377] splitScalar should output k1, k2 such that k1 + k2*lambdaGLV = s mod r", prop.ForAll(
+		func(s fr.Element) bool {
+			var _k1, _k2, _s big.Int
+			s.ToBigIntRegular(&_s)
+			s.FromMont()
+			k1, k2, neg1, neg2 := splitScalar((*[fr.Limbs]uint64)(&s))
+
+			glvToBigInt(&k1, neg1, &_k1)
+			glvToBigInt(&k2, neg2, &_k2)
+			_k2.Mul(&_k2, &lambdaGLV).Add(&_k2, &_k1).Mod(&_k2, fr.Modulus())
+
+			return _k2.Cmp(&_s) == 0
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases
+	for _, v := range []uint64{0, 1} {
+		s := [fr.Limbs]uint64{v}
+		k1, k2, neg1, neg2 := splitScalar(&s)
+		if k1 != [glvLimbs]uint64{v} || k2 != [glvLimbs]uint64{} || neg1 || neg2 {
+			t.Fatal("splitScalar failed on", v)
+		}
+	}
+}
+
+func TestSplitScalarAllocs(t *testing.T) {
+	var s fr.Element
+	s.SetRandom()
+	s.FromMont()
+	allocs := testing.AllocsPerRun(100, func() {
+		splitScalar((*[fr.Limbs]uint64)(&s))
+	})
+	if allocs != 0 {
+		t.Fatal("splitScalar should not allocate")
+	}
+}
+
+func BenchmarkSplitScalar(b *testing.B) {
+	var s fr.Element
+	var _s big.Int
+	s.SetRandom()
+	s.ToBigIntRegular(&_s)
+	s.FromMont()
+
+	b.Run("splitScalar", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			splitScalar((*[fr.Limbs]uint64)(&s))
+		}
+	})
+	b.Run("ecc.SplitScalar", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ecc.SplitScalar(&_s, &glvBasis)
+		}
+	})
+}
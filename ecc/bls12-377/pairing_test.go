@@ -205,9 +205,109 @@ func TestPairing(t *testing.T) {
 		genR2,
 	))
 
+	properties.Property("[BLS12-377] MillerLoopSteps should output the same result as MillerLoop", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint big.Int
+
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			steps, res := MillerLoopSteps(&ag1, &bg2)
+			ml, _ := MillerLoop([]G1Affine{ag1}, []G2Affine{bg2})
+
+			return len(steps) > 0 && res.Equal(&ml)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS12-377] MillerLoopSteps accumulators should be recomputed from the returned lines", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint big.Int
+
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			steps, _ := MillerLoopSteps(&ag1, &bg2)
+
+			return checkMillerLoopSteps(&ag1, steps)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS12-377] FinalExponentiationHard(FinalExponentiationEasy) should equal FinalExponentiation", prop.ForAll(
+		func(a GT) bool {
+			b := FinalExponentiationEasy(&a)
+			b = FinalExponentiationHard(&b)
+			c := FinalExponentiation(&a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+// checkMillerLoopSteps recomputes the line evaluations and the accumulators of MillerLoopSteps
+// from the returned lines: each digit of a loop counter below the leading one squares the
+// accumulator and multiplies a doubling line in, then an addition line if the digit is not zero.
+func checkMillerLoopSteps(P *G1Affine, steps []MillerLoopStep) bool {
+	var acc GT
+	i := 0
+	next := func(doubling bool) bool {
+		if i >= len(steps) {
+			return false
+		}
+		s := &steps[i]
+		i++
+
+		e := s.Line
+		e.R0.MulByElement(&e.R0, &P.Y)
+		e.R1.MulByElement(&e.R1, &P.X)
+		if e != s.Evaluation {
+			return false
+		}
+
+		if doubling {
+			acc.Square(&acc)
+		}
+		acc.MulBy034(&e.R0, &e.R1, &e.R2)
+		return acc.Equal(&s.Accumulator)
+	}
+	loop := func(counter []int8) bool {
+		acc.SetOne()
+		for j := len(counter) - 2; j >= 0; j-- {
+			if !next(true) {
+				return false
+			}
+			if counter[j] != 0 && !next(false) {
+				return false
+			}
+		}
+		return true
+	}
+	if !loop(loopCounter[:]) {
+		return false
+	}
+
+	return i == len(steps)
+}
+
 // ------------------------------------------------------------
 // benches
 
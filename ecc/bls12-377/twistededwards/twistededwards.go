@@ -41,3 +41,8 @@ func init() {
 	edwards.Base.X.SetString("717051916204163000937139483451426116831771857428389560441264442629694842243")
 	edwards.Base.Y.SetString("882565546457454111605105352482086902132191855952243170543452705048019814192")
 }
+
+// mulByA multiplies x by the curve coefficient a=-1
+func mulByA(x *fr.Element) {
+	x.Neg(x)
+}
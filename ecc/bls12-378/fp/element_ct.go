@@ -0,0 +1,412 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"math/bits"
+)
+
+// InverseCT z = x^-1 mod q, in constant time
+// if x == 0, sets and returns z = 0
+//
+// It implements the safegcd algorithm of Bernstein and Yang, "Fast constant-time gcd computation
+// and modular inversion" (https://eprint.iacr.org/2019/266), in batches of 62 divsteps on signed
+// 62-bit limbs, following the constant time modular inversion of libsecp256k1 (src/modinv64_impl.h).
+// The number of divsteps, 1116, is fixed by the bit size of q.
+func (z *Element) InverseCT(x *Element) *Element {
+	// f = q, g = x and the invariants f * R**2 = d * x, g * R**2 = e * x (mod q):
+	// when g reaches 0, f = ±1 and ±d = x**-1 * R**2 is the Montgomery form of x**-1
+	var f, g, d, e signed62
+	f = qSigned62
+	g.setWords(x)
+	e.setWords(&rSquare)
+
+	delta := int64(1)
+	for i := 0; i < divstepsBatches; i++ {
+		var t divstepsMatrix
+		delta = divsteps62(delta, uint64(f[0]), uint64(g[0]), &t)
+		updateDE(&d, &e, &t)
+		updateFG(&f, &g, &t)
+	}
+
+	d.normalize(f[signed62Limbs-1])
+	d.words(z)
+	return z
+}
+
+// SqrtCT z = √x mod q, in constant time
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil; the running time only depends on whether x is a square
+//
+// Unlike Sqrt, it doesn't branch on intermediate values: Tonelli-Shanks does 41 - 1 fixed
+// iterations, with conditional selections (https://www.rfc-editor.org/rfc/rfc9380#appendix-I.4).
+// It uses mulCT, squareCT, addCT and subCT rather than Mul, Square, Add and Sub, whose final subtraction
+// is a branch in the generic Go code (moduli without assembly, targets other than amd64 and arm64);
+// SqrtCT is constant time on all targets.
+func (z *Element) SqrtCT(x *Element) *Element {
+	// q ≡ 1 (mod 4), q - 1 = 2**41 * s
+	var one, w, y, t, b, c, tmp Element
+	one.SetOne()
+
+	// w = x^((s-1)/2), y = x^((s+1)/2), t = x^s
+	w.expBySqrtExpCT(*x)
+	y.mulCT(x, &w)
+	t.mulCT(&w, &y)
+
+	// c = nonResidue ^ s
+	c = Element{
+		15655215628902554004,
+		15894127656167592378,
+		9702012166408397168,
+		12335982559306940759,
+		1313802173610541430,
+		81629743607937133,
+	}
+
+	for i := 41; i >= 2; i-- {
+		// b = t^(2^(i-2)), y and t are updated if b != 1
+		b = t
+		for j := 1; j <= i-2; j++ {
+			b.squareCT(&b)
+		}
+		isOne := ctEqual(&b, &one)
+
+		tmp.mulCT(&y, &c)
+		y.ctSelect(isOne, &tmp, &y)
+		c.squareCT(&c)
+		tmp.mulCT(&t, &c)
+		t.ctSelect(isOne, &tmp, &t)
+	}
+
+	// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+	var square Element
+	square.squareCT(&y)
+	if ctEqual(&square, x) == 1 {
+		return z.Set(&y)
+	}
+	return nil
+}
+
+// mulCT z = x * y (mod q), in constant time
+//
+// The product is reduced with montReduceWide: x * y < q * R, so the result is smaller than 2q
+// and the final subtraction of q is a conditional selection
+func (z *Element) mulCT(x, y *Element) *Element {
+	var acc WideAccumulator
+	acc.MulAdd(x, y)
+	montReduceWide(&acc.t)
+
+	var v, s Element
+	copy(v[:], acc.t[6:12])
+	var b uint64
+	s[0], b = bits.Sub64(v[0], 11045256207009841153, 0)
+	s[1], b = bits.Sub64(v[1], 14886639130118979584, b)
+	s[2], b = bits.Sub64(v[2], 10956628289047010687, b)
+	s[3], b = bits.Sub64(v[3], 9513184293603517222, b)
+	s[4], b = bits.Sub64(v[4], 6038022134869067682, b)
+	s[5], b = bits.Sub64(v[5], 283357621510263184, b)
+	_, b = bits.Sub64(acc.t[12], 0, b)
+
+	// b == 1 if v < q
+	return z.ctSelect(b, &s, &v)
+}
+
+// squareCT z = x * x (mod q), in constant time
+func (z *Element) squareCT(x *Element) *Element {
+	return z.mulCT(x, x)
+}
+
+// addCT z = x + y (mod q), in constant time
+func (z *Element) addCT(x, y *Element) *Element {
+	var v, s Element
+	var c, b uint64
+	v[0], c = bits.Add64(x[0], y[0], 0)
+	v[1], c = bits.Add64(x[1], y[1], c)
+	v[2], c = bits.Add64(x[2], y[2], c)
+	v[3], c = bits.Add64(x[3], y[3], c)
+	v[4], c = bits.Add64(x[4], y[4], c)
+	v[5], c = bits.Add64(x[5], y[5], c)
+
+	s[0], b = bits.Sub64(v[0], 11045256207009841153, 0)
+	s[1], b = bits.Sub64(v[1], 14886639130118979584, b)
+	s[2], b = bits.Sub64(v[2], 10956628289047010687, b)
+	s[3], b = bits.Sub64(v[3], 9513184293603517222, b)
+	s[4], b = bits.Sub64(v[4], 6038022134869067682, b)
+	s[5], b = bits.Sub64(v[5], 283357621510263184, b)
+	_, b = bits.Sub64(c, 0, b)
+
+	// b == 1 if x + y < q
+	return z.ctSelect(b, &s, &v)
+}
+
+// subCT z = x - y (mod q), in constant time
+func (z *Element) subCT(x, y *Element) *Element {
+	var b, c uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+	z[4], b = bits.Sub64(x[4], y[4], b)
+	z[5], b = bits.Sub64(x[5], y[5], b)
+
+	// adds q if x < y
+	mask := -b
+	z[0], c = bits.Add64(z[0], 11045256207009841153&mask, 0)
+	z[1], c = bits.Add64(z[1], 14886639130118979584&mask, c)
+	z[2], c = bits.Add64(z[2], 10956628289047010687&mask, c)
+	z[3], c = bits.Add64(z[3], 9513184293603517222&mask, c)
+	z[4], c = bits.Add64(z[4], 6038022134869067682&mask, c)
+	z[5], _ = bits.Add64(z[5], 283357621510263184&mask, c)
+	return z
+}
+
+// ctEqual returns 1 if x == y, 0 otherwise, in constant time
+func ctEqual(x, y *Element) uint64 {
+	t := x[0] ^ y[0]
+	t |= x[1] ^ y[1]
+	t |= x[2] ^ y[2]
+	t |= x[3] ^ y[3]
+	t |= x[4] ^ y[4]
+	t |= x[5] ^ y[5]
+	return 1 ^ ((t | -t) >> 63)
+}
+
+// ctSelect sets z = x0 if c == 0, z = x1 if c == 1, in constant time
+func (z *Element) ctSelect(c uint64, x0, x1 *Element) *Element {
+	mask := -c
+	z[0] = x0[0] ^ (mask & (x0[0] ^ x1[0]))
+	z[1] = x0[1] ^ (mask & (x0[1] ^ x1[1]))
+	z[2] = x0[2] ^ (mask & (x0[2] ^ x1[2]))
+	z[3] = x0[3] ^ (mask & (x0[3] ^ x1[3]))
+	z[4] = x0[4] ^ (mask & (x0[4] ^ x1[4]))
+	z[5] = x0[5] ^ (mask & (x0[5] ^ x1[5]))
+	return z
+}
+
+const (
+	// signed62Limbs is the number of 62-bit limbs of the safegcd integers: f, g in [-q, q], d, e in (-2q, q)
+	signed62Limbs = 7
+
+	// divstepsBatches is the number of batches of 62 divsteps; Bernstein-Yang, Theorem 11.2:
+	// 1116 divsteps bring g to 0 for 378-bit inputs
+	divstepsBatches = 18
+
+	mask62 = (1 << 62) - 1
+
+	// qInv62 = q**-1 mod 2**62
+	qInv62 uint64 = 2789801848272322561
+)
+
+// signed62 is a signed integer sum(s[i] * 2**(62*i)); s[i] < 2**62 for i < signed62Limbs - 1
+// and the most significant limb holds the sign
+type signed62 [signed62Limbs]int64
+
+// qSigned62 is q on 62-bit limbs
+var qSigned62 = signed62{
+	1821884170155065345,
+	4206324299347263490,
+	61983924511430652,
+	101240358209898918,
+	818850353306378884,
+	4233671284011450703,
+	62,
+}
+
+// divstepsMatrix is the transition matrix of 62 divsteps, scaled by 2**62:
+// (f, g) * 2**62 = (u*f0 + v*g0, q*f0 + r*g0)
+type divstepsMatrix struct {
+	u, v, q, r int64
+}
+
+// divsteps62 applies 62 divsteps to (delta, f, g), from the 62 least significant bits of f and g,
+// stores the transition matrix in t and returns the new delta
+func divsteps62(delta int64, f, g uint64, t *divstepsMatrix) int64 {
+	// the matrix entries are in [-2**62, 2**62], computed mod 2**64
+	u, v, q, r := uint64(1), uint64(0), uint64(0), uint64(1)
+	for i := 0; i < 62; i++ {
+		// c1 = -1 if delta > 0, c2 = -1 if g is odd
+		c1 := uint64((-delta) >> 63)
+		c2 := -(g & 1)
+
+		// if g is odd: g = g - f if delta > 0, g = g + f otherwise (and q, r likewise)
+		x := (f ^ c1) - c1
+		y := (u ^ c1) - c1
+		w := (v ^ c1) - c1
+		g += x & c2
+		q += y & c2
+		r += w & c2
+
+		// if delta > 0 and g is odd: f = g (and u, v = q, r), delta = -delta
+		c1 &= c2
+		delta = (delta ^ int64(c1)) - int64(c1)
+		f += g & c1
+		u += q & c1
+		v += r & c1
+
+		delta++
+		g >>= 1
+		u <<= 1
+		v <<= 1
+	}
+	t.u, t.v, t.q, t.r = int64(u), int64(v), int64(q), int64(r)
+	return delta
+}
+
+// updateFG sets f, g = (u*f + v*g) / 2**62, (q*f + r*g) / 2**62
+func updateFG(f, g *signed62, t *divstepsMatrix) {
+	var cf, cg int128
+	cf.mulAdd(t.u, f[0])
+	cf.mulAdd(t.v, g[0])
+	cg.mulAdd(t.q, f[0])
+	cg.mulAdd(t.r, g[0])
+	// the 62 least significant bits are 0
+	cf.rsh62()
+	cg.rsh62()
+
+	for i := 1; i < signed62Limbs; i++ {
+		cf.mulAdd(t.u, f[i])
+		cf.mulAdd(t.v, g[i])
+		cg.mulAdd(t.q, f[i])
+		cg.mulAdd(t.r, g[i])
+		f[i-1] = int64(cf.lo & mask62)
+		g[i-1] = int64(cg.lo & mask62)
+		cf.rsh62()
+		cg.rsh62()
+	}
+	f[signed62Limbs-1] = int64(cf.lo)
+	g[signed62Limbs-1] = int64(cg.lo)
+}
+
+// updateDE sets d, e = (u*d + v*e) / 2**62, (q*d + r*e) / 2**62 mod q;
+// d and e stay in (-2q, q)
+func updateDE(d, e *signed62, t *divstepsMatrix) {
+	// md, me start as (u, q) if d < 0, plus (v, r) if e < 0
+	sd := d[signed62Limbs-1] >> 63
+	se := e[signed62Limbs-1] >> 63
+	md := (t.u & sd) + (t.v & se)
+	me := (t.q & sd) + (t.r & se)
+
+	var cd, ce int128
+	cd.mulAdd(t.u, d[0])
+	cd.mulAdd(t.v, e[0])
+	ce.mulAdd(t.q, d[0])
+	ce.mulAdd(t.r, e[0])
+
+	// correct md, me so that u*d + v*e + q*md and q*d + r*e + q*me are divisible by 2**62
+	md -= int64((qInv62*cd.lo + uint64(md)) & mask62)
+	me -= int64((qInv62*ce.lo + uint64(me)) & mask62)
+	cd.mulAdd(qSigned62[0], md)
+	ce.mulAdd(qSigned62[0], me)
+	cd.rsh62()
+	ce.rsh62()
+
+	for i := 1; i < signed62Limbs; i++ {
+		cd.mulAdd(t.u, d[i])
+		cd.mulAdd(t.v, e[i])
+		cd.mulAdd(qSigned62[i], md)
+		ce.mulAdd(t.q, d[i])
+		ce.mulAdd(t.r, e[i])
+		ce.mulAdd(qSigned62[i], me)
+		d[i-1] = int64(cd.lo & mask62)
+		e[i-1] = int64(ce.lo & mask62)
+		cd.rsh62()
+		ce.rsh62()
+	}
+	d[signed62Limbs-1] = int64(cd.lo)
+	e[signed62Limbs-1] = int64(ce.lo)
+}
+
+// normalize sets s in (-2q, q) to s mod q if sign >= 0, -s mod q otherwise, in [0, q)
+func (s *signed62) normalize(sign int64) {
+	// add q if s < 0, then negate if sign < 0: s in (-q, q)
+	c := s[signed62Limbs-1] >> 63
+	for i := range s {
+		s[i] += qSigned62[i] & c
+	}
+	c = sign >> 63
+	for i := range s {
+		s[i] = (s[i] ^ c) - c
+	}
+	s.carry()
+
+	// add q if s < 0
+	c = s[signed62Limbs-1] >> 63
+	for i := range s {
+		s[i] += qSigned62[i] & c
+	}
+	s.carry()
+}
+
+// carry brings the limbs of s back in [0, 2**62), except the most significant one
+func (s *signed62) carry() {
+	for i := 0; i < signed62Limbs-1; i++ {
+		s[i+1] += s[i] >> 62
+		s[i] &= mask62
+	}
+}
+
+// setWords sets s to the words of x (x is not converted from Montgomery form)
+func (s *signed62) setWords(x *Element) {
+	for i := range s {
+		k, b := (62*i)/64, uint(62*i)%64
+		var l uint64
+		if k < Limbs {
+			l = x[k] >> b
+		}
+		if b > 2 && k+1 < Limbs {
+			l |= x[k+1] << (64 - b)
+		}
+		s[i] = int64(l & mask62)
+	}
+}
+
+// words sets z to the words of s, in [0, q)
+func (s *signed62) words(z *Element) {
+	for i := range z {
+		k, b := (64*i)/62, uint(64*i)%62
+		w := uint64(s[k]) >> b
+		if k+1 < signed62Limbs {
+			w |= uint64(s[k+1]) << (62 - b)
+		}
+		if b > 60 && k+2 < signed62Limbs {
+			w |= uint64(s[k+2]) << (124 - b)
+		}
+		z[i] = w
+	}
+}
+
+// int128 is the signed integer hi * 2**64 + lo
+type int128 struct {
+	hi int64
+	lo uint64
+}
+
+// mulAdd sets x = x + a * b
+func (x *int128) mulAdd(a, b int64) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	// from the unsigned product, subtract b * 2**64 if a < 0 and a * 2**64 if b < 0
+	hi -= (uint64(b) & uint64(a>>63)) + (uint64(a) & uint64(b>>63))
+	var c uint64
+	x.lo, c = bits.Add64(x.lo, lo, 0)
+	x.hi += int64(hi + c)
+}
+
+// rsh62 sets x = x >> 62 (arithmetic shift)
+func (x *int128) rsh62() {
+	x.lo = x.lo>>62 | uint64(x.hi)<<2
+	x.hi >>= 62
+}
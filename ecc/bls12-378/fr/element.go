@@ -0,0 +1,1585 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// /!\ WARNING /!\
+// this code has not been audited and is provided as-is. In particular,
+// there is no security guarantees such as constant time implementation
+// or side-channel attack resistance
+// /!\ WARNING /!\
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// Element represents a field element stored on 4 words (uint64)
+// Element are assumed to be in Montgomery form in all methods
+// field modulus q =
+//
+// 14883435066912132899950318861128167269793560281114003360875131245101026639873
+type Element [4]uint64
+
+// Limbs number of 64 bits words needed to represent Element
+const Limbs = 4
+
+// Bits number bits needed to represent Element
+const Bits = 254
+
+// Bytes number bytes needed to represent Element
+const Bytes = Limbs * 8
+
+// field modulus stored as big.Int
+var _modulus big.Int
+
+// Modulus returns q as a big.Int
+// q =
+//
+// 14883435066912132899950318861128167269793560281114003360875131245101026639873
+func Modulus() *big.Int {
+	return new(big.Int).Set(&_modulus)
+}
+
+// q (modulus)
+var qElement = Element{
+	3643768340310130689,
+	16926637627159085057,
+	9761692607219216639,
+	2371068001496280753,
+}
+
+// rSquare
+var rSquare = Element{
+	1260465344847950704,
+	15627634503313390135,
+	1085346480195626314,
+	405261321576397495,
+}
+
+var bigIntPool = sync.Pool{
+	New: func() interface{} {
+		return new(big.Int)
+	},
+}
+
+func init() {
+	_modulus.SetString("14883435066912132899950318861128167269793560281114003360875131245101026639873", 10)
+}
+
+// SetUint64 z = v, sets z LSB to v (non-Montgomery form) and convert z to Montgomery form
+func (z *Element) SetUint64(v uint64) *Element {
+	*z = Element{v}
+	return z.Mul(z, &rSquare) // z.ToMont()
+}
+
+// Set z = x
+func (z *Element) Set(x *Element) *Element {
+	z[0] = x[0]
+	z[1] = x[1]
+	z[2] = x[2]
+	z[3] = x[3]
+	return z
+}
+
+// SetInterface converts provided interface into Element
+// returns an error if provided type is not supported
+// supported types: Element, *Element, uint64, int, string (interpreted as base10 integer),
+// *big.Int, big.Int, []byte
+func (z *Element) SetInterface(i1 interface{}) (*Element, error) {
+	switch c1 := i1.(type) {
+	case Element:
+		return z.Set(&c1), nil
+	case *Element:
+		return z.Set(c1), nil
+	case uint64:
+		return z.SetUint64(c1), nil
+	case int:
+		return z.SetString(strconv.Itoa(c1)), nil
+	case string:
+		return z.SetString(c1), nil
+	case *big.Int:
+		return z.SetBigInt(c1), nil
+	case big.Int:
+		return z.SetBigInt(&c1), nil
+	case []byte:
+		return z.SetBytes(c1), nil
+	default:
+		return nil, errors.New("can't set fr.Element from type " + reflect.TypeOf(i1).String())
+	}
+}
+
+// SetZero z = 0
+func (z *Element) SetZero() *Element {
+	z[0] = 0
+	z[1] = 0
+	z[2] = 0
+	z[3] = 0
+	return z
+}
+
+// SetOne z = 1 (in Montgomery form)
+func (z *Element) SetOne() *Element {
+	z[0] = 11387109765248188409
+	z[1] = 10640745125853265911
+	z[2] = 5455128044303689984
+	z[3] = 1849268063235586341
+	return z
+}
+
+// Div z = x*y^-1 mod q
+func (z *Element) Div(x, y *Element) *Element {
+	var yInv Element
+	yInv.Inverse(y)
+	z.Mul(x, &yInv)
+	return z
+}
+
+// Butterfly sets z = z + b, b = z - b (mod q); method form of the package level Butterfly
+func (z *Element) Butterfly(b *Element) {
+	Butterfly(z, b)
+}
+
+// Equal returns z == x
+func (z *Element) Equal(x *Element) bool {
+	return (z[3] == x[3]) && (z[2] == x[2]) && (z[1] == x[1]) && (z[0] == x[0])
+}
+
+// IsZero returns z == 0
+func (z *Element) IsZero() bool {
+	return (z[3] | z[2] | z[1] | z[0]) == 0
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//   -1 if z <  x
+//    0 if z == x
+//   +1 if z >  x
+//
+func (z *Element) Cmp(x *Element) int {
+	_z := *z
+	_x := *x
+	_z.FromMont()
+	_x.FromMont()
+	if _z[3] > _x[3] {
+		return 1
+	} else if _z[3] < _x[3] {
+		return -1
+	}
+	if _z[2] > _x[2] {
+		return 1
+	} else if _z[2] < _x[2] {
+		return -1
+	}
+	if _z[1] > _x[1] {
+		return 1
+	} else if _z[1] < _x[1] {
+		return -1
+	}
+	if _z[0] > _x[0] {
+		return 1
+	} else if _z[0] < _x[0] {
+		return -1
+	}
+	return 0
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *Element) LexicographicallyLargest() bool {
+	// adapted from github.com/zkcrypto/bls12_381
+	// we check if the element is larger than (q-1) / 2
+	// if z - (((q -1) / 2) + 1) have no underflow, then z > (q-1) / 2
+
+	_z := *z
+	_z.FromMont()
+
+	var b uint64
+	_, b = bits.Sub64(_z[0], 11045256207009841153, 0)
+	_, b = bits.Sub64(_z[1], 17686690850434318336, b)
+	_, b = bits.Sub64(_z[2], 14104218340464384127, b)
+	_, b = bits.Sub64(_z[3], 1185534000748140376, b)
+
+	return b == 0
+}
+
+// SetRandom sets z to a random element < q
+func (z *Element) SetRandom() (*Element, error) {
+	var bytes [32]byte
+	if _, err := io.ReadFull(rand.Reader, bytes[:]); err != nil {
+		return nil, err
+	}
+	z[0] = binary.BigEndian.Uint64(bytes[0:8])
+	z[1] = binary.BigEndian.Uint64(bytes[8:16])
+	z[2] = binary.BigEndian.Uint64(bytes[16:24])
+	z[3] = binary.BigEndian.Uint64(bytes[24:32])
+	z[3] %= 2371068001496280753
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[3] < 2371068001496280753 || (z[3] == 2371068001496280753 && (z[2] < 9761692607219216639 || (z[2] == 9761692607219216639 && (z[1] < 16926637627159085057 || (z[1] == 16926637627159085057 && (z[0] < 3643768340310130689))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 3643768340310130689, 0)
+		z[1], b = bits.Sub64(z[1], 16926637627159085057, b)
+		z[2], b = bits.Sub64(z[2], 9761692607219216639, b)
+		z[3], _ = bits.Sub64(z[3], 2371068001496280753, b)
+	}
+
+	return z, nil
+}
+
+// One returns 1 (in montgommery form)
+func One() Element {
+	var one Element
+	one.SetOne()
+	return one
+}
+
+// API with assembly impl
+
+// Mul z = x * y mod q
+// see https://hackmd.io/@zkteam/modular_multiplication
+func (z *Element) Mul(x, y *Element) *Element {
+	mul(z, x, y)
+	return z
+}
+
+// Square z = x * x mod q
+// see https://hackmd.io/@zkteam/modular_multiplication
+func (z *Element) Square(x *Element) *Element {
+	mul(z, x, x)
+	return z
+}
+
+// FromMont converts z in place (i.e. mutates) from Montgomery to regular representation
+// sets and returns z = z * 1
+func (z *Element) FromMont() *Element {
+	fromMont(z)
+	return z
+}
+
+// Add z = x + y mod q
+func (z *Element) Add(x, y *Element) *Element {
+	add(z, x, y)
+	return z
+}
+
+// Double z = x + x mod q, aka Lsh 1
+func (z *Element) Double(x *Element) *Element {
+	double(z, x)
+	return z
+}
+
+// Sub  z = x - y mod q
+func (z *Element) Sub(x, y *Element) *Element {
+	sub(z, x, y)
+	return z
+}
+
+// Neg z = q - x
+func (z *Element) Neg(x *Element) *Element {
+	neg(z, x)
+	return z
+}
+
+// Generic (no ADX instructions, no AMD64) versions of multiplication and squaring algorithms
+
+func _mulGeneric(z, x, y *Element) {
+
+	var t [4]uint64
+	var c [3]uint64
+	{
+		// round 0
+		v := x[0]
+		c[1], c[0] = bits.Mul64(v, y[0])
+		m := c[0] * 3643768340310130687
+		c[2] = madd0(m, 3643768340310130689, c[0])
+		c[1], c[0] = madd1(v, y[1], c[1])
+		c[2], t[0] = madd2(m, 16926637627159085057, c[2], c[0])
+		c[1], c[0] = madd1(v, y[2], c[1])
+		c[2], t[1] = madd2(m, 9761692607219216639, c[2], c[0])
+		c[1], c[0] = madd1(v, y[3], c[1])
+		t[3], t[2] = madd3(m, 2371068001496280753, c[0], c[2], c[1])
+	}
+	{
+		// round 1
+		v := x[1]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 3643768340310130687
+		c[2] = madd0(m, 3643768340310130689, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16926637627159085057, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 9761692607219216639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		t[3], t[2] = madd3(m, 2371068001496280753, c[0], c[2], c[1])
+	}
+	{
+		// round 2
+		v := x[2]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 3643768340310130687
+		c[2] = madd0(m, 3643768340310130689, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16926637627159085057, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 9761692607219216639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		t[3], t[2] = madd3(m, 2371068001496280753, c[0], c[2], c[1])
+	}
+	{
+		// round 3
+		v := x[3]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 3643768340310130687
+		c[2] = madd0(m, 3643768340310130689, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], z[0] = madd2(m, 16926637627159085057, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], z[1] = madd2(m, 9761692607219216639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		z[3], z[2] = madd3(m, 2371068001496280753, c[0], c[2], c[1])
+	}
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[3] < 2371068001496280753 || (z[3] == 2371068001496280753 && (z[2] < 9761692607219216639 || (z[2] == 9761692607219216639 && (z[1] < 16926637627159085057 || (z[1] == 16926637627159085057 && (z[0] < 3643768340310130689))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 3643768340310130689, 0)
+		z[1], b = bits.Sub64(z[1], 16926637627159085057, b)
+		z[2], b = bits.Sub64(z[2], 9761692607219216639, b)
+		z[3], _ = bits.Sub64(z[3], 2371068001496280753, b)
+	}
+}
+
+func _fromMontGeneric(z *Element) {
+	// the following lines implement z = z * 1
+	// with a modified CIOS montgomery multiplication
+	{
+		// m = z[0]n'[0] mod W
+		m := z[0] * 3643768340310130687
+		C := madd0(m, 3643768340310130689, z[0])
+		C, z[0] = madd2(m, 16926637627159085057, z[1], C)
+		C, z[1] = madd2(m, 9761692607219216639, z[2], C)
+		C, z[2] = madd2(m, 2371068001496280753, z[3], C)
+		z[3] = C
+	}
+	{
+		// m = z[0]n'[0] mod W
+		m := z[0] * 3643768340310130687
+		C := madd0(m, 3643768340310130689, z[0])
+		C, z[0] = madd2(m, 16926637627159085057, z[1], C)
+		C, z[1] = madd2(m, 9761692607219216639, z[2], C)
+		C, z[2] = madd2(m, 2371068001496280753, z[3], C)
+		z[3] = C
+	}
+	{
+		// m = z[0]n'[0] mod W
+		m := z[0] * 3643768340310130687
+		C := madd0(m, 3643768340310130689, z[0])
+		C, z[0] = madd2(m, 16926637627159085057, z[1], C)
+		C, z[1] = madd2(m, 9761692607219216639, z[2], C)
+		C, z[2] = madd2(m, 2371068001496280753, z[3], C)
+		z[3] = C
+	}
+	{
+		// m = z[0]n'[0] mod W
+		m := z[0] * 3643768340310130687
+		C := madd0(m, 3643768340310130689, z[0])
+		C, z[0] = madd2(m, 16926637627159085057, z[1], C)
+		C, z[1] = madd2(m, 9761692607219216639, z[2], C)
+		C, z[2] = madd2(m, 2371068001496280753, z[3], C)
+		z[3] = C
+	}
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[3] < 2371068001496280753 || (z[3] == 2371068001496280753 && (z[2] < 9761692607219216639 || (z[2] == 9761692607219216639 && (z[1] < 16926637627159085057 || (z[1] == 16926637627159085057 && (z[0] < 3643768340310130689))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 3643768340310130689, 0)
+		z[1], b = bits.Sub64(z[1], 16926637627159085057, b)
+		z[2], b = bits.Sub64(z[2], 9761692607219216639, b)
+		z[3], _ = bits.Sub64(z[3], 2371068001496280753, b)
+	}
+}
+
+func _addGeneric(z, x, y *Element) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(x[0], y[0], 0)
+	z[1], carry = bits.Add64(x[1], y[1], carry)
+	z[2], carry = bits.Add64(x[2], y[2], carry)
+	z[3], _ = bits.Add64(x[3], y[3], carry)
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[3] < 2371068001496280753 || (z[3] == 2371068001496280753 && (z[2] < 9761692607219216639 || (z[2] == 9761692607219216639 && (z[1] < 16926637627159085057 || (z[1] == 16926637627159085057 && (z[0] < 3643768340310130689))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 3643768340310130689, 0)
+		z[1], b = bits.Sub64(z[1], 16926637627159085057, b)
+		z[2], b = bits.Sub64(z[2], 9761692607219216639, b)
+		z[3], _ = bits.Sub64(z[3], 2371068001496280753, b)
+	}
+}
+
+func _doubleGeneric(z, x *Element) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(x[0], x[0], 0)
+	z[1], carry = bits.Add64(x[1], x[1], carry)
+	z[2], carry = bits.Add64(x[2], x[2], carry)
+	z[3], _ = bits.Add64(x[3], x[3], carry)
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[3] < 2371068001496280753 || (z[3] == 2371068001496280753 && (z[2] < 9761692607219216639 || (z[2] == 9761692607219216639 && (z[1] < 16926637627159085057 || (z[1] == 16926637627159085057 && (z[0] < 3643768340310130689))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 3643768340310130689, 0)
+		z[1], b = bits.Sub64(z[1], 16926637627159085057, b)
+		z[2], b = bits.Sub64(z[2], 9761692607219216639, b)
+		z[3], _ = bits.Sub64(z[3], 2371068001496280753, b)
+	}
+}
+
+func _subGeneric(z, x, y *Element) {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+	if b != 0 {
+		var c uint64
+		z[0], c = bits.Add64(z[0], 3643768340310130689, 0)
+		z[1], c = bits.Add64(z[1], 16926637627159085057, c)
+		z[2], c = bits.Add64(z[2], 9761692607219216639, c)
+		z[3], _ = bits.Add64(z[3], 2371068001496280753, c)
+	}
+}
+
+func _negGeneric(z, x *Element) {
+	if x.IsZero() {
+		z.SetZero()
+		return
+	}
+	var borrow uint64
+	z[0], borrow = bits.Sub64(3643768340310130689, x[0], 0)
+	z[1], borrow = bits.Sub64(16926637627159085057, x[1], borrow)
+	z[2], borrow = bits.Sub64(9761692607219216639, x[2], borrow)
+	z[3], _ = bits.Sub64(2371068001496280753, x[3], borrow)
+}
+
+func _reduceGeneric(z *Element) {
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[3] < 2371068001496280753 || (z[3] == 2371068001496280753 && (z[2] < 9761692607219216639 || (z[2] == 9761692607219216639 && (z[1] < 16926637627159085057 || (z[1] == 16926637627159085057 && (z[0] < 3643768340310130689))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 3643768340310130689, 0)
+		z[1], b = bits.Sub64(z[1], 16926637627159085057, b)
+		z[2], b = bits.Sub64(z[2], 9761692607219216639, b)
+		z[3], _ = bits.Sub64(z[3], 2371068001496280753, b)
+	}
+}
+
+func mulByConstant(z *Element, c uint8) {
+	switch c {
+	case 0:
+		z.SetZero()
+		return
+	case 1:
+		return
+	case 2:
+		z.Double(z)
+		return
+	case 3:
+		_z := *z
+		z.Double(z).Add(z, &_z)
+	case 5:
+		_z := *z
+		z.Double(z).Double(z).Add(z, &_z)
+	default:
+		var y Element
+		y.SetUint64(uint64(c))
+		z.Mul(z, &y)
+	}
+}
+
+// BatchInvert returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+func BatchInvert(a []Element) []Element {
+	res := make([]Element, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	accumulator := One()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+func _butterflyGeneric(a, b *Element) {
+	t := *a
+	a.Add(a, b)
+	b.Sub(&t, b)
+}
+
+// Exp z = x^exponent mod q
+func (z *Element) Exp(x Element, exponent *big.Int) *Element {
+	var bZero big.Int
+	if exponent.Cmp(&bZero) == 0 {
+		return z.SetOne()
+	}
+
+	z.Set(&x)
+
+	for i := exponent.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if exponent.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// ToMont converts z to Montgomery form
+// sets and returns z = z * r^2
+func (z *Element) ToMont() *Element {
+	return z.Mul(z, &rSquare)
+}
+
+// ToRegular returns z in regular form (doesn't mutate z)
+func (z Element) ToRegular() Element {
+	return *z.FromMont()
+}
+
+// String returns the string form of an Element in Montgomery form
+func (z *Element) String() string {
+	vv := bigIntPool.Get().(*big.Int)
+	defer bigIntPool.Put(vv)
+	return z.ToBigIntRegular(vv).String()
+}
+
+// ToBigInt returns z as a big.Int in Montgomery form
+func (z *Element) ToBigInt(res *big.Int) *big.Int {
+	var b [Limbs * 8]byte
+	binary.BigEndian.PutUint64(b[24:32], z[0])
+	binary.BigEndian.PutUint64(b[16:24], z[1])
+	binary.BigEndian.PutUint64(b[8:16], z[2])
+	binary.BigEndian.PutUint64(b[0:8], z[3])
+
+	return res.SetBytes(b[:])
+}
+
+// ToBigIntRegular returns z as a big.Int in regular form
+func (z Element) ToBigIntRegular(res *big.Int) *big.Int {
+	z.FromMont()
+	return z.ToBigInt(res)
+}
+
+// Bytes returns the regular (non montgomery) value
+// of z as a big-endian byte array.
+func (z *Element) Bytes() (res [Limbs * 8]byte) {
+	_z := z.ToRegular()
+	binary.BigEndian.PutUint64(res[24:32], _z[0])
+	binary.BigEndian.PutUint64(res[16:24], _z[1])
+	binary.BigEndian.PutUint64(res[8:16], _z[2])
+	binary.BigEndian.PutUint64(res[0:8], _z[3])
+
+	return
+}
+
+// Marshal returns the regular (non montgomery) value
+// of z as a big-endian byte slice.
+func (z *Element) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytes interprets e as the bytes of a big-endian unsigned integer,
+// sets z to that value (in Montgomery form), and returns z.
+func (z *Element) SetBytes(e []byte) *Element {
+	// get a big int from our pool
+	vv := bigIntPool.Get().(*big.Int)
+	vv.SetBytes(e)
+
+	// set big int
+	z.SetBigInt(vv)
+
+	// put temporary object back in pool
+	bigIntPool.Put(vv)
+
+	return z
+}
+
+// SetBigInt sets z to v (regular form) and returns z in Montgomery form
+func (z *Element) SetBigInt(v *big.Int) *Element {
+	z.SetZero()
+
+	var zero big.Int
+
+	// fast path
+	c := v.Cmp(&_modulus)
+	if c == 0 {
+		// v == 0
+		return z
+	} else if c != 1 && v.Cmp(&zero) != -1 {
+		// 0 < v < q
+		return z.setBigInt(v)
+	}
+
+	// get temporary big int from the pool
+	vv := bigIntPool.Get().(*big.Int)
+
+	// copy input + modular reduction
+	vv.Set(v)
+	vv.Mod(v, &_modulus)
+
+	// set big int byte value
+	z.setBigInt(vv)
+
+	// release object into pool
+	bigIntPool.Put(vv)
+	return z
+}
+
+// setBigInt assumes 0 <= v < q
+func (z *Element) setBigInt(v *big.Int) *Element {
+	vBits := v.Bits()
+
+	if bits.UintSize == 64 {
+		for i := 0; i < len(vBits); i++ {
+			z[i] = uint64(vBits[i])
+		}
+	} else {
+		for i := 0; i < len(vBits); i++ {
+			if i%2 == 0 {
+				z[i/2] = uint64(vBits[i])
+			} else {
+				z[i/2] |= uint64(vBits[i]) << 32
+			}
+		}
+	}
+
+	return z.ToMont()
+}
+
+// SetString creates a big.Int with s (in base 10) and calls SetBigInt on z
+func (z *Element) SetString(s string) *Element {
+	// get temporary big int from the pool
+	vv := bigIntPool.Get().(*big.Int)
+
+	if _, ok := vv.SetString(s, 10); !ok {
+		panic("Element.SetString failed -> can't parse number in base10 into a big.Int")
+	}
+	z.SetBigInt(vv)
+
+	// release object into pool
+	bigIntPool.Put(vv)
+
+	return z
+}
+
+const (
+	// the discrete logarithms of the Tonelli-Shanks algorithm are computed in sqrtNbWindowsElement
+	// windows, the least significant one of sqrtFirstWindowElement bits and the others of sqrtWindowElement bits
+	sqrtWindowElement      = 7
+	sqrtNbWindowsElement   = 6
+	sqrtFirstWindowElement = 42 - sqrtWindowElement*(sqrtNbWindowsElement-1)
+)
+
+var (
+	_sqrtTablesElement     sqrtTablesElement
+	_sqrtTablesOnceElement sync.Once
+)
+
+// sqrtTablesElement holds the precomputed tables of the Tonelli-Shanks discrete logarithms
+type sqrtTablesElement struct {
+	// g[shift][c] = g^(-c * 2^shift), g = nonResidue^s, for the shifts used by Sqrt
+	g [42][]Element
+
+	// dlog[g^(c * 2^(42-sqrtWindow))] = c
+	dlog map[Element]uint64
+}
+
+// sqrtShiftElement returns the position of the least significant bit of the window i
+func sqrtShiftElement(i int) int {
+	if i == 0 {
+		return 0
+	}
+	return sqrtFirstWindowElement + sqrtWindowElement*(i-1)
+}
+
+func (tables *sqrtTablesElement) init() {
+	const w, n, e = sqrtWindowElement, sqrtNbWindowsElement, 42
+
+	// shifts of the windows, and of the windows raised to 2^(w*r) in Sqrt
+	var shifts [e]bool
+	for i := 0; i < n; i++ {
+		for r := 0; r == 0 || r < n-1-i; r++ {
+			shifts[sqrtShiftElement(i)+w*r] = true
+		}
+	}
+
+	var g = Element{
+		4558548184074722573,
+		11721321436470045759,
+		14707307855974552649,
+		1565820507177503731,
+	}
+
+	// base = g^(-2^shift)
+	var base Element
+	base.Inverse(&g)
+	for shift := 0; shift < e; shift++ {
+		if shifts[shift] {
+			tables.g[shift] = make([]Element, 1<<w)
+			tables.g[shift][0].SetOne()
+			for c := 1; c < 1<<w; c++ {
+				tables.g[shift][c].Mul(&tables.g[shift][c-1], &base)
+			}
+		}
+		base.Square(&base)
+	}
+
+	// zeta = g^(2^(e-w)) is of order 2^w
+	zeta := g
+	for i := 0; i < e-w; i++ {
+		zeta.Square(&zeta)
+	}
+	tables.dlog = make(map[Element]uint64, 1<<w)
+	var a Element
+	a.SetOne()
+	for c := uint64(0); c < 1<<w; c++ {
+		tables.dlog[a] = c
+		a.Mul(&a, &zeta)
+	}
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *Element) Legendre() int {
+	var l Element
+	// z^((q-1)/2)
+	l.expByLegendreExp(*z)
+
+	if l.IsZero() {
+		return 0
+	}
+
+	// if l == 1
+	if (l[3] == 1849268063235586341) && (l[2] == 5455128044303689984) && (l[1] == 10640745125853265911) && (l[0] == 11387109765248188409) {
+		return 1
+	}
+	return -1
+}
+
+// Sqrt z = √x mod q
+// if the square root doesn't exist (x is not a square mod q)
+// Sqrt leaves z unchanged and returns nil
+func (z *Element) Sqrt(x *Element) *Element {
+	// q ≡ 1 (mod 4), q - 1 = 2^42 * s with s odd
+	// Tonelli-Shanks, with the discrete logarithm computed from precomputed tables
+	// see P. Sarkar, "Computing square roots faster than the Tonelli-Shanks/Bernstein algorithm"
+	// https://eprint.iacr.org/2020/1407
+	if x.IsZero() {
+		return z.SetZero()
+	}
+	const win, n, win0 = sqrtWindowElement, sqrtNbWindowsElement, sqrtFirstWindowElement
+	_sqrtTablesOnceElement.Do(_sqrtTablesElement.init)
+	tables := &_sqrtTablesElement
+
+	var y, t, w Element
+	// w = x^((s-1)/2))
+	w.expBySqrtExp(*x)
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, &w)
+
+	// t = x^s = w * y = g^k, with g = nonResidue^s of order 2^42:
+	// x is a square iff k is even, and then y * g^(-k/2) is a square root of x
+	t.Mul(&w, &y)
+
+	// p[i] = t^(2^(win*i))
+	var p [n]Element
+	p[0] = t
+	for i := 1; i < n; i++ {
+		p[i] = p[i-1]
+		for j := 0; j < win; j++ {
+			p[i].Square(&p[i])
+		}
+	}
+
+	// k is computed from the least significant window (win0 bits) to the most significant one (win bits):
+	// (t * g^(-k mod 2^sqrtShift(i)))^(2^(win*(n-1-i))) = p[n-1-i] * g^(-k[j] * 2^(sqrtShift(j)+win*(n-1-i))), j < i
+	// is of order 2^win, and its discrete logarithm gives k[i]
+	var k [n]uint64
+	for i := 0; i < n; i++ {
+		a := p[n-1-i]
+		for j := 0; j < i; j++ {
+			if k[j] != 0 {
+				a.Mul(&a, &tables.g[sqrtShiftElement(j)+win*(n-1-i)][k[j]])
+			}
+		}
+		k[i] = tables.dlog[a]
+		if i == 0 {
+			k[0] >>= win - win0
+			if k[0]&1 == 1 {
+				// k is odd, we don't have a square root
+				return nil
+			}
+		}
+	}
+
+	// y = y * g^(-k/2); the iterative Tonelli-Shanks (and math/big) returns y * g^(-k/2 mod 2^(42-1)),
+	// that is -y * g^(-k/2) if k != 0
+	isZero := true
+	for j := 0; j < n; j++ {
+		isZero = isZero && k[j] == 0
+		h := k[j] >> 1
+		if j+1 < n {
+			h |= (k[j+1] & 1) << (sqrtShiftElement(j+1) - sqrtShiftElement(j) - 1)
+		}
+		if h != 0 {
+			y.Mul(&y, &tables.g[sqrtShiftElement(j)][h])
+		}
+	}
+	if !isZero {
+		y.Neg(&y)
+	}
+	return z.Set(&y)
+}
+
+// Inverse z = x^-1 mod q
+// Algorithm 16 in "Efficient Software-Implementation of Finite Fields with Applications to Cryptography"
+// if x == 0, sets and returns z = x
+func (z *Element) Inverse(x *Element) *Element {
+	if x.IsZero() {
+		return z.Set(x)
+	}
+
+	// initialize u = q
+	var u = Element{
+		3643768340310130689,
+		16926637627159085057,
+		9761692607219216639,
+		2371068001496280753,
+	}
+
+	// initialize s = r^2
+	var s = Element{
+		1260465344847950704,
+		15627634503313390135,
+		1085346480195626314,
+		405261321576397495,
+	}
+
+	// r = 0
+	r := Element{}
+
+	v := *x
+
+	var carry, borrow, t, t2 uint64
+	var bigger bool
+
+	for {
+		for v[0]&1 == 0 {
+
+			// v = v >> 1
+			t2 = v[3] << 63
+			v[3] >>= 1
+			t = t2
+			t2 = v[2] << 63
+			v[2] = (v[2] >> 1) | t
+			t = t2
+			t2 = v[1] << 63
+			v[1] = (v[1] >> 1) | t
+			t = t2
+			v[0] = (v[0] >> 1) | t
+
+			if s[0]&1 == 1 {
+
+				// s = s + q
+				s[0], carry = bits.Add64(s[0], 3643768340310130689, 0)
+				s[1], carry = bits.Add64(s[1], 16926637627159085057, carry)
+				s[2], carry = bits.Add64(s[2], 9761692607219216639, carry)
+				s[3], _ = bits.Add64(s[3], 2371068001496280753, carry)
+
+			}
+
+			// s = s >> 1
+			t2 = s[3] << 63
+			s[3] >>= 1
+			t = t2
+			t2 = s[2] << 63
+			s[2] = (s[2] >> 1) | t
+			t = t2
+			t2 = s[1] << 63
+			s[1] = (s[1] >> 1) | t
+			t = t2
+			s[0] = (s[0] >> 1) | t
+
+		}
+		for u[0]&1 == 0 {
+
+			// u = u >> 1
+			t2 = u[3] << 63
+			u[3] >>= 1
+			t = t2
+			t2 = u[2] << 63
+			u[2] = (u[2] >> 1) | t
+			t = t2
+			t2 = u[1] << 63
+			u[1] = (u[1] >> 1) | t
+			t = t2
+			u[0] = (u[0] >> 1) | t
+
+			if r[0]&1 == 1 {
+
+				// r = r + q
+				r[0], carry = bits.Add64(r[0], 3643768340310130689, 0)
+				r[1], carry = bits.Add64(r[1], 16926637627159085057, carry)
+				r[2], carry = bits.Add64(r[2], 9761692607219216639, carry)
+				r[3], _ = bits.Add64(r[3], 2371068001496280753, carry)
+
+			}
+
+			// r = r >> 1
+			t2 = r[3] << 63
+			r[3] >>= 1
+			t = t2
+			t2 = r[2] << 63
+			r[2] = (r[2] >> 1) | t
+			t = t2
+			t2 = r[1] << 63
+			r[1] = (r[1] >> 1) | t
+			t = t2
+			r[0] = (r[0] >> 1) | t
+
+		}
+
+		// v >= u
+		bigger = !(v[3] < u[3] || (v[3] == u[3] && (v[2] < u[2] || (v[2] == u[2] && (v[1] < u[1] || (v[1] == u[1] && (v[0] < u[0])))))))
+
+		if bigger {
+
+			// v = v - u
+			v[0], borrow = bits.Sub64(v[0], u[0], 0)
+			v[1], borrow = bits.Sub64(v[1], u[1], borrow)
+			v[2], borrow = bits.Sub64(v[2], u[2], borrow)
+			v[3], _ = bits.Sub64(v[3], u[3], borrow)
+
+			// s = s - r
+			s[0], borrow = bits.Sub64(s[0], r[0], 0)
+			s[1], borrow = bits.Sub64(s[1], r[1], borrow)
+			s[2], borrow = bits.Sub64(s[2], r[2], borrow)
+			s[3], borrow = bits.Sub64(s[3], r[3], borrow)
+
+			if borrow == 1 {
+
+				// s = s + q
+				s[0], carry = bits.Add64(s[0], 3643768340310130689, 0)
+				s[1], carry = bits.Add64(s[1], 16926637627159085057, carry)
+				s[2], carry = bits.Add64(s[2], 9761692607219216639, carry)
+				s[3], _ = bits.Add64(s[3], 2371068001496280753, carry)
+
+			}
+		} else {
+
+			// u = u - v
+			u[0], borrow = bits.Sub64(u[0], v[0], 0)
+			u[1], borrow = bits.Sub64(u[1], v[1], borrow)
+			u[2], borrow = bits.Sub64(u[2], v[2], borrow)
+			u[3], _ = bits.Sub64(u[3], v[3], borrow)
+
+			// r = r - s
+			r[0], borrow = bits.Sub64(r[0], s[0], 0)
+			r[1], borrow = bits.Sub64(r[1], s[1], borrow)
+			r[2], borrow = bits.Sub64(r[2], s[2], borrow)
+			r[3], borrow = bits.Sub64(r[3], s[3], borrow)
+
+			if borrow == 1 {
+
+				// r = r + q
+				r[0], carry = bits.Add64(r[0], 3643768340310130689, 0)
+				r[1], carry = bits.Add64(r[1], 16926637627159085057, carry)
+				r[2], carry = bits.Add64(r[2], 9761692607219216639, carry)
+				r[3], _ = bits.Add64(r[3], 2371068001496280753, carry)
+
+			}
+		}
+		if (u[0] == 1) && (u[3]|u[2]|u[1]) == 0 {
+			return z.Set(&r)
+		}
+		if (v[0] == 1) && (v[3]|v[2]|v[1]) == 0 {
+			return z.Set(&s)
+		}
+	}
+
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 1073dc...000000) (base 16);
+// it uses an addition chain of 253 squarings and 47 multiplications
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// t[i] = x^(2*i+1)
+	var t [8]Element
+	var x2 Element
+	x2.Square(&x)
+	t[0] = x
+	for i := 1; i < len(t); i++ {
+		t[i].Mul(&t[i-1], &x2)
+	}
+
+	z.Set(&t[0])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[5])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[6])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[6])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[6])
+	z.Square(z)
+	z.Mul(z, &t[0])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[6])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 2; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 21; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 41; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// expBySqrtExp is equivalent to z.Exp(x, 41cf73...265228) (base 16);
+// it uses an addition chain of 211 squarings and 46 multiplications
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// t[i] = x^(2*i+1)
+	var t [8]Element
+	var x2 Element
+	x2.Square(&x)
+	t[0] = x
+	for i := 1; i < len(t); i++ {
+		t[i].Mul(&t[i-1], &x2)
+	}
+
+	z.Set(&t[0])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[5])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[1])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[6])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[6])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[6])
+	z.Square(z)
+	z.Mul(z, &t[0])
+	for s := 0; s < 8; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[3])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[6])
+	for s := 0; s < 9; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 2; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[0])
+	for s := 0; s < 21; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 4; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 5; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[4])
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+	z.Mul(z, &t[2])
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// expBySqrtExpCT is equivalent to z.Exp(x, 41cf73...265228) (base 16);
+// it uses an addition chain of 211 squarings and 46 multiplications
+// (mulCT and squareCT, in constant time)
+func (z *Element) expBySqrtExpCT(x Element) *Element {
+	// t[i] = x^(2*i+1)
+	var t [8]Element
+	var x2 Element
+	x2.squareCT(&x)
+	t[0] = x
+	for i := 1; i < len(t); i++ {
+		t[i].mulCT(&t[i-1], &x2)
+	}
+
+	z.Set(&t[0])
+	for s := 0; s < 8; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 3; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[0])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 3; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[1])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[5])
+	for s := 0; s < 3; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 3; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[1])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[1])
+	for s := 0; s < 7; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 8; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[6])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 7; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[6])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[6])
+	z.squareCT(z)
+	z.mulCT(z, &t[0])
+	for s := 0; s < 8; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 3; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 3; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[3])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[7])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[6])
+	for s := 0; s < 9; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[4])
+	for s := 0; s < 2; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[0])
+	for s := 0; s < 21; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[4])
+	for s := 0; s < 4; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[4])
+	for s := 0; s < 5; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[4])
+	for s := 0; s < 6; s++ {
+		z.squareCT(z)
+	}
+	z.mulCT(z, &t[2])
+	for s := 0; s < 3; s++ {
+		z.squareCT(z)
+	}
+
+	return z
+}
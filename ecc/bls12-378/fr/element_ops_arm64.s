@@ -0,0 +1,627 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x3291440000000001
+DATA q<>+8(SB)/8, $0xeae77f3da0940001
+DATA q<>+16(SB)/8, $0x87787fb4e3dbb0ff
+DATA q<>+24(SB)/8, $0x20e7b9c8ef7b2eb1
+GLOBL q<>(SB), (RODATA+NOPTR), $32
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x329143ffffffffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+// add(res, x, y *Element)
+TEXT ·add(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R6, R7)
+	ADDS  R6, R2, R2
+	ADCS  R7, R3, R3
+	LDP   16(R1), (R6, R7)
+	ADCS  R6, R4, R4
+	ADCS  R7, R5, R5
+	MOVD  $q<>(SB), R8
+	LDP   0(R8), (R10, R11)
+	SUBS  R10, R2, R12
+	SBCS  R11, R3, R12
+	LDP   16(R8), (R10, R11)
+	SBCS  R10, R4, R12
+	SBCS  R11, R5, R12
+	CSETM CS, R9
+	LDP   0(R8), (R10, R11)
+	AND   R9, R10, R10
+	SUBS  R10, R2, R2
+	AND   R9, R11, R11
+	SBCS  R11, R3, R3
+	LDP   16(R8), (R10, R11)
+	AND   R9, R10, R10
+	SBCS  R10, R4, R4
+	AND   R9, R11, R11
+	SBCS  R11, R5, R5
+	MOVD  res+0(FP), R13
+	STP   (R2, R3), 0(R13)
+	STP   (R4, R5), 16(R13)
+	RET
+
+// sub(res, x, y *Element)
+TEXT ·sub(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R6, R7)
+	SUBS  R6, R2, R2
+	SBCS  R7, R3, R3
+	LDP   16(R1), (R6, R7)
+	SBCS  R6, R4, R4
+	SBCS  R7, R5, R5
+	CSETM CC, R8
+	MOVD  $q<>(SB), R9
+	LDP   0(R9), (R10, R11)
+	AND   R8, R10, R10
+	ADDS  R10, R2, R2
+	AND   R8, R11, R11
+	ADCS  R11, R3, R3
+	LDP   16(R9), (R10, R11)
+	AND   R8, R10, R10
+	ADCS  R10, R4, R4
+	AND   R8, R11, R11
+	ADCS  R11, R5, R5
+	MOVD  res+0(FP), R12
+	STP   (R2, R3), 0(R12)
+	STP   (R4, R5), 16(R12)
+	RET
+
+// double(res, x *Element)
+TEXT ·double(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	ADDS  R1, R1, R1
+	ADCS  R2, R2, R2
+	ADCS  R3, R3, R3
+	ADCS  R4, R4, R4
+	MOVD  $q<>(SB), R5
+	LDP   0(R5), (R7, R8)
+	SUBS  R7, R1, R9
+	SBCS  R8, R2, R9
+	LDP   16(R5), (R7, R8)
+	SBCS  R7, R3, R9
+	SBCS  R8, R4, R9
+	CSETM CS, R6
+	LDP   0(R5), (R7, R8)
+	AND   R6, R7, R7
+	SUBS  R7, R1, R1
+	AND   R6, R8, R8
+	SBCS  R8, R2, R2
+	LDP   16(R5), (R7, R8)
+	AND   R6, R7, R7
+	SBCS  R7, R3, R3
+	AND   R6, R8, R8
+	SBCS  R8, R4, R4
+	MOVD  res+0(FP), R10
+	STP   (R1, R2), 0(R10)
+	STP   (R3, R4), 16(R10)
+	RET
+
+// neg(res, x *Element)
+TEXT ·neg(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	ORR   R1, R2, R7
+	ORR   R3, R7, R7
+	ORR   R4, R7, R7
+	CMP   $0, R7
+	CSETM NE, R7
+	MOVD  $q<>(SB), R0
+	LDP   0(R0), (R5, R6)
+	SUBS  R1, R5, R1
+	SBCS  R2, R6, R2
+	LDP   16(R0), (R5, R6)
+	SBCS  R3, R5, R3
+	SBCS  R4, R6, R4
+	AND   R7, R1, R1
+	AND   R7, R2, R2
+	AND   R7, R3, R3
+	AND   R7, R4, R4
+	MOVD  res+0(FP), R0
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	RET
+
+// reduce(res *Element)
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD  res+0(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	MOVD  $q<>(SB), R5
+	LDP   0(R5), (R7, R8)
+	SUBS  R7, R1, R9
+	SBCS  R8, R2, R9
+	LDP   16(R5), (R7, R8)
+	SBCS  R7, R3, R9
+	SBCS  R8, R4, R9
+	CSETM CS, R6
+	LDP   0(R5), (R7, R8)
+	AND   R6, R7, R7
+	SUBS  R7, R1, R1
+	AND   R6, R8, R8
+	SBCS  R8, R2, R2
+	LDP   16(R5), (R7, R8)
+	AND   R6, R7, R7
+	SBCS  R7, R3, R3
+	AND   R6, R8, R8
+	SBCS  R8, R4, R4
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD  a+0(FP), R0
+	MOVD  b+8(FP), R1
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   0(R1), (R6, R7)
+	LDP   16(R1), (R8, R9)
+	ADDS  R6, R2, R10
+	ADCS  R7, R3, R11
+	ADCS  R8, R4, R12
+	ADCS  R9, R5, R13
+	MOVD  $q<>(SB), R14
+	LDP   0(R14), (R16, R17)
+	SUBS  R16, R10, R19
+	SBCS  R17, R11, R19
+	LDP   16(R14), (R16, R17)
+	SBCS  R16, R12, R19
+	SBCS  R17, R13, R19
+	CSETM CS, R15
+	LDP   0(R14), (R16, R17)
+	AND   R15, R16, R16
+	SUBS  R16, R10, R10
+	AND   R15, R17, R17
+	SBCS  R17, R11, R11
+	LDP   16(R14), (R16, R17)
+	AND   R15, R16, R16
+	SBCS  R16, R12, R12
+	AND   R15, R17, R17
+	SBCS  R17, R13, R13
+	STP   (R10, R11), 0(R0)
+	STP   (R12, R13), 16(R0)
+	SUBS  R6, R2, R2
+	SBCS  R7, R3, R3
+	SBCS  R8, R4, R4
+	SBCS  R9, R5, R5
+	CSETM CC, R20
+	MOVD  $q<>(SB), R21
+	LDP   0(R21), (R22, R23)
+	AND   R20, R22, R22
+	ADDS  R22, R2, R2
+	AND   R20, R23, R23
+	ADCS  R23, R3, R3
+	LDP   16(R21), (R22, R23)
+	AND   R20, R22, R22
+	ADCS  R22, R4, R4
+	AND   R20, R23, R23
+	ADCS  R23, R5, R5
+	STP   (R2, R3), 0(R1)
+	STP   (R4, R5), 16(R1)
+	RET
+
+// mul(res, x, y *Element)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	MOVD y+16(FP), R1
+	MOVD $q<>(SB), R2
+	MOVD qInv0<>(SB), R3
+
+	// round 0
+	MOVD  0(R0), R8
+	MOVD  0(R1), R9
+	MUL   R8, R9, R14
+	UMULH R8, R9, R12
+	MUL   R3, R14, R11
+	MOVD  0(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R13
+	MOVD  8(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R12
+	MOVD  8(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R4
+	ADC   ZR, R16, R13
+	MOVD  16(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R12
+	MOVD  16(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R5
+	ADC   ZR, R16, R13
+	MOVD  24(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R12
+	MOVD  24(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R6
+	ADC   ZR, R16, R13
+	ADD   R12, R13, R7
+
+	// round 1
+	MOVD  8(R0), R8
+	MOVD  0(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R4, R15, R14
+	ADC   ZR, R16, R12
+	MUL   R3, R14, R11
+	MOVD  0(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R13
+	MOVD  8(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R16
+	ADDS  R5, R14, R14
+	ADC   ZR, R16, R12
+	MOVD  8(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R4
+	ADC   ZR, R16, R13
+	MOVD  16(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R16
+	ADDS  R6, R14, R14
+	ADC   ZR, R16, R12
+	MOVD  16(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R5
+	ADC   ZR, R16, R13
+	MOVD  24(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R16
+	ADDS  R7, R14, R14
+	ADC   ZR, R16, R12
+	MOVD  24(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R6
+	ADC   ZR, R16, R13
+	ADD   R12, R13, R7
+
+	// round 2
+	MOVD  16(R0), R8
+	MOVD  0(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R4, R15, R14
+	ADC   ZR, R16, R12
+	MUL   R3, R14, R11
+	MOVD  0(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R13
+	MOVD  8(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R16
+	ADDS  R5, R14, R14
+	ADC   ZR, R16, R12
+	MOVD  8(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R4
+	ADC   ZR, R16, R13
+	MOVD  16(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R16
+	ADDS  R6, R14, R14
+	ADC   ZR, R16, R12
+	MOVD  16(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R5
+	ADC   ZR, R16, R13
+	MOVD  24(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R16
+	ADDS  R7, R14, R14
+	ADC   ZR, R16, R12
+	MOVD  24(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R6
+	ADC   ZR, R16, R13
+	ADD   R12, R13, R7
+
+	// round 3
+	MOVD  24(R0), R8
+	MOVD  0(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R4, R15, R14
+	ADC   ZR, R16, R12
+	MUL   R3, R14, R11
+	MOVD  0(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R14, R15, R15
+	ADC   ZR, R16, R13
+	MOVD  8(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R16
+	ADDS  R5, R14, R14
+	ADC   ZR, R16, R12
+	MOVD  8(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R4
+	ADC   ZR, R16, R13
+	MOVD  16(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R16
+	ADDS  R6, R14, R14
+	ADC   ZR, R16, R12
+	MOVD  16(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R5
+	ADC   ZR, R16, R13
+	MOVD  24(R1), R9
+	MUL   R8, R9, R15
+	UMULH R8, R9, R16
+	ADDS  R12, R15, R14
+	ADC   ZR, R16, R16
+	ADDS  R7, R14, R14
+	ADC   ZR, R16, R12
+	MOVD  24(R2), R10
+	MUL   R11, R10, R15
+	UMULH R11, R10, R16
+	ADDS  R13, R15, R15
+	ADC   ZR, R16, R16
+	ADDS  R14, R15, R6
+	ADC   ZR, R16, R13
+	ADD   R12, R13, R7
+	MOVD  $q<>(SB), R17
+	LDP   0(R17), (R20, R21)
+	SUBS  R20, R4, R22
+	SBCS  R21, R5, R22
+	LDP   16(R17), (R20, R21)
+	SBCS  R20, R6, R22
+	SBCS  R21, R7, R22
+	CSETM CS, R19
+	LDP   0(R17), (R20, R21)
+	AND   R19, R20, R20
+	SUBS  R20, R4, R4
+	AND   R19, R21, R21
+	SBCS  R21, R5, R5
+	LDP   16(R17), (R20, R21)
+	AND   R19, R20, R20
+	SBCS  R20, R6, R6
+	AND   R19, R21, R21
+	SBCS  R21, R7, R7
+	MOVD  res+0(FP), R23
+	STP   (R4, R5), 0(R23)
+	STP   (R6, R7), 16(R23)
+	RET
+
+// fromMont(res *Element)
+TEXT ·fromMont(SB), NOSPLIT, $0-8
+	MOVD res+0(FP), R0
+	MOVD $q<>(SB), R1
+	MOVD qInv0<>(SB), R2
+	LDP  0(R0), (R3, R4)
+	LDP  16(R0), (R5, R6)
+
+	// round 0
+	MUL   R2, R3, R8
+	MOVD  0(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R3, R10, R10
+	ADC   ZR, R11, R9
+	MOVD  8(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R4, R10, R3
+	ADC   ZR, R11, R9
+	MOVD  16(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R5, R10, R4
+	ADC   ZR, R11, R9
+	MOVD  24(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R6, R10, R5
+	ADC   ZR, R11, R9
+	MOVD  R9, R6
+
+	// round 1
+	MUL   R2, R3, R8
+	MOVD  0(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R3, R10, R10
+	ADC   ZR, R11, R9
+	MOVD  8(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R4, R10, R3
+	ADC   ZR, R11, R9
+	MOVD  16(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R5, R10, R4
+	ADC   ZR, R11, R9
+	MOVD  24(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R6, R10, R5
+	ADC   ZR, R11, R9
+	MOVD  R9, R6
+
+	// round 2
+	MUL   R2, R3, R8
+	MOVD  0(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R3, R10, R10
+	ADC   ZR, R11, R9
+	MOVD  8(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R4, R10, R3
+	ADC   ZR, R11, R9
+	MOVD  16(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R5, R10, R4
+	ADC   ZR, R11, R9
+	MOVD  24(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R6, R10, R5
+	ADC   ZR, R11, R9
+	MOVD  R9, R6
+
+	// round 3
+	MUL   R2, R3, R8
+	MOVD  0(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R3, R10, R10
+	ADC   ZR, R11, R9
+	MOVD  8(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R4, R10, R3
+	ADC   ZR, R11, R9
+	MOVD  16(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R5, R10, R4
+	ADC   ZR, R11, R9
+	MOVD  24(R1), R7
+	MUL   R8, R7, R10
+	UMULH R8, R7, R11
+	ADDS  R9, R10, R10
+	ADC   ZR, R11, R11
+	ADDS  R6, R10, R5
+	ADC   ZR, R11, R9
+	MOVD  R9, R6
+	MOVD  $q<>(SB), R12
+	LDP   0(R12), (R14, R15)
+	SUBS  R14, R3, R16
+	SBCS  R15, R4, R16
+	LDP   16(R12), (R14, R15)
+	SBCS  R14, R5, R16
+	SBCS  R15, R6, R16
+	CSETM CS, R13
+	LDP   0(R12), (R14, R15)
+	AND   R13, R14, R14
+	SUBS  R14, R3, R3
+	AND   R13, R15, R15
+	SBCS  R15, R4, R4
+	LDP   16(R12), (R14, R15)
+	AND   R13, R14, R14
+	SBCS  R14, R5, R5
+	AND   R13, R15, R15
+	SBCS  R15, R6, R6
+	STP   (R3, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	RET
+
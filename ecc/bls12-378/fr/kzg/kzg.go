@@ -18,16 +18,15 @@ package kzg
 
 import (
 	"errors"
+	"hash"
 	"math/big"
-	"runtime"
 	"sync"
 
-	"crypto/sha256"
 	"github.com/consensys/gnark-crypto/ecc"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr/fft"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/polynomial"
 	"github.com/consensys/gnark-crypto/fiat-shamir"
 )
 
@@ -41,12 +40,12 @@ var (
 )
 
 // Digest commitment of a polynomial.
-type Digest = bw6767.G1Affine
+type Digest = bls12378.G1Affine
 
 // SRS stores the result of the MPC
 type SRS struct {
-	G1 []bw6767.G1Affine  // [gen [alpha]gen , [alpha**2]gen, ... ]
-	G2 [2]bw6767.G2Affine // [gen, [alpha]gen ]
+	G1 []bls12378.G1Affine  // [gen [alpha]gen , [alpha**2]gen, ... ]
+	G2 [2]bls12378.G2Affine // [gen, [alpha]gen ]
 }
 
 // NewSRS returns a new SRS using alpha as randomness source
@@ -59,12 +58,12 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 		return nil, ErrMinSRSSize
 	}
 	var srs SRS
-	srs.G1 = make([]bw6767.G1Affine, size)
+	srs.G1 = make([]bls12378.G1Affine, size)
 
 	var alpha fr.Element
 	alpha.SetBigInt(bAlpha)
 
-	_, _, gen1Aff, gen2Aff := bw6767.Generators()
+	_, _, gen1Aff, gen2Aff := bls12378.Generators()
 	srs.G1[0] = gen1Aff
 	srs.G2[0] = gen2Aff
 	srs.G2[1].ScalarMultiplication(&gen2Aff, bAlpha)
@@ -77,7 +76,7 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 	for i := 0; i < len(alphas); i++ {
 		alphas[i].FromMont()
 	}
-	g1s := bw6767.BatchScalarMultiplicationG1(&gen1Aff, alphas)
+	g1s := bls12378.BatchScalarMultiplicationG1(&gen1Aff, alphas)
 	copy(srs.G1[1:], g1s)
 
 	return &srs, nil
@@ -88,7 +87,7 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 // implements io.ReaderFrom and io.WriterTo
 type OpeningProof struct {
 	// H quotient polynomial (f - f(z))/(x-z)
-	H bw6767.G1Affine
+	H bls12378.G1Affine
 
 	// Point at which the polynomial is evaluated
 	Point fr.Element
@@ -102,7 +101,7 @@ type OpeningProof struct {
 // implements io.ReaderFrom and io.WriterTo
 type BatchOpeningProof struct {
 	// H quotient polynomial Sum_i gamma**i*(f - f(z))/(x-z)
-	H bw6767.G1Affine
+	H bls12378.G1Affine
 
 	// Point at which the polynomials are evaluated
 	Point fr.Element
@@ -113,52 +112,20 @@ type BatchOpeningProof struct {
 
 // Commit commits to a polynomial using a multi exponentiation with the SRS.
 // It is assumed that the polynomial is in canonical form, in Montgomery form.
-//
-// if a ecc.CPUSemaphore is NOT provided, this function may split the multi exponentiation
-// to use all available CPUs
-func Commit(p polynomial.Polynomial, srs *SRS, opt ...*ecc.CPUSemaphore) (Digest, error) {
+func Commit(p polynomial.Polynomial, srs *SRS, nbTasks ...int) (Digest, error) {
+
 	if len(p) == 0 || len(p) > len(srs.G1) {
 		return Digest{}, ErrInvalidPolynomialSize
 	}
 
-	var res bw6767.G1Affine
+	var res bls12378.G1Affine
 
 	config := ecc.MultiExpConfig{ScalarsMont: true}
-	if len(opt) > 0 {
-		config.CPUSemaphore = opt[0]
-		if _, err := res.MultiExp(srs.G1[:len(p)], p, config); err != nil {
-			return Digest{}, err
-		}
-	} else {
-		numCpus := runtime.NumCPU()
-		if numCpus > 16 {
-			// we split the multiExp in 2.
-			// TODO with machines with more than 32 physical cores, we may want to split in more chunks
-			m := len(p) / 2
-			chPart1 := make(chan struct{}, 1)
-			config.CPUSemaphore = ecc.NewCPUSemaphore(numCpus)
-			var p1, p2 bw6767.G1Jac
-			var err1 error
-			go func() {
-				_, err1 = p1.MultiExp(srs.G1[:m], p[:m], config)
-				close(chPart1)
-			}()
-			// part 2
-			if _, err := p2.MultiExp(srs.G1[m:len(p)], p[m:], config); err != nil {
-				return Digest{}, err
-			}
-			<-chPart1 // wait for part 1
-			if err1 != nil {
-				return Digest{}, err1
-			}
-
-			p1.AddAssign(&p2)
-			res.FromJacobian(&p1)
-		} else {
-			if _, err := res.MultiExp(srs.G1[:len(p)], p, config); err != nil {
-				return Digest{}, err
-			}
-		}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	if _, err := res.MultiExp(srs.G1[:len(p)], p, config); err != nil {
+		return Digest{}, err
 	}
 
 	return res, nil
@@ -181,9 +148,10 @@ func Open(p polynomial.Polynomial, point *fr.Element, domain *fft.Domain, srs *S
 	}
 
 	// compute H
-	_p := make(polynomial.Polynomial, len(p), domain.Cardinality)
+	_p := make(polynomial.Polynomial, len(p))
 	copy(_p, p)
-	h := dividePolyByXminusA(domain, _p, res.ClaimedValue, res.Point)
+	h := dividePolyByXminusA(_p, res.ClaimedValue, res.Point)
+
 	_p = nil // h re-use this memory
 
 	// commit to H
@@ -200,23 +168,23 @@ func Open(p polynomial.Polynomial, point *fr.Element, domain *fft.Domain, srs *S
 func Verify(commitment *Digest, proof *OpeningProof, srs *SRS) error {
 
 	// comm(f(a))
-	var claimedValueG1Aff bw6767.G1Affine
+	var claimedValueG1Aff bls12378.G1Affine
 	var claimedValueBigInt big.Int
 	proof.ClaimedValue.ToBigIntRegular(&claimedValueBigInt)
 	claimedValueG1Aff.ScalarMultiplication(&srs.G1[0], &claimedValueBigInt)
 
 	// [f(alpha) - f(a)]G1Jac
-	var fminusfaG1Jac, tmpG1Jac bw6767.G1Jac
+	var fminusfaG1Jac, tmpG1Jac bls12378.G1Jac
 	fminusfaG1Jac.FromAffine(commitment)
 	tmpG1Jac.FromAffine(&claimedValueG1Aff)
 	fminusfaG1Jac.SubAssign(&tmpG1Jac)
 
 	// [-H(alpha)]G1Aff
-	var negH bw6767.G1Affine
+	var negH bls12378.G1Affine
 	negH.Neg(&proof.H)
 
 	// [alpha-a]G2Jac
-	var alphaMinusaG2Jac, genG2Jac, alphaG2Jac bw6767.G2Jac
+	var alphaMinusaG2Jac, genG2Jac, alphaG2Jac bls12378.G2Jac
 	var pointBigInt big.Int
 	proof.Point.ToBigIntRegular(&pointBigInt)
 	genG2Jac.FromAffine(&srs.G2[0])
@@ -226,17 +194,17 @@ func Verify(commitment *Digest, proof *OpeningProof, srs *SRS) error {
 		AddAssign(&alphaG2Jac)
 
 	// [alpha-a]G2Aff
-	var xminusaG2Aff bw6767.G2Affine
+	var xminusaG2Aff bls12378.G2Affine
 	xminusaG2Aff.FromJacobian(&alphaMinusaG2Jac)
 
 	// [f(alpha) - f(a)]G1Aff
-	var fminusfaG1Aff bw6767.G1Affine
+	var fminusfaG1Aff bls12378.G1Affine
 	fminusfaG1Aff.FromJacobian(&fminusfaG1Jac)
 
 	// e([-H(alpha)]G1Aff, G2gen).e([-H(alpha)]G1Aff, [alpha-a]G2Aff) ==? 1
-	check, err := bw6767.PairingCheck(
-		[]bw6767.G1Affine{fminusfaG1Aff, negH},
-		[]bw6767.G2Affine{srs.G2[0], xminusaG2Aff},
+	check, err := bls12378.PairingCheck(
+		[]bls12378.G1Affine{fminusfaG1Aff, negH},
+		[]bls12378.G2Affine{srs.G2[0], xminusaG2Aff},
 	)
 	if err != nil {
 		return err
@@ -252,7 +220,7 @@ func Verify(commitment *Digest, proof *OpeningProof, srs *SRS) error {
 // point is the point at which the polynomials are opened.
 // digests is the list of committed polynomials to open, need to derive the challenge using Fiat Shamir.
 // polynomials is the list of polynomials to open.
-func BatchOpenSinglePoint(polynomials []polynomial.Polynomial, digests []Digest, point *fr.Element, domain *fft.Domain, srs *SRS) (BatchOpeningProof, error) {
+func BatchOpenSinglePoint(polynomials []polynomial.Polynomial, digests []Digest, point *fr.Element, hf hash.Hash, domain *fft.Domain, srs *SRS) (BatchOpeningProof, error) {
 
 	// check for invalid sizes
 	nbDigests := len(digests)
@@ -289,21 +257,10 @@ func BatchOpenSinglePoint(polynomials []polynomial.Polynomial, digests []Digest,
 	res.Point = *point
 
 	// derive the challenge gamma, binded to the point and the commitments
-	fs := fiatshamir.NewTranscript(sha256.New(), "gamma")
-	if err := fs.Bind("gamma", res.Point.Marshal()); err != nil {
-		return BatchOpeningProof{}, err
-	}
-	for i := 0; i < len(digests); i++ {
-		if err := fs.Bind("gamma", digests[i].Marshal()); err != nil {
-			return BatchOpeningProof{}, err
-		}
-	}
-	gammaByte, err := fs.ComputeChallenge("gamma")
+	gamma, err := deriveGamma(res.Point, digests, hf)
 	if err != nil {
 		return BatchOpeningProof{}, err
 	}
-	var gamma fr.Element
-	gamma.SetBytes(gammaByte)
 
 	// compute sum_i gamma**i*f(a)
 	var sumGammaiTimesEval fr.Element
@@ -323,22 +280,22 @@ func BatchOpenSinglePoint(polynomials []polynomial.Polynomial, digests []Digest,
 	// that is p0 + gamma * p1 + gamma^2 * p2 + ... gamma^n * pn
 	// note: if we are willing to paralellize that, we could clone the poly and scale them by
 	// gamma n in parallel, before reducing into sumGammaiTimesPol
-	sumGammaiTimesPol := make(polynomial.Polynomial, largestPoly, domain.Cardinality)
+	sumGammaiTimesPol := make(polynomial.Polynomial, largestPoly)
 	copy(sumGammaiTimesPol, polynomials[0])
 	gammaN := gamma
-	var pj fr.Element
+	scaled := make(fr.Vector, largestPoly)
 	for i := 1; i < len(polynomials); i++ {
-		for j := 0; j < len(polynomials[i]); j++ {
-			pj.Mul(&polynomials[i][j], &gammaN)
-			sumGammaiTimesPol[j].Add(&sumGammaiTimesPol[j], &pj)
-		}
-		// TODO @thomas check that we don't need to scale by gamma if polynmials[i] < largestPoly
+		n := len(polynomials[i])
+		pi := scaled[:n]
+		pi.ScalarMul(fr.Vector(polynomials[i]), &gammaN)
+		acc := fr.Vector(sumGammaiTimesPol[:n])
+		acc.Add(acc, pi)
 		gammaN.Mul(&gammaN, &gamma)
 	}
 
 	// compute H
 	<-chSumGammai
-	h := dividePolyByXminusA(domain, sumGammaiTimesPol, sumGammaiTimesEval, res.Point)
+	h := dividePolyByXminusA(sumGammaiTimesPol, sumGammaiTimesEval, res.Point)
 	sumGammaiTimesPol = nil // same memory as h
 
 	res.H, err = Commit(h, srs)
@@ -349,122 +306,218 @@ func BatchOpenSinglePoint(polynomials []polynomial.Polynomial, digests []Digest,
 	return res, nil
 }
 
-// BatchVerifySinglePoint verifies a batched opening proof at a single point of a list of polynomials.
-// point: point at which the polynomials are evaluated
-// claimedValues: claimed values of the polynomials at _val
-// commitments: list of commitments to the polynomials which are opened
-// batchOpeningProof: the batched opening proof at a single point of the polynomials.
-func BatchVerifySinglePoint(digests []Digest, batchOpeningProof *BatchOpeningProof, srs *SRS) error {
+// FoldProof fold the digests and the proofs in batchOpeningProof using Fiat Shamir
+// to obtain an opening proof at a single point.
+//
+// * digests list of digests on which batchOpeningProof is based
+// * batchOpeningProof opening proof of digests
+// * returns the folded version of batchOpeningProof, Digest, the folded version of digests
+func FoldProof(digests []Digest, batchOpeningProof *BatchOpeningProof, hf hash.Hash) (OpeningProof, Digest, error) {
+
 	nbDigests := len(digests)
 
 	// check consistancy between numbers of claims vs number of digests
 	if nbDigests != len(batchOpeningProof.ClaimedValues) {
-		return ErrInvalidNbDigests
+		return OpeningProof{}, Digest{}, ErrInvalidNbDigests
 	}
 
 	// derive the challenge gamma, binded to the point and the commitments
-	fs := fiatshamir.NewTranscript(sha256.New(), "gamma")
-	if err := fs.Bind("gamma", batchOpeningProof.Point.Marshal()); err != nil {
-		return err
+	gamma, err := deriveGamma(batchOpeningProof.Point, digests, hf)
+	if err != nil {
+		return OpeningProof{}, Digest{}, ErrInvalidNbDigests
 	}
-	for i := 0; i < len(digests); i++ {
-		if err := fs.Bind("gamma", digests[i].Marshal()); err != nil {
-			return err
-		}
+
+	// fold the claimed values and digests
+	gammai := make([]fr.Element, nbDigests)
+	gammai[0].SetOne()
+	for i := 1; i < nbDigests; i++ {
+		gammai[i].Mul(&gammai[i-1], &gamma)
 	}
-	gammaByte, err := fs.ComputeChallenge("gamma")
+	foldedDigests, foldedEvaluations, err := fold(digests, batchOpeningProof.ClaimedValues, gammai)
+	if err != nil {
+		return OpeningProof{}, Digest{}, err
+	}
+
+	// create the folded opening proof
+	var res OpeningProof
+	res.ClaimedValue.Set(&foldedEvaluations)
+	res.H.Set(&batchOpeningProof.H)
+	res.Point.Set(&batchOpeningProof.Point)
+
+	return res, foldedDigests, nil
+}
+
+// BatchVerifySinglePoint verifies a batched opening proof at a single point of a list of polynomials.
+//
+// * digests list of digests on which opening proof is done
+// * batchOpeningProof proof of correct opening on the digests
+func BatchVerifySinglePoint(digests []Digest, batchOpeningProof *BatchOpeningProof, hf hash.Hash, srs *SRS) error {
+
+	// fold the proof
+	foldedProof, foldedDigest, err := FoldProof(digests, batchOpeningProof, hf)
 	if err != nil {
 		return err
 	}
-	var gamma fr.Element
-	gamma.SetBytes(gammaByte)
 
-	var sumGammaiTimesEval fr.Element
-	sumGammaiTimesEval.Set(&batchOpeningProof.ClaimedValues[nbDigests-1])
-	for i := nbDigests - 2; i >= 0; i-- {
-		sumGammaiTimesEval.Mul(&sumGammaiTimesEval, &gamma).
-			Add(&sumGammaiTimesEval, &batchOpeningProof.ClaimedValues[i])
+	// verify the foldedProof againts the foldedDigest
+	err = Verify(&foldedDigest, &foldedProof, srs)
+	return err
+
+}
+
+// BatchVerifyMultiPoints batch verifies a list of opening proofs at different points.
+// The purpose of the batching is to have only one pairing for verifying several proofs.
+//
+// * digests list of committed polynomials which are opened
+// * proofs list of opening proofs of the digest
+func BatchVerifyMultiPoints(digests []Digest, proofs []OpeningProof, srs *SRS) error {
+
+	// check consistancy nb proogs vs nb digests
+	if len(digests) != len(proofs) {
+		return ErrInvalidNbDigests
+	}
+
+	// if only one digest, call Verify
+	if len(digests) == 1 {
+		return Verify(&digests[0], &proofs[0], srs)
 	}
 
-	var sumGammaiTimesEvalBigInt big.Int
-	sumGammaiTimesEval.ToBigIntRegular(&sumGammaiTimesEvalBigInt)
-	var sumGammaiTimesEvalG1Aff bw6767.G1Affine
-	sumGammaiTimesEvalG1Aff.ScalarMultiplication(&srs.G1[0], &sumGammaiTimesEvalBigInt)
+	// sample random numbers for sampling
+	randomNumbers := make([]fr.Element, len(digests))
+	randomNumbers[0].SetOne()
+	for i := 1; i < len(randomNumbers); i++ {
+		_, err := randomNumbers[i].SetRandom()
+		if err != nil {
+			return err
+		}
+	}
 
-	var acc fr.Element
-	acc.SetOne()
-	gammai := make([]fr.Element, len(digests))
-	gammai[0][0] = 1 // 1 in regular form
-	for i := 1; i < len(digests); i++ {
-		acc.Mul(&acc, &gamma)
-		gammai[i] = acc
-		gammai[i].FromMont()
+	// combine random_i*quotient_i
+	var foldedQuotients bls12378.G1Affine
+	quotients := make([]bls12378.G1Affine, len(proofs))
+	for i := 0; i < len(randomNumbers); i++ {
+		quotients[i].Set(&proofs[i].H)
+	}
+	config := ecc.MultiExpConfig{ScalarsMont: true}
+	_, err := foldedQuotients.MultiExp(quotients, randomNumbers, config)
+	if err != nil {
+		return nil
 	}
-	var sumGammaiTimesDigestsG1Aff bw6767.G1Affine
 
-	if _, err := sumGammaiTimesDigestsG1Aff.MultiExp(digests, gammai, ecc.MultiExpConfig{}); err != nil {
+	// fold digests and evals
+	evals := make([]fr.Element, len(digests))
+	for i := 0; i < len(randomNumbers); i++ {
+		evals[i].Set(&proofs[i].ClaimedValue)
+	}
+	foldedDigests, foldedEvals, err := fold(digests, evals, randomNumbers)
+	if err != nil {
 		return err
 	}
 
-	// sum_i [gamma**i * (f-f(a))]G1
-	var sumGammiDiffG1Aff bw6767.G1Affine
-	var t1, t2 bw6767.G1Jac
-	t1.FromAffine(&sumGammaiTimesDigestsG1Aff)
-	t2.FromAffine(&sumGammaiTimesEvalG1Aff)
-	t1.SubAssign(&t2)
-	sumGammiDiffG1Aff.FromJacobian(&t1)
+	// compute commitment to folded Eval
+	var foldedEvalsCommit bls12378.G1Affine
+	var foldedEvalsBigInt big.Int
+	foldedEvals.ToBigIntRegular(&foldedEvalsBigInt)
+	foldedEvalsCommit.ScalarMultiplication(&srs.G1[0], &foldedEvalsBigInt)
 
-	// [alpha-a]G2Jac
-	var alphaMinusaG2Jac, genG2Jac, alphaG2Jac bw6767.G2Jac
-	var pointBigInt big.Int
-	batchOpeningProof.Point.ToBigIntRegular(&pointBigInt)
-	genG2Jac.FromAffine(&srs.G2[0])
-	alphaG2Jac.FromAffine(&srs.G2[1])
-	alphaMinusaG2Jac.ScalarMultiplication(&genG2Jac, &pointBigInt).
-		Neg(&alphaMinusaG2Jac).
-		AddAssign(&alphaG2Jac)
+	// compute F = foldedDigests - foldedEvalsCommit
+	foldedDigests.Sub(&foldedDigests, &foldedEvalsCommit)
 
-	// [alpha-a]G2Aff
-	var xminusaG2Aff bw6767.G2Affine
-	xminusaG2Aff.FromJacobian(&alphaMinusaG2Jac)
+	// combine random_i*(point_i*quotient_i)
+	var foldedPointsQuotients bls12378.G1Affine
+	for i := 0; i < len(randomNumbers); i++ {
+		randomNumbers[i].Mul(&randomNumbers[i], &proofs[i].Point)
+	}
+	_, err = foldedPointsQuotients.MultiExp(quotients, randomNumbers, config)
+	if err != nil {
+		return err
+	}
 
-	// [-H(alpha)]G1Aff
-	var negH bw6767.G1Affine
-	negH.Neg(&batchOpeningProof.H)
+	// lhs first pairing
+	foldedDigests.Add(&foldedDigests, &foldedPointsQuotients)
+
+	// lhs second pairing
+	foldedQuotients.Neg(&foldedQuotients)
 
-	// check the pairing equation
-	check, err := bw6767.PairingCheck(
-		[]bw6767.G1Affine{sumGammiDiffG1Aff, negH},
-		[]bw6767.G2Affine{srs.G2[0], xminusaG2Aff},
+	// pairing check
+	check, err := bls12378.PairingCheck(
+		[]bls12378.G1Affine{foldedDigests, foldedQuotients},
+		[]bls12378.G2Affine{srs.G2[0], srs.G2[1]},
 	)
 	if err != nil {
 		return err
 	}
 	if !check {
-		return ErrVerifyBatchOpeningSinglePoint
+		return ErrVerifyOpeningProof
 	}
 	return nil
+
+}
+
+// fold folds digests and evaluations using the list of factors as random numbers.
+//
+// * digests list of digests to fold
+// * evaluations list of evaluations to fold
+// * factors list of multiplicative factors used for the folding (in Montgomery form)
+func fold(digests []Digest, evaluations []fr.Element, factors []fr.Element) (Digest, fr.Element, error) {
+
+	// length inconsistancy between digests and evaluations should have been done before calling this function
+	nbDigests := len(digests)
+
+	// fold the claimed values
+	evals := fr.Vector(evaluations[:nbDigests])
+	foldedEvaluations := evals.InnerProduct(factors[:nbDigests])
+
+	// fold the digests
+	var foldedDigests Digest
+	_, err := foldedDigests.MultiExp(digests, factors, ecc.MultiExpConfig{ScalarsMont: true})
+	if err != nil {
+		return foldedDigests, foldedEvaluations, err
+	}
+
+	// folding done
+	return foldedDigests, foldedEvaluations, nil
+
+}
+
+// deriveGamma derives a challenge using Fiat Shamir to fold proofs.
+func deriveGamma(point fr.Element, digests []Digest, hf hash.Hash) (fr.Element, error) {
+
+	// derive the challenge gamma, binded to the point and the commitments
+	fs := fiatshamir.NewTranscript(hf, "gamma")
+	if err := fs.Bind("gamma", point.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	for i := 0; i < len(digests); i++ {
+		if err := fs.Bind("gamma", digests[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	gammaByte, err := fs.ComputeChallenge("gamma")
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var gamma fr.Element
+	gamma.SetBytes(gammaByte)
+
+	return gamma, nil
 }
 
 // dividePolyByXminusA computes (f-f(a))/(x-a), in canonical basis, in regular form
 // f memory is re-used for the result
-// @precondition: cap(f) must be d.Cardinality or this will panic
-func dividePolyByXminusA(d *fft.Domain, f polynomial.Polynomial, fa, a fr.Element) polynomial.Polynomial {
-	degree := len(f) - 1 // the result if of degree  deg(f)-1
+func dividePolyByXminusA(f polynomial.Polynomial, fa, a fr.Element) polynomial.Polynomial {
 
 	// first we compute f-f(a)
-	f = f[:d.Cardinality]
 	f[0].Sub(&f[0], &fa)
 
 	// now we use syntetic division to divide by x-a
-	// TODO check with large polynomials, we may want to parallelize this
-	var c, t fr.Element
-	for i := len(f) - 1; i >= 0; i-- {
-		t.Mul(&c, &a)
+	var t fr.Element
+	for i := len(f) - 2; i >= 0; i-- {
+		t.Mul(&f[i+1], &a)
+
 		f[i].Add(&f[i], &t)
-		c, f[i] = f[i], c
 	}
 
 	// the result is of degree deg(f)-1
-	return f[:degree]
+	return f[1:]
 }
@@ -0,0 +1,805 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/internal/fptower"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestG2AffineEndomorphism(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS12-378] check that phi(P) = lambdaGLV * P", prop.ForAll(
+		func(a fptower.E2) bool {
+			var p, res1, res2 G2Jac
+			g := MapToCurveG2Svdw(a)
+			p.FromAffine(&g)
+			res1.phi(&p)
+			res2.mulWindowed(&p, &lambdaGLV)
+
+			return p.IsInSubGroup() && res1.Equal(&res2)
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] check that phi^2(P) + phi(P) + P = 0", prop.ForAll(
+		func(a fptower.E2) bool {
+			var p, res, tmp G2Jac
+			g := MapToCurveG2Svdw(a)
+			p.FromAffine(&g)
+			tmp.phi(&p)
+			res.phi(&tmp).
+				AddAssign(&tmp).
+				AddAssign(&p)
+
+			return res.Z.IsZero()
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] check that psi^2(P) = -phi(P)", prop.ForAll(
+		func(a fptower.E2) bool {
+			var p, res1, res2 G2Jac
+			g := MapToCurveG2Svdw(a)
+			p.FromAffine(&g)
+			res1.psi(&p).psi(&res1).Neg(&res1)
+			res2.Set(&p)
+			res2.X.MulByElement(&res2.X, &thirdRootOneG1)
+
+			return p.IsInSubGroup() && res1.Equal(&res2)
+		},
+		GenE2(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestMapToCurveG2(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[G2] Svsw mapping should output point on the curve", prop.ForAll(
+		func(a fptower.E2) bool {
+			g := MapToCurveG2Svdw(a)
+			return g.IsInSubGroup()
+		},
+		GenE2(),
+	))
+
+	properties.Property("[G2] Svsw mapping should be deterministic", prop.ForAll(
+		func(a fptower.E2) bool {
+			g1 := MapToCurveG2Svdw(a)
+			g2 := MapToCurveG2Svdw(a)
+			return g1.Equal(&g2)
+		},
+		GenE2(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestG2AffineIsOnCurve(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS12-378] g2Gen (affine) should be on the curve", prop.ForAll(
+		func(a fptower.E2) bool {
+			var op1, op2 G2Affine
+			op1.FromJacobian(&g2Gen)
+			op2.FromJacobian(&g2Gen)
+			op2.Y.Mul(&op2.Y, &a)
+			return op1.IsOnCurve() && !op2.IsOnCurve()
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] g2Gen (Jacobian) should be on the curve", prop.ForAll(
+		func(a fptower.E2) bool {
+			var op1, op2, op3 G2Jac
+			op1.Set(&g2Gen)
+			op3.Set(&g2Gen)
+
+			op2 = fuzzJacobianG2Affine(&g2Gen, a)
+			op3.Y.Mul(&op3.Y, &a)
+			return op1.IsOnCurve() && op2.IsOnCurve() && !op3.IsOnCurve()
+		},
+		GenE2(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestG2AffineConversions(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS12-378] Affine representation should be independent of the Jacobian representative", prop.ForAll(
+		func(a fptower.E2) bool {
+			g := fuzzJacobianG2Affine(&g2Gen, a)
+			var op1 G2Affine
+			op1.FromJacobian(&g)
+			return op1.X.Equal(&g2Gen.X) && op1.Y.Equal(&g2Gen.Y)
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] Affine representation should be independent of a Extended Jacobian representative", prop.ForAll(
+		func(a fptower.E2) bool {
+			var g g2JacExtended
+			g.X.Set(&g2Gen.X)
+			g.Y.Set(&g2Gen.Y)
+			g.ZZ.Set(&g2Gen.Z)
+			g.ZZZ.Set(&g2Gen.Z)
+			gfuzz := fuzzExtendedJacobianG2Affine(&g, a)
+
+			var op1 G2Affine
+			op1.fromJacExtended(&gfuzz)
+			return op1.X.Equal(&g2Gen.X) && op1.Y.Equal(&g2Gen.Y)
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] Jacobian representation should be the same as the affine representative", prop.ForAll(
+		func(a fptower.E2) bool {
+			var g G2Jac
+			var op1 G2Affine
+			op1.X.Set(&g2Gen.X)
+			op1.Y.Set(&g2Gen.Y)
+
+			var one fptower.E2
+			one.SetOne()
+
+			g.FromAffine(&op1)
+
+			return g.X.Equal(&g2Gen.X) && g.Y.Equal(&g2Gen.Y) && g.Z.Equal(&one)
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] Converting affine symbol for infinity to Jacobian should output correct infinity in Jacobian", prop.ForAll(
+		func() bool {
+			var g G2Affine
+			g.X.SetZero()
+			g.Y.SetZero()
+			var op1 G2Jac
+			op1.FromAffine(&g)
+			var one, zero fptower.E2
+			one.SetOne()
+			return op1.X.Equal(&one) && op1.Y.Equal(&one) && op1.Z.Equal(&zero)
+		},
+	))
+
+	properties.Property("[BLS12-378] Converting infinity in extended Jacobian to affine should output infinity symbol in Affine", prop.ForAll(
+		func() bool {
+			var g G2Affine
+			var op1 g2JacExtended
+			var zero fptower.E2
+			op1.X.Set(&g2Gen.X)
+			op1.Y.Set(&g2Gen.Y)
+			g.fromJacExtended(&op1)
+			return g.X.Equal(&zero) && g.Y.Equal(&zero)
+		},
+	))
+
+	properties.Property("[BLS12-378] Converting infinity in extended Jacobian to Jacobian should output infinity in Jacobian", prop.ForAll(
+		func() bool {
+			var g G2Jac
+			var op1 g2JacExtended
+			var zero, one fptower.E2
+			one.SetOne()
+			op1.X.Set(&g2Gen.X)
+			op1.Y.Set(&g2Gen.Y)
+			g.fromJacExtended(&op1)
+			return g.X.Equal(&one) && g.Y.Equal(&one) && g.Z.Equal(&zero)
+		},
+	))
+
+	properties.Property("[BLS12-378] [Jacobian] Two representatives of the same class should be equal", prop.ForAll(
+		func(a, b fptower.E2) bool {
+			op1 := fuzzJacobianG2Affine(&g2Gen, a)
+			op2 := fuzzJacobianG2Affine(&g2Gen, b)
+			return op1.Equal(&op2)
+		},
+		GenE2(),
+		GenE2(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestG2AffineOps(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	properties.Property("[BLS12-378] [Jacobian] Add should call double when having adding the same point", prop.ForAll(
+		func(a, b fptower.E2) bool {
+			fop1 := fuzzJacobianG2Affine(&g2Gen, a)
+			fop2 := fuzzJacobianG2Affine(&g2Gen, b)
+			var op1, op2 G2Jac
+			op1.Set(&fop1).AddAssign(&fop2)
+			op2.Double(&fop2)
+			return op1.Equal(&op2)
+		},
+		GenE2(),
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] [Jacobian] Adding the opposite of a point to itself should output inf", prop.ForAll(
+		func(a, b fptower.E2) bool {
+			fop1 := fuzzJacobianG2Affine(&g2Gen, a)
+			fop2 := fuzzJacobianG2Affine(&g2Gen, b)
+			fop2.Neg(&fop2)
+			fop1.AddAssign(&fop2)
+			return fop1.Equal(&g2Infinity)
+		},
+		GenE2(),
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] [Jacobian] Adding the inf to a point should not modify the point", prop.ForAll(
+		func(a fptower.E2) bool {
+			fop1 := fuzzJacobianG2Affine(&g2Gen, a)
+			fop1.AddAssign(&g2Infinity)
+			var op2 G2Jac
+			op2.Set(&g2Infinity)
+			op2.AddAssign(&g2Gen)
+			return fop1.Equal(&g2Gen) && op2.Equal(&g2Gen)
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] [Jacobian Extended] addMixed (-G) should equal subMixed(G)", prop.ForAll(
+		func(a fptower.E2) bool {
+			fop1 := fuzzJacobianG2Affine(&g2Gen, a)
+			var p1, p1Neg G2Affine
+			p1.FromJacobian(&fop1)
+			p1Neg = p1
+			p1Neg.Y.Neg(&p1Neg.Y)
+			var o1, o2 g2JacExtended
+			o1.addMixed(&p1Neg)
+			o2.subMixed(&p1)
+
+			return o1.X.Equal(&o2.X) &&
+				o1.Y.Equal(&o2.Y) &&
+				o1.ZZ.Equal(&o2.ZZ) &&
+				o1.ZZZ.Equal(&o2.ZZZ)
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] [Jacobian Extended] doubleMixed (-G) should equal doubleNegMixed(G)", prop.ForAll(
+		func(a fptower.E2) bool {
+			fop1 := fuzzJacobianG2Affine(&g2Gen, a)
+			var p1, p1Neg G2Affine
+			p1.FromJacobian(&fop1)
+			p1Neg = p1
+			p1Neg.Y.Neg(&p1Neg.Y)
+			var o1, o2 g2JacExtended
+			o1.doubleMixed(&p1Neg)
+			o2.doubleNegMixed(&p1)
+
+			return o1.X.Equal(&o2.X) &&
+				o1.Y.Equal(&o2.Y) &&
+				o1.ZZ.Equal(&o2.ZZ) &&
+				o1.ZZZ.Equal(&o2.ZZZ)
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] [Jacobian] Addmix the negation to itself should output 0", prop.ForAll(
+		func(a fptower.E2) bool {
+			fop1 := fuzzJacobianG2Affine(&g2Gen, a)
+			fop1.Neg(&fop1)
+			var op2 G2Affine
+			op2.FromJacobian(&g2Gen)
+			fop1.AddMixed(&op2)
+			return fop1.Equal(&g2Infinity)
+		},
+		GenE2(),
+	))
+
+	properties.Property("[BLS12-378] scalar multiplication (double and add) should depend only on the scalar mod r", prop.ForAll(
+		func(s fr.Element) bool {
+
+			r := fr.Modulus()
+			var g G2Jac
+			g.ScalarMultiplication(&g2Gen, r)
+
+			var scalar, blindedScalard, rminusone big.Int
+			var op1, op2, op3, gneg G2Jac
+			rminusone.SetUint64(1).Sub(r, &rminusone)
+			op3.ScalarMultiplication(&g2Gen, &rminusone)
+			gneg.Neg(&g2Gen)
+			s.ToBigIntRegular(&scalar)
+			blindedScalard.Add(&scalar, r)
+			op1.ScalarMultiplication(&g2Gen, &scalar)
+			op2.ScalarMultiplication(&g2Gen, &blindedScalard)
+
+			return op1.Equal(&op2) && g.Equal(&g2Infinity) && !op1.Equal(&g2Infinity) && gneg.Equal(&op3)
+
+		},
+		genScalar,
+	))
+
+	properties.Property("[BLS12-378] psi should map points from E' to itself", prop.ForAll(
+		func() bool {
+			var a G2Jac
+			a.psi(&g2Gen)
+			return a.IsOnCurve() && !a.Equal(&g2Gen)
+		},
+	))
+
+	properties.Property("[BLS12-378] scalar multiplication (GLV) should depend only on the scalar mod r", prop.ForAll(
+		func(s fr.Element) bool {
+
+			r := fr.Modulus()
+			var g G2Jac
+			g.mulGLV(&g2Gen, r)
+
+			var scalar, blindedScalard, rminusone big.Int
+			var op1, op2, op3, gneg G2Jac
+			rminusone.SetUint64(1).Sub(r, &rminusone)
+			op3.mulGLV(&g2Gen, &rminusone)
+			gneg.Neg(&g2Gen)
+			s.ToBigIntRegular(&scalar)
+			blindedScalard.Add(&scalar, r)
+			op1.mulGLV(&g2Gen, &scalar)
+			op2.mulGLV(&g2Gen, &blindedScalard)
+
+			return op1.Equal(&op2) && g.Equal(&g2Infinity) && !op1.Equal(&g2Infinity) && gneg.Equal(&op3)
+
+		},
+		genScalar,
+	))
+
+	properties.Property("[BLS12-378] GLV and Double and Add should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var r big.Int
+			var op1, op2 G2Jac
+			s.ToBigIntRegular(&r)
+			op1.mulWindowed(&g2Gen, &r)
+			op2.mulGLV(&g2Gen, &r)
+			return op1.Equal(&op2) && !op1.Equal(&g2Infinity)
+
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestG2AffineCofactorCleaning(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BLS12-378] Clearing the cofactor of a random point should set it in the r-torsion", prop.ForAll(
+		func() bool {
+			var a, x, b fptower.E2
+			a.SetRandom()
+
+			x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+			for x.Legendre() != 1 {
+				a.SetRandom()
+				x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+			}
+
+			b.Sqrt(&x)
+			var point, pointCleared, infinity G2Jac
+			point.X.Set(&a)
+			point.Y.Set(&b)
+			point.Z.SetOne()
+			pointCleared.ClearCofactor(&point)
+			infinity.Set(&g2Infinity)
+			return point.IsOnCurve() && pointCleared.IsInSubGroup() && !pointCleared.Equal(&infinity)
+		},
+	))
+
+	properties.Property("[BLS12-378] IsInSubGroup should match the [r]p == 0 check on random, cofactor-cleared and small torsion points", prop.ForAll(
+		func(s fr.Element) bool {
+			var a, x, b fptower.E2
+			a.SetRandom()
+
+			x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+			for x.Legendre() != 1 {
+				a.SetRandom()
+				x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+			}
+
+			b.Sqrt(&x)
+			var point, pointCleared, rp G2Jac
+			point.X.Set(&a)
+			point.Y.Set(&b)
+			point.Z.SetOne()
+			pointCleared.ClearCofactor(&point)
+			r := fr.Modulus()
+			rp.mulWindowed(&point, r)
+			if point.IsInSubGroup() != rp.Z.IsZero() {
+				return false
+			}
+
+			// [k]g2Gen and cleared points are in the r-torsion
+			var k big.Int
+			var kGen G2Jac
+			kGen.mulWindowed(&g2Gen, s.ToBigIntRegular(&k))
+			if !kGen.IsInSubGroup() || !pointCleared.IsInSubGroup() {
+				return false
+			}
+			rp.mulWindowed(&pointCleared, r)
+			if !rp.Z.IsZero() {
+				return false
+			}
+
+			// [r]point is in the torsion of order dividing the cofactor, coprime to r:
+			// it is not in the r-torsion unless it is the infinity, and neither is its sum with kGen
+			torsion := rp
+			torsion.mulWindowed(&point, r)
+			if torsion.Z.IsZero() {
+				return true
+			}
+			var mixed G2Jac
+			mixed.Set(&kGen).AddAssign(&torsion)
+			return !torsion.IsInSubGroup() && !mixed.IsInSubGroup()
+		},
+		GenFr(),
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+}
+
+func TestG2AffineBatchScalarMultiplication(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	// size of the multiExps
+	const nbSamples = 10
+
+	properties.Property("[BLS12-378] BatchScalarMultiplication should be consistant with individual scalar multiplications", prop.ForAll(
+		func(mixer fr.Element) bool {
+			// mixer ensures that all the words of a fpElement are set
+			var sampleScalars [nbSamples]fr.Element
+
+			for i := 1; i <= nbSamples; i++ {
+				sampleScalars[i-1].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i-1], &mixer).
+					FromMont()
+			}
+
+			result := BatchScalarMultiplicationG2(&g2GenAff, sampleScalars[:])
+
+			if len(result) != len(sampleScalars) {
+				return false
+			}
+
+			for i := 0; i < len(result); i++ {
+				var expectedJac G2Jac
+				var expected G2Affine
+				var b big.Int
+				expectedJac.mulGLV(&g2Gen, sampleScalars[i].ToBigInt(&b))
+				expected.FromJacobian(&expectedJac)
+				if !result[i].Equal(&expected) {
+					return false
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkG2JacIsInSubGroup(b *testing.B) {
+	var a G2Jac
+	a.Set(&g2Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.IsInSubGroup()
+	}
+
+}
+
+func BenchmarkG2AffineBatchScalarMul(b *testing.B) {
+	// ensure every words of the scalars are filled
+	var mixer fr.Element
+	mixer.SetString("7716837800905789770901243404444209691916730933998574719964609384059111546487")
+
+	const pow = 15
+	const nbSamples = 1 << pow
+
+	var sampleScalars [nbSamples]fr.Element
+
+	for i := 1; i <= nbSamples; i++ {
+		sampleScalars[i-1].SetUint64(uint64(i)).
+			Mul(&sampleScalars[i-1], &mixer).
+			FromMont()
+	}
+
+	for i := 5; i <= pow; i++ {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				_ = BatchScalarMultiplicationG2(&g2GenAff, sampleScalars[:using])
+			}
+		})
+	}
+}
+
+func BenchmarkG2JacScalarMul(b *testing.B) {
+
+	var scalar big.Int
+	r := fr.Modulus()
+	scalar.SetString("5243587517512619047944770508185965837690552500527637822603658699938581184513", 10)
+	scalar.Add(&scalar, r)
+
+	var doubleAndAdd G2Jac
+
+	b.Run("double and add", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			doubleAndAdd.mulWindowed(&g2Gen, &scalar)
+		}
+	})
+
+	var glv G2Jac
+	b.Run("GLV", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			glv.mulGLV(&g2Gen, &scalar)
+		}
+	})
+
+}
+
+func BenchmarkG2AffineCofactorClearing(b *testing.B) {
+	var a G2Jac
+	a.Set(&g2Gen)
+	for i := 0; i < b.N; i++ {
+		a.ClearCofactor(&a)
+	}
+}
+
+func BenchmarkG2JacAdd(b *testing.B) {
+	var a G2Jac
+	a.Double(&g2Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.AddAssign(&g2Gen)
+	}
+}
+
+func BenchmarkG2JacAddMixed(b *testing.B) {
+	var a G2Jac
+	a.Double(&g2Gen)
+
+	var c G2Affine
+	c.FromJacobian(&g2Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.AddMixed(&c)
+	}
+
+}
+
+func BenchmarkG2JacDouble(b *testing.B) {
+	var a G2Jac
+	a.Set(&g2Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.DoubleAssign()
+	}
+
+}
+
+func BenchmarkG2JacExtAddMixed(b *testing.B) {
+	var a g2JacExtended
+	a.doubleMixed(&g2GenAff)
+
+	var c G2Affine
+	c.FromJacobian(&g2Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.addMixed(&c)
+	}
+}
+
+func BenchmarkG2JacExtSubMixed(b *testing.B) {
+	var a g2JacExtended
+	a.doubleMixed(&g2GenAff)
+
+	var c G2Affine
+	c.FromJacobian(&g2Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.subMixed(&c)
+	}
+}
+
+func BenchmarkG2JacExtDoubleMixed(b *testing.B) {
+	var a g2JacExtended
+	a.doubleMixed(&g2GenAff)
+
+	var c G2Affine
+	c.FromJacobian(&g2Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.doubleMixed(&c)
+	}
+}
+
+func BenchmarkG2JacExtDoubleNegMixed(b *testing.B) {
+	var a g2JacExtended
+	a.doubleMixed(&g2GenAff)
+
+	var c G2Affine
+	c.FromJacobian(&g2Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.doubleNegMixed(&c)
+	}
+}
+
+func BenchmarkG2JacExtAdd(b *testing.B) {
+	var a, c g2JacExtended
+	a.doubleMixed(&g2GenAff)
+	c.double(&a)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.add(&c)
+	}
+}
+
+func BenchmarkG2JacExtDouble(b *testing.B) {
+	var a g2JacExtended
+	a.doubleMixed(&g2GenAff)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.double(&a)
+	}
+}
+
+func fuzzJacobianG2Affine(p *G2Jac, f fptower.E2) G2Jac {
+	var res G2Jac
+	res.X.Mul(&p.X, &f).Mul(&res.X, &f)
+	res.Y.Mul(&p.Y, &f).Mul(&res.Y, &f).Mul(&res.Y, &f)
+	res.Z.Mul(&p.Z, &f)
+	return res
+}
+
+func fuzzExtendedJacobianG2Affine(p *g2JacExtended, f fptower.E2) g2JacExtended {
+	var res g2JacExtended
+	var ff, fff fptower.E2
+	ff.Square(&f)
+	fff.Mul(&ff, &f)
+	res.X.Mul(&p.X, &ff)
+	res.Y.Mul(&p.Y, &fff)
+	res.ZZ.Mul(&p.ZZ, &ff)
+	res.ZZZ.Mul(&p.ZZZ, &fff)
+	return res
+}
+
+func FuzzG2Jac(f *testing.F) {
+	f.Add([]byte{1}, []byte{2})
+	f.Add([]byte{}, []byte{42})
+	f.Add([]byte{3}, []byte{3})
+	{
+		var r, rMinusOne big.Int
+		r.Set(fr.Modulus())
+		rMinusOne.Sub(&r, big.NewInt(1))
+		f.Add(r.Bytes(), rMinusOne.Bytes())
+	}
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		var s1, s2, sum big.Int
+		s1.SetBytes(a)
+		s2.SetBytes(b)
+		sum.Add(&s1, &s2)
+
+		var p1, p2, p, q G2Jac
+		p1.ScalarMultiplication(&g2Gen, &s1)
+		p2.ScalarMultiplication(&g2Gen, &s2)
+		if !p1.IsOnCurve() || !p1.IsInSubGroup() {
+			t.Fatal("[s1]G is not in the subgroup")
+		}
+
+		// [s1]G + [s2]G = [s1+s2]G
+		p.Set(&p1).AddAssign(&p2)
+		q.mulWindowed(&g2Gen, &sum)
+		if !p.Equal(&q) {
+			t.Fatal("[s1]G + [s2]G != [s1+s2]G")
+		}
+
+		// GLV and windowed scalar multiplications agree
+		q.mulWindowed(&g2Gen, &s1)
+		if !p1.Equal(&q) {
+			t.Fatal("GLV and windowed scalar multiplications mismatch")
+		}
+
+		// Double and AddAssign agree, SubAssign and Neg agree
+		p.Double(&p1)
+		q.Set(&p1).AddAssign(&p1)
+		if !p.Equal(&q) {
+			t.Fatal("2P != P + P")
+		}
+		p.Set(&p1).SubAssign(&p2)
+		q.Neg(&p2).AddAssign(&p1)
+		if !p.Equal(&q) {
+			t.Fatal("P - Q != -Q + P")
+		}
+
+		// mixed and extended Jacobian additions agree
+		var a2 G2Affine
+		var e g2JacExtended
+		a2.FromJacobian(&p2)
+		p.Set(&p1).AddMixed(&a2)
+		e.setInfinity()
+		e.addMixed(&a2)
+		{
+			var e1 g2JacExtended
+			var a1 G2Affine
+			a1.FromJacobian(&p1)
+			e1.setInfinity()
+			e1.addMixed(&a1)
+			e.add(&e1)
+		}
+		q.fromJacExtended(&e)
+		if !p.Equal(&q) {
+			t.Fatal("AddMixed and extended Jacobian add mismatch")
+		}
+	})
+}
@@ -0,0 +1,276 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bls12378
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/internal/fptower"
+)
+
+// hashToFp hashes msg to count prime field elements.
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5.2
+func hashToFp(msg, dst []byte, count int) ([]fp.Element, error) {
+
+	// 128 bits of security
+	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
+	L := 64
+
+	lenInBytes := count * L
+	pseudoRandomBytes, err := ecc.ExpandMsgXmd(msg, dst, lenInBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]fp.Element, count)
+	for i := 0; i < count; i++ {
+		res[i].SetBytes(pseudoRandomBytes[i*L : (i+1)*L])
+	}
+	return res, nil
+}
+
+// returns false if u>-u when seen as a bigInt
+func sign0(u fp.Element) bool {
+	var a, b big.Int
+	u.ToBigIntRegular(&a)
+	u.Neg(&u)
+	u.ToBigIntRegular(&b)
+	return a.Cmp(&b) <= 0
+}
+
+// ----------------------------------------------------------------------------------------
+// G1Affine
+
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-4.1
+// Shallue and van de Woestijne method, works for any elliptic curve in Weierstrass curve
+func svdwMapG1(u fp.Element) G1Affine {
+
+	var res G1Affine
+
+	// constants
+	// sage script to find z: https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#appendix-E.1
+	var z, c1, c2, c3, c4 fp.Element
+	z.SetOne()
+	c1.SetString("2")
+	c2.SetString("302624103037653085866624240790900480369923845885462456876760372017370467951700652388141901174418655585487141470208")
+	c3.SetString("318098448634154485319650708913468709964888658615701180476019925144258731192840796899994345002846682634186900611828")
+	c4.SetString("403498804050204114488832321054533973826565127847283275835680496023160623935600869850855868232558207447316188626942")
+
+	var tv1, tv2, tv3, tv4, one, x1, gx1, x2, gx2, x3, x, gx, y fp.Element
+	one.SetOne()
+	tv1.Square(&u).Mul(&tv1, &c1)
+	tv2.Add(&one, &tv1)
+	tv1.Sub(&one, &tv1)
+	tv3.Mul(&tv2, &tv1).Inverse(&tv3)
+	tv4.Mul(&u, &tv1)
+	tv4.Mul(&tv4, &tv3)
+	tv4.Mul(&tv4, &c3)
+	x1.Sub(&c2, &tv4)
+	gx1.Square(&x1)
+	// 12. gx1 = gx1 + A
+	gx1.Mul(&gx1, &x1)
+	gx1.Add(&gx1, &bCurveCoeff)
+	e1 := gx1.Legendre()
+	x2.Add(&c2, &tv4)
+	gx2.Square(&x2)
+	// 18. gx2 = gx2 + A
+	gx2.Mul(&gx2, &x2)
+	gx2.Add(&gx2, &bCurveCoeff)
+	e2 := gx2.Legendre() - e1 // 2 if is_square(gx2) AND NOT e1
+	x3.Square(&tv2)
+	x3.Mul(&x3, &tv3)
+	x3.Square(&x3)
+	x3.Mul(&x3, &c4)
+	x3.Add(&x3, &z)
+	if e1 == 1 {
+		x.Set(&x1)
+	} else {
+		x.Set(&x3)
+	}
+	if e2 == 2 {
+		x.Set(&x2)
+	}
+	gx.Square(&x)
+	// gx = gx + A
+	gx.Mul(&gx, &x)
+	gx.Add(&gx, &bCurveCoeff)
+	y.Sqrt(&gx)
+	e3 := sign0(u) && sign0(y)
+	if !e3 {
+		y.Neg(&y)
+	}
+	res.X.Set(&x)
+	res.Y.Set(&y)
+
+	return res
+}
+
+// MapToCurveG1Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.1
+func MapToCurveG1Svdw(t fp.Element) G1Affine {
+	res := svdwMapG1(t)
+	res.ClearCofactor(&res)
+	return res
+}
+
+// EncodeToCurveG1Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.2
+func EncodeToCurveG1Svdw(msg, dst []byte) (G1Affine, error) {
+	var res G1Affine
+	t, err := hashToFp(msg, dst, 1)
+	if err != nil {
+		return res, err
+	}
+	res = MapToCurveG1Svdw(t[0])
+	return res, nil
+}
+
+// HashToCurveG1Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-3
+func HashToCurveG1Svdw(msg, dst []byte) (G1Affine, error) {
+	var res G1Affine
+	u, err := hashToFp(msg, dst, 2)
+	if err != nil {
+		return res, err
+	}
+	Q0 := MapToCurveG1Svdw(u[0])
+	Q1 := MapToCurveG1Svdw(u[1])
+	var _Q0, _Q1, _res G1Jac
+	_Q0.FromAffine(&Q0)
+	_Q1.FromAffine(&Q1)
+	_res.Set(&_Q1).AddAssign(&_Q0)
+	res.FromJacobian(&_res)
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------------------
+// G2Affine
+
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-4.1
+// Shallue and van de Woestijne method, works for any elliptic curve in Weierstrass curve
+func svdwMapG2(u fptower.E2) G2Affine {
+
+	var res G2Affine
+
+	// constants
+	// sage script to find z: https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#appendix-E.1
+	var z, c1, c2, c3, c4 fptower.E2
+	z.A0.SetString("605248206075306171733248481581800960739847691770924913753520744034740935903401304776283802348837311170974282940416")
+	z.A1.SetString("605248206075306171733248481581800960739847691770924913753520744034740935903401304776283802348837311170974282940416")
+	c1.A0.SetString("14")
+	c1.A1.SetString("3")
+	c2.A0.SetString("302624103037653085866624240790900480369923845885462456876760372017370467951700652388141901174418655585487141470209")
+	c2.A1.SetString("302624103037653085866624240790900480369923845885462456876760372017370467951700652388141901174418655585487141470209")
+	c3.A0.SetString("166936493707393430927436899047944897264676364504941026912758161666296457360928472589702626152254738771811843454087")
+	c3.A1.SetString("529720771666248156658604773027587918088070473657558225612554078891775661593301481218721617994296839441019533997000")
+	c4.A0.SetString("425915404275215454182656338890896972372485412727687902270996079135558436376467584842570083134366996749944865772887")
+	c4.A1.SetString("515581805175260812957952410236348966556166552249306408012258411585149686139934444809426942741602153960459574356653")
+
+	var tv1, tv2, tv3, tv4, one, x1, gx1, x2, gx2, x3, x, gx, y fptower.E2
+	one.SetOne()
+	tv1.Square(&u).Mul(&tv1, &c1)
+	tv2.Add(&one, &tv1)
+	tv1.Sub(&one, &tv1)
+	tv3.Mul(&tv2, &tv1).Inverse(&tv3)
+	tv4.Mul(&u, &tv1)
+	tv4.Mul(&tv4, &tv3)
+	tv4.Mul(&tv4, &c3)
+	x1.Sub(&c2, &tv4)
+	gx1.Square(&x1)
+	// 12. gx1 = gx1 + A
+	gx1.Mul(&gx1, &x1)
+	gx1.Add(&gx1, &bTwistCurveCoeff)
+	e1 := gx1.Legendre()
+	x2.Add(&c2, &tv4)
+	gx2.Square(&x2)
+	// 18. gx2 = gx2 + A
+	gx2.Mul(&gx2, &x2)
+	gx2.Add(&gx2, &bTwistCurveCoeff)
+	e2 := gx2.Legendre() - e1 // 2 if is_square(gx2) AND NOT e1
+	x3.Square(&tv2)
+	x3.Mul(&x3, &tv3)
+	x3.Square(&x3)
+	x3.Mul(&x3, &c4)
+	x3.Add(&x3, &z)
+	if e1 == 1 {
+		x.Set(&x1)
+	} else {
+		x.Set(&x3)
+	}
+	if e2 == 2 {
+		x.Set(&x2)
+	}
+	gx.Square(&x)
+	// gx = gx + A
+	gx.Mul(&gx, &x)
+	gx.Add(&gx, &bTwistCurveCoeff)
+	y.Sqrt(&gx)
+	e3 := sign0(u.A0) && sign0(y.A0)
+	if !e3 {
+		y.Neg(&y)
+	}
+	res.X.Set(&x)
+	res.Y.Set(&y)
+
+	return res
+}
+
+// MapToCurveG2Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.1
+func MapToCurveG2Svdw(t fptower.E2) G2Affine {
+	res := svdwMapG2(t)
+	res.ClearCofactor(&res)
+	return res
+}
+
+// EncodeToCurveG2Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.2
+func EncodeToCurveG2Svdw(msg, dst []byte) (G2Affine, error) {
+	var res G2Affine
+	_t, err := hashToFp(msg, dst, 2)
+	if err != nil {
+		return res, err
+	}
+	var t fptower.E2
+	t.A0.Set(&_t[0])
+	t.A1.Set(&_t[1])
+	res = MapToCurveG2Svdw(t)
+	return res, nil
+}
+
+// HashToCurveG2Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-3
+func HashToCurveG2Svdw(msg, dst []byte) (G2Affine, error) {
+	var res G2Affine
+	u, err := hashToFp(msg, dst, 4)
+	if err != nil {
+		return res, err
+	}
+	var u0, u1 fptower.E2
+	u0.A0.Set(&u[0])
+	u0.A1.Set(&u[1])
+	u1.A0.Set(&u[2])
+	u1.A1.Set(&u[3])
+	Q0 := MapToCurveG2Svdw(u0)
+	Q1 := MapToCurveG2Svdw(u1)
+	var _Q0, _Q1, _res G2Jac
+	_Q0.FromAffine(&Q0)
+	_Q1.FromAffine(&Q1)
+	_res.Set(&_Q1).AddAssign(&_Q0)
+	res.FromJacobian(&_res)
+	return res, nil
+}
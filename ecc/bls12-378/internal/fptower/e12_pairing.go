@@ -0,0 +1,76 @@
+package fptower
+
+// Expt set z to x^t in E12 and return z
+func (z *E12) Expt(x *E12) *E12 {
+	// const tAbsVal uint64 = 11045256207009841153
+	// tAbsVal in binary: 1001100101001000101000100000000000000000000000000000000000000001
+	// drop the low 41 bits (all 0 except the least significant bit): 10011001010010001010001 = 5022801
+	// Shortest addition chains can be found at https://wwwhomes.uni-bielefeld.de/achim/addition_chain.html
+
+	var result E12
+
+	// an addition chain for 5022801
+	result.Set(x)                    // 0                  1
+	result.CyclotomicSquare(&result) // 1( 0)              2
+	result.CyclotomicSquare(&result) // 2( 1)              4
+	result.CyclotomicSquare(&result) // 3( 2)              8
+	result.Mul(&result, x)           // 4( 3, 0)           9
+	result.CyclotomicSquare(&result) // 5( 4)             18
+	result.Mul(&result, x)           // 6( 5, 0)          19
+	result.CyclotomicSquare(&result) // 7( 6)             38
+	result.CyclotomicSquare(&result) // 8( 7)             76
+	result.CyclotomicSquare(&result) // 9( 8)            152
+	result.Mul(&result, x)           // 10( 9, 0)        153
+	result.CyclotomicSquare(&result) // 11(10)           306
+	result.CyclotomicSquare(&result) // 12(11)           612
+	result.Mul(&result, x)           // 13(12, 0)        613
+	result.CyclotomicSquare(&result) // 14(13)          1226
+	result.CyclotomicSquare(&result) // 15(14)          2452
+	result.CyclotomicSquare(&result) // 16(15)          4904
+	result.Mul(&result, x)           // 17(16, 0)       4905
+	result.CyclotomicSquare(&result) // 18(17)          9810
+	result.CyclotomicSquare(&result) // 19(18)         19620
+	result.CyclotomicSquare(&result) // 20(19)         39240
+	result.CyclotomicSquare(&result) // 21(20)         78480
+	result.Mul(&result, x)           // 22(21, 0)      78481
+	result.CyclotomicSquare(&result) // 23(22)        156962
+	result.CyclotomicSquare(&result) // 24(23)        313924
+	result.Mul(&result, x)           // 25(24, 0)     313925
+	result.CyclotomicSquare(&result) // 26(25)        627850
+	result.CyclotomicSquare(&result) // 27(26)       1255700
+	result.CyclotomicSquare(&result) // 28(27)       2511400
+	result.CyclotomicSquare(&result) // 29(28)       5022800
+	result.Mul(&result, x)           // 30(29, 0)    5022801
+
+	// the remaining 41 bits
+	for i := 0; i < 41; i++ {
+		result.CyclotomicSquare(&result)
+	}
+	result.Mul(&result, x)
+
+	z.Set(&result)
+	return z
+}
+
+// MulBy014 multiplication by sparse element (c0, c1, 0, 0, c4)
+func (z *E12) MulBy014(c0, c1, c4 *E2) *E12 {
+
+	var a, b E6
+	var d E2
+
+	a.Set(&z.C0)
+	a.MulBy01(c0, c1)
+
+	b.Set(&z.C1)
+	b.MulBy1(c4)
+	d.Add(c1, c4)
+
+	z.C1.Add(&z.C1, &z.C0)
+	z.C1.MulBy01(c0, &d)
+	z.C1.Sub(&z.C1, &a)
+	z.C1.Sub(&z.C1, &b)
+	z.C0.MulByNonResidue(&b)
+	z.C0.Add(&z.C0, &a)
+
+	return z
+}
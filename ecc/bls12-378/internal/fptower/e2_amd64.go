@@ -0,0 +1,45 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fptower
+
+// q (modulus)
+var qE2 = [6]uint64{
+	11045256207009841153,
+	14886639130118979584,
+	10956628289047010687,
+	9513184293603517222,
+	6038022134869067682,
+	283357621510263184,
+}
+
+// q'[0], see montgommery multiplication algorithm
+var (
+	qE2Inv0 uint64 = 11045256207009841151
+	_              = qE2Inv0 // used in asm
+)
+
+//go:noescape
+func addE2(res, x, y *E2)
+
+//go:noescape
+func subE2(res, x, y *E2)
+
+//go:noescape
+func doubleE2(res, x *E2)
+
+//go:noescape
+func negE2(res, x *E2)
@@ -0,0 +1,318 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bls12378
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/internal/fptower"
+)
+
+// GT target group of the pairing
+type GT = fptower.E12
+
+// LineEvaluation holds the three non-zero coefficients of a line of the Miller loop,
+// as multiplied into the accumulator with GT.MulBy014
+type LineEvaluation struct {
+	R0 fptower.E2
+	R1 fptower.E2
+	R2 fptower.E2
+}
+
+// Pair calculates the reduced pairing for a set of points
+func Pair(P []G1Affine, Q []G2Affine) (GT, error) {
+	f, err := MillerLoop(P, Q)
+	if err != nil {
+		return GT{}, err
+	}
+	return FinalExponentiation(&f), nil
+}
+
+// PairingCheck calculates the reduced pairing for a set of points and returns True if the result is One
+func PairingCheck(P []G1Affine, Q []G2Affine) (bool, error) {
+	f, err := Pair(P, Q)
+	if err != nil {
+		return false, err
+	}
+	var one GT
+	one.SetOne()
+	return f.Equal(&one), nil
+}
+
+// FinalExponentiation computes the final expo x**(p**6-1)(p**2+1)(p**4 - p**2 +1)/r
+func FinalExponentiation(z *GT, _z ...*GT) GT {
+
+	var result GT
+	result.Set(z)
+
+	for _, e := range _z {
+		result.Mul(&result, e)
+	}
+
+	result = FinalExponentiationEasy(&result)
+	return FinalExponentiationHard(&result)
+}
+
+// FinalExponentiationEasy computes the easy part x**(p**6-1)(p**2+1) of the final expo.
+// The result lies in the cyclotomic subgroup of GT.
+func FinalExponentiationEasy(z *GT) GT {
+
+	var result, t GT
+	t.Conjugate(z)
+	result.Inverse(z)
+	t.Mul(&t, &result)
+	result.FrobeniusSquare(&t).
+		Mul(&result, &t)
+
+	return result
+}
+
+// FinalExponentiationHard computes the hard part x**(p**4 - p**2 +1)/r of the final expo.
+// z must lie in the cyclotomic subgroup of GT, e.g. be the output of FinalExponentiationEasy.
+func FinalExponentiationHard(z *GT) GT {
+
+	var result GT
+	result.Set(z)
+
+	// https://eprint.iacr.org/2016/130.pdf
+	var t [3]GT
+
+	// hard part (up to permutation)
+	// Daiki Hayashida and Kenichiro Hayasaka
+	// and Tadanori Teruya
+	// https://eprint.iacr.org/2020/875.pdf
+	t[0].CyclotomicSquare(&result)
+	t[1].Expt(&result)
+	t[2].InverseUnitary(&result)
+	t[1].Mul(&t[1], &t[2])
+	t[2].Expt(&t[1])
+	t[1].InverseUnitary(&t[1])
+	t[1].Mul(&t[1], &t[2])
+	t[2].Expt(&t[1])
+	t[1].Frobenius(&t[1])
+	t[1].Mul(&t[1], &t[2])
+	result.Mul(&result, &t[0])
+	t[0].Expt(&t[1])
+	t[2].Expt(&t[0])
+	t[0].FrobeniusSquare(&t[1])
+	t[1].InverseUnitary(&t[1])
+	t[1].Mul(&t[1], &t[2])
+	t[1].Mul(&t[1], &t[0])
+	result.Mul(&result, &t[1])
+
+	return result
+}
+
+// MillerLoop Miller loop
+func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
+	// check input size match
+	n := len(P)
+	if n == 0 || n != len(Q) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+
+	// filter infinity points
+	p := make([]G1Affine, 0, n)
+	q := make([]G2Affine, 0, n)
+
+	for k := 0; k < n; k++ {
+		if P[k].IsInfinity() || Q[k].IsInfinity() {
+			continue
+		}
+		p = append(p, P[k])
+		q = append(q, Q[k])
+	}
+
+	n = len(p)
+
+	// projective points for Q
+	qProj := make([]g2Proj, n)
+	for k := 0; k < n; k++ {
+		qProj[k].FromAffine(&q[k])
+	}
+
+	var result GT
+	result.SetOne()
+
+	var l LineEvaluation
+
+	// i == 62
+	for k := 0; k < n; k++ {
+		qProj[k].DoubleStep(&l)
+		// line eval
+		l.R1.MulByElement(&l.R1, &p[k].X)
+		l.R2.MulByElement(&l.R2, &p[k].Y)
+		result.MulBy014(&l.R0, &l.R1, &l.R2)
+	}
+
+	for i := 61; i >= 0; i-- {
+		result.Square(&result)
+
+		for k := 0; k < n; k++ {
+			qProj[k].DoubleStep(&l)
+			// line eval
+			l.R1.MulByElement(&l.R1, &p[k].X)
+			l.R2.MulByElement(&l.R2, &p[k].Y)
+			result.MulBy014(&l.R0, &l.R1, &l.R2)
+		}
+
+		if loopCounter[i] == 0 {
+			continue
+		}
+
+		for k := 0; k < n; k++ {
+			qProj[k].AddMixedStep(&l, &q[k])
+			// line eval
+			l.R1.MulByElement(&l.R1, &p[k].X)
+			l.R2.MulByElement(&l.R2, &p[k].Y)
+			result.MulBy014(&l.R0, &l.R1, &l.R2)
+		}
+	}
+
+	return result, nil
+}
+
+// MillerLoopStep records a line multiplication of the Miller loop
+type MillerLoopStep struct {
+	Line        LineEvaluation // line as output by the doubling or addition step
+	Evaluation  LineEvaluation // Line evaluated at the G1 point
+	Accumulator GT             // Miller loop accumulator once Evaluation is multiplied in
+}
+
+// MillerLoopSteps runs the Miller loop on a single pair (P, Q) and returns, in order,
+// every line multiplied into the accumulator along with the resulting accumulator.
+// The returned GT equals MillerLoop([]G1Affine{P}, []G2Affine{Q}).
+// If P or Q is the point at infinity, no step is recorded and the result is one.
+func MillerLoopSteps(P *G1Affine, Q *G2Affine) ([]MillerLoopStep, GT) {
+	var result GT
+	result.SetOne()
+	if P.IsInfinity() || Q.IsInfinity() {
+		return nil, result
+	}
+
+	var qProj g2Proj
+	qProj.FromAffine(Q)
+
+	var l LineEvaluation
+	steps := make([]MillerLoopStep, 0, 2*len(loopCounter))
+	mulLine := func() {
+		step := MillerLoopStep{Line: l}
+		l.R1.MulByElement(&l.R1, &P.X)
+		l.R2.MulByElement(&l.R2, &P.Y)
+		step.Evaluation = l
+		result.MulBy014(&l.R0, &l.R1, &l.R2)
+		step.Accumulator = result
+		steps = append(steps, step)
+	}
+
+	// i == 62
+	qProj.DoubleStep(&l)
+	mulLine()
+
+	for i := 61; i >= 0; i-- {
+		result.Square(&result)
+
+		qProj.DoubleStep(&l)
+		mulLine()
+
+		if loopCounter[i] == 0 {
+			continue
+		}
+
+		qProj.AddMixedStep(&l, Q)
+		mulLine()
+	}
+
+	return steps, result
+}
+
+// DoubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
+// https://eprint.iacr.org/2013/722.pdf (Section 4.3)
+func (p *g2Proj) DoubleStep(l *LineEvaluation) {
+
+	// get some Element from our pool
+	var t0, t1, A, B, C, D, E, EE, F, G, H, I, J, K fptower.E2
+	t0.Mul(&p.x, &p.y)
+	A.MulByElement(&t0, &twoInv)
+	B.Square(&p.y)
+	C.Square(&p.z)
+	D.Double(&C).
+		Add(&D, &C)
+	E.Mul(&D, &bTwistCurveCoeff)
+	F.Double(&E).
+		Add(&F, &E)
+	G.Add(&B, &F)
+	G.MulByElement(&G, &twoInv)
+	H.Add(&p.y, &p.z).
+		Square(&H)
+	t1.Add(&B, &C)
+	H.Sub(&H, &t1)
+	I.Sub(&E, &B)
+	J.Square(&p.x)
+	EE.Square(&E)
+	K.Double(&EE).
+		Add(&K, &EE)
+
+	// X, Y, Z
+	p.x.Sub(&B, &F).
+		Mul(&p.x, &A)
+	p.y.Square(&G).
+		Sub(&p.y, &K)
+	p.z.Mul(&B, &H)
+
+	// Line evaluation
+	l.R0.Set(&I)
+	l.R1.Double(&J).
+		Add(&l.R1, &J)
+	l.R2.Neg(&H)
+
+}
+
+// AddMixedStep point addition in Mixed Homogenous projective and Affine coordinates
+// https://eprint.iacr.org/2013/722.pdf (Section 4.3)
+func (p *g2Proj) AddMixedStep(l *LineEvaluation, a *G2Affine) {
+
+	// get some Element from our pool
+	var Y2Z1, X2Z1, O, L, C, D, E, F, G, H, t0, t1, t2, J fptower.E2
+	Y2Z1.Mul(&a.Y, &p.z)
+	O.Sub(&p.y, &Y2Z1)
+	X2Z1.Mul(&a.X, &p.z)
+	L.Sub(&p.x, &X2Z1)
+	C.Square(&O)
+	D.Square(&L)
+	E.Mul(&L, &D)
+	F.Mul(&p.z, &C)
+	G.Mul(&p.x, &D)
+	t0.Double(&G)
+	H.Add(&E, &F).
+		Sub(&H, &t0)
+	t1.Mul(&p.y, &E)
+
+	// X, Y, Z
+	p.x.Mul(&L, &H)
+	p.y.Sub(&G, &H).
+		Mul(&p.y, &O).
+		Sub(&p.y, &t1)
+	p.z.Mul(&E, &p.z)
+
+	t2.Mul(&L, &a.Y)
+	J.Mul(&a.X, &O).
+		Sub(&J, &t2)
+
+	// Line evaluation
+	l.R0.Set(&J)
+	l.R1.Neg(&O)
+	l.R2.Set(&L)
+}
@@ -0,0 +1,366 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestPairing(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE12()
+	genR1 := GenFr()
+	genR2 := GenFr()
+
+	properties.Property("[BLS12-378] Having the receiver as operand (final expo) should output the same result", prop.ForAll(
+		func(a GT) bool {
+			b := a
+			b = FinalExponentiation(&a)
+			a = FinalExponentiation(&a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-378] Exponentiating FinalExpo(a) to r should output 1", prop.ForAll(
+		func(a GT) bool {
+			b := FinalExponentiation(&a)
+			return !a.IsInSubGroup() && b.IsInSubGroup()
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-378] Expt(Expt) and Exp(t^2) should output the same result in the cyclotomic subgroup", prop.ForAll(
+		func(a GT) bool {
+			var b, c, d GT
+			b.Conjugate(&a)
+			a.Inverse(&a)
+			b.Mul(&b, &a)
+
+			a.FrobeniusSquare(&b).
+				Mul(&a, &b)
+
+			c.Expt(&a).Expt(&c)
+			d.Exp(&a, xGen).Exp(&d, xGen)
+			return c.Equal(&d)
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-378] bilinearity", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var res, resa, resb, resab, zero GT
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint, ab big.Int
+
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+			ab.Mul(&abigint, &bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			res, _ = Pair([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+			resa, _ = Pair([]G1Affine{ag1}, []G2Affine{g2GenAff})
+			resb, _ = Pair([]G1Affine{g1GenAff}, []G2Affine{bg2})
+
+			resab.Exp(&res, ab)
+			resa.Exp(&resa, bbigint)
+			resb.Exp(&resb, abigint)
+
+			return resab.Equal(&resa) && resab.Equal(&resb) && !res.Equal(&zero)
+
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS12-378] MillerLoop of pairs should be equal to the product of MillerLoops", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var simpleProd, factorizedProd GT
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint big.Int
+
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			P0 := []G1Affine{g1GenAff}
+			P1 := []G1Affine{ag1}
+			Q0 := []G2Affine{g2GenAff}
+			Q1 := []G2Affine{bg2}
+
+			// FE( ML(a,b) * ML(c,d) * ML(e,f) * ML(g,h) )
+			M1, _ := MillerLoop(P0, Q0)
+			M2, _ := MillerLoop(P1, Q0)
+			M3, _ := MillerLoop(P0, Q1)
+			M4, _ := MillerLoop(P1, Q1)
+			simpleProd.Mul(&M1, &M2).Mul(&simpleProd, &M3).Mul(&simpleProd, &M4)
+			simpleProd = FinalExponentiation(&simpleProd)
+
+			tabP := []G1Affine{g1GenAff, ag1, g1GenAff, ag1}
+			tabQ := []G2Affine{g2GenAff, g2GenAff, bg2, bg2}
+
+			// FE( ML([a,c,e,g] ; [b,d,f,h]) ) -> saves 3 squares in Fqk
+			factorizedProd, _ = Pair(tabP, tabQ)
+
+			return simpleProd.Equal(&factorizedProd)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS12-378] PairingCheck", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var g1GenAffNeg G1Affine
+			g1GenAffNeg.Neg(&g1GenAff)
+			tabP := []G1Affine{g1GenAff, g1GenAffNeg}
+			tabQ := []G2Affine{g2GenAff, g2GenAff}
+
+			res, _ := PairingCheck(tabP, tabQ)
+
+			return res
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS12-378] MillerLoop should skip pairs with a point at infinity", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var one GT
+
+			var ag1, g1Inf G1Affine
+			var bg2, g2Inf G2Affine
+
+			var abigint, bbigint big.Int
+
+			one.SetOne()
+
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			g1Inf.FromJacobian(&g1Infinity)
+			g2Inf.FromJacobian(&g2Infinity)
+
+			// e([0,c] ; [b,d])
+			tabP := []G1Affine{g1Inf, ag1}
+			tabQ := []G2Affine{g2GenAff, bg2}
+			res1, _ := Pair(tabP, tabQ)
+
+			// e([a,c] ; [0,d])
+			tabP = []G1Affine{g1GenAff, ag1}
+			tabQ = []G2Affine{g2Inf, bg2}
+			res2, _ := Pair(tabP, tabQ)
+
+			// e([0,c] ; [d,0])
+			tabP = []G1Affine{g1Inf, ag1}
+			tabQ = []G2Affine{bg2, g2Inf}
+			res3, _ := Pair(tabP, tabQ)
+
+			return res1.Equal(&res2) && !res2.Equal(&res3) && res3.Equal(&one)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS12-378] MillerLoopSteps should output the same result as MillerLoop", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint big.Int
+
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			steps, res := MillerLoopSteps(&ag1, &bg2)
+			ml, _ := MillerLoop([]G1Affine{ag1}, []G2Affine{bg2})
+
+			return len(steps) > 0 && res.Equal(&ml)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS12-378] MillerLoopSteps accumulators should be recomputed from the returned lines", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint big.Int
+
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			steps, _ := MillerLoopSteps(&ag1, &bg2)
+
+			return checkMillerLoopSteps(&ag1, steps)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS12-378] FinalExponentiationHard(FinalExponentiationEasy) should equal FinalExponentiation", prop.ForAll(
+		func(a GT) bool {
+			b := FinalExponentiationEasy(&a)
+			b = FinalExponentiationHard(&b)
+			c := FinalExponentiation(&a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// checkMillerLoopSteps recomputes the line evaluations and the accumulators of MillerLoopSteps
+// from the returned lines: each digit of a loop counter below the leading one squares the
+// accumulator and multiplies a doubling line in, then an addition line if the digit is not zero.
+func checkMillerLoopSteps(P *G1Affine, steps []MillerLoopStep) bool {
+	var acc GT
+	i := 0
+	next := func(doubling bool) bool {
+		if i >= len(steps) {
+			return false
+		}
+		s := &steps[i]
+		i++
+
+		e := s.Line
+		e.R1.MulByElement(&e.R1, &P.X)
+		e.R2.MulByElement(&e.R2, &P.Y)
+		if e != s.Evaluation {
+			return false
+		}
+
+		if doubling {
+			acc.Square(&acc)
+		}
+		acc.MulBy014(&e.R0, &e.R1, &e.R2)
+		return acc.Equal(&s.Accumulator)
+	}
+	loop := func(counter []int8) bool {
+		acc.SetOne()
+		for j := len(counter) - 2; j >= 0; j-- {
+			if !next(true) {
+				return false
+			}
+			if counter[j] != 0 && !next(false) {
+				return false
+			}
+		}
+		return true
+	}
+	if !loop(loopCounter[:]) {
+		return false
+	}
+
+	return i == len(steps)
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkPairing(b *testing.B) {
+
+	var g1GenAff G1Affine
+	var g2GenAff G2Affine
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Pair([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+	}
+}
+
+func BenchmarkMillerLoop(b *testing.B) {
+
+	var g1GenAff G1Affine
+	var g2GenAff G2Affine
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MillerLoop([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+	}
+}
+
+func BenchmarkFinalExponentiation(b *testing.B) {
+
+	var a GT
+	a.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FinalExponentiation(&a)
+	}
+
+}
+
+func BenchmarkMultiPairing(b *testing.B) {
+
+	var g1GenAff G1Affine
+	var g2GenAff G2Affine
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Pair([]G1Affine{g1GenAff, g1GenAff, g1GenAff}, []G2Affine{g2GenAff, g2GenAff, g2GenAff})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"math/big"
+)
+
+// refCurve is a slow math/big implementation of the affine arithmetic on y**2 = x**3 + b
+// over a prime field, used as a reference by the fuzz tests
+type refCurve struct {
+	p, b big.Int
+}
+
+// refPoint is an affine point of a refCurve
+type refPoint struct {
+	x, y big.Int
+	inf  bool
+}
+
+func newRefCurve(p, b *big.Int) *refCurve {
+	var c refCurve
+	c.p.Set(p)
+	c.b.Mod(b, p)
+	return &c
+}
+
+func (c *refCurve) isOnCurve(a *refPoint) bool {
+	if a.inf {
+		return true
+	}
+	var left, right big.Int
+	left.Mul(&a.y, &a.y).Mod(&left, &c.p)
+	right.Mul(&a.x, &a.x).Mul(&right, &a.x).Add(&right, &c.b).Mod(&right, &c.p)
+	return left.Cmp(&right) == 0
+}
+
+func (c *refCurve) equal(a, b *refPoint) bool {
+	if a.inf || b.inf {
+		return a.inf == b.inf
+	}
+	return a.x.Cmp(&b.x) == 0 && a.y.Cmp(&b.y) == 0
+}
+
+func (c *refCurve) neg(a *refPoint) refPoint {
+	var res refPoint
+	res.inf = a.inf
+	res.x.Set(&a.x)
+	res.y.Neg(&a.y).Mod(&res.y, &c.p)
+	return res
+}
+
+func (c *refCurve) add(a, b *refPoint) refPoint {
+	if a.inf {
+		return *b
+	}
+	if b.inf {
+		return *a
+	}
+	if a.x.Cmp(&b.x) == 0 {
+		var sum big.Int
+		if sum.Add(&a.y, &b.y).Mod(&sum, &c.p).Sign() == 0 {
+			return refPoint{inf: true}
+		}
+		return c.double(a)
+	}
+
+	// lambda = (y2-y1)/(x2-x1)
+	var lambda, den big.Int
+	den.Sub(&b.x, &a.x).Mod(&den, &c.p).ModInverse(&den, &c.p)
+	lambda.Sub(&b.y, &a.y).Mul(&lambda, &den).Mod(&lambda, &c.p)
+	return c.chord(&lambda, a, &b.x)
+}
+
+func (c *refCurve) double(a *refPoint) refPoint {
+	if a.inf || a.y.Sign() == 0 {
+		return refPoint{inf: true}
+	}
+
+	// lambda = 3x**2/2y
+	var lambda, den big.Int
+	den.Lsh(&a.y, 1).Mod(&den, &c.p).ModInverse(&den, &c.p)
+	lambda.Mul(&a.x, &a.x).Mul(&lambda, big.NewInt(3)).Mul(&lambda, &den).Mod(&lambda, &c.p)
+	return c.chord(&lambda, a, &a.x)
+}
+
+// chord returns the third intersection of the line of slope lambda through a, negated
+func (c *refCurve) chord(lambda *big.Int, a *refPoint, x2 *big.Int) refPoint {
+	var res refPoint
+	res.x.Mul(lambda, lambda).Sub(&res.x, &a.x).Sub(&res.x, x2).Mod(&res.x, &c.p)
+	res.y.Sub(&a.x, &res.x).Mul(&res.y, lambda).Sub(&res.y, &a.y).Mod(&res.y, &c.p)
+	return res
+}
+
+// scalarMul returns [s]a with the double and add algorithm, s >= 0
+func (c *refCurve) scalarMul(a *refPoint, s *big.Int) refPoint {
+	res := refPoint{inf: true}
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res = c.double(&res)
+		if s.Bit(i) == 1 {
+			res = c.add(&res, a)
+		}
+	}
+	return res
+}
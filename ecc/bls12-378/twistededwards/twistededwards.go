@@ -0,0 +1,50 @@
+package twistededwards
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fr.Element // in Montgomery form
+	Cofactor fr.Element // not in Montgomery form
+	Order    big.Int
+	Base     PointAffine
+}
+
+var edwards CurveParams
+
+// GetEdwardsCurve returns the twisted Edwards curve on BLS12-378's Fr
+func GetEdwardsCurve() CurveParams {
+
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&edwards.A)
+	res.D.Set(&edwards.D)
+	res.Cofactor.Set(&edwards.Cofactor)
+	res.Order.Set(&edwards.Order)
+	res.Base.Set(&edwards.Base)
+
+	return res
+}
+
+func init() {
+
+	edwards.A.SetUint64(5)
+	edwards.D.SetString("10430015006549412386195900310309886739270379422272482629677751595951192988184")
+	edwards.Cofactor.SetUint64(4).FromMont()
+	edwards.Order.SetString("3720858766728033224987579715282041817410220489694890086377104905406820261933", 10)
+
+	edwards.Base.X.SetString("140073824894040919356096578385806197428123088107741236524217817364030310183")
+	edwards.Base.Y.SetString("2325303323310101083829106377411781501373527171751706097407989570619358481764")
+}
+
+// mulByA multiplies x by the curve coefficient a=5
+func mulByA(x *fr.Element) {
+	var tmp fr.Element
+	tmp.Double(x).Double(&tmp)
+	x.Add(x, &tmp)
+}
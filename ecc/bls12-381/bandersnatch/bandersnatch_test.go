@@ -0,0 +1,318 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bandersnatch
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestCurveParams(t *testing.T) {
+
+	params := GetEdwardsCurve()
+
+	if !params.Base.IsOnCurve() {
+		t.Fatal("base point is not on the curve")
+	}
+	if !params.Base.IsInSubGroup() {
+		t.Fatal("base point is not in the prime order subgroup")
+	}
+
+	// lambda^2 = -2 mod r
+	var l big.Int
+	l.Mul(&lambdaGLV, &lambdaGLV).Add(&l, big.NewInt(2)).Mod(&l, &params.Order)
+	if l.Sign() != 0 {
+		t.Fatal("lambda is not a square root of -2")
+	}
+}
+
+func TestEndomorphism(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+	genS := GenBigInt()
+
+	properties.Property("phi(P) = lambdaGLV * P", prop.ForAll(
+		func(s big.Int) bool {
+			var p, res1, res2 PointExtended
+			p.FromAffine(&edwards.Base)
+			p.scalarMulWindowed(&p, &s)
+			res1.phi(&p)
+			res2.scalarMulWindowed(&p, &lambdaGLV)
+
+			return res1.Equal(&res2)
+		},
+		genS,
+	))
+
+	properties.Property("GLV and double-and-add scalar multiplications should output the same result", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+			var p, res1, res2 PointExtended
+			p.FromAffine(&edwards.Base)
+			p.scalarMulWindowed(&p, &s1)
+			res1.scalarMulGLV(&p, &s2)
+			res2.scalarMulWindowed(&p, &s2)
+
+			return res1.Equal(&res2)
+		},
+		genS,
+		genS,
+	))
+
+	properties.Property("[r]P = O with GLV", prop.ForAll(
+		func(s big.Int) bool {
+			var p PointExtended
+			p.FromAffine(&edwards.Base)
+			p.ScalarMul(&p, &s)
+			p.ScalarMul(&p, &edwards.Order)
+
+			return p.IsZero()
+		},
+		genS,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestOps(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+	genS1 := GenBigInt()
+	genS2 := GenBigInt()
+
+	properties.Property("(affine) P+(-P)=O", prop.ForAll(
+		func(s1 big.Int) bool {
+
+			var p1, p2 PointAffine
+			p1.ScalarMul(&edwards.Base, &s1)
+			p2.Neg(&p1)
+
+			p1.Add(&p1, &p2)
+
+			return p1.IsZero()
+		},
+		genS1,
+	))
+
+	properties.Property("(affine) P+P=2*P", prop.ForAll(
+		func(s big.Int) bool {
+
+			var p1, p2 PointAffine
+			p1.ScalarMul(&edwards.Base, &s)
+			p2.ScalarMul(&edwards.Base, &s)
+
+			p1.Add(&p1, &p2)
+			p2.Double(&p2)
+
+			return p1.Equal(&p2) && p1.IsOnCurve()
+		},
+		genS1,
+	))
+
+	properties.Property("(affine) [a]P+[b]P = [a+b]P", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+
+			var p1, p2, p3 PointAffine
+			p1.ScalarMul(&edwards.Base, &s1)
+			p2.ScalarMul(&edwards.Base, &s2)
+
+			p2.Add(&p1, &p2)
+
+			s1.Add(&s1, &s2)
+			p3.ScalarMul(&edwards.Base, &s1)
+
+			return p3.Equal(&p2)
+		},
+		genS1,
+		genS2,
+	))
+
+	properties.Property("(projective) [a]P+[b]P = [a+b]P, 2*([a]P) = [2a]P", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+
+			var p1, p2, p3, p4 PointProj
+			var base PointProj
+			base.FromAffine(&edwards.Base)
+			p1.ScalarMul(&base, &s1)
+			p2.ScalarMul(&base, &s2)
+			p4.Double(&p1)
+
+			p2.Add(&p1, &p2)
+
+			var s big.Int
+			s.Add(&s1, &s2)
+			p3.ScalarMul(&base, &s)
+			s.Lsh(&s1, 1)
+			p1.ScalarMul(&base, &s)
+
+			return p3.Equal(&p2) && p1.Equal(&p4)
+		},
+		genS1,
+		genS2,
+	))
+
+	properties.Property("(extended) mixed addition, addition and doubling should output the same result", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+
+			var p1, p2 PointAffine
+			p1.ScalarMul(&edwards.Base, &s1)
+			p2.ScalarMul(&edwards.Base, &s2)
+
+			var e1, e2, add, madd, dbl, dbl2 PointExtended
+			e1.FromAffine(&p1)
+			e2.FromAffine(&p2)
+			add.Add(&e1, &e2)
+			madd.MixedAdd(&e1, &p2)
+			dbl.Double(&e1)
+			dbl2.Add(&e1, &e1)
+
+			var expected PointAffine
+			expected.Add(&p1, &p2)
+			var res PointAffine
+			res.FromExtended(&add)
+
+			return add.Equal(&madd) && dbl.Equal(&dbl2) && res.Equal(&expected)
+		},
+		genS1,
+		genS2,
+	))
+
+	properties.Property("(extended) the T coordinate should be XY/Z", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+
+			var e1, e2 PointExtended
+			e1.FromAffine(&edwards.Base)
+			e1.ScalarMul(&e1, &s1)
+			e2.FromAffine(&edwards.Base)
+			e2.ScalarMul(&e2, &s2)
+			e1.Add(&e1, &e2).Double(&e1)
+
+			var lhs, rhs = e1.T, e1.X
+			lhs.Mul(&lhs, &e1.Z)
+			rhs.Mul(&rhs, &e1.Y)
+
+			return lhs.Equal(&rhs)
+		},
+		genS1,
+		genS2,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestMultiExp(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 3
+
+	properties := gopter.NewProperties(parameters)
+
+	const nbSamples = 73
+
+	var samplePoints [nbSamples]PointAffine
+	var g PointExtended
+	g.FromAffine(&edwards.Base)
+	for i := 0; i < nbSamples; i++ {
+		g.Double(&g)
+		samplePoints[i].FromExtended(&g)
+	}
+
+	properties.Property("MultiExp should match the sum of the scalar multiplications", prop.ForAll(
+		func(mixer fr.Element) bool {
+
+			var scalars [nbSamples]fr.Element
+			var expected PointExtended
+			expected.setInfinity()
+			for i := 0; i < nbSamples; i++ {
+				scalars[i].SetUint64(uint64(i+1)).
+					Mul(&scalars[i], &mixer)
+
+				var s big.Int
+				scalars[i].ToBigIntRegular(&s)
+				var tmp PointExtended
+				tmp.FromAffine(&samplePoints[i])
+				tmp.ScalarMul(&tmp, &s)
+				expected.Add(&expected, &tmp)
+
+				scalars[i].FromMont()
+			}
+
+			var res PointExtended
+			_, err := res.MultiExp(samplePoints[:], scalars[:], ecc.MultiExpConfig{})
+
+			return err == nil && res.Equal(&expected)
+		},
+		GenFr(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestMarshal(t *testing.T) {
+
+	var point, unmarshalPoint PointAffine
+	point.Set(&edwards.Base)
+	for i := 0; i < 20; i++ {
+		b := point.Marshal()
+		if err := unmarshalPoint.Unmarshal(b); err != nil {
+			t.Fatal(err)
+		}
+		if !point.Equal(&unmarshalPoint) {
+			t.Fatal("error unmarshal(marshal(point))")
+		}
+		point.Add(&point, &edwards.Base)
+	}
+}
+
+// GenBigInt generates a big.Int
+func GenBigInt() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var s big.Int
+		var b [fr.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		s.SetBytes(b[:])
+		genResult := gopter.NewGenResult(s, gopter.NoShrinker)
+		return genResult
+	}
+}
+
+// GenFr generates an Fr element
+func GenFr() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var elmt fr.Element
+		var b [fr.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		elmt.SetBytes(b[:])
+		genResult := gopter.NewGenResult(elmt, gopter.NoShrinker)
+		return genResult
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package banderwagon
+
+import (
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// SizeElementCompressed size in byte of a compressed element
+const SizeElementCompressed = fp.Bytes
+
+var (
+	// ErrInvalidEncoding is returned when the buffer is not the canonical encoding of an x-coordinate
+	ErrInvalidEncoding = errors.New("invalid banderwagon encoding")
+	// ErrNotInGroup is returned when the decoded x-coordinate doesn't correspond to an element of the group
+	ErrNotInGroup = errors.New("point not in the banderwagon group")
+)
+
+// Element is an element of the banderwagon group, that is a bandersnatch point of
+// order dividing 2r, where P and P+(0,-1) = (-x,-y) are identified.
+// The zero value is not a valid Element, use Identity() instead.
+type Element struct {
+	inner bandersnatch.PointExtended
+}
+
+// Identity returns the neutral element of the group
+func Identity() Element {
+	var res Element
+	res.inner.X.SetZero()
+	res.inner.Y.SetOne()
+	res.inner.T.SetZero()
+	res.inner.Z.SetOne()
+	return res
+}
+
+// Generator returns the generator of the group, the class of bandersnatch's base point
+func Generator() Element {
+	var res Element
+	base := bandersnatch.GetEdwardsCurve().Base
+	res.inner.FromAffine(&base)
+	return res
+}
+
+// Set sets e to e1 and returns it
+func (e *Element) Set(e1 *Element) *Element {
+	e.inner.Set(&e1.inner)
+	return e
+}
+
+// Equal returns true if e and e1 represent the same element
+// (x1, y1) ~ (x2, y2) if and only if x1*y2 = x2*y1
+func (e *Element) Equal(e1 *Element) bool {
+	var lhs, rhs fp.Element
+	lhs.Mul(&e.inner.X, &e1.inner.Y)
+	rhs.Mul(&e1.inner.X, &e.inner.Y)
+	return lhs.Equal(&rhs)
+}
+
+// IsIdentity returns true if e is the neutral element of the group
+func (e *Element) IsIdentity() bool {
+	return e.inner.X.IsZero()
+}
+
+// Add sets e to e1+e2 and returns it
+func (e *Element) Add(e1, e2 *Element) *Element {
+	e.inner.Add(&e1.inner, &e2.inner)
+	return e
+}
+
+// Sub sets e to e1-e2 and returns it
+func (e *Element) Sub(e1, e2 *Element) *Element {
+	var neg bandersnatch.PointExtended
+	neg.Neg(&e2.inner)
+	e.inner.Add(&e1.inner, &neg)
+	return e
+}
+
+// Double sets e to 2*e1 and returns it
+func (e *Element) Double(e1 *Element) *Element {
+	e.inner.Double(&e1.inner)
+	return e
+}
+
+// Neg sets e to -e1 and returns it
+func (e *Element) Neg(e1 *Element) *Element {
+	e.inner.Neg(&e1.inner)
+	return e
+}
+
+// ScalarMul sets e to [scalar]e1 and returns it
+// scalar NOT in Montgomery form
+func (e *Element) ScalarMul(e1 *Element, scalar *big.Int) *Element {
+	e.inner.ScalarMul(&e1.inner, scalar)
+	return e
+}
+
+// MultiExp sets e to the sum of the [scalars[i]]elements[i] and returns it
+// cf bandersnatch.PointExtended.MultiExp
+func (e *Element) MultiExp(elements []Element, scalars []fr.Element, config ecc.MultiExpConfig) (*Element, error) {
+	points := BatchToAffine(elements)
+	if _, err := e.inner.MultiExp(points, scalars, config); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// BatchToAffine returns an affine representative of each element,
+// using a single field inversion
+func BatchToAffine(elements []Element) []bandersnatch.PointAffine {
+	res := make([]bandersnatch.PointAffine, len(elements))
+	if len(elements) == 0 {
+		return res
+	}
+
+	// Montgomery's trick: accumulator[i] = Z_0 * ... * Z_{i-1}
+	accumulator := make([]fp.Element, len(elements))
+	var acc fp.Element
+	acc.SetOne()
+	for i := 0; i < len(elements); i++ {
+		accumulator[i].Set(&acc)
+		acc.Mul(&acc, &elements[i].inner.Z)
+	}
+	acc.Inverse(&acc)
+	for i := len(elements) - 1; i >= 0; i-- {
+		var zInv fp.Element
+		zInv.Mul(&acc, &accumulator[i])
+		acc.Mul(&acc, &elements[i].inner.Z)
+		res[i].X.Mul(&elements[i].inner.X, &zInv)
+		res[i].Y.Mul(&elements[i].inner.Y, &zInv)
+	}
+	return res
+}
+
+// Bytes returns the compressed encoding of e, that is the x-coordinate of the
+// representative (x, y) of e with the lexicographically largest y, in big endian
+func (e *Element) Bytes() [SizeElementCompressed]byte {
+	var p bandersnatch.PointAffine
+	p.FromExtended(&e.inner)
+	if !p.Y.LexicographicallyLargest() {
+		p.X.Neg(&p.X)
+	}
+	return p.X.Bytes()
+}
+
+// Marshal converts e to a byte slice
+func (e *Element) Marshal() []byte {
+	b := e.Bytes()
+	return b[:]
+}
+
+// SetBytes sets e from buf, the compressed encoding of an element (see Bytes()).
+// It checks that buf is canonical and that the point is in the group,
+// that is that 1-ax^2 is a square.
+// Returns the number of read bytes and an error if the buffer is too short
+// or doesn't encode an element.
+func (e *Element) SetBytes(buf []byte) (int, error) {
+
+	if len(buf) < SizeElementCompressed {
+		return 0, io.ErrShortBuffer
+	}
+
+	var x, y, num, den fp.Element
+	x.SetBytes(buf[:SizeElementCompressed])
+	if b := x.Bytes(); string(b[:]) != string(buf[:SizeElementCompressed]) {
+		return 0, ErrInvalidEncoding
+	}
+
+	curve := bandersnatch.GetEdwardsCurve()
+
+	// y^2 = (1-ax^2)/(1-dx^2)
+	x.Square(&x)
+	num.Mul(&x, &curve.A)
+	den.Mul(&x, &curve.D)
+	x.SetOne()
+	num.Sub(&x, &num)
+	den.Sub(&x, &den)
+
+	// the points of order dividing 2r are the ones for which 1-ax^2 is a square
+	if num.Legendre() != 1 {
+		return 0, ErrNotInGroup
+	}
+	y.Div(&num, &den)
+	if y.Sqrt(&y) == nil {
+		return 0, ErrNotInGroup
+	}
+	if !y.LexicographicallyLargest() {
+		y.Neg(&y)
+	}
+
+	var p bandersnatch.PointAffine
+	p.X.SetBytes(buf[:SizeElementCompressed])
+	p.Y.Set(&y)
+	e.inner.FromAffine(&p)
+
+	return SizeElementCompressed, nil
+}
+
+// Unmarshal alias to SetBytes()
+func (e *Element) Unmarshal(b []byte) error {
+	_, err := e.SetBytes(b)
+	return err
+}
@@ -0,0 +1,225 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package banderwagon
+
+import (
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// encodings of [i]G for i = 1..8
+var expectedEncodings = []string{
+	"4a2c7486fd924882bf02c6908de395122843e3e05264d7991e18e7985dad51e9",
+	"43aa74ef706605705989e8fd38df46873b7eae5921fbed115ac9d937399ce4d5",
+	"49730da2a2931b0402ee45d704997e8e33d462382e41ad209aa2dd869de5cb9b",
+	"5e5f550494159f38aa54d2ed7f11a7e93e4968617990445cc93ac8e59808c126",
+	"68cbece0b8fb55450410cbc058928a567eed293d168faef44bfde25f943aabe0",
+	"5556928265856af0c775ea91276d9c8094020f3d03b13c429bb015f54ca2344a",
+	"43ddf73ea8a3011d3bf79d6c7bddb10cd4f8a08b2a9345d3cf2c30b4dd421de5",
+	"0e7e3748db7c5c999a7bcd93d71d671f1f40090423792266f94cb27ca43fce5c",
+}
+
+func TestEncodingVectors(t *testing.T) {
+
+	g := Generator()
+	p := Identity()
+	for i, expected := range expectedEncodings {
+		p.Add(&p, &g)
+		b := p.Bytes()
+		if hex.EncodeToString(b[:]) != expected {
+			t.Fatalf("wrong encoding of [%d]G", i+1)
+		}
+
+		var q Element
+		if err := q.Unmarshal(b[:]); err != nil {
+			t.Fatal(err)
+		}
+		if !q.Equal(&p) {
+			t.Fatalf("error unmarshal(marshal([%d]G))", i+1)
+		}
+	}
+}
+
+func TestElement(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+	genS := GenBigInt()
+
+	properties.Property("P and P+(0,-1) should be equal and have the same encoding", prop.ForAll(
+		func(s big.Int) bool {
+			var p, q Element
+			g := Generator()
+			p.ScalarMul(&g, &s)
+
+			// (x, y) + (0, -1) = (-x, -y)
+			q.Set(&p)
+			q.inner.X.Neg(&q.inner.X)
+			q.inner.Y.Neg(&q.inner.Y)
+
+			return p.Equal(&q) && p.Bytes() == q.Bytes()
+		},
+		genS,
+	))
+
+	properties.Property("unmarshal(marshal(P)) = P", prop.ForAll(
+		func(s big.Int) bool {
+			var p, q Element
+			g := Generator()
+			p.ScalarMul(&g, &s)
+
+			err := q.Unmarshal(p.Marshal())
+			return err == nil && q.Equal(&p)
+		},
+		genS,
+	))
+
+	properties.Property("[a]P - [b]P = [a-b]P", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+			var p1, p2, p3 Element
+			g := Generator()
+			p1.ScalarMul(&g, &s1)
+			p2.ScalarMul(&g, &s2)
+			p1.Sub(&p1, &p2)
+
+			var s big.Int
+			s.Sub(&s1, &s2)
+			p3.ScalarMul(&g, &s)
+
+			return p1.Equal(&p3)
+		},
+		genS,
+		genS,
+	))
+
+	properties.Property("SetBytes should accept exactly the x-coordinates of points for which 1-ax^2 is a square", prop.ForAll(
+		func(x fp.Element) bool {
+			var e Element
+			b := x.Bytes()
+			_, err := e.SetBytes(b[:])
+
+			curve := bandersnatch.GetEdwardsCurve()
+			var num, den, one fp.Element
+			one.SetOne()
+			num.Square(&x).Mul(&num, &curve.A)
+			num.Sub(&one, &num)
+			den.Square(&x).Mul(&den, &curve.D)
+			den.Sub(&one, &den)
+			// (x, y) is on the curve iff y^2 = (1-ax^2)/(1-dx^2)
+			if num.Legendre() != 1 || den.Legendre() != 1 {
+				return err == ErrNotInGroup
+			}
+			if err != nil {
+				return false
+			}
+			// the decoded point must be in the group
+			var o Element
+			o.ScalarMul(&e, fr.Modulus())
+			return o.IsIdentity()
+		},
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestSetBytesNonCanonical(t *testing.T) {
+	g := Generator()
+	b := g.Bytes()
+
+	// x + p doesn't fit in 255 bits, setting the top bit gives a non canonical encoding
+	b[0] |= 0x80
+	var e Element
+	if _, err := e.SetBytes(b[:]); err != ErrInvalidEncoding {
+		t.Fatal("non canonical encoding should be rejected")
+	}
+}
+
+func TestMultiExp(t *testing.T) {
+
+	const nbSamples = 37
+
+	var elements [nbSamples]Element
+	var scalars [nbSamples]fr.Element
+	expected := Identity()
+	g := Generator()
+	for i := 0; i < nbSamples; i++ {
+		var s big.Int
+		s.SetUint64(uint64(i + 3))
+		elements[i].ScalarMul(&g, &s)
+		// use the other representative of some of the elements
+		if i%2 == 0 {
+			elements[i].inner.X.Neg(&elements[i].inner.X)
+			elements[i].inner.Y.Neg(&elements[i].inner.Y)
+		}
+		scalars[i].SetRandom()
+
+		var tmp Element
+		scalars[i].ToBigIntRegular(&s)
+		tmp.ScalarMul(&elements[i], &s)
+		expected.Add(&expected, &tmp)
+
+		scalars[i].FromMont()
+	}
+
+	var res Element
+	if _, err := res.MultiExp(elements[:], scalars[:], ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if !res.Equal(&expected) {
+		t.Fatal("MultiExp doesn't match the sum of the scalar multiplications")
+	}
+}
+
+// GenBigInt generates a big.Int
+func GenBigInt() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var s big.Int
+		var b [fr.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		s.SetBytes(b[:])
+		genResult := gopter.NewGenResult(s, gopter.NoShrinker)
+		return genResult
+	}
+}
+
+// GenFp generates an Fp element
+func GenFp() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var elmt fp.Element
+		var b [fp.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		elmt.SetBytes(b[:])
+		genResult := gopter.NewGenResult(elmt, gopter.NoShrinker)
+		return genResult
+	}
+}
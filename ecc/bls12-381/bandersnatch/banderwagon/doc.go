@@ -0,0 +1,20 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package banderwagon provides the banderwagon group, the quotient of the bandersnatch
+// points of order dividing 2r by the 2-torsion point (0,-1).
+//
+// The group has prime order r, and elements are encoded in 32 bytes
+// (the x-coordinate of a canonical representative), with a cheap subgroup check on decoding.
+package banderwagon
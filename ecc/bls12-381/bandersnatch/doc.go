@@ -0,0 +1,25 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bandersnatch provides the bandersnatch twisted Edwards curve, defined on bls12-381's fr.
+//
+// bandersnatch has a degree 2 endomorphism used for GLV scalar multiplications.
+// Points are represented in affine, projective or extended coordinates, the latter
+// being used for scalar multiplications and multi-exponentiations.
+//
+// The banderwagon sub-package provides the prime order quotient group of the points
+// of order dividing 2r by the 2-torsion point (0,-1).
+//
+// cf https://eprint.iacr.org/2021/1152.pdf
+package bandersnatch
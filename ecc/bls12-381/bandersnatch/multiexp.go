@@ -0,0 +1,181 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bandersnatch
+
+import (
+	"errors"
+	"math"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
+func (p *PointAffine) MultiExp(points []PointAffine, scalars []fr.Element, config ecc.MultiExpConfig) (*PointAffine, error) {
+	var _p PointExtended
+	if _, err := _p.MultiExp(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromExtended(&_p)
+	return p, nil
+}
+
+// MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
+//
+// step 1
+// we compute, for each scalars over c-bit wide windows, nbChunk digits
+// if the digit is larger than 2^{c-1}, then, we borrow 2^c from the next window and substract
+// 2^{c} to the current digit, making it negative.
+// negative digits are processed in the next step as adding -G into the bucket instead of G
+// (computing -G is cheap, and this saves us half of the buckets)
+// step 2
+// each chunk places the points into 2^{c-1} buckets and computes the weighted bucket sum
+// the chunks are processed in parallel
+// step 3
+// reduce the weighted sums of the chunks into our result
+func (p *PointExtended) MultiExp(points []PointAffine, scalars []fr.Element, config ecc.MultiExpConfig) (*PointExtended, error) {
+
+	// ensure len(points) == len(scalars)
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	}
+
+	// approximate cost (in group operations)
+	// cost = bits/c * (nbPoints + 2^{c})
+	var c uint64
+	min := math.MaxFloat64
+	for _c := uint64(2); _c <= 16; _c++ {
+		cost := float64(fr.Limbs*64*(nbPoints+(1<<_c))) / float64(_c)
+		if cost < min {
+			min = cost
+			c = _c
+		}
+	}
+
+	// number of c-bit radixes in a scalar
+	// note that fr.Bits < fr.Limbs*64, so the carry of the last window is always 0
+	nbChunks := int((fr.Limbs*64 + c - 1) / c)
+
+	digits := partitionScalars(scalars, c, nbChunks, config.ScalarsMont, config.NbTasks)
+
+	chunks := make([]PointExtended, nbChunks)
+	parallel.Execute(nbChunks, func(start, end int) {
+		buckets := make([]PointExtended, 1<<(c-1))
+		for chunk := start; chunk < end; chunk++ {
+			msmProcessChunk(&chunks[chunk], buckets, chunk, nbChunks, points, digits)
+		}
+	}, config.NbTasks)
+
+	var res PointExtended
+	res.Set(&chunks[nbChunks-1])
+	for j := nbChunks - 2; j >= 0; j-- {
+		for l := uint64(0); l < c; l++ {
+			res.Double(&res)
+		}
+		res.Add(&res, &chunks[j])
+	}
+
+	p.Set(&res)
+	return p, nil
+}
+
+// partitionScalars computes, for each scalar, its nbChunks signed c-bit digits
+// digits[i*nbChunks+j] is the j-th digit of scalars[i], in [-2^{c-1}, 2^{c-1})
+// scalarsMont indicates wheter the provided scalars are in montgomery form
+func partitionScalars(scalars []fr.Element, c uint64, nbChunks int, scalarsMont bool, nbTasks int) []int32 {
+	digits := make([]int32, len(scalars)*nbChunks)
+
+	mask := uint64((1 << c) - 1) // low c bits are 1
+	max := int32(1 << (c - 1))   // max value we want for our digits
+
+	parallel.Execute(len(scalars), func(start, end int) {
+		for i := start; i < end; i++ {
+			var carry int32
+
+			scalar := scalars[i]
+			if scalarsMont {
+				scalar.FromMont()
+			}
+
+			for chunk := 0; chunk < nbChunks; chunk++ {
+				jc := uint64(chunk) * c
+				index := jc / 64
+				shift := jc % 64
+
+				// digit = value of the c-bit window, plus the carry
+				w := scalar[index] >> shift
+				if shift+c > 64 && index+1 < fr.Limbs {
+					// we are selecting bits over 2 words
+					w |= scalar[index+1] << (64 - shift)
+				}
+				digit := int32(w&mask) + carry
+				carry = 0
+
+				// if the digit is larger than 2^{c-1}, then, we borrow 2^c from the next window and substract
+				// 2^{c} to the current digit, making it negative.
+				if digit >= max {
+					digit -= (1 << c)
+					carry = 1
+				}
+				digits[i*nbChunks+chunk] = digit
+			}
+		}
+	}, nbTasks)
+
+	return digits
+}
+
+// msmProcessChunk places the points in the buckets according to their chunk-th digit,
+// and sets res to the weighted sum of the buckets
+func msmProcessChunk(res *PointExtended, buckets []PointExtended, chunk, nbChunks int, points []PointAffine, digits []int32) {
+
+	for i := 0; i < len(buckets); i++ {
+		buckets[i].setInfinity()
+	}
+
+	var neg PointAffine
+	for i := 0; i < len(points); i++ {
+		digit := digits[i*nbChunks+chunk]
+		if digit == 0 {
+			continue
+		}
+		if digit > 0 {
+			buckets[digit-1].MixedAdd(&buckets[digit-1], &points[i])
+		} else {
+			neg.Neg(&points[i])
+			buckets[-digit-1].MixedAdd(&buckets[-digit-1], &neg)
+		}
+	}
+
+	// reduce buckets into total
+	// total =  bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+	var runningSum, total PointExtended
+	runningSum.setInfinity()
+	total.setInfinity()
+	for k := len(buckets) - 1; k >= 0; k-- {
+		runningSum.Add(&runningSum, &buckets[k])
+		total.Add(&total, &runningSum)
+	}
+
+	res.Set(&total)
+}
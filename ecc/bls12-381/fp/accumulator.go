@@ -0,0 +1,219 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"math/bits"
+)
+
+// WideAccumulator accumulates products of Element on 12 + 1 words, without modular reduction.
+//
+// A sum of products costs one Montgomery reduction (Reduce) instead of one per product:
+//
+//	var acc WideAccumulator
+//	for i := range a {
+//		acc.MulAdd(&a[i], &b[i])
+//	}
+//	res := acc.Reduce()
+//
+// The zero value is an empty accumulator; it can hold up to 2**63 products.
+type WideAccumulator struct {
+	t [13]uint64
+}
+
+// MulAdd sets acc = acc + x * y, without reduction
+func (acc *WideAccumulator) MulAdd(x, y *Element) *WideAccumulator {
+	var p [12]uint64
+	var C uint64
+
+	// p = x * y
+	C, p[0] = bits.Mul64(x[0], y[0])
+	C, p[1] = madd1(x[0], y[1], C)
+	C, p[2] = madd1(x[0], y[2], C)
+	C, p[3] = madd1(x[0], y[3], C)
+	C, p[4] = madd1(x[0], y[4], C)
+	C, p[5] = madd1(x[0], y[5], C)
+	p[6] = C
+	C, p[1] = madd1(x[1], y[0], p[1])
+	C, p[2] = madd2(x[1], y[1], p[2], C)
+	C, p[3] = madd2(x[1], y[2], p[3], C)
+	C, p[4] = madd2(x[1], y[3], p[4], C)
+	C, p[5] = madd2(x[1], y[4], p[5], C)
+	C, p[6] = madd2(x[1], y[5], p[6], C)
+	p[7] = C
+	C, p[2] = madd1(x[2], y[0], p[2])
+	C, p[3] = madd2(x[2], y[1], p[3], C)
+	C, p[4] = madd2(x[2], y[2], p[4], C)
+	C, p[5] = madd2(x[2], y[3], p[5], C)
+	C, p[6] = madd2(x[2], y[4], p[6], C)
+	C, p[7] = madd2(x[2], y[5], p[7], C)
+	p[8] = C
+	C, p[3] = madd1(x[3], y[0], p[3])
+	C, p[4] = madd2(x[3], y[1], p[4], C)
+	C, p[5] = madd2(x[3], y[2], p[5], C)
+	C, p[6] = madd2(x[3], y[3], p[6], C)
+	C, p[7] = madd2(x[3], y[4], p[7], C)
+	C, p[8] = madd2(x[3], y[5], p[8], C)
+	p[9] = C
+	C, p[4] = madd1(x[4], y[0], p[4])
+	C, p[5] = madd2(x[4], y[1], p[5], C)
+	C, p[6] = madd2(x[4], y[2], p[6], C)
+	C, p[7] = madd2(x[4], y[3], p[7], C)
+	C, p[8] = madd2(x[4], y[4], p[8], C)
+	C, p[9] = madd2(x[4], y[5], p[9], C)
+	p[10] = C
+	C, p[5] = madd1(x[5], y[0], p[5])
+	C, p[6] = madd2(x[5], y[1], p[6], C)
+	C, p[7] = madd2(x[5], y[2], p[7], C)
+	C, p[8] = madd2(x[5], y[3], p[8], C)
+	C, p[9] = madd2(x[5], y[4], p[9], C)
+	C, p[10] = madd2(x[5], y[5], p[10], C)
+	p[11] = C
+
+	// acc = acc + p
+	acc.t[0], C = bits.Add64(acc.t[0], p[0], 0)
+	acc.t[1], C = bits.Add64(acc.t[1], p[1], C)
+	acc.t[2], C = bits.Add64(acc.t[2], p[2], C)
+	acc.t[3], C = bits.Add64(acc.t[3], p[3], C)
+	acc.t[4], C = bits.Add64(acc.t[4], p[4], C)
+	acc.t[5], C = bits.Add64(acc.t[5], p[5], C)
+	acc.t[6], C = bits.Add64(acc.t[6], p[6], C)
+	acc.t[7], C = bits.Add64(acc.t[7], p[7], C)
+	acc.t[8], C = bits.Add64(acc.t[8], p[8], C)
+	acc.t[9], C = bits.Add64(acc.t[9], p[9], C)
+	acc.t[10], C = bits.Add64(acc.t[10], p[10], C)
+	acc.t[11], C = bits.Add64(acc.t[11], p[11], C)
+	acc.t[12] += C
+
+	return acc
+}
+
+// Reset empties the accumulator
+func (acc *WideAccumulator) Reset() *WideAccumulator {
+	acc.t = [13]uint64{}
+	return acc
+}
+
+// Reduce returns the sum of the accumulated products, as a reduced Element;
+// the accumulator is left unchanged
+func (acc *WideAccumulator) Reduce() (z Element) {
+	t := acc.t
+
+	// the products are in Montgomery form (x*y*R**2), the montgomery reduction
+	// of t gives v = t * R**-1 mod q on 7 words: v < t / R + q
+	montReduceWide(&t)
+
+	// v = vLo + vHi * R with vLo < R, vHi < 2**64
+	// vLo * R**-1 * (R mod q) < 2q
+	var lo [13]uint64
+	var C uint64
+	C, lo[0] = bits.Mul64(t[6], 8505329371266088957)
+	C, lo[1] = madd1(t[6], 17002214543764226050, C)
+	C, lo[2] = madd1(t[6], 6865905132761471162, C)
+	C, lo[3] = madd1(t[6], 8632934651105793861, C)
+	C, lo[4] = madd1(t[6], 6631298214892334189, C)
+	C, lo[5] = madd1(t[6], 1582556514881692819, C)
+	lo[6] = C
+	C, lo[1] = madd1(t[7], 8505329371266088957, lo[1])
+	C, lo[2] = madd2(t[7], 17002214543764226050, lo[2], C)
+	C, lo[3] = madd2(t[7], 6865905132761471162, lo[3], C)
+	C, lo[4] = madd2(t[7], 8632934651105793861, lo[4], C)
+	C, lo[5] = madd2(t[7], 6631298214892334189, lo[5], C)
+	C, lo[6] = madd2(t[7], 1582556514881692819, lo[6], C)
+	lo[7] = C
+	C, lo[2] = madd1(t[8], 8505329371266088957, lo[2])
+	C, lo[3] = madd2(t[8], 17002214543764226050, lo[3], C)
+	C, lo[4] = madd2(t[8], 6865905132761471162, lo[4], C)
+	C, lo[5] = madd2(t[8], 8632934651105793861, lo[5], C)
+	C, lo[6] = madd2(t[8], 6631298214892334189, lo[6], C)
+	C, lo[7] = madd2(t[8], 1582556514881692819, lo[7], C)
+	lo[8] = C
+	C, lo[3] = madd1(t[9], 8505329371266088957, lo[3])
+	C, lo[4] = madd2(t[9], 17002214543764226050, lo[4], C)
+	C, lo[5] = madd2(t[9], 6865905132761471162, lo[5], C)
+	C, lo[6] = madd2(t[9], 8632934651105793861, lo[6], C)
+	C, lo[7] = madd2(t[9], 6631298214892334189, lo[7], C)
+	C, lo[8] = madd2(t[9], 1582556514881692819, lo[8], C)
+	lo[9] = C
+	C, lo[4] = madd1(t[10], 8505329371266088957, lo[4])
+	C, lo[5] = madd2(t[10], 17002214543764226050, lo[5], C)
+	C, lo[6] = madd2(t[10], 6865905132761471162, lo[6], C)
+	C, lo[7] = madd2(t[10], 8632934651105793861, lo[7], C)
+	C, lo[8] = madd2(t[10], 6631298214892334189, lo[8], C)
+	C, lo[9] = madd2(t[10], 1582556514881692819, lo[9], C)
+	lo[10] = C
+	C, lo[5] = madd1(t[11], 8505329371266088957, lo[5])
+	C, lo[6] = madd2(t[11], 17002214543764226050, lo[6], C)
+	C, lo[7] = madd2(t[11], 6865905132761471162, lo[7], C)
+	C, lo[8] = madd2(t[11], 8632934651105793861, lo[8], C)
+	C, lo[9] = madd2(t[11], 6631298214892334189, lo[9], C)
+	C, lo[10] = madd2(t[11], 1582556514881692819, lo[10], C)
+	lo[11] = C
+	montReduceWide(&lo)
+
+	copy(z[:], lo[6:12])
+	if lo[12] != 0 {
+		// z + 2**384 - q
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 13402431016077863595, 0)
+		z[1], b = bits.Sub64(z[1], 2210141511517208575, b)
+		z[2], b = bits.Sub64(z[2], 7435674573564081700, b)
+		z[3], b = bits.Sub64(z[3], 7239337960414712511, b)
+		z[4], b = bits.Sub64(z[4], 5412103778470702295, b)
+		z[5], b = bits.Sub64(z[5], 1873798617647539866, b)
+	} else {
+
+		// if z > q --> z -= q
+		// note: this is NOT constant time
+		if !(z[5] < 1873798617647539866 || (z[5] == 1873798617647539866 && (z[4] < 5412103778470702295 || (z[4] == 5412103778470702295 && (z[3] < 7239337960414712511 || (z[3] == 7239337960414712511 && (z[2] < 7435674573564081700 || (z[2] == 7435674573564081700 && (z[1] < 2210141511517208575 || (z[1] == 2210141511517208575 && (z[0] < 13402431016077863595))))))))))) {
+			var b uint64
+			z[0], b = bits.Sub64(z[0], 13402431016077863595, 0)
+			z[1], b = bits.Sub64(z[1], 2210141511517208575, b)
+			z[2], b = bits.Sub64(z[2], 7435674573564081700, b)
+			z[3], b = bits.Sub64(z[3], 7239337960414712511, b)
+			z[4], b = bits.Sub64(z[4], 5412103778470702295, b)
+			z[5], _ = bits.Sub64(z[5], 1873798617647539866, b)
+		}
+	}
+
+	// vHi * R mod q is the Montgomery form of vHi
+	if t[12] != 0 {
+		var hi Element
+		hi.SetUint64(t[12])
+		z.Add(&z, &hi)
+	}
+
+	return
+}
+
+// montReduceWide sets t[6:] = t * R**-1 mod q, with t[6:] < t / R + q;
+// t must be smaller than 2**768 * 2**63 so that the result fits on 7 words
+func montReduceWide(t *[13]uint64) {
+	for i := 0; i < 6; i++ {
+		// m = t[i]n'[0] mod W
+		m := t[i] * 9940570264628428797
+		C := madd0(m, 13402431016077863595, t[i])
+		C, t[i+1] = madd2(m, 2210141511517208575, t[i+1], C)
+		C, t[i+2] = madd2(m, 7435674573564081700, t[i+2], C)
+		C, t[i+3] = madd2(m, 7239337960414712511, t[i+3], C)
+		C, t[i+4] = madd2(m, 5412103778470702295, t[i+4], C)
+		C, t[i+5] = madd2(m, 1873798617647539866, t[i+5], C)
+		for k := i + 6; k < len(t); k++ {
+			t[k], C = bits.Add64(t[k], C, 0)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jubjub provides the Jubjub twisted Edwards curve of the Zcash protocol, defined on bls12-381's fr.
+//
+// Points are encoded as in the Zcash protocol specification (repr_J and abst_J, section 5.4.9.3),
+// with the canonicity checks of ZIP 216. The package also provides the Zcash group hash into
+// the prime order subgroup (GroupHash^J and FindGroupHash^J, section 5.4.9.5).
+//
+// The redjubjub sub-package provides RedJubjub signatures.
+//
+// cf https://zips.z.cash/protocol/protocol.pdf
+package jubjub
@@ -0,0 +1,79 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jubjub
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/internal/blake2"
+)
+
+// urs is the uniform random string used by the Zcash group hash
+// cf Zcash specification, section 5.9
+const urs = "096b36a5804bfacef1691e173c366a47ff5ba84a44f26ddd7e8d9f79d5b42df0"
+
+var (
+	// ErrGroupHash is returned by GroupHash when the hash doesn't map to a point of the prime order subgroup
+	ErrGroupHash       = errors.New("group hash failed")
+	errPersonalization = errors.New("the personalization must be 8 bytes long")
+)
+
+// GroupHash implements GroupHash^J(D, M), which hashes M to a point of the prime order subgroup,
+// or fails with probability ~1/2 (cf Zcash specification, section 5.4.9.5)
+// The personalization D must be 8 bytes long.
+func GroupHash(personalization, msg []byte) (PointAffine, error) {
+	var res PointAffine
+	if len(personalization) != 8 {
+		return res, errPersonalization
+	}
+
+	h, _ := blake2.NewBlake2s(32, personalization)
+	h.Write([]byte(urs))
+	h.Write(msg)
+	digest := h.Sum(nil)
+
+	if _, err := res.SetBytes(digest); err != nil {
+		return res, ErrGroupHash
+	}
+
+	// clear the cofactor
+	var _res PointExtended
+	_res.FromAffine(&res)
+	_res.Double(&_res).Double(&_res).Double(&_res)
+	if _res.IsZero() {
+		return res, ErrGroupHash
+	}
+	res.FromExtended(&_res)
+
+	return res, nil
+}
+
+// FindGroupHash implements FindGroupHash^J(D, M), which returns the first successful
+// GroupHash^J(D, M || [i]) for i in [0, 256) (cf Zcash specification, section 5.4.9.5)
+func FindGroupHash(personalization, msg []byte) (PointAffine, error) {
+	buf := make([]byte, len(msg)+1)
+	copy(buf, msg)
+	for i := 0; i < 256; i++ {
+		buf[len(msg)] = byte(i)
+		res, err := GroupHash(personalization, buf)
+		if err == nil {
+			return res, nil
+		}
+		if err != ErrGroupHash {
+			return res, err
+		}
+	}
+	return PointAffine{}, ErrGroupHash
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jubjub
+
+import (
+	"math/big"
+
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// E: -x^2 + y^2 = 1 + d*x^2*y^2, d = -(10240/10241)
+// Fp: p=52435875175126190479447740508185965837690552500527637822603658699938581184513 (bls12-381's r)
+// Fr: r=6554484396890773809930967563523245729705921265872317281365359162392183254199
+// E(Fp) has order 8*r
+// x and y are respectively the u and v coordinates of the Zcash specification
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fp.Element // in Montgomery form
+	Cofactor fp.Element // not in Montgomery form
+	Order    big.Int
+	Base     PointAffine // the spending key generator of Zcash Sapling
+}
+
+var edwards CurveParams
+
+// valueCommitmentRandomnessGenerator is FindGroupHash^J("Zcash_cv", "r")
+var valueCommitmentRandomnessGenerator PointAffine
+
+// GetEdwardsCurve returns the Jubjub curve on BLS12-381's Fr
+func GetEdwardsCurve() CurveParams {
+
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&edwards.A)
+	res.D.Set(&edwards.D)
+	res.Cofactor.Set(&edwards.Cofactor)
+	res.Order.Set(&edwards.Order)
+	res.Base.Set(&edwards.Base)
+
+	return res
+}
+
+// SpendingKeyGenerator returns FindGroupHash^J("Zcash_G_", ""), the base point of
+// RedJubjub spend authorization signatures
+func SpendingKeyGenerator() PointAffine {
+	return edwards.Base
+}
+
+// ValueCommitmentRandomnessGenerator returns FindGroupHash^J("Zcash_cv", "r"), the base point of
+// RedJubjub binding signatures
+func ValueCommitmentRandomnessGenerator() PointAffine {
+	return valueCommitmentRandomnessGenerator
+}
+
+func init() {
+
+	edwards.A.SetOne().Neg(&edwards.A)
+	edwards.D.SetString("19257038036680949359750312669786877991949435402254120286184196891950884077233") // -(10240/10241)
+	edwards.Cofactor.SetUint64(8).FromMont()
+	edwards.Order.SetString("6554484396890773809930967563523245729705921265872317281365359162392183254199", 10)
+
+	edwards.Base.X.SetString("4139425550610461525665941076812662132363359224232624900223172373014329534291")
+	edwards.Base.Y.SetString("39635691377166599497441725607757882405510648532010642268690928210480481875248")
+
+	valueCommitmentRandomnessGenerator.X.SetString("47042227020334719030310671629496501061777616454137182971856918820250544653111")
+	valueCommitmentRandomnessGenerator.Y.SetString("49531484613049745751551498609154147537293487462303198979615882148044956461707")
+}
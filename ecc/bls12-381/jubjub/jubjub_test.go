@@ -0,0 +1,220 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jubjub
+
+import (
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestGenerators(t *testing.T) {
+
+	// the spend authorization and value commitment randomness generators of the Zcash specification
+	vectors := []struct {
+		personalization, msg string
+		expected             PointAffine
+		expectedRepr         string
+	}{
+		{"Zcash_G_", "", SpendingKeyGenerator(), "30b5f2aaad325630bcdddbce4d67656d05fd1cc2d037bb5375b6e96d9e01a1d7"},
+		{"Zcash_cv", "r", ValueCommitmentRandomnessGenerator(), "8b6a0b38b9faae3c3b803b47b0f146ad50ab221e6e2afbe6dbde45cba9d381ed"},
+	}
+
+	for _, v := range vectors {
+		p, err := FindGroupHash([]byte(v.personalization), []byte(v.msg))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&v.expected) {
+			t.Fatalf("FindGroupHash(%q, %q) doesn't match the expected generator", v.personalization, v.msg)
+		}
+		b := p.Bytes()
+		if hex.EncodeToString(b[:]) != v.expectedRepr {
+			t.Fatalf("wrong encoding of FindGroupHash(%q, %q)", v.personalization, v.msg)
+		}
+		if !p.IsInSubGroup() {
+			t.Fatal("generator not in the prime order subgroup")
+		}
+	}
+}
+
+func TestNonCanonicalEncodings(t *testing.T) {
+
+	// y = p+1
+	yOverflow, _ := hex.DecodeString("02000000fffffffffe5bfeff02a4bd5305d8a10908d83933487d9d2953a7ed73")
+	// x = -0
+	negativeZero, _ := hex.DecodeString("0100000000000000000000000000000000000000000000000000000000000080")
+
+	var p PointAffine
+	if err := p.Unmarshal(yOverflow); err != ErrInvalidEncoding {
+		t.Fatal("non canonical y should be rejected")
+	}
+	if err := p.Unmarshal(negativeZero); err != ErrInvalidEncoding {
+		t.Fatal("non canonical x=-0 should be rejected")
+	}
+	negativeZero[31] = 0
+	if err := p.Unmarshal(negativeZero); err != nil || !p.IsZero() {
+		t.Fatal("(0, 1) should be decoded")
+	}
+}
+
+func TestOps(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+	genS1 := GenBigInt()
+	genS2 := GenBigInt()
+
+	properties.Property("(affine) P+(-P)=O", prop.ForAll(
+		func(s1 big.Int) bool {
+
+			var p1, p2 PointAffine
+			p1.ScalarMul(&edwards.Base, &s1)
+			p2.Neg(&p1)
+
+			p1.Add(&p1, &p2)
+
+			return p1.IsZero()
+		},
+		genS1,
+	))
+
+	properties.Property("(affine) P+P=2*P", prop.ForAll(
+		func(s big.Int) bool {
+
+			var p1, p2 PointAffine
+			p1.ScalarMul(&edwards.Base, &s)
+			p2.ScalarMul(&edwards.Base, &s)
+
+			p1.Add(&p1, &p2)
+			p2.Double(&p2)
+
+			return p1.Equal(&p2) && p1.IsOnCurve()
+		},
+		genS1,
+	))
+
+	properties.Property("(affine) [a]P+[b]P = [a+b]P", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+
+			var p1, p2, p3 PointAffine
+			p1.ScalarMul(&edwards.Base, &s1)
+			p2.ScalarMul(&edwards.Base, &s2)
+
+			p2.Add(&p1, &p2)
+
+			s1.Add(&s1, &s2)
+			p3.ScalarMul(&edwards.Base, &s1)
+
+			return p3.Equal(&p2)
+		},
+		genS1,
+		genS2,
+	))
+
+	properties.Property("[r]P = O", prop.ForAll(
+		func(s big.Int) bool {
+
+			var p PointAffine
+			p.ScalarMul(&edwards.Base, &s).
+				ScalarMul(&p, &edwards.Order)
+
+			return p.IsZero() && p.IsSmallOrder()
+		},
+		genS1,
+	))
+
+	properties.Property("unmarshal(marshal(P)) = P", prop.ForAll(
+		func(s big.Int) bool {
+
+			var p, q PointAffine
+			p.ScalarMul(&edwards.Base, &s)
+
+			err := q.Unmarshal(p.Marshal())
+
+			return err == nil && p.Equal(&q)
+		},
+		genS1,
+	))
+
+	properties.Property("SetBytes should accept exactly the canonical encodings of points of the curve", prop.ForAll(
+		func(y fp.Element) bool {
+
+			// y with a random sign bit
+			var p PointAffine
+			b := y.Bytes()
+			var buf [SizePointCompressed]byte
+			for i := range buf {
+				buf[i] = b[SizePointCompressed-1-i]
+			}
+			buf[SizePointCompressed-1] |= b[SizePointCompressed-1] & 0x80
+
+			// x^2 = (1 - y^2)/(a - dy^2)
+			var one, num, den fp.Element
+			one.SetOne()
+			num.Square(&y)
+			den.Mul(&num, &edwards.D)
+			num.Sub(&one, &num)
+			den.Sub(&edwards.A, &den)
+			num.Div(&num, &den)
+
+			_, err := p.SetBytes(buf[:])
+			if num.Legendre() == -1 {
+				return err == ErrInvalidEncoding
+			}
+			return err == nil && p.IsOnCurve() && p.Bytes() == buf
+		},
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// GenBigInt generates a big.Int
+func GenBigInt() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var s big.Int
+		var b [fp.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		s.SetBytes(b[:])
+		genResult := gopter.NewGenResult(s, gopter.NoShrinker)
+		return genResult
+	}
+}
+
+// GenFp generates an Fp element
+func GenFp() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var elmt fp.Element
+		var b [fp.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		elmt.SetBytes(b[:])
+		genResult := gopter.NewGenResult(elmt, gopter.NoShrinker)
+		return genResult
+	}
+}
@@ -0,0 +1,383 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jubjub
+
+import (
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// PointAffine point on the Jubjub curve
+type PointAffine struct {
+	X, Y fp.Element
+}
+
+// PointExtended point in extended coordinates
+// (X, Y, T, Z) corresponds to the affine point (X/Z, Y/Z), with T=XY/Z
+type PointExtended struct {
+	X, Y, T, Z fp.Element
+}
+
+// SizePointCompressed size in byte of a compressed point
+const SizePointCompressed = fp.Bytes
+
+// ErrInvalidEncoding is returned by SetBytes when the buffer doesn't encode a point of the curve
+var ErrInvalidEncoding = errors.New("invalid jubjub point encoding")
+
+// -------------------------------------------------------------------------------------------------
+// Affine coordinates
+
+// Bytes returns repr_J(p), the little endian encoding of y, with the parity of x
+// in the most significant bit (cf Zcash specification, section 5.4.9.3)
+func (p *PointAffine) Bytes() [SizePointCompressed]byte {
+
+	var res [SizePointCompressed]byte
+
+	// fp.Bytes() is big endian
+	y := p.Y.Bytes()
+	for i := 0; i < SizePointCompressed; i++ {
+		res[i] = y[SizePointCompressed-1-i]
+	}
+
+	var x fp.Element
+	x.Set(&p.X).FromMont()
+	res[SizePointCompressed-1] |= byte(x[0]&1) << 7
+
+	return res
+}
+
+// Marshal converts p to a byte slice
+func (p *PointAffine) Marshal() []byte {
+	b := p.Bytes()
+	return b[:]
+}
+
+// SetBytes sets p to abst_J(buf) (cf Zcash specification, section 5.4.9.3)
+// len(buf) >= SizePointCompressed
+// Non canonical encodings are rejected, as mandated by ZIP 216.
+// The point is not checked to be in the prime order subgroup.
+// Returns the number of read bytes and an error if the buffer is too short
+// or doesn't encode a point of the curve.
+func (p *PointAffine) SetBytes(buf []byte) (int, error) {
+
+	if len(buf) < SizePointCompressed {
+		return 0, io.ErrShortBuffer
+	}
+
+	var yBytes [SizePointCompressed]byte
+	for i := 0; i < SizePointCompressed; i++ {
+		yBytes[i] = buf[SizePointCompressed-1-i]
+	}
+	xParity := uint64(yBytes[0] >> 7)
+	yBytes[0] &= 0x7f
+
+	// y must be canonical
+	var y fp.Element
+	y.SetBytes(yBytes[:])
+	if b := y.Bytes(); b != yBytes {
+		return 0, ErrInvalidEncoding
+	}
+
+	// x^2 = (1 - y^2)/(a - dy^2)
+	var one, num, den, x fp.Element
+	one.SetOne()
+	num.Square(&y)
+	den.Mul(&num, &edwards.D)
+	num.Sub(&one, &num)
+	den.Sub(&edwards.A, &den)
+	x.Div(&num, &den)
+	if x.Sqrt(&x) == nil {
+		return 0, ErrInvalidEncoding
+	}
+
+	var xRegular fp.Element
+	xRegular.Set(&x).FromMont()
+	if xRegular[0]&1 != xParity {
+		if x.IsZero() {
+			// -0 is not a canonical encoding (ZIP 216)
+			return 0, ErrInvalidEncoding
+		}
+		x.Neg(&x)
+	}
+
+	p.X.Set(&x)
+	p.Y.Set(&y)
+
+	return SizePointCompressed, nil
+}
+
+// Unmarshal alias to SetBytes()
+func (p *PointAffine) Unmarshal(b []byte) error {
+	_, err := p.SetBytes(b)
+	return err
+}
+
+// Set sets p to p1 and return it
+func (p *PointAffine) Set(p1 *PointAffine) *PointAffine {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	return p
+}
+
+// Equal returns true if p=p1 false otherwise
+func (p *PointAffine) Equal(p1 *PointAffine) bool {
+	return p.X.Equal(&p1.X) && p.Y.Equal(&p1.Y)
+}
+
+// IsZero returns true if p=(0,1), the neutral element
+func (p *PointAffine) IsZero() bool {
+	var one fp.Element
+	one.SetOne()
+	return p.X.IsZero() && p.Y.Equal(&one)
+}
+
+// NewPointAffine creates a new instance of PointAffine
+func NewPointAffine(x, y fp.Element) PointAffine {
+	return PointAffine{x, y}
+}
+
+// IsOnCurve checks if a point is on the twisted Edwards curve
+func (p *PointAffine) IsOnCurve() bool {
+
+	var lhs, rhs, tmp fp.Element
+
+	tmp.Square(&p.Y)
+	lhs.Square(&p.X).
+		Neg(&lhs).
+		Add(&lhs, &tmp)
+
+	tmp.Mul(&p.X, &p.Y).
+		Square(&tmp).
+		Mul(&tmp, &edwards.D)
+	rhs.SetOne().Add(&rhs, &tmp)
+
+	return lhs.Equal(&rhs)
+}
+
+// IsInSubGroup checks if a point is on the curve and in the prime order subgroup
+func (p *PointAffine) IsInSubGroup() bool {
+	if !p.IsOnCurve() {
+		return false
+	}
+	var _p PointExtended
+	_p.FromAffine(p)
+	_p.ScalarMul(&_p, &edwards.Order)
+	return _p.IsZero()
+}
+
+// IsSmallOrder returns true if p is a point of order dividing the cofactor 8
+func (p *PointAffine) IsSmallOrder() bool {
+	var _p PointExtended
+	_p.FromAffine(p)
+	_p.Double(&_p).Double(&_p).Double(&_p)
+	return _p.IsZero()
+}
+
+// Add adds two points (x,y), (u,v) on a twisted Edwards curve with parameters a, d
+// modifies p
+func (p *PointAffine) Add(p1, p2 *PointAffine) *PointAffine {
+	var _p1, _p2 PointExtended
+	_p1.FromAffine(p1)
+	_p2.FromAffine(p2)
+	_p1.Add(&_p1, &_p2)
+	p.FromExtended(&_p1)
+	return p
+}
+
+// Double doubles point (x,y) on a twisted Edwards curve with parameters a, d
+// modifies p
+func (p *PointAffine) Double(p1 *PointAffine) *PointAffine {
+	var _p PointExtended
+	_p.FromAffine(p1)
+	_p.Double(&_p)
+	p.FromExtended(&_p)
+	return p
+}
+
+// Neg sets p to -p1 and returns it
+func (p *PointAffine) Neg(p1 *PointAffine) *PointAffine {
+	p.Set(p1)
+	p.X.Neg(&p.X)
+	return p
+}
+
+// FromExtended sets p in affine from p in extended coordinates
+func (p *PointAffine) FromExtended(p1 *PointExtended) *PointAffine {
+	var I fp.Element
+	I.Inverse(&p1.Z)
+	p.X.Mul(&p1.X, &I)
+	p.Y.Mul(&p1.Y, &I)
+	return p
+}
+
+// ScalarMul scalar multiplication of a point
+// scalar NOT in Montgomery form
+// modifies p
+func (p *PointAffine) ScalarMul(p1 *PointAffine, scalar *big.Int) *PointAffine {
+	var _p PointExtended
+	_p.FromAffine(p1)
+	_p.ScalarMul(&_p, scalar)
+	p.FromExtended(&_p)
+	return p
+}
+
+// -------------------------------------------------------------------------------------------------
+// Extended coordinates
+
+// Set sets p to p1 and return it
+func (p *PointExtended) Set(p1 *PointExtended) *PointExtended {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	p.T.Set(&p1.T)
+	p.Z.Set(&p1.Z)
+	return p
+}
+
+// setInfinity sets p to O (0:1:0:1)
+func (p *PointExtended) setInfinity() *PointExtended {
+	p.X.SetZero()
+	p.Y.SetOne()
+	p.T.SetZero()
+	p.Z.SetOne()
+	return p
+}
+
+// Equal returns true if p=p1 false otherwise
+// If one point is on the affine chart Z=0 it returns false
+func (p *PointExtended) Equal(p1 *PointExtended) bool {
+	if p.Z.IsZero() || p1.Z.IsZero() {
+		return false
+	}
+	var lhs, rhs fp.Element
+	lhs.Mul(&p.X, &p1.Z)
+	rhs.Mul(&p1.X, &p.Z)
+	if !lhs.Equal(&rhs) {
+		return false
+	}
+	lhs.Mul(&p.Y, &p1.Z)
+	rhs.Mul(&p1.Y, &p.Z)
+	return lhs.Equal(&rhs)
+}
+
+// IsZero returns true if p=(0:1:0:1), the neutral element
+func (p *PointExtended) IsZero() bool {
+	return p.X.IsZero() && p.Y.Equal(&p.Z)
+}
+
+// Neg sets p to -p1 and returns it
+func (p *PointExtended) Neg(p1 *PointExtended) *PointExtended {
+	p.Set(p1)
+	p.X.Neg(&p.X)
+	p.T.Neg(&p.T)
+	return p
+}
+
+// FromAffine sets p in extended from p in affine
+func (p *PointExtended) FromAffine(p1 *PointAffine) *PointExtended {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	p.T.Mul(&p1.X, &p1.Y)
+	p.Z.SetOne()
+	return p
+}
+
+// Add adds points in extended coordinates
+// cf https://hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#addition-add-2008-hwcd (with a=-1)
+func (p *PointExtended) Add(p1, p2 *PointExtended) *PointExtended {
+
+	var A, B, C, D, E, F, G, H, tmp fp.Element
+	A.Mul(&p1.X, &p2.X)
+	B.Mul(&p1.Y, &p2.Y)
+	C.Mul(&p1.T, &p2.T).Mul(&C, &edwards.D)
+	D.Mul(&p1.Z, &p2.Z)
+	tmp.Add(&p1.X, &p1.Y)
+	E.Add(&p2.X, &p2.Y).
+		Mul(&E, &tmp).
+		Sub(&E, &A).
+		Sub(&E, &B)
+	F.Sub(&D, &C)
+	G.Add(&D, &C)
+	H.Add(&B, &A) // a = -1
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&E, &H)
+	p.Z.Mul(&F, &G)
+
+	return p
+}
+
+// Double doubles a point in extended coordinates
+// cf https://hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#doubling-dbl-2008-hwcd (with a=-1)
+func (p *PointExtended) Double(p1 *PointExtended) *PointExtended {
+
+	var A, B, C, D, E, F, G, H fp.Element
+	A.Square(&p1.X)
+	B.Square(&p1.Y)
+	C.Square(&p1.Z).Double(&C)
+	D.Neg(&A) // a = -1
+	E.Add(&p1.X, &p1.Y).
+		Square(&E).
+		Sub(&E, &A).
+		Sub(&E, &B)
+	G.Add(&D, &B)
+	F.Sub(&G, &C)
+	H.Sub(&D, &B)
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&E, &H)
+	p.Z.Mul(&F, &G)
+
+	return p
+}
+
+// ScalarMul scalar multiplication of a point, using a double-and-add
+// scalar NOT in Montgomery form
+// modifies p
+func (p *PointExtended) ScalarMul(p1 *PointExtended, scalar *big.Int) *PointExtended {
+
+	var _scalar big.Int
+	var res, base PointExtended
+
+	_scalar.Set(scalar)
+	base.Set(p1)
+	if _scalar.Sign() == -1 {
+		_scalar.Neg(&_scalar)
+		base.Neg(&base)
+	}
+	res.setInfinity()
+
+	const wordSize = bits.UintSize
+	sWords := _scalar.Bits()
+
+	for i := len(sWords) - 1; i >= 0; i-- {
+		ithWord := sWords[i]
+		for k := 0; k < wordSize; k++ {
+			res.Double(&res)
+			kthBit := (ithWord >> (wordSize - 1 - k)) & 1
+			if kthBit == 1 {
+				res.Add(&res, &base)
+			}
+		}
+	}
+
+	p.Set(&res)
+	return p
+}
@@ -0,0 +1,24 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redjubjub provides RedJubjub signatures, the re-randomizable Schnorr signatures
+// on Jubjub used for spend authorization in Zcash Sapling (RedDSA instantiated with Jubjub,
+// the spending key generator and H* = BLAKE2b-512("Zcash_RedJubjubH", .)).
+//
+// Keys are derived from a 32 bytes spending key as in Zcash (ask = ToScalar(PRF^expand(sk, [0]))),
+// points and scalars are encoded in little endian as in the Zcash specification, and
+// verification is cofactored.
+//
+// cf https://zips.z.cash/protocol/protocol.pdf, section 5.4.7
+package redjubjub
@@ -0,0 +1,271 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redjubjub
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/jubjub"
+	"github.com/consensys/gnark-crypto/internal/blake2"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+var (
+	errNotOnCurve     = errors.New("point not on curve")
+	errScalarTooLarge = errors.New("scalar is not reduced modulo the subgroup order")
+)
+
+const (
+	sizeFr         = 32
+	sizePublicKey  = jubjub.SizePointCompressed
+	sizeSignature  = sizePublicKey + sizeFr
+	sizePrivateKey = sizePublicKey + sizeFr
+)
+
+// PublicKey RedJubjub public key (vk in the Zcash specification)
+type PublicKey struct {
+	A jubjub.PointAffine
+}
+
+// PrivateKey RedJubjub private key (sk in the Zcash specification)
+type PrivateKey struct {
+	PublicKey PublicKey    // copy of the associated public key
+	scalar    [sizeFr]byte // secret scalar, in little endian
+}
+
+// Signature represents a RedJubjub signature
+type Signature struct {
+	R jubjub.PointAffine
+	S [sizeFr]byte // in little endian
+}
+
+// curveOrder order of the prime order subgroup of Jubjub
+var curveOrder big.Int
+
+func init() {
+	curve := jubjub.GetEdwardsCurve()
+	curveOrder.Set(&curve.Order)
+
+	signature.Register(signature.REDJUBJUB, GenerateKeyInterfaces)
+}
+
+// hStar implements H*(data) = LEOS2IP(BLAKE2b-512("Zcash_RedJubjubH", data)) mod r
+func hStar(res *big.Int, data ...[]byte) {
+	h, _ := blake2.NewBlake2b(64, []byte("Zcash_RedJubjubH"))
+	for _, d := range data {
+		h.Write(d)
+	}
+	leos2ip(res, h.Sum(nil))
+	res.Mod(res, &curveOrder)
+}
+
+// leos2ip sets res to the integer encoded in little endian in b
+func leos2ip(res *big.Int, b []byte) {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[i] = b[len(b)-1-i]
+	}
+	res.SetBytes(be)
+}
+
+// i2leosp returns the little endian encoding of s on sizeFr bytes
+func i2leosp(s *big.Int) [sizeFr]byte {
+	var res [sizeFr]byte
+	be := s.Bytes()
+	for i := range be {
+		res[i] = be[len(be)-1-i]
+	}
+	return res
+}
+
+// GenerateKey derives a key pair from a 32 bytes spending key sk read from r,
+// as in Zcash Sapling: the secret scalar is ToScalar(PRF^expand(sk, [0])).
+func GenerateKey(r io.Reader) (PrivateKey, error) {
+	var priv PrivateKey
+
+	var sk [32]byte
+	if _, err := io.ReadFull(r, sk[:]); err != nil {
+		return priv, err
+	}
+
+	// PRF^expand(sk, t) = BLAKE2b-512("Zcash_ExpandSeed", sk || t)
+	h, _ := blake2.NewBlake2b(64, []byte("Zcash_ExpandSeed"))
+	h.Write(sk[:])
+	h.Write([]byte{0})
+
+	var s big.Int
+	leos2ip(&s, h.Sum(nil))
+	s.Mod(&s, &curveOrder)
+
+	return newPrivateKey(&s), nil
+}
+
+// newPrivateKey returns the key pair associated to the secret scalar s < r
+func newPrivateKey(s *big.Int) PrivateKey {
+	var priv PrivateKey
+	priv.scalar = i2leosp(s)
+	base := jubjub.SpendingKeyGenerator()
+	priv.PublicKey.A.ScalarMul(&base, s)
+	return priv
+}
+
+// GenerateKeyInterfaces generate interfaces for the public/private key.
+// This purpose of this function is to be registered in the list of signature schemes.
+func GenerateKeyInterfaces(r io.Reader) (signature.Signer, error) {
+	priv, err := GenerateKey(r)
+	return &priv, err
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(other signature.PublicKey) bool {
+	bpk := pub.Bytes()
+	bother := other.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bother) == 1
+}
+
+// Public returns the public key associated to the private key.
+// From Signer interface defined in gnark/crypto/signature.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Randomize returns the private key sk + alpha (rsk in the Zcash specification)
+func (privKey *PrivateKey) Randomize(alpha *big.Int) PrivateKey {
+	var s big.Int
+	leos2ip(&s, privKey.scalar[:])
+	s.Add(&s, alpha).Mod(&s, &curveOrder)
+	return newPrivateKey(&s)
+}
+
+// Randomize returns the public key vk + [alpha]G (rk in the Zcash specification),
+// which matches the private key randomized with alpha
+func (pub *PublicKey) Randomize(alpha *big.Int) PublicKey {
+	var res PublicKey
+	var a big.Int
+	a.Mod(alpha, &curveOrder)
+	base := jubjub.SpendingKeyGenerator()
+	res.A.ScalarMul(&base, &a).
+		Add(&res.A, &pub.A)
+	return res
+}
+
+// Sign signs a message
+// If hFunc is not nil, the message is hashed with it first.
+// cf Zcash specification, section 5.4.7 (RedDSA.Sign)
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	return privKey.sign(rand.Reader, message, hFunc)
+}
+
+func (privKey *PrivateKey) sign(rnd io.Reader, message []byte, hFunc hash.Hash) ([]byte, error) {
+
+	message, err := prehash(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	// T is (l_H + 128) bits of randomness, l_H = 512
+	var t [80]byte
+	if _, err := io.ReadFull(rnd, t[:]); err != nil {
+		return nil, err
+	}
+
+	vk := privKey.PublicKey.A.Bytes()
+
+	// r = H*(T || vk || M)
+	var r big.Int
+	hStar(&r, t[:], vk[:], message)
+
+	// R = [r]G
+	var res Signature
+	base := jubjub.SpendingKeyGenerator()
+	res.R.ScalarMul(&base, &r)
+	rBar := res.R.Bytes()
+
+	// S = r + H*(R || vk || M) * sk mod r
+	var c, s big.Int
+	hStar(&c, rBar[:], vk[:], message)
+	leos2ip(&s, privKey.scalar[:])
+	s.Mul(&s, &c).
+		Add(&s, &r).
+		Mod(&s, &curveOrder)
+	res.S = i2leosp(&s)
+
+	return res.Bytes(), nil
+}
+
+// Verify verifies a RedJubjub signature
+// If hFunc is not nil, the message is hashed with it first.
+// cf Zcash specification, section 5.4.7 (RedDSA.Validate)
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+
+	message, err := prehash(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+
+	if !pub.A.IsOnCurve() {
+		return false, errNotOnCurve
+	}
+
+	// Deserialize the signature, R and S must be canonical
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	var s big.Int
+	leos2ip(&s, sig.S[:])
+	if s.Cmp(&curveOrder) >= 0 {
+		return false, errScalarTooLarge
+	}
+
+	// c = H*(R || vk || M)
+	var c big.Int
+	vk := pub.A.Bytes()
+	rBar := sig.R.Bytes()
+	hStar(&c, rBar[:], vk[:], message)
+
+	// check that [8](-[S]G + R + [c]vk) = O
+	var lhs, tmp jubjub.PointExtended
+	base := jubjub.SpendingKeyGenerator()
+	lhs.FromAffine(&base)
+	lhs.ScalarMul(&lhs, &s).Neg(&lhs)
+	tmp.FromAffine(&sig.R)
+	lhs.Add(&lhs, &tmp)
+	tmp.FromAffine(&pub.A)
+	tmp.ScalarMul(&tmp, &c)
+	lhs.Add(&lhs, &tmp)
+	lhs.Double(&lhs).Double(&lhs).Double(&lhs)
+
+	return lhs.IsZero(), nil
+}
+
+// prehash returns hFunc(message), or message if hFunc is nil
+func prehash(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	return hFunc.Sum(nil), nil
+}
@@ -0,0 +1,244 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redjubjub
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+// Zcash Sapling key components test vectors (ask, ak) for the spending keys sk = [i]*32
+// cf https://github.com/zcash-hackworks/zcash-test-vectors (sapling_key_components)
+var keyVectors = []struct {
+	ask, ak string
+}{
+	{"8548a14a473ea547aa2378402044f818cf1911cf5dd2054f678345f00d0e8806", "f344ec380fe1273e3098c2588c5d3a791fd7ba958032760777fd0efa8ef11620"},
+	{"c9435629bf8bffe55e7335ec077718ba60ba28d7ac3794b74f512c31af0a5304", "82ff5effc527ae84020bf2d35201c10219131947ff4b96f881a45f2e8ae30518"},
+	{"ee1c3d7efe0a78063d6af3d9d81212af47b7c1b761f85ccb066fc11a6a421703", "ab83574eb5de859a0ab8629dec34c7bee8c3fc74dfa0b19a3a7468d15dca64c6"},
+}
+
+func TestKeyVectors(t *testing.T) {
+	for i, v := range keyVectors {
+		priv, err := GenerateKey(bytes.NewReader(bytes.Repeat([]byte{byte(i)}, 32)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(priv.scalar[:]) != v.ask {
+			t.Fatalf("wrong ask for sk=[%d]*32", i)
+		}
+		if hex.EncodeToString(priv.PublicKey.Bytes()) != v.ak {
+			t.Fatalf("wrong ak for sk=[%d]*32", i)
+		}
+	}
+}
+
+// RedJubjub signatures for the keys of keyVectors, with the randomness T[j] = 80*sk + j (mod 256),
+// and for the keys randomized with alpha (rsk = ask + alpha, rk = ak + [alpha]G).
+// They were computed with an independent implementation of the Zcash specification (sections 5.4.7
+// and 5.4.9.3) that reproduces keyVectors. The challenge is H*(R || vk || M), as in the
+// specification (librustzcash gets the same challenge by signing rk || SigHash with H*(R || M)).
+// These are not librustzcash test vectors.
+var signatureVectors = []struct {
+	sk            byte
+	msg, alpha    string
+	sig, rk, rsig string
+}{
+	{
+		sk:    0,
+		msg:   "",
+		alpha: "ffd0d42f3eacb41ec603787b519446010babd488ee3408e3b3f194ce611d250e",
+		sig:   "1db1bde6a9bb9389e45a466f5d21d70fe3013bd11605beb525b1db025feec5a90adfdb7a20685d682b10e0f2ac1fae7ccd9a419031e653cae96ded2d9f555c0a",
+		rk:    "44ee2b7f9275081c20877a859f8df7c9feb113f60bb335566952a1eb5b2f7294",
+		rsig:  "78e0cb98c67ffdc197b3607f3fffe9ae86a1c068055ae7b532d7cb32e2d5e29e3b99326580b3a26b2fae5b82c821104fd6e2b20866f8e9901c641dd377a04b07",
+	},
+	{
+		sk:    1,
+		msg:   "616263",
+		alpha: "1cc72e592b274110d10b54a651fd8ad6b0c26c444c34dcd8e4ca7a165eb2220a",
+		sig:   "5a8129cc231dc8169f7f46ff2f7a2949bc342fad798c7e483d502b47ceb80dd6e595622e4dd45226c95fa836f471f2817b71086c7586febf8054146034f17504",
+		rk:    "df746e5a3d0a9de73bf44b0beaada0856d8014645d1988d93d3538281db91097",
+		rsig:  "9709da0b0dc132b94e6258f430d35b731541de551b61fed705dcae94d5404241ea413f8089162073c8d34e2173ac1440d5bc81b1e4a5ac108d9637f088e96f09",
+	},
+	{
+		sk:    2,
+		msg:   "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9fa0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebfc0c1c2c3c4c5c6c7c8c9cacbcccdcecfd0d1d2d3d4d5d6d7d8d9dadbdcdddedfe0e1e2e3e4e5e6e7e8e9eaebecedeeeff0f1f2f3f4f5f6f7f8f9fafbfcfdfeff",
+		alpha: "d112bd3a852601cafc224dd409381aa458543ef57625c3d4b50fd107b5d0480c",
+		sig:   "0148dab201ff1c88ee135cdbb4b0ba509d83b25823b3c0d7d49e7e6df12f7481e05cde18df039ff0bc7a92495723f57ffa9b82d29105c232cf52e80bd6c7a902",
+		rk:    "e85dccf905f7c88c70df7696da236dafd0eef8a380c20022ce10b965d9c36162",
+		rsig:  "3742a9a0a4c5a5e88213cb476526379b9e75a1726bb74fec39454aea3d28c887c090bdc8bc253ccce298c81e9a90334ae15b97e96571f1203c2c09e5a4587f06",
+	},
+}
+
+func TestSignatureVectors(t *testing.T) {
+	for _, v := range signatureVectors {
+		priv, err := GenerateKey(bytes.NewReader(bytes.Repeat([]byte{v.sk}, 32)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var T [80]byte
+		for j := range T {
+			T[j] = byte(80*int(v.sk) + j)
+		}
+		msg, _ := hex.DecodeString(v.msg)
+
+		sig, err := priv.sign(bytes.NewReader(T[:]), msg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(sig) != v.sig {
+			t.Fatalf("wrong signature for sk=[%d]*32", v.sk)
+		}
+		if ok, err := priv.PublicKey.Verify(sig, msg, nil); err != nil || !ok {
+			t.Fatalf("the signature for sk=[%d]*32 should verify", v.sk)
+		}
+
+		// randomized keys
+		var alpha big.Int
+		alphaBytes, _ := hex.DecodeString(v.alpha)
+		leos2ip(&alpha, alphaBytes)
+		rsk := priv.Randomize(&alpha)
+		rk := priv.PublicKey.Randomize(&alpha)
+		if hex.EncodeToString(rk.Bytes()) != v.rk || hex.EncodeToString(rsk.PublicKey.Bytes()) != v.rk {
+			t.Fatalf("wrong randomized key for sk=[%d]*32", v.sk)
+		}
+		rsig, err := rsk.sign(bytes.NewReader(T[:]), msg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(rsig) != v.rsig {
+			t.Fatalf("wrong randomized signature for sk=[%d]*32", v.sk)
+		}
+		if ok, err := rk.Verify(rsig, msg, nil); err != nil || !ok {
+			t.Fatalf("the randomized signature for sk=[%d]*32 should verify with rk", v.sk)
+		}
+		if ok, _ := priv.PublicKey.Verify(rsig, msg, nil); ok {
+			t.Fatalf("the randomized signature for sk=[%d]*32 shouldn't verify with ak", v.sk)
+		}
+		rsig[sizePublicKey] ^= 1
+		if ok, _ := rk.Verify(rsig, msg, nil); ok {
+			t.Fatalf("a modified signature for sk=[%d]*32 shouldn't verify", v.sk)
+		}
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+
+	msg := []byte("Zcash_RedJubjubH test message")
+
+	signer, err := signature.REDJUBJUB.New(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := signer.Public()
+
+	sig, err := signer.Sign(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := pub.Verify(sig, msg, nil); err != nil || !ok {
+		t.Fatal("verify correct signature should return true")
+	}
+	if ok, _ := pub.Verify(sig, []byte("wrong message"), nil); ok {
+		t.Fatal("verify wrong message should return false")
+	}
+
+	// with a pre-hashing of the message
+	sig, err = signer.Sign(msg, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := pub.Verify(sig, msg, sha256.New()); err != nil || !ok {
+		t.Fatal("verify correct signature should return true")
+	}
+	if ok, _ := pub.Verify(sig, msg, nil); ok {
+		t.Fatal("verify without the pre-hashing should return false")
+	}
+
+	// S must be reduced
+	var s big.Int
+	leos2ip(&s, sig[sizePublicKey:])
+	s.Add(&s, &curveOrder)
+	sByte := i2leosp(&s)
+	copy(sig[sizePublicKey:], sByte[:])
+	if _, err := pub.Verify(sig, msg, sha256.New()); err != errScalarTooLarge {
+		t.Fatal("verify with a non reduced S should fail")
+	}
+}
+
+func TestRandomize(t *testing.T) {
+
+	msg := []byte("sighash")
+
+	priv, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alpha, err := rand.Int(rand.Reader, &curveOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsk := priv.Randomize(alpha)
+	rk := priv.PublicKey.Randomize(alpha)
+	if !rk.Equal(&rsk.PublicKey) {
+		t.Fatal("rk doesn't match the randomized private key")
+	}
+
+	sig, err := rsk.Sign(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := rk.Verify(sig, msg, nil); err != nil || !ok {
+		t.Fatal("the randomized key should verify the signature")
+	}
+	if ok, _ := priv.PublicKey.Verify(sig, msg, nil); ok {
+		t.Fatal("the original key shouldn't verify the signature")
+	}
+}
+
+func TestMarshal(t *testing.T) {
+
+	priv, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var priv2 PrivateKey
+	if _, err := priv2.SetBytes(priv.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(priv.Bytes(), priv2.Bytes()) {
+		t.Fatal("unmarshal(marshal(privKey)) != privKey")
+	}
+
+	sigBin, err := priv.Sign([]byte("message"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sig.Bytes(), sigBin) {
+		t.Fatal("unmarshal(marshal(signature)) != signature")
+	}
+}
@@ -23,10 +23,12 @@ import (
 // GT target group of the pairing
 type GT = fptower.E12
 
-type lineEvaluation struct {
-	r0 fptower.E2
-	r1 fptower.E2
-	r2 fptower.E2
+// LineEvaluation holds the three non-zero coefficients of a line of the Miller loop,
+// as multiplied into the accumulator with GT.MulBy014
+type LineEvaluation struct {
+	R0 fptower.E2
+	R1 fptower.E2
+	R2 fptower.E2
 }
 
 // Pair calculates the reduced pairing for a set of points
@@ -58,14 +60,32 @@ func FinalExponentiation(z *GT, _z ...*GT) GT {
 		result.Mul(&result, e)
 	}
 
-	var t [3]GT
+	result = FinalExponentiationEasy(&result)
+	return FinalExponentiationHard(&result)
+}
+
+// FinalExponentiationEasy computes the easy part x**(p**6-1)(p**2+1) of the final expo.
+// The result lies in the cyclotomic subgroup of GT.
+func FinalExponentiationEasy(z *GT) GT {
+
+	var result, t GT
+	t.Conjugate(z)
+	result.Inverse(z)
+	t.Mul(&t, &result)
+	result.FrobeniusSquare(&t).
+		Mul(&result, &t)
+
+	return result
+}
+
+// FinalExponentiationHard computes the hard part x**(p**4 - p**2 +1)/r of the final expo.
+// z must lie in the cyclotomic subgroup of GT, e.g. be the output of FinalExponentiationEasy.
+func FinalExponentiationHard(z *GT) GT {
 
-	// easy part
-	t[0].Conjugate(&result)
-	result.Inverse(&result)
-	t[0].Mul(&t[0], &result)
-	result.FrobeniusSquare(&t[0]).
-		Mul(&result, &t[0])
+	var result GT
+	result.Set(z)
+
+	var t [3]GT
 
 	// hard part (up to permutation)
 	// Daiki Hayashida and Kenichiro Hayasaka
@@ -124,21 +144,21 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	var result GT
 	result.SetOne()
 
-	var l lineEvaluation
+	var l LineEvaluation
 
 	// i == 62
 	for k := 0; k < n; k++ {
 		qProj[k].DoubleStep(&l)
 		// line eval
-		l.r1.MulByElement(&l.r1, &p[k].X)
-		l.r2.MulByElement(&l.r2, &p[k].Y)
-		result.MulBy014(&l.r0, &l.r1, &l.r2)
+		l.R1.MulByElement(&l.R1, &p[k].X)
+		l.R2.MulByElement(&l.R2, &p[k].Y)
+		result.MulBy014(&l.R0, &l.R1, &l.R2)
 
 		qProj[k].AddMixedStep(&l, &q[k])
 		// line eval
-		l.r1.MulByElement(&l.r1, &p[k].X)
-		l.r2.MulByElement(&l.r2, &p[k].Y)
-		result.MulBy014(&l.r0, &l.r1, &l.r2)
+		l.R1.MulByElement(&l.R1, &p[k].X)
+		l.R2.MulByElement(&l.R2, &p[k].Y)
+		result.MulBy014(&l.R0, &l.R1, &l.R2)
 	}
 
 	for i := 61; i >= 0; i-- {
@@ -147,9 +167,9 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		for k := 0; k < n; k++ {
 			qProj[k].DoubleStep(&l)
 			// line eval
-			l.r1.MulByElement(&l.r1, &p[k].X)
-			l.r2.MulByElement(&l.r2, &p[k].Y)
-			result.MulBy014(&l.r0, &l.r1, &l.r2)
+			l.R1.MulByElement(&l.R1, &p[k].X)
+			l.R2.MulByElement(&l.R2, &p[k].Y)
+			result.MulBy014(&l.R0, &l.R1, &l.R2)
 		}
 
 		if loopCounter[i] == 0 {
@@ -159,9 +179,9 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		for k := 0; k < n; k++ {
 			qProj[k].AddMixedStep(&l, &q[k])
 			// line eval
-			l.r1.MulByElement(&l.r1, &p[k].X)
-			l.r2.MulByElement(&l.r2, &p[k].Y)
-			result.MulBy014(&l.r0, &l.r1, &l.r2)
+			l.R1.MulByElement(&l.R1, &p[k].X)
+			l.R2.MulByElement(&l.R2, &p[k].Y)
+			result.MulBy014(&l.R0, &l.R1, &l.R2)
 		}
 	}
 
@@ -170,9 +190,69 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	return result, nil
 }
 
+// MillerLoopStep records a line multiplication of the Miller loop
+type MillerLoopStep struct {
+	Line        LineEvaluation // line as output by the doubling or addition step
+	Evaluation  LineEvaluation // Line evaluated at the G1 point
+	Accumulator GT             // Miller loop accumulator once Evaluation is multiplied in
+}
+
+// MillerLoopSteps runs the Miller loop on a single pair (P, Q) and returns, in order,
+// every line multiplied into the accumulator along with the resulting accumulator.
+// The last accumulator is conjugated to get the result, since the loop parameter is negative.
+// The returned GT equals MillerLoop([]G1Affine{P}, []G2Affine{Q}).
+// If P or Q is the point at infinity, no step is recorded and the result is one.
+func MillerLoopSteps(P *G1Affine, Q *G2Affine) ([]MillerLoopStep, GT) {
+	var result GT
+	result.SetOne()
+	if P.IsInfinity() || Q.IsInfinity() {
+		return nil, result
+	}
+
+	var qProj g2Proj
+	qProj.FromAffine(Q)
+
+	var l LineEvaluation
+	steps := make([]MillerLoopStep, 0, 2*len(loopCounter))
+	mulLine := func() {
+		step := MillerLoopStep{Line: l}
+		l.R1.MulByElement(&l.R1, &P.X)
+		l.R2.MulByElement(&l.R2, &P.Y)
+		step.Evaluation = l
+		result.MulBy014(&l.R0, &l.R1, &l.R2)
+		step.Accumulator = result
+		steps = append(steps, step)
+	}
+
+	// i == 62
+	qProj.DoubleStep(&l)
+	mulLine()
+
+	qProj.AddMixedStep(&l, Q)
+	mulLine()
+
+	for i := 61; i >= 0; i-- {
+		result.Square(&result)
+
+		qProj.DoubleStep(&l)
+		mulLine()
+
+		if loopCounter[i] == 0 {
+			continue
+		}
+
+		qProj.AddMixedStep(&l, Q)
+		mulLine()
+	}
+
+	result.Conjugate(&result)
+
+	return steps, result
+}
+
 // DoubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) DoubleStep(l *lineEvaluation) {
+func (p *g2Proj) DoubleStep(l *LineEvaluation) {
 
 	// get some Element from our pool
 	var t0, t1, A, B, C, D, E, EE, F, G, H, I, J, K fptower.E2
@@ -205,16 +285,16 @@ func (p *g2Proj) DoubleStep(l *lineEvaluation) {
 	p.z.Mul(&B, &H)
 
 	// Line evaluation
-	l.r0.Set(&I)
-	l.r1.Double(&J).
-		Add(&l.r1, &J)
-	l.r2.Neg(&H)
+	l.R0.Set(&I)
+	l.R1.Double(&J).
+		Add(&l.R1, &J)
+	l.R2.Neg(&H)
 
 }
 
 // AddMixedStep point addition in Mixed Homogenous projective and Affine coordinates
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) AddMixedStep(l *lineEvaluation, a *G2Affine) {
+func (p *g2Proj) AddMixedStep(l *LineEvaluation, a *G2Affine) {
 
 	// get some Element from our pool
 	var Y2Z1, X2Z1, O, L, C, D, E, F, G, H, t0, t1, t2, J fptower.E2
@@ -244,7 +324,7 @@ func (p *g2Proj) AddMixedStep(l *lineEvaluation, a *G2Affine) {
 		Sub(&J, &t2)
 
 	// Line evaluation
-	l.r0.Set(&J)
-	l.r1.Neg(&O)
-	l.r2.Set(&L)
+	l.R0.Set(&J)
+	l.R1.Neg(&O)
+	l.R2.Set(&L)
 }
@@ -57,3 +57,8 @@ func init() {
 	edwards.Base.X.SetString("23426137002068529236790192115758361610982344002369094106619281483467893291614")
 	edwards.Base.Y.SetString("39325435222430376843701388596190331198052476467368316772266670064146548432123")
 }
+
+// mulByA multiplies x by the curve coefficient a=-1
+func mulByA(x *fr.Element) {
+	x.Neg(x)
+}
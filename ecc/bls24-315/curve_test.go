@@ -0,0 +1,178 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestCurve(t *testing.T) {
+	c, err := ID.Curve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.ID() != ID {
+		t.Fatal("wrong curve registered")
+	}
+	if c.ScalarFieldModulus().Cmp(fr.Modulus()) != 0 {
+		t.Fatal("wrong scalar field modulus")
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genR1 := GenFr()
+	genR2 := GenFr()
+
+	properties.Property("[BLS24-315] runtime group operations should match the concrete types", prop.ForAll(
+		func(a, b fr.Element) bool {
+			var abigint, bbigint big.Int
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+
+			var ag1, bg1, sum G1Affine
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg1.ScalarMultiplication(&g1GenAff, &bbigint)
+			sum.Add(&ag1, &bg1)
+
+			_ag1 := c.NewG1().ScalarMultiplication(c.G1Generator(), &abigint)
+			_bg1 := c.NewG1().ScalarMultiplication(c.G1Generator(), &bbigint)
+			_sum := c.NewG1().Add(_ag1, _bg1)
+
+			var bg2 G2Affine
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+			_bg2 := c.NewG2().ScalarMultiplication(c.G2Generator(), &bbigint)
+
+			return _sum.(*g1Point).affine().Equal(&sum) && _bg2.(*g2Point).affine().Equal(&bg2)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS24-315] runtime MultiExp should match the sum of scalar multiplications", prop.ForAll(
+		func(a, b fr.Element) bool {
+			var abigint, bbigint big.Int
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+
+			g1 := c.G1Generator()
+			h1 := c.NewG1().ScalarMultiplication(g1, big.NewInt(3))
+			expected := c.NewG1().ScalarMultiplication(g1, &abigint)
+			expected.Add(expected, c.NewG1().ScalarMultiplication(h1, &bbigint))
+			msm, err := c.NewG1().MultiExp([]ecc.Point{g1, h1}, []*big.Int{&abigint, &bbigint}, ecc.MultiExpConfig{})
+			if err != nil || !msm.Equal(expected) {
+				return false
+			}
+
+			g2 := c.G2Generator()
+			h2 := c.NewG2().ScalarMultiplication(g2, big.NewInt(3))
+			expected = c.NewG2().ScalarMultiplication(g2, &abigint)
+			expected.Add(expected, c.NewG2().ScalarMultiplication(h2, &bbigint))
+			msm, err = c.NewG2().MultiExp([]ecc.Point{g2, h2}, []*big.Int{&abigint, &bbigint}, ecc.MultiExpConfig{})
+			return err == nil && msm.Equal(expected)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS24-315] runtime pairing should be bilinear", prop.ForAll(
+		func(a, b fr.Element) bool {
+			var abigint, bbigint, ab big.Int
+			a.ToBigIntRegular(&abigint)
+			b.ToBigIntRegular(&bbigint)
+			ab.Mul(&abigint, &bbigint)
+
+			ag1 := c.NewG1().ScalarMultiplication(c.G1Generator(), &abigint)
+			bg2 := c.NewG2().ScalarMultiplication(c.G2Generator(), &bbigint)
+
+			res, err := c.Pair([]ecc.Point{c.G1Generator()}, []ecc.Point{c.G2Generator()})
+			if err != nil {
+				return false
+			}
+			resab, err := c.Pair([]ecc.Point{ag1}, []ecc.Point{bg2})
+			if err != nil {
+				return false
+			}
+			expected := c.NewGT().Exp(res, &ab)
+
+			// e([a]g1, [b]g2) * e(-[ab]g1, g2) == 1
+			abg1 := c.NewG1().ScalarMultiplication(c.G1Generator(), &ab)
+			abg1.Neg(abg1)
+			ok, err := c.PairingCheck([]ecc.Point{ag1, abg1}, []ecc.Point{bg2, c.G2Generator()})
+
+			return err == nil && ok && resab.Equal(expected) && !res.IsOne()
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS24-315] runtime encoding should round trip", prop.ForAll(
+		func(a fr.Element) bool {
+			var abigint big.Int
+			a.ToBigIntRegular(&abigint)
+
+			p1 := c.NewG1().ScalarMultiplication(c.G1Generator(), &abigint)
+			p2 := c.NewG2().ScalarMultiplication(c.G2Generator(), &abigint)
+			gt := c.NewGT().Exp(c.NewGT().Set(mustPair(c)), &abigint)
+
+			b1, r1, b2, r2, bt := p1.Bytes(), p1.RawBytes(), p2.Bytes(), p2.RawBytes(), gt.Bytes()
+			if len(b1) != c.SizeOfG1AffineCompressed() || len(r1) != c.SizeOfG1AffineUncompressed() ||
+				len(b2) != c.SizeOfG2AffineCompressed() || len(r2) != c.SizeOfG2AffineUncompressed() ||
+				len(bt) != c.SizeOfGT() {
+				return false
+			}
+
+			q1, q2, qt := c.NewG1(), c.NewG2(), c.NewGT()
+			if _, err := q1.SetBytes(b1); err != nil || !q1.Equal(p1) {
+				return false
+			}
+			if _, err := q1.SetBytes(r1); err != nil || !q1.Equal(p1) {
+				return false
+			}
+			if _, err := q2.SetBytes(b2); err != nil || !q2.Equal(p2) {
+				return false
+			}
+			if _, err := q2.SetBytes(r2); err != nil || !q2.Equal(p2) {
+				return false
+			}
+			return qt.SetBytes(bt) == nil && qt.Equal(gt)
+		},
+		genR1,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func mustPair(c ecc.Curve) ecc.GTElement {
+	res, err := c.Pair([]ecc.Point{c.G1Generator()}, []ecc.Point{c.G2Generator()})
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
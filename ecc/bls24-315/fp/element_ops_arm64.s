@@ -0,0 +1,882 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x6fe802ff40300001
+DATA q<>+8(SB)/8, $0x421ee5da52bde502
+DATA q<>+16(SB)/8, $0xdec1d01aa27a1ae0
+DATA q<>+24(SB)/8, $0xd3f7498be97c5eaf
+DATA q<>+32(SB)/8, $0x04c23a02b586d650
+GLOBL q<>(SB), (RODATA+NOPTR), $40
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x702ff9ff402fffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+// add(res, x, y *Element)
+TEXT ·add(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	MOVD  32(R0), R6
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R7, R8)
+	ADDS  R7, R2, R2
+	ADCS  R8, R3, R3
+	LDP   16(R1), (R7, R8)
+	ADCS  R7, R4, R4
+	ADCS  R8, R5, R5
+	MOVD  32(R1), R7
+	ADCS  R7, R6, R6
+	MOVD  $q<>(SB), R9
+	LDP   0(R9), (R11, R12)
+	SUBS  R11, R2, R13
+	SBCS  R12, R3, R13
+	LDP   16(R9), (R11, R12)
+	SBCS  R11, R4, R13
+	SBCS  R12, R5, R13
+	MOVD  32(R9), R11
+	SBCS  R11, R6, R13
+	CSETM CS, R10
+	LDP   0(R9), (R11, R12)
+	AND   R10, R11, R11
+	SUBS  R11, R2, R2
+	AND   R10, R12, R12
+	SBCS  R12, R3, R3
+	LDP   16(R9), (R11, R12)
+	AND   R10, R11, R11
+	SBCS  R11, R4, R4
+	AND   R10, R12, R12
+	SBCS  R12, R5, R5
+	MOVD  32(R9), R11
+	AND   R10, R11, R11
+	SBCS  R11, R6, R6
+	MOVD  res+0(FP), R14
+	STP   (R2, R3), 0(R14)
+	STP   (R4, R5), 16(R14)
+	MOVD  R6, 32(R14)
+	RET
+
+// sub(res, x, y *Element)
+TEXT ·sub(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	MOVD  32(R0), R6
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R7, R8)
+	SUBS  R7, R2, R2
+	SBCS  R8, R3, R3
+	LDP   16(R1), (R7, R8)
+	SBCS  R7, R4, R4
+	SBCS  R8, R5, R5
+	MOVD  32(R1), R7
+	SBCS  R7, R6, R6
+	CSETM CC, R9
+	MOVD  $q<>(SB), R10
+	LDP   0(R10), (R11, R12)
+	AND   R9, R11, R11
+	ADDS  R11, R2, R2
+	AND   R9, R12, R12
+	ADCS  R12, R3, R3
+	LDP   16(R10), (R11, R12)
+	AND   R9, R11, R11
+	ADCS  R11, R4, R4
+	AND   R9, R12, R12
+	ADCS  R12, R5, R5
+	MOVD  32(R10), R11
+	AND   R9, R11, R11
+	ADCS  R11, R6, R6
+	MOVD  res+0(FP), R13
+	STP   (R2, R3), 0(R13)
+	STP   (R4, R5), 16(R13)
+	MOVD  R6, 32(R13)
+	RET
+
+// double(res, x *Element)
+TEXT ·double(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	MOVD  32(R0), R5
+	ADDS  R1, R1, R1
+	ADCS  R2, R2, R2
+	ADCS  R3, R3, R3
+	ADCS  R4, R4, R4
+	ADCS  R5, R5, R5
+	MOVD  $q<>(SB), R6
+	LDP   0(R6), (R8, R9)
+	SUBS  R8, R1, R10
+	SBCS  R9, R2, R10
+	LDP   16(R6), (R8, R9)
+	SBCS  R8, R3, R10
+	SBCS  R9, R4, R10
+	MOVD  32(R6), R8
+	SBCS  R8, R5, R10
+	CSETM CS, R7
+	LDP   0(R6), (R8, R9)
+	AND   R7, R8, R8
+	SUBS  R8, R1, R1
+	AND   R7, R9, R9
+	SBCS  R9, R2, R2
+	LDP   16(R6), (R8, R9)
+	AND   R7, R8, R8
+	SBCS  R8, R3, R3
+	AND   R7, R9, R9
+	SBCS  R9, R4, R4
+	MOVD  32(R6), R8
+	AND   R7, R8, R8
+	SBCS  R8, R5, R5
+	MOVD  res+0(FP), R11
+	STP   (R1, R2), 0(R11)
+	STP   (R3, R4), 16(R11)
+	MOVD  R5, 32(R11)
+	RET
+
+// neg(res, x *Element)
+TEXT ·neg(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	MOVD  32(R0), R5
+	ORR   R1, R2, R8
+	ORR   R3, R8, R8
+	ORR   R4, R8, R8
+	ORR   R5, R8, R8
+	CMP   $0, R8
+	CSETM NE, R8
+	MOVD  $q<>(SB), R0
+	LDP   0(R0), (R6, R7)
+	SUBS  R1, R6, R1
+	SBCS  R2, R7, R2
+	LDP   16(R0), (R6, R7)
+	SBCS  R3, R6, R3
+	SBCS  R4, R7, R4
+	MOVD  32(R0), R6
+	SBCS  R5, R6, R5
+	AND   R8, R1, R1
+	AND   R8, R2, R2
+	AND   R8, R3, R3
+	AND   R8, R4, R4
+	AND   R8, R5, R5
+	MOVD  res+0(FP), R0
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	MOVD  R5, 32(R0)
+	RET
+
+// reduce(res *Element)
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD  res+0(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	MOVD  32(R0), R5
+	MOVD  $q<>(SB), R6
+	LDP   0(R6), (R8, R9)
+	SUBS  R8, R1, R10
+	SBCS  R9, R2, R10
+	LDP   16(R6), (R8, R9)
+	SBCS  R8, R3, R10
+	SBCS  R9, R4, R10
+	MOVD  32(R6), R8
+	SBCS  R8, R5, R10
+	CSETM CS, R7
+	LDP   0(R6), (R8, R9)
+	AND   R7, R8, R8
+	SUBS  R8, R1, R1
+	AND   R7, R9, R9
+	SBCS  R9, R2, R2
+	LDP   16(R6), (R8, R9)
+	AND   R7, R8, R8
+	SBCS  R8, R3, R3
+	AND   R7, R9, R9
+	SBCS  R9, R4, R4
+	MOVD  32(R6), R8
+	AND   R7, R8, R8
+	SBCS  R8, R5, R5
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	MOVD  R5, 32(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD  a+0(FP), R0
+	MOVD  b+8(FP), R1
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	MOVD  32(R0), R6
+	LDP   0(R1), (R7, R8)
+	LDP   16(R1), (R9, R10)
+	MOVD  32(R1), R11
+	ADDS  R7, R2, R12
+	ADCS  R8, R3, R13
+	ADCS  R9, R4, R14
+	ADCS  R10, R5, R15
+	ADCS  R11, R6, R16
+	MOVD  $q<>(SB), R17
+	LDP   0(R17), (R20, R21)
+	SUBS  R20, R12, R22
+	SBCS  R21, R13, R22
+	LDP   16(R17), (R20, R21)
+	SBCS  R20, R14, R22
+	SBCS  R21, R15, R22
+	MOVD  32(R17), R20
+	SBCS  R20, R16, R22
+	CSETM CS, R19
+	LDP   0(R17), (R20, R21)
+	AND   R19, R20, R20
+	SUBS  R20, R12, R12
+	AND   R19, R21, R21
+	SBCS  R21, R13, R13
+	LDP   16(R17), (R20, R21)
+	AND   R19, R20, R20
+	SBCS  R20, R14, R14
+	AND   R19, R21, R21
+	SBCS  R21, R15, R15
+	MOVD  32(R17), R20
+	AND   R19, R20, R20
+	SBCS  R20, R16, R16
+	STP   (R12, R13), 0(R0)
+	STP   (R14, R15), 16(R0)
+	MOVD  R16, 32(R0)
+	SUBS  R7, R2, R2
+	SBCS  R8, R3, R3
+	SBCS  R9, R4, R4
+	SBCS  R10, R5, R5
+	SBCS  R11, R6, R6
+	CSETM CC, R23
+	MOVD  $q<>(SB), R24
+	LDP   0(R24), (R25, R26)
+	AND   R23, R25, R25
+	ADDS  R25, R2, R2
+	AND   R23, R26, R26
+	ADCS  R26, R3, R3
+	LDP   16(R24), (R25, R26)
+	AND   R23, R25, R25
+	ADCS  R25, R4, R4
+	AND   R23, R26, R26
+	ADCS  R26, R5, R5
+	MOVD  32(R24), R25
+	AND   R23, R25, R25
+	ADCS  R25, R6, R6
+	STP   (R2, R3), 0(R1)
+	STP   (R4, R5), 16(R1)
+	MOVD  R6, 32(R1)
+	RET
+
+// mul(res, x, y *Element)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	MOVD y+16(FP), R1
+	MOVD $q<>(SB), R2
+	MOVD qInv0<>(SB), R3
+
+	// round 0
+	MOVD  0(R0), R9
+	MOVD  0(R1), R10
+	MUL   R9, R10, R15
+	UMULH R9, R10, R13
+	MUL   R3, R15, R12
+	MOVD  0(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R14
+	MOVD  8(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R13
+	MOVD  8(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R4
+	ADC   ZR, R17, R14
+	MOVD  16(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R13
+	MOVD  16(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R5
+	ADC   ZR, R17, R14
+	MOVD  24(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R13
+	MOVD  24(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R6
+	ADC   ZR, R17, R14
+	MOVD  32(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R13
+	MOVD  32(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R7
+	ADC   ZR, R17, R14
+	ADD   R13, R14, R8
+
+	// round 1
+	MOVD  8(R0), R9
+	MOVD  0(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R4, R16, R15
+	ADC   ZR, R17, R13
+	MUL   R3, R15, R12
+	MOVD  0(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R14
+	MOVD  8(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R5, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  8(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R4
+	ADC   ZR, R17, R14
+	MOVD  16(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R6, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  16(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R5
+	ADC   ZR, R17, R14
+	MOVD  24(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R7, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  24(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R6
+	ADC   ZR, R17, R14
+	MOVD  32(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R8, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  32(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R7
+	ADC   ZR, R17, R14
+	ADD   R13, R14, R8
+
+	// round 2
+	MOVD  16(R0), R9
+	MOVD  0(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R4, R16, R15
+	ADC   ZR, R17, R13
+	MUL   R3, R15, R12
+	MOVD  0(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R14
+	MOVD  8(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R5, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  8(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R4
+	ADC   ZR, R17, R14
+	MOVD  16(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R6, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  16(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R5
+	ADC   ZR, R17, R14
+	MOVD  24(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R7, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  24(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R6
+	ADC   ZR, R17, R14
+	MOVD  32(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R8, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  32(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R7
+	ADC   ZR, R17, R14
+	ADD   R13, R14, R8
+
+	// round 3
+	MOVD  24(R0), R9
+	MOVD  0(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R4, R16, R15
+	ADC   ZR, R17, R13
+	MUL   R3, R15, R12
+	MOVD  0(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R14
+	MOVD  8(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R5, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  8(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R4
+	ADC   ZR, R17, R14
+	MOVD  16(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R6, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  16(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R5
+	ADC   ZR, R17, R14
+	MOVD  24(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R7, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  24(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R6
+	ADC   ZR, R17, R14
+	MOVD  32(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R8, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  32(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R7
+	ADC   ZR, R17, R14
+	ADD   R13, R14, R8
+
+	// round 4
+	MOVD  32(R0), R9
+	MOVD  0(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R4, R16, R15
+	ADC   ZR, R17, R13
+	MUL   R3, R15, R12
+	MOVD  0(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R14
+	MOVD  8(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R5, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  8(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R4
+	ADC   ZR, R17, R14
+	MOVD  16(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R6, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  16(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R5
+	ADC   ZR, R17, R14
+	MOVD  24(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R7, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  24(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R6
+	ADC   ZR, R17, R14
+	MOVD  32(R1), R10
+	MUL   R9, R10, R16
+	UMULH R9, R10, R17
+	ADDS  R13, R16, R15
+	ADC   ZR, R17, R17
+	ADDS  R8, R15, R15
+	ADC   ZR, R17, R13
+	MOVD  32(R2), R11
+	MUL   R12, R11, R16
+	UMULH R12, R11, R17
+	ADDS  R14, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R15, R16, R7
+	ADC   ZR, R17, R14
+	ADD   R13, R14, R8
+	MOVD  $q<>(SB), R19
+	LDP   0(R19), (R21, R22)
+	SUBS  R21, R4, R23
+	SBCS  R22, R5, R23
+	LDP   16(R19), (R21, R22)
+	SBCS  R21, R6, R23
+	SBCS  R22, R7, R23
+	MOVD  32(R19), R21
+	SBCS  R21, R8, R23
+	CSETM CS, R20
+	LDP   0(R19), (R21, R22)
+	AND   R20, R21, R21
+	SUBS  R21, R4, R4
+	AND   R20, R22, R22
+	SBCS  R22, R5, R5
+	LDP   16(R19), (R21, R22)
+	AND   R20, R21, R21
+	SBCS  R21, R6, R6
+	AND   R20, R22, R22
+	SBCS  R22, R7, R7
+	MOVD  32(R19), R21
+	AND   R20, R21, R21
+	SBCS  R21, R8, R8
+	MOVD  res+0(FP), R24
+	STP   (R4, R5), 0(R24)
+	STP   (R6, R7), 16(R24)
+	MOVD  R8, 32(R24)
+	RET
+
+// fromMont(res *Element)
+TEXT ·fromMont(SB), NOSPLIT, $0-8
+	MOVD res+0(FP), R0
+	MOVD $q<>(SB), R1
+	MOVD qInv0<>(SB), R2
+	LDP  0(R0), (R3, R4)
+	LDP  16(R0), (R5, R6)
+	MOVD 32(R0), R7
+
+	// round 0
+	MUL   R2, R3, R9
+	MOVD  0(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R3, R11, R11
+	ADC   ZR, R12, R10
+	MOVD  8(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R4, R11, R3
+	ADC   ZR, R12, R10
+	MOVD  16(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R5, R11, R4
+	ADC   ZR, R12, R10
+	MOVD  24(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R6, R11, R5
+	ADC   ZR, R12, R10
+	MOVD  32(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R7, R11, R6
+	ADC   ZR, R12, R10
+	MOVD  R10, R7
+
+	// round 1
+	MUL   R2, R3, R9
+	MOVD  0(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R3, R11, R11
+	ADC   ZR, R12, R10
+	MOVD  8(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R4, R11, R3
+	ADC   ZR, R12, R10
+	MOVD  16(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R5, R11, R4
+	ADC   ZR, R12, R10
+	MOVD  24(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R6, R11, R5
+	ADC   ZR, R12, R10
+	MOVD  32(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R7, R11, R6
+	ADC   ZR, R12, R10
+	MOVD  R10, R7
+
+	// round 2
+	MUL   R2, R3, R9
+	MOVD  0(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R3, R11, R11
+	ADC   ZR, R12, R10
+	MOVD  8(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R4, R11, R3
+	ADC   ZR, R12, R10
+	MOVD  16(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R5, R11, R4
+	ADC   ZR, R12, R10
+	MOVD  24(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R6, R11, R5
+	ADC   ZR, R12, R10
+	MOVD  32(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R7, R11, R6
+	ADC   ZR, R12, R10
+	MOVD  R10, R7
+
+	// round 3
+	MUL   R2, R3, R9
+	MOVD  0(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R3, R11, R11
+	ADC   ZR, R12, R10
+	MOVD  8(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R4, R11, R3
+	ADC   ZR, R12, R10
+	MOVD  16(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R5, R11, R4
+	ADC   ZR, R12, R10
+	MOVD  24(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R6, R11, R5
+	ADC   ZR, R12, R10
+	MOVD  32(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R7, R11, R6
+	ADC   ZR, R12, R10
+	MOVD  R10, R7
+
+	// round 4
+	MUL   R2, R3, R9
+	MOVD  0(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R3, R11, R11
+	ADC   ZR, R12, R10
+	MOVD  8(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R4, R11, R3
+	ADC   ZR, R12, R10
+	MOVD  16(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R5, R11, R4
+	ADC   ZR, R12, R10
+	MOVD  24(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R6, R11, R5
+	ADC   ZR, R12, R10
+	MOVD  32(R1), R8
+	MUL   R9, R8, R11
+	UMULH R9, R8, R12
+	ADDS  R10, R11, R11
+	ADC   ZR, R12, R12
+	ADDS  R7, R11, R6
+	ADC   ZR, R12, R10
+	MOVD  R10, R7
+	MOVD  $q<>(SB), R13
+	LDP   0(R13), (R15, R16)
+	SUBS  R15, R3, R17
+	SBCS  R16, R4, R17
+	LDP   16(R13), (R15, R16)
+	SBCS  R15, R5, R17
+	SBCS  R16, R6, R17
+	MOVD  32(R13), R15
+	SBCS  R15, R7, R17
+	CSETM CS, R14
+	LDP   0(R13), (R15, R16)
+	AND   R14, R15, R15
+	SUBS  R15, R3, R3
+	AND   R14, R16, R16
+	SBCS  R16, R4, R4
+	LDP   16(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R5, R5
+	AND   R14, R16, R16
+	SBCS  R16, R6, R6
+	MOVD  32(R13), R15
+	AND   R14, R15, R15
+	SBCS  R15, R7, R7
+	STP   (R3, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	MOVD  R7, 32(R0)
+	RET
+
@@ -1738,6 +1738,95 @@ func TestElementFromMont(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+// -------------------------------------------------------------------------------------------------
+// native fuzzing, against a slow math/big reference implementation
+
+func FuzzElement(f *testing.F) {
+	for i := range staticTestValues {
+		a := staticTestValues[i].Bytes()
+		b := staticTestValues[len(staticTestValues)-1-i].Bytes()
+		f.Add(a[:], b[:])
+	}
+	f.Add([]byte{}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		q := Modulus()
+
+		// SetBytes interprets its input as a big endian integer, reduced mod q
+		var x, y Element
+		var ra, rb big.Int
+		x.SetBytes(a)
+		y.SetBytes(b)
+		ra.SetBytes(a).Mod(&ra, q)
+		rb.SetBytes(b).Mod(&rb, q)
+
+		check := func(op string, z *Element, expected *big.Int) {
+			t.Helper()
+			var res big.Int
+			if z.biggerOrEqualModulus() {
+				t.Fatalf("%s: result is not reduced", op)
+			}
+			if z.ToBigIntRegular(&res).Cmp(expected) != 0 {
+				t.Fatalf("%s: %s != %s (math/big)", op, res.String(), expected.String())
+			}
+		}
+		check("SetBytes", &x, &ra)
+		check("SetBytes", &y, &rb)
+
+		var z Element
+		var expected big.Int
+
+		expected.Add(&ra, &rb).Mod(&expected, q)
+		check("Add", z.Add(&x, &y), &expected)
+
+		expected.Sub(&ra, &rb).Mod(&expected, q)
+		check("Sub", z.Sub(&x, &y), &expected)
+
+		expected.Mul(&ra, &rb).Mod(&expected, q)
+		check("Mul", z.Mul(&x, &y), &expected)
+
+		expected.Mul(&ra, &ra).Mod(&expected, q)
+		check("Square", z.Square(&x), &expected)
+
+		expected.Lsh(&ra, 1).Mod(&expected, q)
+		check("Double", z.Double(&x), &expected)
+
+		expected.Neg(&ra).Mod(&expected, q)
+		check("Neg", z.Neg(&x), &expected)
+
+		expected.Exp(&ra, &rb, q)
+		check("Exp", z.Exp(x, &rb), &expected)
+
+		if rb.Sign() != 0 {
+			expected.ModInverse(&rb, q)
+			check("Inverse", z.Inverse(&y), &expected)
+
+			expected.Mul(&ra, &expected).Mod(&expected, q)
+			check("Div", z.Div(&x, &y), &expected)
+		}
+
+		if x.Legendre() != big.Jacobi(&ra, q) {
+			t.Fatal("Legendre doesn't match math/big")
+		}
+		if z.Sqrt(&x) == nil {
+			if new(big.Int).ModSqrt(&ra, q) != nil {
+				t.Fatal("Sqrt failed on a square")
+			}
+		} else {
+			expected.Mul(z.ToBigIntRegular(&expected), &expected).Mod(&expected, q)
+			if expected.Cmp(&ra) != 0 {
+				t.Fatal("Sqrt(x)**2 != x")
+			}
+		}
+
+		// round trip
+		bytes := x.Bytes()
+		if !z.SetBytes(bytes[:]).Equal(&x) {
+			t.Fatal("SetBytes(Bytes(x)) != x")
+		}
+	})
+}
+
 type testPairElement struct {
 	element Element
 	bigint  big.Int
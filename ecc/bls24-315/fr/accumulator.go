@@ -0,0 +1,165 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+import (
+	"math/bits"
+)
+
+// WideAccumulator accumulates products of Element on 8 + 1 words, without modular reduction.
+//
+// A sum of products costs one Montgomery reduction (Reduce) instead of one per product:
+//
+//	var acc WideAccumulator
+//	for i := range a {
+//		acc.MulAdd(&a[i], &b[i])
+//	}
+//	res := acc.Reduce()
+//
+// The zero value is an empty accumulator; it can hold up to 2**63 products.
+type WideAccumulator struct {
+	t [9]uint64
+}
+
+// MulAdd sets acc = acc + x * y, without reduction
+func (acc *WideAccumulator) MulAdd(x, y *Element) *WideAccumulator {
+	var p [8]uint64
+	var C uint64
+
+	// p = x * y
+	C, p[0] = bits.Mul64(x[0], y[0])
+	C, p[1] = madd1(x[0], y[1], C)
+	C, p[2] = madd1(x[0], y[2], C)
+	C, p[3] = madd1(x[0], y[3], C)
+	p[4] = C
+	C, p[1] = madd1(x[1], y[0], p[1])
+	C, p[2] = madd2(x[1], y[1], p[2], C)
+	C, p[3] = madd2(x[1], y[2], p[3], C)
+	C, p[4] = madd2(x[1], y[3], p[4], C)
+	p[5] = C
+	C, p[2] = madd1(x[2], y[0], p[2])
+	C, p[3] = madd2(x[2], y[1], p[3], C)
+	C, p[4] = madd2(x[2], y[2], p[4], C)
+	C, p[5] = madd2(x[2], y[3], p[5], C)
+	p[6] = C
+	C, p[3] = madd1(x[3], y[0], p[3])
+	C, p[4] = madd2(x[3], y[1], p[4], C)
+	C, p[5] = madd2(x[3], y[2], p[5], C)
+	C, p[6] = madd2(x[3], y[3], p[6], C)
+	p[7] = C
+
+	// acc = acc + p
+	acc.t[0], C = bits.Add64(acc.t[0], p[0], 0)
+	acc.t[1], C = bits.Add64(acc.t[1], p[1], C)
+	acc.t[2], C = bits.Add64(acc.t[2], p[2], C)
+	acc.t[3], C = bits.Add64(acc.t[3], p[3], C)
+	acc.t[4], C = bits.Add64(acc.t[4], p[4], C)
+	acc.t[5], C = bits.Add64(acc.t[5], p[5], C)
+	acc.t[6], C = bits.Add64(acc.t[6], p[6], C)
+	acc.t[7], C = bits.Add64(acc.t[7], p[7], C)
+	acc.t[8] += C
+
+	return acc
+}
+
+// Reset empties the accumulator
+func (acc *WideAccumulator) Reset() *WideAccumulator {
+	acc.t = [9]uint64{}
+	return acc
+}
+
+// Reduce returns the sum of the accumulated products, as a reduced Element;
+// the accumulator is left unchanged
+func (acc *WideAccumulator) Reduce() (z Element) {
+	t := acc.t
+
+	// the products are in Montgomery form (x*y*R**2), the montgomery reduction
+	// of t gives v = t * R**-1 mod q on 5 words: v < t / R + q
+	montReduceWide(&t)
+
+	// v = vLo + vHi * R with vLo < R, vHi < 2**64
+	// vLo * R**-1 * (R mod q) < 2q
+	var lo [9]uint64
+	var C uint64
+	C, lo[0] = bits.Mul64(t[4], 18291444782079148022)
+	C, lo[1] = madd1(t[4], 2905656009828539926, C)
+	C, lo[2] = madd1(t[4], 9521467359714817544, C)
+	C, lo[3] = madd1(t[4], 122956637648958544, C)
+	lo[4] = C
+	C, lo[1] = madd1(t[5], 18291444782079148022, lo[1])
+	C, lo[2] = madd2(t[5], 2905656009828539926, lo[2], C)
+	C, lo[3] = madd2(t[5], 9521467359714817544, lo[3], C)
+	C, lo[4] = madd2(t[5], 122956637648958544, lo[4], C)
+	lo[5] = C
+	C, lo[2] = madd1(t[6], 18291444782079148022, lo[2])
+	C, lo[3] = madd2(t[6], 2905656009828539926, lo[3], C)
+	C, lo[4] = madd2(t[6], 9521467359714817544, lo[4], C)
+	C, lo[5] = madd2(t[6], 122956637648958544, lo[5], C)
+	lo[6] = C
+	C, lo[3] = madd1(t[7], 18291444782079148022, lo[3])
+	C, lo[4] = madd2(t[7], 2905656009828539926, lo[4], C)
+	C, lo[5] = madd2(t[7], 9521467359714817544, lo[5], C)
+	C, lo[6] = madd2(t[7], 122956637648958544, lo[6], C)
+	lo[7] = C
+	montReduceWide(&lo)
+
+	copy(z[:], lo[4:8])
+	if lo[8] != 0 {
+		// z + 2**256 - q
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 1860204336533995521, 0)
+		z[1], b = bits.Sub64(z[1], 14466829657984787300, b)
+		z[2], b = bits.Sub64(z[2], 2737202078770428568, b)
+		z[3], b = bits.Sub64(z[3], 1832378743606059307, b)
+	} else {
+
+		// if z > q --> z -= q
+		// note: this is NOT constant time
+		if !(z[3] < 1832378743606059307 || (z[3] == 1832378743606059307 && (z[2] < 2737202078770428568 || (z[2] == 2737202078770428568 && (z[1] < 14466829657984787300 || (z[1] == 14466829657984787300 && (z[0] < 1860204336533995521))))))) {
+			var b uint64
+			z[0], b = bits.Sub64(z[0], 1860204336533995521, 0)
+			z[1], b = bits.Sub64(z[1], 14466829657984787300, b)
+			z[2], b = bits.Sub64(z[2], 2737202078770428568, b)
+			z[3], _ = bits.Sub64(z[3], 1832378743606059307, b)
+		}
+	}
+
+	// vHi * R mod q is the Montgomery form of vHi
+	if t[8] != 0 {
+		var hi Element
+		hi.SetUint64(t[8])
+		z.Add(&z, &hi)
+	}
+
+	return
+}
+
+// montReduceWide sets t[4:] = t * R**-1 mod q, with t[4:] < t / R + q;
+// t must be smaller than 2**512 * 2**63 so that the result fits on 5 words
+func montReduceWide(t *[9]uint64) {
+	for i := 0; i < 4; i++ {
+		// m = t[i]n'[0] mod W
+		m := t[i] * 2184305180030271487
+		C := madd0(m, 1860204336533995521, t[i])
+		C, t[i+1] = madd2(m, 14466829657984787300, t[i+1], C)
+		C, t[i+2] = madd2(m, 2737202078770428568, t[i+2], C)
+		C, t[i+3] = madd2(m, 1832378743606059307, t[i+3], C)
+		for k := i + 4; k < len(t); k++ {
+			t[k], C = bits.Add64(t[k], C, 0)
+		}
+	}
+}
@@ -21,4 +21,8 @@ package fr
 
 import "golang.org/x/sys/cpu"
 
-var supportAdx = cpu.X86.HasADX && cpu.X86.HasBMI2
+var (
+	supportAdx    = cpu.X86.HasADX && cpu.X86.HasBMI2
+	supportAvx512 = supportAdx && cpu.X86.HasAVX512 && cpu.X86.HasAVX512DQ && cpu.X86.HasAVX512IFMA
+	supportAvx2   = cpu.X86.HasAVX2
+)
@@ -444,6 +444,69 @@ func BenchmarkKZGBatchVerify10(b *testing.B) {
 	}
 }
 
+func FuzzKZG(f *testing.F) {
+	f.Add([]byte{1, 2, 3}, []byte{42})
+	f.Add([]byte{}, []byte{0})
+	f.Add(bytes.Repeat([]byte{0xff}, 3*fr.Bytes), []byte{0xff})
+
+	domain := fft.NewDomain(uint64(len(testSRS.G1)), 0, false)
+
+	f.Fuzz(func(t *testing.T, coefficients, at []byte) {
+		// the coefficients are fr.Bytes chunks of the input, reduced mod r
+		p := make(polynomial.Polynomial, 0, len(testSRS.G1))
+		for len(coefficients) > 0 && len(p) < len(testSRS.G1) {
+			n := fr.Bytes
+			if n > len(coefficients) {
+				n = len(coefficients)
+			}
+			var c fr.Element
+			c.SetBytes(coefficients[:n])
+			p = append(p, c)
+			coefficients = coefficients[n:]
+		}
+		// Open commits to the quotient by (X - point), of degree at least 0
+		for len(p) < 2 {
+			p = append(p, fr.Element{})
+		}
+		var point fr.Element
+		point.SetBytes(at)
+
+		// the SRS is built with alpha = 42, so the commitment is [p(42)]G1
+		digest, err := Commit(p, testSRS)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var alpha fr.Element
+		var expected bls24315.G1Affine
+		var b big.Int
+		alpha.SetUint64(42)
+		pAlpha := p.Eval(&alpha)
+		expected.ScalarMultiplication(&testSRS.G1[0], pAlpha.ToBigIntRegular(&b))
+		if !expected.Equal(&digest) {
+			t.Fatal("commitment != [p(alpha)]G1")
+		}
+
+		proof, err := Open(p, &point, domain, testSRS)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pPoint := p.Eval(&point); !proof.ClaimedValue.Equal(&pPoint) {
+			t.Fatal("inconsistant claimed value")
+		}
+		if err := Verify(&digest, &proof, testSRS); err != nil {
+			t.Fatal(err)
+		}
+
+		// a wrong claimed value is rejected
+		var one fr.Element
+		one.SetOne()
+		proof.ClaimedValue.Add(&proof.ClaimedValue, &one)
+		if Verify(&digest, &proof, testSRS) == nil {
+			t.Fatal("verifying wrong proof should have failed")
+		}
+	})
+}
+
 func randomPolynomial(size int) polynomial.Polynomial {
 	f := make(polynomial.Polynomial, size)
 	for i := 0; i < size; i++ {
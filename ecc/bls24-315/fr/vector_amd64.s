@@ -0,0 +1,969 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x19d0c5fd00c00001
+DATA q<>+8(SB)/8, $0xc8c480ece644e364
+DATA q<>+16(SB)/8, $0x25fc7ec9cf927a98
+DATA q<>+24(SB)/8, $0x196deac24a9da12b
+GLOBL q<>(SB), (RODATA+NOPTR), $32
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x1e5035fd00bfffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+#define REDUCE(ra0, ra1, ra2, ra3, rb0, rb1, rb2, rb3) \
+	MOVQ    ra0, rb0;        \
+	SUBQ    q<>(SB), ra0;    \
+	MOVQ    ra1, rb1;        \
+	SBBQ    q<>+8(SB), ra1;  \
+	MOVQ    ra2, rb2;        \
+	SBBQ    q<>+16(SB), ra2; \
+	MOVQ    ra3, rb3;        \
+	SBBQ    q<>+24(SB), ra3; \
+	CMOVQCS rb0, ra0;        \
+	CMOVQCS rb1, ra1;        \
+	CMOVQCS rb2, ra2;        \
+	CMOVQCS rb3, ra3;        \
+
+// q in radix 2**52
+DATA q52<>+0(SB)/8, $0x0000c5fd00c00001
+DATA q52<>+8(SB)/8, $0x000ece644e36419d
+DATA q52<>+16(SB)/8, $0x000f927a98c8c480
+DATA q52<>+24(SB)/8, $0x000a12b25fc7ec9c
+DATA q52<>+32(SB)/8, $0x0000196deac24a9d
+GLOBL q52<>(SB), (RODATA+NOPTR), $40
+
+// qInv52 -q**-1 mod 2**52
+DATA qInv52<>(SB)/8, $0x000035fd00bfffff
+GLOBL qInv52<>(SB), (RODATA+NOPTR), $8
+
+DATA mask52<>(SB)/8, $0x000fffffffffffff
+GLOBL mask52<>(SB), (RODATA+NOPTR), $8
+DATA mask48<>(SB)/8, $0x0000ffffffffffff
+GLOBL mask48<>(SB), (RODATA+NOPTR), $8
+
+// vecIdx gathers the word k of 8 consecutive elements (in qwords)
+DATA vecIdx<>+0(SB)/8, $0
+DATA vecIdx<>+8(SB)/8, $4
+DATA vecIdx<>+16(SB)/8, $8
+DATA vecIdx<>+24(SB)/8, $12
+DATA vecIdx<>+32(SB)/8, $16
+DATA vecIdx<>+40(SB)/8, $20
+DATA vecIdx<>+48(SB)/8, $24
+DATA vecIdx<>+56(SB)/8, $28
+GLOBL vecIdx<>(SB), (RODATA+NOPTR), $64
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2         // n == 0, we are done
+	MOVQ  0(DX), SI
+	MOVQ  8(DX), DI
+	MOVQ  16(DX), R8
+	MOVQ  24(DX), R9
+	ADDQ  0(CX), SI
+	ADCQ  8(CX), DI
+	ADCQ  16(CX), R8
+	ADCQ  24(CX), R9
+
+	// reduce element(SI,DI,R8,R9) using temp registers (R10,R11,R12,R13)
+	REDUCE(SI,DI,R8,R9,R10,R11,R12,R13)
+
+	MOVQ SI, 0(AX)
+	MOVQ DI, 8(AX)
+	MOVQ R8, 16(AX)
+	MOVQ R9, 24(AX)
+	ADDQ $32, AX
+	ADDQ $32, DX
+	ADDQ $32, CX
+	DECQ BX         // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+	XORQ R14, R14
+
+l3:
+	TESTQ   BX, BX
+	JEQ     l4                       // n == 0, we are done
+	MOVQ    0(DX), SI
+	MOVQ    8(DX), DI
+	MOVQ    16(DX), R8
+	MOVQ    24(DX), R9
+	SUBQ    0(CX), SI
+	SBBQ    8(CX), DI
+	SBBQ    16(CX), R8
+	SBBQ    24(CX), R9
+	MOVQ    $0x19d0c5fd00c00001, R10
+	MOVQ    $0xc8c480ece644e364, R11
+	MOVQ    $0x25fc7ec9cf927a98, R12
+	MOVQ    $0x196deac24a9da12b, R13
+	CMOVQCC R14, R10
+	CMOVQCC R14, R11
+	CMOVQCC R14, R12
+	CMOVQCC R14, R13
+	ADDQ    R10, SI
+	ADCQ    R11, DI
+	ADCQ    R12, R8
+	ADCQ    R13, R9
+	MOVQ    SI, 0(AX)
+	MOVQ    DI, 8(AX)
+	MOVQ    R8, 16(AX)
+	MOVQ    R9, 24(AX)
+	ADDQ    $32, AX
+	ADDQ    $32, DX
+	ADDQ    $32, CX
+	DECQ    BX                       // decrement n
+	JMP     l3
+
+l4:
+	RET
+
+// sumVec(res *[8]uint64, a *Element, n uint64) res = sum of the 32-bit half words of a[0...n]
+TEXT ·sumVec(SB), NOSPLIT, $0-24
+	MOVQ  a+8(FP), DX
+	MOVQ  n+16(FP), CX
+	VPXOR Y0, Y0, Y0
+	VPXOR Y1, Y1, Y1
+
+l5:
+	TESTQ     CX, CX
+	JEQ       l6         // n == 0, we are done
+	VPMOVZXDQ 0(DX), Y2
+	VPMOVZXDQ 16(DX), Y3
+	VPADDQ    Y2, Y0, Y0
+	VPADDQ    Y3, Y1, Y1
+	ADDQ      $32, DX
+	DECQ      CX         // decrement n
+	JMP       l5
+
+l6:
+	MOVQ    res+0(FP), AX
+	VMOVDQU Y0, 0(AX)
+	VMOVDQU Y1, 32(AX)
+	VZEROUPPER
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b[0...8n]
+// requires AVX-512 IFMA; the elements are processed in radix 2**52, 8 at a time
+TEXT ·mulVec(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+	VPBROADCASTQ qInv52<>(SB), Z25
+	VPBROADCASTQ mask52<>(SB), Z26
+	VPBROADCASTQ mask48<>(SB), Z27
+	VMOVDQU64    vecIdx<>(SB), Z30
+
+l7:
+	TESTQ            BX, BX
+	JEQ              l8                     // n == 0, we are done
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       0(DX)(Z30*8), K1, Z0
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       8(DX)(Z30*8), K1, Z1
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       16(DX)(Z30*8), K1, Z2
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       24(DX)(Z30*8), K1, Z3
+	VPSRLQ           $16, Z3, Z4
+	VPSLLQ           $36, Z3, Z3
+	VPSRLQ           $28, Z2, Z29
+	VPORQ            Z29, Z3, Z3
+	VPANDQ           Z26, Z3, Z3
+	VPSLLQ           $24, Z2, Z2
+	VPSRLQ           $40, Z1, Z29
+	VPORQ            Z29, Z2, Z2
+	VPANDQ           Z26, Z2, Z2
+	VPSLLQ           $12, Z1, Z1
+	VPSRLQ           $52, Z0, Z29
+	VPORQ            Z29, Z1, Z1
+	VPANDQ           Z26, Z1, Z1
+	VPANDQ           Z26, Z0, Z0
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       0(CX)(Z30*8), K1, Z5
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       8(CX)(Z30*8), K1, Z6
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       16(CX)(Z30*8), K1, Z7
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       24(CX)(Z30*8), K1, Z8
+	VPSRLQ           $16, Z8, Z9
+	VPSLLQ           $36, Z8, Z8
+	VPSRLQ           $28, Z7, Z29
+	VPORQ            Z29, Z8, Z8
+	VPANDQ           Z26, Z8, Z8
+	VPSLLQ           $24, Z7, Z7
+	VPSRLQ           $40, Z6, Z29
+	VPORQ            Z29, Z7, Z7
+	VPANDQ           Z26, Z7, Z7
+	VPSLLQ           $12, Z6, Z6
+	VPSRLQ           $52, Z5, Z29
+	VPORQ            Z29, Z6, Z6
+	VPANDQ           Z26, Z6, Z6
+	VPANDQ           Z26, Z5, Z5
+	VPXORQ           Z10, Z10, Z10
+	VPXORQ           Z11, Z11, Z11
+	VPXORQ           Z12, Z12, Z12
+	VPXORQ           Z13, Z13, Z13
+	VPXORQ           Z14, Z14, Z14
+	VPXORQ           Z15, Z15, Z15
+	VPXORQ           Z16, Z16, Z16
+	VPXORQ           Z17, Z17, Z17
+	VPXORQ           Z18, Z18, Z18
+	VPXORQ           Z19, Z19, Z19
+	VPMADD52LUQ      Z5, Z0, Z10
+	VPMADD52HUQ      Z5, Z0, Z11
+	VPMADD52LUQ      Z6, Z0, Z11
+	VPMADD52HUQ      Z6, Z0, Z12
+	VPMADD52LUQ      Z7, Z0, Z12
+	VPMADD52HUQ      Z7, Z0, Z13
+	VPMADD52LUQ      Z8, Z0, Z13
+	VPMADD52HUQ      Z8, Z0, Z14
+	VPMADD52LUQ      Z9, Z0, Z14
+	VPMADD52HUQ      Z9, Z0, Z15
+	VPMADD52LUQ      Z5, Z1, Z11
+	VPMADD52HUQ      Z5, Z1, Z12
+	VPMADD52LUQ      Z6, Z1, Z12
+	VPMADD52HUQ      Z6, Z1, Z13
+	VPMADD52LUQ      Z7, Z1, Z13
+	VPMADD52HUQ      Z7, Z1, Z14
+	VPMADD52LUQ      Z8, Z1, Z14
+	VPMADD52HUQ      Z8, Z1, Z15
+	VPMADD52LUQ      Z9, Z1, Z15
+	VPMADD52HUQ      Z9, Z1, Z16
+	VPMADD52LUQ      Z5, Z2, Z12
+	VPMADD52HUQ      Z5, Z2, Z13
+	VPMADD52LUQ      Z6, Z2, Z13
+	VPMADD52HUQ      Z6, Z2, Z14
+	VPMADD52LUQ      Z7, Z2, Z14
+	VPMADD52HUQ      Z7, Z2, Z15
+	VPMADD52LUQ      Z8, Z2, Z15
+	VPMADD52HUQ      Z8, Z2, Z16
+	VPMADD52LUQ      Z9, Z2, Z16
+	VPMADD52HUQ      Z9, Z2, Z17
+	VPMADD52LUQ      Z5, Z3, Z13
+	VPMADD52HUQ      Z5, Z3, Z14
+	VPMADD52LUQ      Z6, Z3, Z14
+	VPMADD52HUQ      Z6, Z3, Z15
+	VPMADD52LUQ      Z7, Z3, Z15
+	VPMADD52HUQ      Z7, Z3, Z16
+	VPMADD52LUQ      Z8, Z3, Z16
+	VPMADD52HUQ      Z8, Z3, Z17
+	VPMADD52LUQ      Z9, Z3, Z17
+	VPMADD52HUQ      Z9, Z3, Z18
+	VPMADD52LUQ      Z5, Z4, Z14
+	VPMADD52HUQ      Z5, Z4, Z15
+	VPMADD52LUQ      Z6, Z4, Z15
+	VPMADD52HUQ      Z6, Z4, Z16
+	VPMADD52LUQ      Z7, Z4, Z16
+	VPMADD52HUQ      Z7, Z4, Z17
+	VPMADD52LUQ      Z8, Z4, Z17
+	VPMADD52HUQ      Z8, Z4, Z18
+	VPMADD52LUQ      Z9, Z4, Z18
+	VPMADD52HUQ      Z9, Z4, Z19
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z10, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z10
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z11
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z11
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z12
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z12
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z13
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z15
+	VPSRLQ           $52, Z10, Z29
+	VPADDQ           Z29, Z11, Z11
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z11, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z11
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z12
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z12
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z13
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z16
+	VPSRLQ           $52, Z11, Z29
+	VPADDQ           Z29, Z12, Z12
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z12, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z12
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z13
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z16
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z16
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z17
+	VPSRLQ           $52, Z12, Z29
+	VPADDQ           Z29, Z13, Z13
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z13, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z16
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z16
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z17
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z17
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z18
+	VPSRLQ           $52, Z13, Z29
+	VPADDQ           Z29, Z14, Z14
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z14, Z28
+	VPANDQ           Z27, Z28, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z16
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z16
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z17
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z17
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z18
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z18
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z19
+	VPSRLQ           $52, Z14, Z29
+	VPADDQ           Z29, Z15, Z15
+	VPANDQ           Z26, Z14, Z14
+	VPSRLQ           $52, Z15, Z29
+	VPADDQ           Z29, Z16, Z16
+	VPANDQ           Z26, Z15, Z15
+	VPSRLQ           $52, Z16, Z29
+	VPADDQ           Z29, Z17, Z17
+	VPANDQ           Z26, Z16, Z16
+	VPSRLQ           $52, Z17, Z29
+	VPADDQ           Z29, Z18, Z18
+	VPANDQ           Z26, Z17, Z17
+	VPSRLQ           $52, Z18, Z29
+	VPADDQ           Z29, Z19, Z19
+	VPANDQ           Z26, Z18, Z18
+	VPSRLQ           $48, Z14, Z10
+	VPSLLQ           $4, Z15, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z10, Z10
+	VPSRLQ           $48, Z15, Z11
+	VPSLLQ           $4, Z16, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z11, Z11
+	VPSRLQ           $48, Z16, Z12
+	VPSLLQ           $4, Z17, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z12, Z12
+	VPSRLQ           $48, Z17, Z13
+	VPSLLQ           $4, Z18, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z13, Z13
+	VPSRLQ           $48, Z18, Z14
+	VPSLLQ           $4, Z19, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z14, Z14
+	VPSUBQ.BCST      q52<>+0(SB), Z10, Z15
+	VPSRLQ           $63, Z15, Z31
+	VPANDQ           Z26, Z15, Z15
+	VPSUBQ.BCST      q52<>+8(SB), Z11, Z16
+	VPSUBQ           Z31, Z16, Z16
+	VPSRLQ           $63, Z16, Z31
+	VPANDQ           Z26, Z16, Z16
+	VPSUBQ.BCST      q52<>+16(SB), Z12, Z17
+	VPSUBQ           Z31, Z17, Z17
+	VPSRLQ           $63, Z17, Z31
+	VPANDQ           Z26, Z17, Z17
+	VPSUBQ.BCST      q52<>+24(SB), Z13, Z18
+	VPSUBQ           Z31, Z18, Z18
+	VPSRLQ           $63, Z18, Z31
+	VPANDQ           Z26, Z18, Z18
+	VPSUBQ.BCST      q52<>+32(SB), Z14, Z19
+	VPSUBQ           Z31, Z19, Z19
+	VPSRLQ           $63, Z19, Z31
+	VPANDQ           Z26, Z19, Z19
+	VPTESTNMQ        Z31, Z31, K2
+	VMOVDQU64        Z15, K2, Z10
+	VMOVDQU64        Z16, K2, Z11
+	VMOVDQU64        Z17, K2, Z12
+	VMOVDQU64        Z18, K2, Z13
+	VMOVDQU64        Z19, K2, Z14
+	VPSLLQ           $52, Z11, Z29
+	VPORQ            Z29, Z10, Z10
+	VPSRLQ           $12, Z11, Z11
+	VPSLLQ           $40, Z12, Z29
+	VPORQ            Z29, Z11, Z11
+	VPSRLQ           $24, Z12, Z12
+	VPSLLQ           $28, Z13, Z29
+	VPORQ            Z29, Z12, Z12
+	VPSRLQ           $36, Z13, Z13
+	VPSLLQ           $16, Z14, Z29
+	VPORQ            Z29, Z13, Z13
+	KXNORW           K1, K1, K1
+	VPSCATTERQQ      Z10, K1, 0(AX)(Z30*8)
+	KXNORW           K1, K1, K1
+	VPSCATTERQQ      Z11, K1, 8(AX)(Z30*8)
+	KXNORW           K1, K1, K1
+	VPSCATTERQQ      Z12, K1, 16(AX)(Z30*8)
+	KXNORW           K1, K1, K1
+	VPSCATTERQQ      Z13, K1, 24(AX)(Z30*8)
+	ADDQ             $256, AX
+	ADDQ             $256, DX
+	ADDQ             $256, CX
+	DECQ             BX                     // decrement n
+	JMP              l7
+
+l8:
+	VZEROUPPER
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b
+// requires AVX-512 IFMA; the elements are processed in radix 2**52, 8 at a time
+TEXT ·scalarMulVec(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+	VPBROADCASTQ qInv52<>(SB), Z25
+	VPBROADCASTQ mask52<>(SB), Z26
+	VPBROADCASTQ mask48<>(SB), Z27
+	VMOVDQU64    vecIdx<>(SB), Z30
+	VPBROADCASTQ 0(CX), Z5
+	VPBROADCASTQ 8(CX), Z6
+	VPBROADCASTQ 16(CX), Z7
+	VPBROADCASTQ 24(CX), Z8
+	VPSRLQ       $16, Z8, Z9
+	VPSLLQ       $36, Z8, Z8
+	VPSRLQ       $28, Z7, Z29
+	VPORQ        Z29, Z8, Z8
+	VPANDQ       Z26, Z8, Z8
+	VPSLLQ       $24, Z7, Z7
+	VPSRLQ       $40, Z6, Z29
+	VPORQ        Z29, Z7, Z7
+	VPANDQ       Z26, Z7, Z7
+	VPSLLQ       $12, Z6, Z6
+	VPSRLQ       $52, Z5, Z29
+	VPORQ        Z29, Z6, Z6
+	VPANDQ       Z26, Z6, Z6
+	VPANDQ       Z26, Z5, Z5
+
+l9:
+	TESTQ            BX, BX
+	JEQ              l10                    // n == 0, we are done
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       0(DX)(Z30*8), K1, Z0
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       8(DX)(Z30*8), K1, Z1
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       16(DX)(Z30*8), K1, Z2
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       24(DX)(Z30*8), K1, Z3
+	VPSRLQ           $16, Z3, Z4
+	VPSLLQ           $36, Z3, Z3
+	VPSRLQ           $28, Z2, Z29
+	VPORQ            Z29, Z3, Z3
+	VPANDQ           Z26, Z3, Z3
+	VPSLLQ           $24, Z2, Z2
+	VPSRLQ           $40, Z1, Z29
+	VPORQ            Z29, Z2, Z2
+	VPANDQ           Z26, Z2, Z2
+	VPSLLQ           $12, Z1, Z1
+	VPSRLQ           $52, Z0, Z29
+	VPORQ            Z29, Z1, Z1
+	VPANDQ           Z26, Z1, Z1
+	VPANDQ           Z26, Z0, Z0
+	VPXORQ           Z10, Z10, Z10
+	VPXORQ           Z11, Z11, Z11
+	VPXORQ           Z12, Z12, Z12
+	VPXORQ           Z13, Z13, Z13
+	VPXORQ           Z14, Z14, Z14
+	VPXORQ           Z15, Z15, Z15
+	VPXORQ           Z16, Z16, Z16
+	VPXORQ           Z17, Z17, Z17
+	VPXORQ           Z18, Z18, Z18
+	VPXORQ           Z19, Z19, Z19
+	VPMADD52LUQ      Z5, Z0, Z10
+	VPMADD52HUQ      Z5, Z0, Z11
+	VPMADD52LUQ      Z6, Z0, Z11
+	VPMADD52HUQ      Z6, Z0, Z12
+	VPMADD52LUQ      Z7, Z0, Z12
+	VPMADD52HUQ      Z7, Z0, Z13
+	VPMADD52LUQ      Z8, Z0, Z13
+	VPMADD52HUQ      Z8, Z0, Z14
+	VPMADD52LUQ      Z9, Z0, Z14
+	VPMADD52HUQ      Z9, Z0, Z15
+	VPMADD52LUQ      Z5, Z1, Z11
+	VPMADD52HUQ      Z5, Z1, Z12
+	VPMADD52LUQ      Z6, Z1, Z12
+	VPMADD52HUQ      Z6, Z1, Z13
+	VPMADD52LUQ      Z7, Z1, Z13
+	VPMADD52HUQ      Z7, Z1, Z14
+	VPMADD52LUQ      Z8, Z1, Z14
+	VPMADD52HUQ      Z8, Z1, Z15
+	VPMADD52LUQ      Z9, Z1, Z15
+	VPMADD52HUQ      Z9, Z1, Z16
+	VPMADD52LUQ      Z5, Z2, Z12
+	VPMADD52HUQ      Z5, Z2, Z13
+	VPMADD52LUQ      Z6, Z2, Z13
+	VPMADD52HUQ      Z6, Z2, Z14
+	VPMADD52LUQ      Z7, Z2, Z14
+	VPMADD52HUQ      Z7, Z2, Z15
+	VPMADD52LUQ      Z8, Z2, Z15
+	VPMADD52HUQ      Z8, Z2, Z16
+	VPMADD52LUQ      Z9, Z2, Z16
+	VPMADD52HUQ      Z9, Z2, Z17
+	VPMADD52LUQ      Z5, Z3, Z13
+	VPMADD52HUQ      Z5, Z3, Z14
+	VPMADD52LUQ      Z6, Z3, Z14
+	VPMADD52HUQ      Z6, Z3, Z15
+	VPMADD52LUQ      Z7, Z3, Z15
+	VPMADD52HUQ      Z7, Z3, Z16
+	VPMADD52LUQ      Z8, Z3, Z16
+	VPMADD52HUQ      Z8, Z3, Z17
+	VPMADD52LUQ      Z9, Z3, Z17
+	VPMADD52HUQ      Z9, Z3, Z18
+	VPMADD52LUQ      Z5, Z4, Z14
+	VPMADD52HUQ      Z5, Z4, Z15
+	VPMADD52LUQ      Z6, Z4, Z15
+	VPMADD52HUQ      Z6, Z4, Z16
+	VPMADD52LUQ      Z7, Z4, Z16
+	VPMADD52HUQ      Z7, Z4, Z17
+	VPMADD52LUQ      Z8, Z4, Z17
+	VPMADD52HUQ      Z8, Z4, Z18
+	VPMADD52LUQ      Z9, Z4, Z18
+	VPMADD52HUQ      Z9, Z4, Z19
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z10, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z10
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z11
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z11
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z12
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z12
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z13
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z15
+	VPSRLQ           $52, Z10, Z29
+	VPADDQ           Z29, Z11, Z11
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z11, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z11
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z12
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z12
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z13
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z16
+	VPSRLQ           $52, Z11, Z29
+	VPADDQ           Z29, Z12, Z12
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z12, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z12
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z13
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z16
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z16
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z17
+	VPSRLQ           $52, Z12, Z29
+	VPADDQ           Z29, Z13, Z13
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z13, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z16
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z16
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z17
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z17
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z18
+	VPSRLQ           $52, Z13, Z29
+	VPADDQ           Z29, Z14, Z14
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z14, Z28
+	VPANDQ           Z27, Z28, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z16
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z16
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z17
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z17
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z18
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z18
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z19
+	VPSRLQ           $52, Z14, Z29
+	VPADDQ           Z29, Z15, Z15
+	VPANDQ           Z26, Z14, Z14
+	VPSRLQ           $52, Z15, Z29
+	VPADDQ           Z29, Z16, Z16
+	VPANDQ           Z26, Z15, Z15
+	VPSRLQ           $52, Z16, Z29
+	VPADDQ           Z29, Z17, Z17
+	VPANDQ           Z26, Z16, Z16
+	VPSRLQ           $52, Z17, Z29
+	VPADDQ           Z29, Z18, Z18
+	VPANDQ           Z26, Z17, Z17
+	VPSRLQ           $52, Z18, Z29
+	VPADDQ           Z29, Z19, Z19
+	VPANDQ           Z26, Z18, Z18
+	VPSRLQ           $48, Z14, Z10
+	VPSLLQ           $4, Z15, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z10, Z10
+	VPSRLQ           $48, Z15, Z11
+	VPSLLQ           $4, Z16, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z11, Z11
+	VPSRLQ           $48, Z16, Z12
+	VPSLLQ           $4, Z17, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z12, Z12
+	VPSRLQ           $48, Z17, Z13
+	VPSLLQ           $4, Z18, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z13, Z13
+	VPSRLQ           $48, Z18, Z14
+	VPSLLQ           $4, Z19, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z14, Z14
+	VPSUBQ.BCST      q52<>+0(SB), Z10, Z15
+	VPSRLQ           $63, Z15, Z31
+	VPANDQ           Z26, Z15, Z15
+	VPSUBQ.BCST      q52<>+8(SB), Z11, Z16
+	VPSUBQ           Z31, Z16, Z16
+	VPSRLQ           $63, Z16, Z31
+	VPANDQ           Z26, Z16, Z16
+	VPSUBQ.BCST      q52<>+16(SB), Z12, Z17
+	VPSUBQ           Z31, Z17, Z17
+	VPSRLQ           $63, Z17, Z31
+	VPANDQ           Z26, Z17, Z17
+	VPSUBQ.BCST      q52<>+24(SB), Z13, Z18
+	VPSUBQ           Z31, Z18, Z18
+	VPSRLQ           $63, Z18, Z31
+	VPANDQ           Z26, Z18, Z18
+	VPSUBQ.BCST      q52<>+32(SB), Z14, Z19
+	VPSUBQ           Z31, Z19, Z19
+	VPSRLQ           $63, Z19, Z31
+	VPANDQ           Z26, Z19, Z19
+	VPTESTNMQ        Z31, Z31, K2
+	VMOVDQU64        Z15, K2, Z10
+	VMOVDQU64        Z16, K2, Z11
+	VMOVDQU64        Z17, K2, Z12
+	VMOVDQU64        Z18, K2, Z13
+	VMOVDQU64        Z19, K2, Z14
+	VPSLLQ           $52, Z11, Z29
+	VPORQ            Z29, Z10, Z10
+	VPSRLQ           $12, Z11, Z11
+	VPSLLQ           $40, Z12, Z29
+	VPORQ            Z29, Z11, Z11
+	VPSRLQ           $24, Z12, Z12
+	VPSLLQ           $28, Z13, Z29
+	VPORQ            Z29, Z12, Z12
+	VPSRLQ           $36, Z13, Z13
+	VPSLLQ           $16, Z14, Z29
+	VPORQ            Z29, Z13, Z13
+	KXNORW           K1, K1, K1
+	VPSCATTERQQ      Z10, K1, 0(AX)(Z30*8)
+	KXNORW           K1, K1, K1
+	VPSCATTERQQ      Z11, K1, 8(AX)(Z30*8)
+	KXNORW           K1, K1, K1
+	VPSCATTERQQ      Z12, K1, 16(AX)(Z30*8)
+	KXNORW           K1, K1, K1
+	VPSCATTERQQ      Z13, K1, 24(AX)(Z30*8)
+	ADDQ             $256, AX
+	ADDQ             $256, DX
+	DECQ             BX                     // decrement n
+	JMP              l9
+
+l10:
+	VZEROUPPER
+	RET
+
+// innerProdVec(res *[40]uint64, a, b *Element, n uint64) res = sum of a[0...8n] * b[0...8n], unreduced
+// requires AVX-512 IFMA; the elements are processed in radix 2**52, 8 at a time
+TEXT ·innerProdVec(SB), NOSPLIT, $0-32
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+	VPBROADCASTQ qInv52<>(SB), Z25
+	VPBROADCASTQ mask52<>(SB), Z26
+	VPBROADCASTQ mask48<>(SB), Z27
+	VMOVDQU64    vecIdx<>(SB), Z30
+	VPXORQ       Z20, Z20, Z20
+	VPXORQ       Z21, Z21, Z21
+	VPXORQ       Z22, Z22, Z22
+	VPXORQ       Z23, Z23, Z23
+	VPXORQ       Z24, Z24, Z24
+
+l11:
+	TESTQ            BX, BX
+	JEQ              l12                    // n == 0, we are done
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       0(DX)(Z30*8), K1, Z0
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       8(DX)(Z30*8), K1, Z1
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       16(DX)(Z30*8), K1, Z2
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       24(DX)(Z30*8), K1, Z3
+	VPSRLQ           $16, Z3, Z4
+	VPSLLQ           $36, Z3, Z3
+	VPSRLQ           $28, Z2, Z29
+	VPORQ            Z29, Z3, Z3
+	VPANDQ           Z26, Z3, Z3
+	VPSLLQ           $24, Z2, Z2
+	VPSRLQ           $40, Z1, Z29
+	VPORQ            Z29, Z2, Z2
+	VPANDQ           Z26, Z2, Z2
+	VPSLLQ           $12, Z1, Z1
+	VPSRLQ           $52, Z0, Z29
+	VPORQ            Z29, Z1, Z1
+	VPANDQ           Z26, Z1, Z1
+	VPANDQ           Z26, Z0, Z0
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       0(CX)(Z30*8), K1, Z5
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       8(CX)(Z30*8), K1, Z6
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       16(CX)(Z30*8), K1, Z7
+	KXNORW           K1, K1, K1
+	VPGATHERQQ       24(CX)(Z30*8), K1, Z8
+	VPSRLQ           $16, Z8, Z9
+	VPSLLQ           $36, Z8, Z8
+	VPSRLQ           $28, Z7, Z29
+	VPORQ            Z29, Z8, Z8
+	VPANDQ           Z26, Z8, Z8
+	VPSLLQ           $24, Z7, Z7
+	VPSRLQ           $40, Z6, Z29
+	VPORQ            Z29, Z7, Z7
+	VPANDQ           Z26, Z7, Z7
+	VPSLLQ           $12, Z6, Z6
+	VPSRLQ           $52, Z5, Z29
+	VPORQ            Z29, Z6, Z6
+	VPANDQ           Z26, Z6, Z6
+	VPANDQ           Z26, Z5, Z5
+	VPXORQ           Z10, Z10, Z10
+	VPXORQ           Z11, Z11, Z11
+	VPXORQ           Z12, Z12, Z12
+	VPXORQ           Z13, Z13, Z13
+	VPXORQ           Z14, Z14, Z14
+	VPXORQ           Z15, Z15, Z15
+	VPXORQ           Z16, Z16, Z16
+	VPXORQ           Z17, Z17, Z17
+	VPXORQ           Z18, Z18, Z18
+	VPXORQ           Z19, Z19, Z19
+	VPMADD52LUQ      Z5, Z0, Z10
+	VPMADD52HUQ      Z5, Z0, Z11
+	VPMADD52LUQ      Z6, Z0, Z11
+	VPMADD52HUQ      Z6, Z0, Z12
+	VPMADD52LUQ      Z7, Z0, Z12
+	VPMADD52HUQ      Z7, Z0, Z13
+	VPMADD52LUQ      Z8, Z0, Z13
+	VPMADD52HUQ      Z8, Z0, Z14
+	VPMADD52LUQ      Z9, Z0, Z14
+	VPMADD52HUQ      Z9, Z0, Z15
+	VPMADD52LUQ      Z5, Z1, Z11
+	VPMADD52HUQ      Z5, Z1, Z12
+	VPMADD52LUQ      Z6, Z1, Z12
+	VPMADD52HUQ      Z6, Z1, Z13
+	VPMADD52LUQ      Z7, Z1, Z13
+	VPMADD52HUQ      Z7, Z1, Z14
+	VPMADD52LUQ      Z8, Z1, Z14
+	VPMADD52HUQ      Z8, Z1, Z15
+	VPMADD52LUQ      Z9, Z1, Z15
+	VPMADD52HUQ      Z9, Z1, Z16
+	VPMADD52LUQ      Z5, Z2, Z12
+	VPMADD52HUQ      Z5, Z2, Z13
+	VPMADD52LUQ      Z6, Z2, Z13
+	VPMADD52HUQ      Z6, Z2, Z14
+	VPMADD52LUQ      Z7, Z2, Z14
+	VPMADD52HUQ      Z7, Z2, Z15
+	VPMADD52LUQ      Z8, Z2, Z15
+	VPMADD52HUQ      Z8, Z2, Z16
+	VPMADD52LUQ      Z9, Z2, Z16
+	VPMADD52HUQ      Z9, Z2, Z17
+	VPMADD52LUQ      Z5, Z3, Z13
+	VPMADD52HUQ      Z5, Z3, Z14
+	VPMADD52LUQ      Z6, Z3, Z14
+	VPMADD52HUQ      Z6, Z3, Z15
+	VPMADD52LUQ      Z7, Z3, Z15
+	VPMADD52HUQ      Z7, Z3, Z16
+	VPMADD52LUQ      Z8, Z3, Z16
+	VPMADD52HUQ      Z8, Z3, Z17
+	VPMADD52LUQ      Z9, Z3, Z17
+	VPMADD52HUQ      Z9, Z3, Z18
+	VPMADD52LUQ      Z5, Z4, Z14
+	VPMADD52HUQ      Z5, Z4, Z15
+	VPMADD52LUQ      Z6, Z4, Z15
+	VPMADD52HUQ      Z6, Z4, Z16
+	VPMADD52LUQ      Z7, Z4, Z16
+	VPMADD52HUQ      Z7, Z4, Z17
+	VPMADD52LUQ      Z8, Z4, Z17
+	VPMADD52HUQ      Z8, Z4, Z18
+	VPMADD52LUQ      Z9, Z4, Z18
+	VPMADD52HUQ      Z9, Z4, Z19
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z10, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z10
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z11
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z11
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z12
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z12
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z13
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z15
+	VPSRLQ           $52, Z10, Z29
+	VPADDQ           Z29, Z11, Z11
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z11, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z11
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z12
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z12
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z13
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z16
+	VPSRLQ           $52, Z11, Z29
+	VPADDQ           Z29, Z12, Z12
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z12, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z12
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z13
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z16
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z16
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z17
+	VPSRLQ           $52, Z12, Z29
+	VPADDQ           Z29, Z13, Z13
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z13, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z13
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z14
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z16
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z16
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z17
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z17
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z18
+	VPSRLQ           $52, Z13, Z29
+	VPADDQ           Z29, Z14, Z14
+	VPXORQ           Z28, Z28, Z28
+	VPMADD52LUQ      Z25, Z14, Z28
+	VPANDQ           Z27, Z28, Z28
+	VPMADD52LUQ.BCST q52<>+0(SB), Z28, Z14
+	VPMADD52HUQ.BCST q52<>+0(SB), Z28, Z15
+	VPMADD52LUQ.BCST q52<>+8(SB), Z28, Z15
+	VPMADD52HUQ.BCST q52<>+8(SB), Z28, Z16
+	VPMADD52LUQ.BCST q52<>+16(SB), Z28, Z16
+	VPMADD52HUQ.BCST q52<>+16(SB), Z28, Z17
+	VPMADD52LUQ.BCST q52<>+24(SB), Z28, Z17
+	VPMADD52HUQ.BCST q52<>+24(SB), Z28, Z18
+	VPMADD52LUQ.BCST q52<>+32(SB), Z28, Z18
+	VPMADD52HUQ.BCST q52<>+32(SB), Z28, Z19
+	VPSRLQ           $52, Z14, Z29
+	VPADDQ           Z29, Z15, Z15
+	VPANDQ           Z26, Z14, Z14
+	VPSRLQ           $52, Z15, Z29
+	VPADDQ           Z29, Z16, Z16
+	VPANDQ           Z26, Z15, Z15
+	VPSRLQ           $52, Z16, Z29
+	VPADDQ           Z29, Z17, Z17
+	VPANDQ           Z26, Z16, Z16
+	VPSRLQ           $52, Z17, Z29
+	VPADDQ           Z29, Z18, Z18
+	VPANDQ           Z26, Z17, Z17
+	VPSRLQ           $52, Z18, Z29
+	VPADDQ           Z29, Z19, Z19
+	VPANDQ           Z26, Z18, Z18
+	VPSRLQ           $48, Z14, Z10
+	VPSLLQ           $4, Z15, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z10, Z10
+	VPSRLQ           $48, Z15, Z11
+	VPSLLQ           $4, Z16, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z11, Z11
+	VPSRLQ           $48, Z16, Z12
+	VPSLLQ           $4, Z17, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z12, Z12
+	VPSRLQ           $48, Z17, Z13
+	VPSLLQ           $4, Z18, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z13, Z13
+	VPSRLQ           $48, Z18, Z14
+	VPSLLQ           $4, Z19, Z29
+	VPANDQ           Z26, Z29, Z29
+	VPORQ            Z29, Z14, Z14
+	VPADDQ           Z10, Z20, Z20
+	VPADDQ           Z11, Z21, Z21
+	VPADDQ           Z12, Z22, Z22
+	VPADDQ           Z13, Z23, Z23
+	VPADDQ           Z14, Z24, Z24
+	ADDQ             $256, DX
+	ADDQ             $256, CX
+	DECQ             BX                     // decrement n
+	JMP              l11
+
+l12:
+	MOVQ      res+0(FP), AX
+	VMOVDQU64 Z20, 0(AX)
+	VMOVDQU64 Z21, 64(AX)
+	VMOVDQU64 Z22, 128(AX)
+	VMOVDQU64 Z23, 192(AX)
+	VMOVDQU64 Z24, 256(AX)
+	VZEROUPPER
+	RET
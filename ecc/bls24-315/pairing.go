@@ -23,10 +23,12 @@ import (
 // GT target group of the pairing
 type GT = fptower.E24
 
-type lineEvaluation struct {
-	r0 fptower.E4
-	r1 fptower.E4
-	r2 fptower.E4
+// LineEvaluation holds the three non-zero coefficients of a line of the Miller loop,
+// as multiplied into the accumulator with GT.MulBy012
+type LineEvaluation struct {
+	R0 fptower.E4
+	R1 fptower.E4
+	R2 fptower.E4
 }
 
 // Pair calculates the reduced pairing for a set of points
@@ -59,16 +61,34 @@ func FinalExponentiation(z *GT, _z ...*GT) GT {
 		result.Mul(&result, e)
 	}
 
+	result = FinalExponentiationEasy(&result)
+	return FinalExponentiationHard(&result)
+}
+
+// FinalExponentiationEasy computes the easy part x**(p**12-1)(p**4+1) of the final expo.
+// The result lies in the cyclotomic subgroup of GT.
+func FinalExponentiationEasy(z *GT) GT {
+
+	var result, t GT
+	t.Conjugate(z)
+	result.Inverse(z)
+	t.Mul(&t, &result)
+	result.FrobeniusQuad(&t).
+		Mul(&result, &t)
+
+	return result
+}
+
+// FinalExponentiationHard computes the hard part x**(p**8 - p**4 +1)/r of the final expo.
+// z must lie in the cyclotomic subgroup of GT, e.g. be the output of FinalExponentiationEasy.
+func FinalExponentiationHard(z *GT) GT {
+
+	var result GT
+	result.Set(z)
+
 	// https://eprint.iacr.org/2012/232.pdf, section 7
 	var t [9]GT
 
-	// easy part
-	t[0].Conjugate(&result)
-	result.Inverse(&result)
-	t[0].Mul(&t[0], &result)
-	result.FrobeniusQuad(&t[0]).
-		Mul(&result, &t[0])
-
 	// hard part (up to permutation)
 	// Daiki Hayashida and Kenichiro Hayasaka
 	// and Tadanori Teruya
@@ -135,15 +155,15 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	var result GT
 	result.SetOne()
 
-	var l lineEvaluation
+	var l LineEvaluation
 
 	// i == 31
 	for k := 0; k < n; k++ {
 		qProj[k].DoubleStep(&l)
 		// line evaluation
-		l.r0.MulByElement(&l.r0, &p[k].Y)
-		l.r2.MulByElement(&l.r2, &p[k].X)
-		result.MulBy012(&l.r0, &l.r1, &l.r2)
+		l.R0.MulByElement(&l.R0, &p[k].Y)
+		l.R2.MulByElement(&l.R2, &p[k].X)
+		result.MulBy012(&l.R0, &l.R1, &l.R2)
 	}
 
 	for i := len(loopCounter) - 3; i >= 0; i-- {
@@ -152,23 +172,23 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		for k := 0; k < n; k++ {
 			qProj[k].DoubleStep(&l)
 			// line evaluation
-			l.r0.MulByElement(&l.r0, &p[k].Y)
-			l.r2.MulByElement(&l.r2, &p[k].X)
-			result.MulBy012(&l.r0, &l.r1, &l.r2)
+			l.R0.MulByElement(&l.R0, &p[k].Y)
+			l.R2.MulByElement(&l.R2, &p[k].X)
+			result.MulBy012(&l.R0, &l.R1, &l.R2)
 
 			if loopCounter[i] == 1 {
 				qProj[k].AddMixedStep(&l, &q[k])
 				// line evaluation
-				l.r0.MulByElement(&l.r0, &p[k].Y)
-				l.r2.MulByElement(&l.r2, &p[k].X)
-				result.MulBy012(&l.r0, &l.r1, &l.r2)
+				l.R0.MulByElement(&l.R0, &p[k].Y)
+				l.R2.MulByElement(&l.R2, &p[k].X)
+				result.MulBy012(&l.R0, &l.R1, &l.R2)
 
 			} else if loopCounter[i] == -1 {
 				qProj[k].AddMixedStep(&l, &qNeg[k])
 				// line evaluation
-				l.r0.MulByElement(&l.r0, &p[k].Y)
-				l.r2.MulByElement(&l.r2, &p[k].X)
-				result.MulBy012(&l.r0, &l.r1, &l.r2)
+				l.R0.MulByElement(&l.R0, &p[k].Y)
+				l.R2.MulByElement(&l.R2, &p[k].X)
+				result.MulBy012(&l.R0, &l.R1, &l.R2)
 			}
 		}
 	}
@@ -178,9 +198,69 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	return result, nil
 }
 
+// MillerLoopStep records a line multiplication of the Miller loop
+type MillerLoopStep struct {
+	Line        LineEvaluation // line as output by the doubling or addition step
+	Evaluation  LineEvaluation // Line evaluated at the G1 point
+	Accumulator GT             // Miller loop accumulator once Evaluation is multiplied in
+}
+
+// MillerLoopSteps runs the Miller loop on a single pair (P, Q) and returns, in order,
+// every line multiplied into the accumulator along with the resulting accumulator.
+// The last accumulator is conjugated to get the result, since the loop parameter is negative.
+// The returned GT equals MillerLoop([]G1Affine{P}, []G2Affine{Q}).
+// If P or Q is the point at infinity, no step is recorded and the result is one.
+func MillerLoopSteps(P *G1Affine, Q *G2Affine) ([]MillerLoopStep, GT) {
+	var result GT
+	result.SetOne()
+	if P.IsInfinity() || Q.IsInfinity() {
+		return nil, result
+	}
+
+	var qProj g2Proj
+	var qNeg G2Affine
+	qProj.FromAffine(Q)
+	qNeg.Neg(Q)
+
+	var l LineEvaluation
+	steps := make([]MillerLoopStep, 0, 2*len(loopCounter))
+	mulLine := func() {
+		step := MillerLoopStep{Line: l}
+		l.R0.MulByElement(&l.R0, &P.Y)
+		l.R2.MulByElement(&l.R2, &P.X)
+		step.Evaluation = l
+		result.MulBy012(&l.R0, &l.R1, &l.R2)
+		step.Accumulator = result
+		steps = append(steps, step)
+	}
+
+	// i == 31
+	qProj.DoubleStep(&l)
+	mulLine()
+
+	for i := len(loopCounter) - 3; i >= 0; i-- {
+		result.Square(&result)
+
+		qProj.DoubleStep(&l)
+		mulLine()
+
+		if loopCounter[i] == 1 {
+			qProj.AddMixedStep(&l, Q)
+			mulLine()
+		} else if loopCounter[i] == -1 {
+			qProj.AddMixedStep(&l, &qNeg)
+			mulLine()
+		}
+	}
+
+	result.Conjugate(&result)
+
+	return steps, result
+}
+
 // DoubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) DoubleStep(evaluations *lineEvaluation) {
+func (p *g2Proj) DoubleStep(evaluations *LineEvaluation) {
 
 	// get some Element from our pool
 	var t0, t1, A, B, C, D, E, EE, F, G, H, I, J, K fptower.E4
@@ -213,15 +293,15 @@ func (p *g2Proj) DoubleStep(evaluations *lineEvaluation) {
 	p.z.Mul(&B, &H)
 
 	// Line evaluation
-	evaluations.r0.Neg(&H)
-	evaluations.r1.Set(&I)
-	evaluations.r2.Double(&J).
-		Add(&evaluations.r2, &J)
+	evaluations.R0.Neg(&H)
+	evaluations.R1.Set(&I)
+	evaluations.R2.Double(&J).
+		Add(&evaluations.R2, &J)
 }
 
 // AddMixedStep point addition in Mixed Homogenous projective and Affine coordinates
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) AddMixedStep(evaluations *lineEvaluation, a *G2Affine) {
+func (p *g2Proj) AddMixedStep(evaluations *LineEvaluation, a *G2Affine) {
 
 	// get some Element from our pool
 	var Y2Z1, X2Z1, O, L, C, D, E, F, G, H, t0, t1, t2, J fptower.E4
@@ -251,7 +331,7 @@ func (p *g2Proj) AddMixedStep(evaluations *lineEvaluation, a *G2Affine) {
 		Sub(&J, &t2)
 
 	// Line evaluation
-	evaluations.r0.Set(&L)
-	evaluations.r1.Set(&J)
-	evaluations.r2.Neg(&O)
+	evaluations.R0.Set(&L)
+	evaluations.R1.Set(&J)
+	evaluations.R2.Neg(&O)
 }
@@ -173,9 +173,9 @@ func (p *PointAffine) IsOnCurve() bool {
 	var lhs, rhs, tmp fr.Element
 
 	tmp.Mul(&p.Y, &p.Y)
-	lhs.Mul(&p.X, &p.X).
-		Neg(&lhs).
-		Add(&lhs, &tmp)
+	lhs.Mul(&p.X, &p.X)
+	mulByA(&lhs)
+	lhs.Add(&lhs, &tmp)
 
 	tmp.Mul(&p.X, &p.X).
 		Mul(&tmp, &p.Y).
@@ -200,7 +200,8 @@ func (p *PointAffine) Add(p1, p2 *PointAffine) *PointAffine {
 
 	xu.Mul(&p1.X, &p2.X)
 	yv.Mul(&p1.Y, &p2.Y)
-	pRes.Y.Add(&yv, &xu)
+	mulByA(&xu)
+	pRes.Y.Sub(&yv, &xu)
 
 	dxyuv.Mul(&xv, &yu).Mul(&dxyuv, &ecurve.D)
 	one.SetOne()
@@ -222,14 +223,15 @@ func (p *PointAffine) Double(p1 *PointAffine) *PointAffine {
 	xx.Square(&p.X)
 	yy.Square(&p.Y)
 	xy.Mul(&p.X, &p.Y)
-	denum.Sub(&yy, &xx)
+	mulByA(&xx)
+	denum.Add(&xx, &yy)
 
 	p.X.Double(&xy).Div(&p.X, &denum)
 
 	two.SetOne().Double(&two)
 	denum.Neg(&denum).Add(&denum, &two)
 
-	p.Y.Add(&xx, &yy).Div(&p.Y, &denum)
+	p.Y.Sub(&yy, &xx).Div(&p.Y, &denum)
 
 	return p
 }
@@ -280,7 +282,8 @@ func (p *PointProj) Add(p1, p2 *PointProj) *PointProj {
 		Sub(&res.X, &D).
 		Mul(&res.X, &p1.Z).
 		Mul(&res.X, &F)
-	res.Y.Add(&D, &C).
+	mulByA(&C)
+	res.Y.Sub(&D, &C).
 		Mul(&res.Y, &p.Z).
 		Mul(&res.Y, &G)
 	res.Z.Mul(&F, &G)
@@ -300,7 +303,8 @@ func (p *PointProj) Double(p1 *PointProj) *PointProj {
 	B.Add(&p1.X, &p1.Y).Square(&B)
 	C.Square(&p1.X)
 	D.Square(&p1.Y)
-	E.Neg(&C)
+	E.Set(&C)
+	mulByA(&E)
 	F.Add(&E, &D)
 	H.Square(&p1.Z)
 	tmp.Double(&H)
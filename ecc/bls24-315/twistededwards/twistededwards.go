@@ -41,3 +41,8 @@ func init() {
 	edwards.Base.X.SetString("750878639751052675245442739791837325424717022593512121860796337974109802674")
 	edwards.Base.Y.SetString("1210739767513185331118744674165833946943116652645479549122735386298364723201")
 }
+
+// mulByA multiplies x by the curve coefficient a=-1
+func mulByA(x *fr.Element) {
+	x.Neg(x)
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package babyjubjub
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// E: 168700x^2 + y^2 = 1 + 168696x^2y^2
+// Fp: p=21888242871839275222246405745257275088548364400416034343698204186575808495617 (bn254's r)
+// Fr: r=2736030358979909402780800718157159386076813972158567259200215660948447373041
+// E(Fp) has order 8*r
+// cf https://eips.ethereum.org/EIPS/eip-2494
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fr.Element // in Montgomery form
+	Cofactor fr.Element // not in Montgomery form
+	Order    big.Int
+	Base     PointAffine // Base8 of circomlib, generates the prime order subgroup
+}
+
+var edwards CurveParams
+
+// generator generates the whole group E(Fp), Base = [8]generator
+var generator PointAffine
+
+// GetEdwardsCurve returns Baby Jubjub (circomlib's parameters) on BN254's Fr
+func GetEdwardsCurve() CurveParams {
+
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&edwards.A)
+	res.D.Set(&edwards.D)
+	res.Cofactor.Set(&edwards.Cofactor)
+	res.Order.Set(&edwards.Order)
+	res.Base.Set(&edwards.Base)
+
+	return res
+}
+
+// Generator returns the generator of the whole group E(Fp) of circomlib,
+// the base point being [8]Generator
+func Generator() PointAffine {
+	return generator
+}
+
+func init() {
+
+	edwards.A.SetUint64(168700)
+	edwards.D.SetUint64(168696)
+	edwards.Cofactor.SetUint64(8).FromMont()
+	edwards.Order.SetString("2736030358979909402780800718157159386076813972158567259200215660948447373041", 10)
+
+	edwards.Base.X.SetString("5299619240641551281634865583518297030282874472190772894086521144482721001553")
+	edwards.Base.Y.SetString("16950150798460657717958625567821834550301663161624707787222815936182638968203")
+
+	generator.X.SetString("995203441582195749578291179787384436505546430278305826713579947235728471134")
+	generator.Y.SetString("5472060717959818805561601436314318772137091100104008585924551046643952123905")
+}
+
+// mulByA multiplies x by the curve coefficient a=168700
+func mulByA(x *fr.Element) {
+	x.Mul(x, &edwards.A)
+}
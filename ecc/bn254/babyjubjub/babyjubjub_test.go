@@ -0,0 +1,194 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package babyjubjub
+
+import (
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestCurveParams(t *testing.T) {
+
+	params := GetEdwardsCurve()
+
+	if !params.Base.IsOnCurve() {
+		t.Fatal("base point is not on the curve")
+	}
+	if !params.Base.IsInSubGroup() {
+		t.Fatal("base point is not in the prime order subgroup")
+	}
+
+	// Base8 = [8]Generator
+	var cofactor big.Int
+	params.Cofactor.ToBigInt(&cofactor)
+	g := Generator()
+	g.ScalarMul(&g, &cofactor)
+	if !g.Equal(&params.Base) {
+		t.Fatal("base point is not [8]Generator")
+	}
+}
+
+// test vectors of circomlib (test/babyjub.js)
+func TestCircomlibVectors(t *testing.T) {
+
+	var p, expected PointAffine
+	p.X.SetString("17777552123799933955779906779655732241715742912184938656739573121738514868268")
+	p.Y.SetString("2626589144620713026669568689430873010625803728049924121243784502389097019475")
+	expected.X.SetString("6890855772600357754907169075114257697580319025794532037257385534741338397365")
+	expected.Y.SetString("4338620300185947561074059802482547481416142213883829469920100239455078257889")
+
+	var sum PointAffine
+	sum.Add(&p, &p)
+	if !sum.Equal(&expected) {
+		t.Fatal("wrong addition")
+	}
+	sum.Double(&p)
+	if !sum.Equal(&expected) {
+		t.Fatal("wrong doubling")
+	}
+
+	b := p.Bytes()
+	if hex.EncodeToString(b[:]) != "53b81ed5bffe9545b54016234682e7b2f699bd42a5e9eae27ff4051bc698ce85" {
+		t.Fatal("wrong packing")
+	}
+	var q PointAffine
+	if err := q.Unmarshal(b[:]); err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&p) {
+		t.Fatal("wrong unpacking")
+	}
+}
+
+func TestOps(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+	genS1 := GenBigInt()
+	genS2 := GenBigInt()
+
+	properties.Property("(affine) P+(-P)=O", prop.ForAll(
+		func(s1 big.Int) bool {
+
+			var p1, p2 PointAffine
+			p1.ScalarMul(&edwards.Base, &s1)
+			p2.Neg(&p1)
+
+			p1.Add(&p1, &p2)
+
+			return p1.IsZero()
+		},
+		genS1,
+	))
+
+	properties.Property("(affine) P+P=2*P", prop.ForAll(
+		func(s big.Int) bool {
+
+			var p1, p2 PointAffine
+			p1.ScalarMul(&edwards.Base, &s)
+			p2.ScalarMul(&edwards.Base, &s)
+
+			p1.Add(&p1, &p2)
+			p2.Double(&p2)
+
+			return p1.Equal(&p2) && p1.IsOnCurve()
+		},
+		genS1,
+	))
+
+	properties.Property("(affine) [a]P+[b]P = [a+b]P", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+
+			var p1, p2, p3 PointAffine
+			p1.ScalarMul(&edwards.Base, &s1)
+			p2.ScalarMul(&edwards.Base, &s2)
+
+			p2.Add(&p1, &p2)
+
+			s1.Add(&s1, &s2)
+			p3.ScalarMul(&edwards.Base, &s1)
+
+			return p3.Equal(&p2)
+		},
+		genS1,
+		genS2,
+	))
+
+	properties.Property("[r]P = O", prop.ForAll(
+		func(s big.Int) bool {
+			var p PointAffine
+			p.ScalarMul(&edwards.Base, &s)
+			p.ScalarMul(&p, &edwards.Order)
+
+			return p.IsZero()
+		},
+		genS1,
+	))
+
+	properties.Property("unpack(pack(P)) = P, on the whole group", prop.ForAll(
+		func(s big.Int) bool {
+			var p, q PointAffine
+			g := Generator()
+			p.ScalarMul(&g, &s)
+
+			err := q.Unmarshal(p.Marshal())
+			return err == nil && q.Equal(&p)
+		},
+		genS1,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestSetBytesNonCanonical(t *testing.T) {
+	b := edwards.Base.Bytes()
+
+	// y + p fits in 255 bits
+	var y big.Int
+	edwards.Base.Y.ToBigIntRegular(&y)
+	y.Add(&y, fr.Modulus())
+	yBytes := y.Bytes()
+	for i := 0; i < SizePointCompressed; i++ {
+		b[i] = yBytes[len(yBytes)-1-i]
+	}
+
+	var p PointAffine
+	if _, err := p.SetBytes(b[:]); err != ErrInvalidEncoding {
+		t.Fatal("non canonical encoding should be rejected")
+	}
+}
+
+// GenBigInt generates a big.Int
+func GenBigInt() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var s big.Int
+		var b [fr.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		s.SetBytes(b[:])
+		genResult := gopter.NewGenResult(s, gopter.NoShrinker)
+		return genResult
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package babyjubjub provides Baby Jubjub with the parameters of circomlib and the iden3 libraries,
+// defined on bn254's fr.
+//
+// The twistededwards package provides the same curve in reduced form (a = -1), with a different
+// base point and encoding; this package uses the circomlib equation 168700x^2 + y^2 = 1 + 168696x^2y^2,
+// the base point Base8 = [8]Generator and circomlib's point packing, so that keys, points and
+// signatures are interoperable with circom circuits.
+//
+// The eddsa sub-package provides circomlib's EdDSA with Poseidon and MiMC-7.
+//
+// # See also
+//
+// https://eips.ethereum.org/EIPS/eip-2494
+//
+// https://github.com/iden3/circomlib
+package babyjubjub
@@ -0,0 +1,28 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eddsa provides circomlib's EdDSA on Baby Jubjub, with Poseidon or MiMC-7.
+//
+// Keys are derived from a 32 bytes private key as in circomlib: the secret scalar and the
+// nonce seed are the two halves of BLAKE-512(private key), the scalar being pruned.
+// Messages are field elements, signatures are packPoint(R8)||S with S in little endian,
+// and verification checks [S]Base8 = R8 + [8*H(R8, A, msg)]A, so that signatures
+// produced or verified here match circomlib (eddsa.js and the EdDSA verifier circuits).
+//
+// The signature.Signer interface uses Poseidon.
+//
+// # See also
+//
+// https://github.com/iden3/circomlib
+package eddsa
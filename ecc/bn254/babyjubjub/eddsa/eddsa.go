@@ -0,0 +1,288 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/babyjubjub"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc7"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/poseidon"
+	"github.com/consensys/gnark-crypto/internal/blake512"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+var (
+	errNotOnCurve     = errors.New("point not on curve")
+	errScalarTooLarge = errors.New("scalar is not reduced modulo the subgroup order")
+)
+
+const (
+	sizeFr         = fr.Bytes
+	sizePublicKey  = babyjubjub.SizePointCompressed
+	sizeSignature  = sizePublicKey + sizeFr
+	sizePrivateKey = sizePublicKey + 2*sizeFr
+)
+
+// PublicKey eddsa public key, A = [s>>3]Base8
+type PublicKey struct {
+	A babyjubjub.PointAffine
+}
+
+// PrivateKey eddsa private key
+type PrivateKey struct {
+	PublicKey PublicKey    // copy of the associated public key
+	scalar    [sizeFr]byte // pruned secret scalar s, in little endian
+	randSrc   [32]byte     // second half of BLAKE-512(private key), used to derive the nonces
+}
+
+// Signature represents an eddsa signature
+type Signature struct {
+	R babyjubjub.PointAffine // R8 in circomlib
+	S [sizeFr]byte           // in little endian
+}
+
+// hashFunc computes H(R8, A, msg)
+type hashFunc func(r, a *babyjubjub.PointAffine, msg *fr.Element) fr.Element
+
+func init() {
+	signature.Register(signature.EDDSA_BABYJUBJUB, GenerateKeyInterfaces)
+}
+
+// leos2ip sets res to the integer encoded in little endian in b
+func leos2ip(res *big.Int, b []byte) {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[i] = b[len(b)-1-i]
+	}
+	res.SetBytes(be)
+}
+
+// i2leosp returns the little endian encoding of s on sizeFr bytes
+func i2leosp(s *big.Int) [sizeFr]byte {
+	var res [sizeFr]byte
+	be := s.Bytes()
+	for i := range be {
+		res[i] = be[len(be)-1-i]
+	}
+	return res
+}
+
+// GenerateKey generates a key pair from a 32 bytes private key read from r,
+// as circomlib's prv2pub
+func GenerateKey(r io.Reader) (PrivateKey, error) {
+
+	var priv PrivateKey
+
+	var prv [32]byte
+	if _, err := io.ReadFull(r, prv[:]); err != nil {
+		return priv, err
+	}
+
+	h := blake512.Sum512(prv[:])
+	copy(priv.randSrc[:], h[32:])
+
+	// prune the key
+	// https://tools.ietf.org/html/rfc8032#section-5.1.5, key generation
+	h[0] &= 0xF8
+	h[31] &= 0x7F
+	h[31] |= 0x40
+	copy(priv.scalar[:], h[:sizeFr])
+
+	// A = [s>>3]Base8
+	var s big.Int
+	leos2ip(&s, priv.scalar[:])
+	s.Rsh(&s, 3)
+	base := babyjubjub.GetEdwardsCurve().Base
+	priv.PublicKey.A.ScalarMul(&base, &s)
+
+	return priv, nil
+}
+
+// GenerateKeyInterfaces generate interfaces for the public/private key.
+// This purpose of this function is to be registered in the list of signature schemes.
+func GenerateKeyInterfaces(r io.Reader) (signature.Signer, error) {
+	priv, err := GenerateKey(r)
+	return &priv, err
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(other signature.PublicKey) bool {
+	bpk := pub.Bytes()
+	bother := other.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bother) == 1
+}
+
+// Public returns the public key associated to the private key.
+// From Signer interface defined in gnark/crypto/signature.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// hashPoseidon returns Poseidon(R8.x, R8.y, A.x, A.y, msg)
+func hashPoseidon(r, a *babyjubjub.PointAffine, msg *fr.Element) fr.Element {
+	// 5 inputs is in the supported range
+	h, _ := poseidon.Hash([]fr.Element{r.X, r.Y, a.X, a.Y, *msg})
+	return h
+}
+
+// hashMiMC7 returns MiMC7.multiHash(R8.x, R8.y, A.x, A.y, msg) with a zero key
+func hashMiMC7(r, a *babyjubjub.PointAffine, msg *fr.Element) fr.Element {
+	var key fr.Element
+	return mimc7.MultiHash([]fr.Element{r.X, r.Y, a.X, a.Y, *msg}, &key)
+}
+
+// SignPoseidon signs the field element msg as circomlib's signPoseidon
+func (privKey *PrivateKey) SignPoseidon(msg *fr.Element) Signature {
+	return privKey.sign(msg, hashPoseidon)
+}
+
+// SignMiMC7 signs the field element msg as circomlib's signMiMC
+func (privKey *PrivateKey) SignMiMC7(msg *fr.Element) Signature {
+	return privKey.sign(msg, hashMiMC7)
+}
+
+func (privKey *PrivateKey) sign(msg *fr.Element, h hashFunc) Signature {
+
+	curveParams := babyjubjub.GetEdwardsCurve()
+
+	var res Signature
+
+	// r = BLAKE-512(randSrc || msg) mod l, msg in little endian
+	msgBytes := msg.Bytes()
+	data := make([]byte, 32+sizeFr)
+	copy(data, privKey.randSrc[:])
+	for i := 0; i < sizeFr; i++ {
+		data[32+i] = msgBytes[sizeFr-1-i]
+	}
+	rBytes := blake512.Sum512(data)
+	var r big.Int
+	leos2ip(&r, rBytes[:])
+	r.Mod(&r, &curveParams.Order)
+
+	// R8 = [r]Base8
+	res.R.ScalarMul(&curveParams.Base, &r)
+
+	// S = r + H(R8, A, msg)*s mod l
+	hm := h(&res.R, &privKey.PublicKey.A, msg)
+	var hmInt, s big.Int
+	hm.ToBigIntRegular(&hmInt)
+	leos2ip(&s, privKey.scalar[:])
+	s.Mul(&s, &hmInt).
+		Add(&s, &r).
+		Mod(&s, &curveParams.Order)
+	res.S = i2leosp(&s)
+
+	return res
+}
+
+// VerifyPoseidon verifies a signature of the field element msg as circomlib's verifyPoseidon
+func (pub *PublicKey) VerifyPoseidon(sig *Signature, msg *fr.Element) bool {
+	return pub.verify(sig, msg, hashPoseidon)
+}
+
+// VerifyMiMC7 verifies a signature of the field element msg as circomlib's verifyMiMC
+func (pub *PublicKey) VerifyMiMC7(sig *Signature, msg *fr.Element) bool {
+	return pub.verify(sig, msg, hashMiMC7)
+}
+
+func (pub *PublicKey) verify(sig *Signature, msg *fr.Element, h hashFunc) bool {
+
+	curveParams := babyjubjub.GetEdwardsCurve()
+
+	if !pub.A.IsOnCurve() || !sig.R.IsOnCurve() {
+		return false
+	}
+	var s big.Int
+	leos2ip(&s, sig.S[:])
+	if s.Cmp(&curveParams.Order) >= 0 {
+		return false
+	}
+
+	// [S]Base8 = R8 + [8*H(R8, A, msg)]A
+	hm := h(&sig.R, &pub.A, msg)
+	var hmInt big.Int
+	hm.ToBigIntRegular(&hmInt)
+	hmInt.Lsh(&hmInt, 3)
+
+	var lhs, rhs babyjubjub.PointAffine
+	lhs.ScalarMul(&curveParams.Base, &s)
+	rhs.ScalarMul(&pub.A, &hmInt).
+		Add(&rhs, &sig.R)
+
+	return lhs.Equal(&rhs)
+}
+
+// Sign signs a message with Poseidon (cf SignPoseidon).
+// The message is interpreted as a big endian integer reduced modulo r,
+// if hFunc is not nil, the message is hashed with it first.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	msg, err := messageToElement(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	sig := privKey.SignPoseidon(&msg)
+	return sig.Bytes(), nil
+}
+
+// Verify verifies a signature of a message with Poseidon (cf VerifyPoseidon).
+// The message is interpreted as a big endian integer reduced modulo r,
+// if hFunc is not nil, the message is hashed with it first.
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+
+	if !pub.A.IsOnCurve() {
+		return false, errNotOnCurve
+	}
+
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	curveParams := babyjubjub.GetEdwardsCurve()
+	var s big.Int
+	leos2ip(&s, sig.S[:])
+	if s.Cmp(&curveParams.Order) >= 0 {
+		return false, errScalarTooLarge
+	}
+
+	msg, err := messageToElement(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+	return pub.VerifyPoseidon(&sig, &msg), nil
+}
+
+// messageToElement returns hFunc(message) (or message if hFunc is nil) as a field element
+func messageToElement(message []byte, hFunc hash.Hash) (fr.Element, error) {
+	var res fr.Element
+	if hFunc != nil {
+		hFunc.Reset()
+		if _, err := hFunc.Write(message); err != nil {
+			return res, err
+		}
+		message = hFunc.Sum(nil)
+	}
+	var m big.Int
+	m.SetBytes(message)
+	res.SetBigInt(&m)
+	return res, nil
+}
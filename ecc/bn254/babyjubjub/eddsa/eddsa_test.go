@@ -0,0 +1,183 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/babyjubjub"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+// test vectors of circomlib (test/eddsa.js)
+func TestCircomlibVectors(t *testing.T) {
+
+	prv, _ := hex.DecodeString("0001020304050607080900010203040506070809000102030405060708090001")
+	privKey, err := GenerateKey(bytes.NewReader(prv))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ax, ay fr.Element
+	ax.SetString("13277427435165878497778222415993513565335242147425444199013288855685581939618")
+	ay.SetString("13622229784656158136036771217484571176836296686641868549125388198837476602820")
+	if !privKey.PublicKey.A.X.Equal(&ax) || !privKey.PublicKey.A.Y.Equal(&ay) {
+		t.Fatal("wrong public key")
+	}
+
+	// msg = fromRprLE(000102030405060708090000)
+	var msg fr.Element
+	msgBytes, _ := hex.DecodeString("000102030405060708090000")
+	var m big.Int
+	leos2ip(&m, msgBytes)
+	msg.SetBigInt(&m)
+
+	var rx, ry fr.Element
+	rx.SetString("11384336176656855268977457483345535180380036354188103142384839473266348197733")
+	ry.SetString("15383486972088797283337779941324724402501462225528836549661220478783371668959")
+
+	vectors := []struct {
+		name   string
+		sign   func(*fr.Element) Signature
+		verify func(*Signature, *fr.Element) bool
+		s      string
+		packed string
+	}{
+		{"poseidon", privKey.SignPoseidon, privKey.PublicKey.VerifyPoseidon,
+			"1672775540645840396591609181675628451599263765380031905495115170613215233181",
+			"dfedb4315d3f2eb4de2d3c510d7a987dcab67089c8ace06308827bf5bcbe02a29d043ece562a8f82bfc0adb640c0107a7d3a27c1c7c1a6179a0da73de5c1b203"},
+		{"mimc7", privKey.SignMiMC7, privKey.PublicKey.VerifyMiMC7,
+			"2523202440825208709475937830811065542425109372212752003460238913256192595070",
+			"dfedb4315d3f2eb4de2d3c510d7a987dcab67089c8ace06308827bf5bcbe02a27ed40dab29bf993c928e789d007387998901a24913d44fddb64b1f21fc149405"},
+	}
+
+	for _, v := range vectors {
+		sig := v.sign(&msg)
+		if !sig.R.X.Equal(&rx) || !sig.R.Y.Equal(&ry) {
+			t.Fatalf("%s: wrong R8", v.name)
+		}
+		var s big.Int
+		leos2ip(&s, sig.S[:])
+		if s.String() != v.s {
+			t.Fatalf("%s: wrong S", v.name)
+		}
+		if hex.EncodeToString(sig.Bytes()) != v.packed {
+			t.Fatalf("%s: wrong packed signature", v.name)
+		}
+
+		var unpacked Signature
+		if _, err := unpacked.SetBytes(sig.Bytes()); err != nil {
+			t.Fatal(err)
+		}
+		if !v.verify(&unpacked, &msg) {
+			t.Fatalf("%s: the signature should be valid", v.name)
+		}
+		var wrongMsg fr.Element
+		wrongMsg.SetUint64(1).Add(&wrongMsg, &msg)
+		if v.verify(&unpacked, &wrongMsg) {
+			t.Fatalf("%s: the signature of another message should be invalid", v.name)
+		}
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	privKey, err := signature.EDDSA_BABYJUBJUB.New(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.Public()
+
+	curveParams := babyjubjub.GetEdwardsCurve()
+
+	msg := []byte("message to sign")
+	for _, h := range []hash.Hash{nil, sha256.New()} {
+		sig, err := privKey.Sign(msg, h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ok, err := pubKey.Verify(sig, msg, h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Fatal("the signature should be valid")
+		}
+
+		// S must be reduced
+		var tampered Signature
+		if _, err := tampered.SetBytes(sig); err != nil {
+			t.Fatal(err)
+		}
+		var s big.Int
+		leos2ip(&s, tampered.S[:])
+		s.Add(&s, &curveParams.Order)
+		tampered.S = i2leosp(&s)
+		if _, err := pubKey.Verify(tampered.Bytes(), msg, h); err != errScalarTooLarge {
+			t.Fatal("a non reduced scalar should be rejected")
+		}
+
+		ok, err = pubKey.Verify(sig, []byte("another message"), h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok {
+			t.Fatal("the signature of another message should be invalid")
+		}
+	}
+}
+
+func TestSerialization(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	privKey1, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var privKey2 PrivateKey
+	if _, err := privKey2.SetBytes(privKey1.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(privKey1.Bytes(), privKey2.Bytes()) {
+		t.Fatal("error unmarshal(marshal(privKey))")
+	}
+
+	var pubKey PublicKey
+	if _, err := pubKey.SetBytes(privKey1.PublicKey.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !pubKey.Equal(&privKey1.PublicKey) {
+		t.Fatal("error unmarshal(marshal(pubKey))")
+	}
+
+	var msg fr.Element
+	msg.SetUint64(42)
+	sig := privKey2.SignPoseidon(&msg)
+	if !pubKey.VerifyPoseidon(&sig, &msg) {
+		t.Fatal("the signature should be valid")
+	}
+}
@@ -283,12 +283,13 @@ func BatchOpenSinglePoint(polynomials []polynomial.Polynomial, digests []Digest,
 	sumGammaiTimesPol := make(polynomial.Polynomial, largestPoly)
 	copy(sumGammaiTimesPol, polynomials[0])
 	gammaN := gamma
-	var pj fr.Element
+	scaled := make(fr.Vector, largestPoly)
 	for i := 1; i < len(polynomials); i++ {
-		for j := 0; j < len(polynomials[i]); j++ {
-			pj.Mul(&polynomials[i][j], &gammaN)
-			sumGammaiTimesPol[j].Add(&sumGammaiTimesPol[j], &pj)
-		}
+		n := len(polynomials[i])
+		pi := scaled[:n]
+		pi.ScalarMul(fr.Vector(polynomials[i]), &gammaN)
+		acc := fr.Vector(sumGammaiTimesPol[:n])
+		acc.Add(acc, pi)
 		gammaN.Mul(&gammaN, &gamma)
 	}
 
@@ -464,11 +465,8 @@ func fold(digests []Digest, evaluations []fr.Element, factors []fr.Element) (Dig
 	nbDigests := len(digests)
 
 	// fold the claimed values
-	var foldedEvaluations, tmp fr.Element
-	for i := 0; i < nbDigests; i++ {
-		tmp.Mul(&evaluations[i], &factors[i])
-		foldedEvaluations.Add(&foldedEvaluations, &tmp)
-	}
+	evals := fr.Vector(evaluations[:nbDigests])
+	foldedEvaluations := evals.InnerProduct(factors[:nbDigests])
 
 	// fold the digests
 	var foldedDigests Digest
@@ -0,0 +1,23 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mimc7 provides the MiMC-7 hash function of circomlib on bn254's fr.
+//
+// It differs from the mimc package: the S-box is x^7, the round constants are derived from
+// the seed "mimc" with Keccak-256 and the multi-input hash is circomlib's multiHash.
+//
+// # See also
+//
+// https://eprint.iacr.org/2016/492.pdf
+package mimc7
@@ -0,0 +1,74 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mimc7
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	nbRounds = 91
+	seed     = "mimc"
+)
+
+// constants round constants, constants[0] = 0 and
+// constants[i] = Keccak256^(i+1)(seed) mod r for i > 0
+var constants [nbRounds]fr.Element
+
+func init() {
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte(seed))
+	c := h.Sum(nil)
+
+	var v big.Int
+	for i := 1; i < nbRounds; i++ {
+		h.Reset()
+		h.Write(c)
+		c = h.Sum(c[:0])
+		v.SetBytes(c)
+		constants[i].SetBigInt(&v)
+	}
+}
+
+// Hash returns the encryption of x with the key k, that is
+// E_k(x) + k where E_k is 91 rounds of x -> (x+k+c_i)^7
+func Hash(x, k *fr.Element) fr.Element {
+	var res, t, t2 fr.Element
+	res.Set(x)
+	for i := 0; i < nbRounds; i++ {
+		t.Add(&res, k).Add(&t, &constants[i])
+		t2.Square(&t)
+		res.Square(&t2).
+			Mul(&res, &t2).
+			Mul(&res, &t)
+	}
+	res.Add(&res, k)
+	return res
+}
+
+// MultiHash returns circomlib's multiHash of inputs with the given key,
+// r_{i+1} = r_i + x_i + Hash(x_i, r_i) with r_0 = key
+func MultiHash(inputs []fr.Element, key *fr.Element) fr.Element {
+	var res fr.Element
+	res.Set(key)
+	for i := range inputs {
+		h := Hash(&inputs[i], &res)
+		res.Add(&res, &inputs[i]).Add(&res, &h)
+	}
+	return res
+}
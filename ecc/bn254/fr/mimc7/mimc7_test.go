@@ -0,0 +1,60 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mimc7
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+func TestConstants(t *testing.T) {
+	var expected fr.Element
+	expected.SetString("20888961410941983456478427210666206549300505294776164667214940546594746570981")
+	if !constants[0].IsZero() || !constants[1].Equal(&expected) {
+		t.Fatal("wrong round constants")
+	}
+}
+
+// output of circomlib's mimc7.hash(1, 2)
+func TestHash(t *testing.T) {
+	var x, k, expected fr.Element
+	x.SetUint64(1)
+	k.SetUint64(2)
+	expected.SetString("10594780656576967754230020536574539122676596303354946869887184401991294982664") // 0x176c6eef...859f1a08
+
+	h := Hash(&x, &k)
+	if !h.Equal(&expected) {
+		t.Fatal("wrong hash")
+	}
+}
+
+func TestMultiHash(t *testing.T) {
+	var x1, x2, zero fr.Element
+	x1.SetUint64(1)
+	x2.SetUint64(2)
+
+	// r_1 = x_1 + Hash(x_1, 0), r_2 = r_1 + x_2 + Hash(x_2, r_1)
+	var expected fr.Element
+	h := Hash(&x1, &zero)
+	expected.Add(&x1, &h)
+	h = Hash(&x2, &expected)
+	expected.Add(&expected, &x2).Add(&expected, &h)
+
+	res := MultiHash([]fr.Element{x1, x2}, &zero)
+	if !res.Equal(&expected) {
+		t.Fatal("wrong multi hash")
+	}
+}
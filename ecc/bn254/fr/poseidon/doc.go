@@ -0,0 +1,26 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package poseidon provides the Poseidon hash function on bn254's fr, with the parameters of circomlib.
+//
+// The permutation has a width t = nbInputs+1 (1 <= nbInputs <= 16), 8 full rounds, the S-box x^5 and the
+// number of partial rounds of circomlib. The round constants and the MDS matrix are sampled with the Grain
+// LFSR of the reference implementation, so that the outputs match circomlib and the iden3 libraries.
+//
+// # See also
+//
+// https://eprint.iacr.org/2019/458.pdf
+//
+// https://extgit.iaik.tugraz.at/krypto/hadeshash
+package poseidon
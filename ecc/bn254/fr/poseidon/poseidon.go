@@ -0,0 +1,234 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poseidon
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// MaxInputs maximum number of inputs of the hash function
+const MaxInputs = 16
+
+const nbFullRounds = 8
+
+// nbPartialRounds[t-2] number of partial rounds of the permutation of width t
+var nbPartialRounds = [MaxInputs]int{56, 57, 56, 60, 60, 63, 64, 63, 60, 66, 60, 65, 70, 60, 64, 68}
+
+var errInvalidNbInputs = errors.New("poseidon: the number of inputs must be between 1 and 16")
+
+// parameters round constants and MDS matrix of the permutation of width t
+type parameters struct {
+	t   int
+	rc  []fr.Element   // (nbFullRounds+nbPartialRounds)*t round constants
+	mds [][]fr.Element // t*t matrix
+}
+
+var (
+	params     [MaxInputs]parameters
+	paramsOnce [MaxInputs]sync.Once
+)
+
+// getParameters returns the parameters of the permutation of width t, computed on first use
+func getParameters(t int) *parameters {
+	paramsOnce[t-2].Do(func() {
+		params[t-2] = newParameters(t)
+	})
+	return &params[t-2]
+}
+
+// Hash returns the Poseidon hash of inputs, that is the first element of the state
+// obtained by applying the permutation to (0, inputs...)
+func Hash(inputs []fr.Element) (fr.Element, error) {
+	var res fr.Element
+	if len(inputs) < 1 || len(inputs) > MaxInputs {
+		return res, errInvalidNbInputs
+	}
+	t := len(inputs) + 1
+
+	state := make([]fr.Element, t)
+	copy(state[1:], inputs)
+	permutation(state, getParameters(t))
+
+	res.Set(&state[0])
+	return res, nil
+}
+
+// permutation applies the Poseidon permutation to state, in place
+func permutation(state []fr.Element, p *parameters) {
+	t := p.t
+	nbRounds := nbFullRounds + nbPartialRounds[t-2]
+	tmp := make([]fr.Element, t)
+
+	for r := 0; r < nbRounds; r++ {
+		// add round constants
+		for i := 0; i < t; i++ {
+			state[i].Add(&state[i], &p.rc[r*t+i])
+		}
+
+		// S-box, on the whole state for the first and last nbFullRounds/2 rounds,
+		// on the first element only for the partial rounds
+		if r < nbFullRounds/2 || r >= nbRounds-nbFullRounds/2 {
+			for i := 0; i < t; i++ {
+				sBox(&state[i])
+			}
+		} else {
+			sBox(&state[0])
+		}
+
+		// mix
+		for i := 0; i < t; i++ {
+			tmp[i].SetZero()
+			for j := 0; j < t; j++ {
+				var m fr.Element
+				m.Mul(&p.mds[i][j], &state[j])
+				tmp[i].Add(&tmp[i], &m)
+			}
+		}
+		copy(state, tmp)
+	}
+}
+
+// sBox sets x to x^5
+func sBox(x *fr.Element) {
+	var x2 fr.Element
+	x2.Square(x)
+	x2.Square(&x2)
+	x.Mul(x, &x2)
+}
+
+// newParameters samples the parameters of the permutation of width t as in the reference
+// implementation (generate_parameters_grain.sage, with field=1, sbox=0, n=254)
+func newParameters(t int) parameters {
+	res := parameters{t: t}
+	g := newGrain(t, nbFullRounds, nbPartialRounds[t-2])
+
+	// round constants are sampled with rejection
+	res.rc = make([]fr.Element, (nbFullRounds+nbPartialRounds[t-2])*t)
+	for i := range res.rc {
+		x := g.nextInt(fr.Bits)
+		for x.Cmp(fr.Modulus()) >= 0 {
+			x = g.nextInt(fr.Bits)
+		}
+		res.rc[i].SetBigInt(x)
+	}
+
+	// the MDS matrix is the Cauchy matrix 1/(x_i+y_j), with x_i, y_j sampled
+	// modulo r, pairwise distinct and such that x_i+y_j != 0
+	for {
+		xy := make([]fr.Element, 2*t)
+		for !distinct(xy) {
+			for i := range xy {
+				xy[i].SetBigInt(g.nextInt(fr.Bits))
+			}
+		}
+
+		ok := true
+		res.mds = make([][]fr.Element, t)
+		for i := 0; i < t && ok; i++ {
+			res.mds[i] = make([]fr.Element, t)
+			for j := 0; j < t; j++ {
+				res.mds[i][j].Add(&xy[i], &xy[t+j])
+				if res.mds[i][j].IsZero() {
+					ok = false
+					break
+				}
+				res.mds[i][j].Inverse(&res.mds[i][j])
+			}
+		}
+		if ok {
+			return res
+		}
+	}
+}
+
+// distinct returns true if the elements of s are pairwise distinct
+func distinct(s []fr.Element) bool {
+	for i := 0; i < len(s); i++ {
+		for j := i + 1; j < len(s); j++ {
+			if s[i].Equal(&s[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// grain the self-shrinking Grain LFSR used to sample the parameters
+type grain struct {
+	state [80]byte // one bit per byte
+}
+
+func newGrain(t, nbFullRounds, nbPartialRounds int) *grain {
+	g := new(grain)
+
+	// field (2 bits) || sbox (4 bits) || n (12 bits) || t (12 bits) || R_F (10 bits) || R_P (10 bits) || 1 (30 bits)
+	fields := []struct {
+		value, size int
+	}{
+		{1, 2},
+		{0, 4},
+		{fr.Bits, 12},
+		{t, 12},
+		{nbFullRounds, 10},
+		{nbPartialRounds, 10},
+		{1<<30 - 1, 30},
+	}
+	i := 0
+	for _, f := range fields {
+		for k := f.size - 1; k >= 0; k-- {
+			g.state[i] = byte(f.value>>k) & 1
+			i++
+		}
+	}
+
+	// discard the first 160 bits
+	for i := 0; i < 160; i++ {
+		g.step()
+	}
+	return g
+}
+
+// step updates the LFSR and returns the new bit
+func (g *grain) step() byte {
+	b := g.state[62] ^ g.state[51] ^ g.state[38] ^ g.state[23] ^ g.state[13] ^ g.state[0]
+	copy(g.state[:], g.state[1:])
+	g.state[79] = b
+	return b
+}
+
+// nextBit returns the next output bit: bits are produced in pairs,
+// the second one is output if the first one is 1, discarded otherwise
+func (g *grain) nextBit() byte {
+	for g.step() == 0 {
+		g.step()
+	}
+	return g.step()
+}
+
+// nextInt returns an integer from the next nbBits output bits, most significant bit first
+func (g *grain) nextInt(nbBits int) *big.Int {
+	res := new(big.Int)
+	for i := 0; i < nbBits; i++ {
+		res.Lsh(res, 1)
+		if g.nextBit() == 1 {
+			res.SetBit(res, 0, 1)
+		}
+	}
+	return res
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poseidon
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+func TestParameters(t *testing.T) {
+	p := getParameters(3)
+
+	// first round constant and MDS coefficient of circomlib for t=3
+	// (0x0ee9a592...04cd8e6e and 0x109b7f41...2ba8118b)
+	var c0, m00 fr.Element
+	c0.SetString("6745197990210204598374042828761989596302876299545964402857411729872131034734")
+	m00.SetString("7511745149465107256748700652201246547602992235352608707588321460060273774987")
+	if !p.rc[0].Equal(&c0) {
+		t.Fatal("wrong first round constant")
+	}
+	if !p.mds[0][0].Equal(&m00) {
+		t.Fatal("wrong first MDS coefficient")
+	}
+}
+
+// outputs of circomlib's poseidon
+func TestVectors(t *testing.T) {
+	vectors := []struct {
+		inputs   []uint64
+		expected string
+	}{
+		{[]uint64{1}, "18586133768512220936620570745912940619677854269274689475585506675881198879027"},
+		{[]uint64{1, 2}, "7853200120776062878684798364095072458815029376092732009249414926327459813530"},
+		{[]uint64{1, 2, 3}, "6542985608222806190361240322586112750744169038454362455181422643027100751666"},
+		{[]uint64{1, 2, 3, 4}, "18821383157269793795438455681495246036402687001665670618754263018637548127333"},
+		{[]uint64{1, 2, 3, 4, 5}, "6183221330272524995739186171720101788151706631170188140075976616310159254464"},
+		{[]uint64{1, 2, 0, 0, 0}, "1018317224307729531995786483840663576608797660851238720571059489595066344487"},
+		{[]uint64{1, 2, 3, 4, 5, 6}, "20400040500897583745843009878988256314335038853985262692600694741116813247201"},
+	}
+
+	for _, v := range vectors {
+		inputs := make([]fr.Element, len(v.inputs))
+		for i := range inputs {
+			inputs[i].SetUint64(v.inputs[i])
+		}
+		h, err := Hash(inputs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var expected fr.Element
+		expected.SetString(v.expected)
+		if !h.Equal(&expected) {
+			t.Fatalf("wrong hash of %v", v.inputs)
+		}
+	}
+}
+
+func TestNbInputs(t *testing.T) {
+	if _, err := Hash(nil); err == nil {
+		t.Fatal("hashing 0 inputs should fail")
+	}
+	if _, err := Hash(make([]fr.Element, MaxInputs+1)); err == nil {
+		t.Fatal("hashing more than MaxInputs inputs should fail")
+	}
+	if _, err := Hash(make([]fr.Element, MaxInputs)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bn254
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// glvLimbs is the number of 64-bit words of the absolute values of the GLV half-scalars
+const glvLimbs = 2
+
+// glvWideLimbs is the number of 64-bit words of the intermediate values of splitScalar,
+// which are computed modulo 2**(64*glvWideLimbs) in two's complement
+const glvWideLimbs = 3
+
+// glvShiftLimbs is such that the rounding constants are scaled by 2**(64*glvShiftLimbs)
+const glvShiftLimbs = 5
+
+// absolute values of the coordinates of the GLV lattice basis (a1, b1), (a2, b2) in glvBasis
+var (
+	glvA1 = [glvWideLimbs]uint64{9931322734385697763, 0, 0}
+	glvB1 = [glvWideLimbs]uint64{9372478919628755240, 8020209761171036668, 0}
+	glvA2 = [glvWideLimbs]uint64{857057580304901387, 8020209761171036669, 0}
+	glvB2 = [glvWideLimbs]uint64{9931322734385697763, 0, 0}
+)
+
+// rounding constants glvG1 = round(2**(64*glvShiftLimbs) * |b2| / |det|)
+// and glvG2 = round(2**(64*glvShiftLimbs) * |b1| / |det|)
+var (
+	glvG1 = [4]uint64{7978627105577135859, 15644699364383830999, 2, 0}
+	glvG2 = [4]uint64{11953552847224317658, 8825887400277225869, 5534624963584316111, 2}
+)
+
+// splitScalar outputs the absolute values of k1, k2 and their signs such that
+// k1 + k2*lambdaGLV = s mod r, where s < r is in regular (non Montgomery) form.
+//
+// It is the allocation free equivalent of ecc.SplitScalar(s, &glvBasis): (k1, k2) is
+// (s, 0) minus a close vector of the lattice, whose rounded coordinates
+// c1 = round(s*b2/det) and c2 = round(-s*b1/det) are computed with the precomputed
+// constants glvG1, glvG2 instead of a division by det.
+func splitScalar(s *[fr.Limbs]uint64) (k1, k2 [glvLimbs]uint64, neg1, neg2 bool) {
+	c1 := glvRound(s, &glvG1)
+	c2 := glvRound(s, &glvG2)
+
+	// k1 = s - c1*a1 - c2*a2
+	var u [glvWideLimbs]uint64
+	copy(u[:], s[:])
+	glvMulAcc(&u, &c1, &glvA1, true)
+	glvMulAcc(&u, &c2, &glvA2, true)
+	k1, neg1 = glvAbs(&u)
+
+	// k2 = - c1*b1 - c2*b2
+	u = [glvWideLimbs]uint64{}
+	glvMulAcc(&u, &c1, &glvB1, false)
+	glvMulAcc(&u, &c2, &glvB2, true)
+	k2, neg2 = glvAbs(&u)
+
+	return
+}
+
+// glvRound returns round(s*g / 2**(64*glvShiftLimbs))
+func glvRound(s *[fr.Limbs]uint64, g *[4]uint64) (res [glvLimbs]uint64) {
+	var p [8]uint64
+	for i := 0; i < len(s); i++ {
+		var carry uint64
+		for j := 0; j < len(g); j++ {
+			hi, lo := bits.Mul64(s[i], g[j])
+			var c uint64
+			lo, c = bits.Add64(lo, p[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			p[i+j] = lo
+			carry = hi
+		}
+		p[i+len(g)] = carry
+	}
+
+	// add 2**(64*glvShiftLimbs-1) to round to the nearest integer
+	var carry uint64
+	p[glvShiftLimbs-1], carry = bits.Add64(p[glvShiftLimbs-1], 1<<63, 0)
+	for i := glvShiftLimbs; i < len(p); i++ {
+		p[i], carry = bits.Add64(p[i], 0, carry)
+	}
+
+	copy(res[:], p[glvShiftLimbs:])
+	return
+}
+
+// glvMulAcc sets z = z - x*y if sub, z = z + x*y otherwise, modulo 2**(64*glvWideLimbs)
+func glvMulAcc(z *[glvWideLimbs]uint64, x *[glvLimbs]uint64, y *[glvWideLimbs]uint64, sub bool) {
+	var t [glvWideLimbs]uint64
+	for i := 0; i < len(x); i++ {
+		var carry uint64
+		for j := 0; i+j < len(t); j++ {
+			hi, lo := bits.Mul64(x[i], y[j])
+			var c uint64
+			lo, c = bits.Add64(lo, t[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			t[i+j] = lo
+			carry = hi
+		}
+	}
+
+	var c uint64
+	for i := range z {
+		if sub {
+			z[i], c = bits.Sub64(z[i], t[i], c)
+		} else {
+			z[i], c = bits.Add64(z[i], t[i], c)
+		}
+	}
+}
+
+// glvAbs returns the absolute value of the two's complement z, and whether z < 0
+func glvAbs(z *[glvWideLimbs]uint64) (res [glvLimbs]uint64, neg bool) {
+	neg = z[glvWideLimbs-1]>>63 == 1
+	if neg {
+		var borrow uint64
+		for i := range res {
+			res[i], borrow = bits.Sub64(0, z[i], borrow)
+		}
+		return
+	}
+	copy(res[:], z[:])
+	return
+}
@@ -23,10 +23,12 @@ import (
 // GT target group of the pairing
 type GT = fptower.E12
 
-type lineEvaluation struct {
-	r0 fptower.E2
-	r1 fptower.E2
-	r2 fptower.E2
+// LineEvaluation holds the three non-zero coefficients of a line of the Miller loop,
+// as multiplied into the accumulator with GT.MulBy034
+type LineEvaluation struct {
+	R0 fptower.E2
+	R1 fptower.E2
+	R2 fptower.E2
 }
 
 // Pair calculates the reduced pairing for a set of points
@@ -59,19 +61,34 @@ func FinalExponentiation(z *GT, _z ...*GT) GT {
 		result.Mul(&result, e)
 	}
 
+	result = FinalExponentiationEasy(&result)
+	return FinalExponentiationHard(&result)
+}
+
+// FinalExponentiationEasy computes the easy part x**(p**6-1)(p**2+1) of the final expo.
+// The result lies in the cyclotomic subgroup of GT.
+func FinalExponentiationEasy(z *GT) GT {
+
+	var result, temp GT
+	temp.Conjugate(z)
+	result.Inverse(z)
+	temp.Mul(&temp, &result)
+	result.FrobeniusSquare(&temp).
+		Mul(&result, &temp)
+
+	return result
+}
+
+// FinalExponentiationHard computes the hard part x**(p**4 - p**2 +1)/r of the final expo.
+// z must lie in the cyclotomic subgroup of GT, e.g. be the output of FinalExponentiationEasy.
+func FinalExponentiationHard(z *GT) GT {
+
+	var result GT
+
 	// https://eprint.iacr.org/2008/490.pdf
 	var mt [4]GT // mt[i] is m^(t^i)
+	mt[0].Set(z)
 
-	// easy part
-	mt[0].Set(&result)
-	var temp GT
-	temp.Conjugate(&mt[0])
-	mt[0].Inverse(&mt[0])
-	temp.Mul(&temp, &mt[0])
-	mt[0].FrobeniusSquare(&temp).
-		Mul(&mt[0], &temp)
-
-	// hard part
 	mt[1].Expt(&mt[0])
 	mt[2].Expt(&mt[1])
 	mt[3].Expt(&mt[2])
@@ -152,7 +169,7 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	var result GT
 	result.SetOne()
 
-	var l lineEvaluation
+	var l LineEvaluation
 
 	for i := len(loopCounter) - 2; i >= 0; i-- {
 		result.Square(&result)
@@ -160,23 +177,23 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		for k := 0; k < n; k++ {
 			qProj[k].DoubleStep(&l)
 			// line evaluation
-			l.r0.MulByElement(&l.r0, &p[k].Y)
-			l.r1.MulByElement(&l.r1, &p[k].X)
-			result.MulBy034(&l.r0, &l.r1, &l.r2)
+			l.R0.MulByElement(&l.R0, &p[k].Y)
+			l.R1.MulByElement(&l.R1, &p[k].X)
+			result.MulBy034(&l.R0, &l.R1, &l.R2)
 
 			if loopCounter[i] == 1 {
 				qProj[k].AddMixedStep(&l, &q[k])
 				// line evaluation
-				l.r0.MulByElement(&l.r0, &p[k].Y)
-				l.r1.MulByElement(&l.r1, &p[k].X)
-				result.MulBy034(&l.r0, &l.r1, &l.r2)
+				l.R0.MulByElement(&l.R0, &p[k].Y)
+				l.R1.MulByElement(&l.R1, &p[k].X)
+				result.MulBy034(&l.R0, &l.R1, &l.R2)
 
 			} else if loopCounter[i] == -1 {
 				qProj[k].AddMixedStep(&l, &qNeg[k])
 				// line evaluation
-				l.r0.MulByElement(&l.r0, &p[k].Y)
-				l.r1.MulByElement(&l.r1, &p[k].X)
-				result.MulBy034(&l.r0, &l.r1, &l.r2)
+				l.R0.MulByElement(&l.R0, &p[k].Y)
+				l.R1.MulByElement(&l.R1, &p[k].X)
+				result.MulBy034(&l.R0, &l.R1, &l.R2)
 			}
 		}
 	}
@@ -194,23 +211,91 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 
 		qProj[k].AddMixedStep(&l, &Q1)
 		// line evaluation
-		l.r0.MulByElement(&l.r0, &p[k].Y)
-		l.r1.MulByElement(&l.r1, &p[k].X)
-		result.MulBy034(&l.r0, &l.r1, &l.r2)
+		l.R0.MulByElement(&l.R0, &p[k].Y)
+		l.R1.MulByElement(&l.R1, &p[k].X)
+		result.MulBy034(&l.R0, &l.R1, &l.R2)
 
 		qProj[k].AddMixedStep(&l, &Q2)
 		// line evaluation
-		l.r0.MulByElement(&l.r0, &p[k].Y)
-		l.r1.MulByElement(&l.r1, &p[k].X)
-		result.MulBy034(&l.r0, &l.r1, &l.r2)
+		l.R0.MulByElement(&l.R0, &p[k].Y)
+		l.R1.MulByElement(&l.R1, &p[k].X)
+		result.MulBy034(&l.R0, &l.R1, &l.R2)
 	}
 
 	return result, nil
 }
 
+// MillerLoopStep records a line multiplication of the Miller loop
+type MillerLoopStep struct {
+	Line        LineEvaluation // line as output by the doubling or addition step
+	Evaluation  LineEvaluation // Line evaluated at the G1 point
+	Accumulator GT             // Miller loop accumulator once Evaluation is multiplied in
+}
+
+// MillerLoopSteps runs the Miller loop on a single pair (P, Q) and returns, in order,
+// every line multiplied into the accumulator along with the resulting accumulator.
+// The returned GT equals MillerLoop([]G1Affine{P}, []G2Affine{Q}).
+// If P or Q is the point at infinity, no step is recorded and the result is one.
+func MillerLoopSteps(P *G1Affine, Q *G2Affine) ([]MillerLoopStep, GT) {
+	var result GT
+	result.SetOne()
+	if P.IsInfinity() || Q.IsInfinity() {
+		return nil, result
+	}
+
+	var qProj g2Proj
+	var qNeg G2Affine
+	qProj.FromAffine(Q)
+	qNeg.Neg(Q)
+
+	var l LineEvaluation
+	steps := make([]MillerLoopStep, 0, 2*len(loopCounter))
+	mulLine := func() {
+		step := MillerLoopStep{Line: l}
+		l.R0.MulByElement(&l.R0, &P.Y)
+		l.R1.MulByElement(&l.R1, &P.X)
+		step.Evaluation = l
+		result.MulBy034(&l.R0, &l.R1, &l.R2)
+		step.Accumulator = result
+		steps = append(steps, step)
+	}
+
+	for i := len(loopCounter) - 2; i >= 0; i-- {
+		result.Square(&result)
+
+		qProj.DoubleStep(&l)
+		mulLine()
+
+		if loopCounter[i] == 1 {
+			qProj.AddMixedStep(&l, Q)
+			mulLine()
+		} else if loopCounter[i] == -1 {
+			qProj.AddMixedStep(&l, &qNeg)
+			mulLine()
+		}
+	}
+
+	var Q1, Q2 G2Affine
+	//Q1 = Frob(Q)
+	Q1.X.Conjugate(&Q.X).MulByNonResidue1Power2(&Q1.X)
+	Q1.Y.Conjugate(&Q.Y).MulByNonResidue1Power3(&Q1.Y)
+
+	// Q2 = -Frob2(Q)
+	Q2.X.MulByNonResidue2Power2(&Q.X)
+	Q2.Y.MulByNonResidue2Power3(&Q.Y).Neg(&Q2.Y)
+
+	qProj.AddMixedStep(&l, &Q1)
+	mulLine()
+
+	qProj.AddMixedStep(&l, &Q2)
+	mulLine()
+
+	return steps, result
+}
+
 // DoubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) DoubleStep(evaluations *lineEvaluation) {
+func (p *g2Proj) DoubleStep(evaluations *LineEvaluation) {
 
 	// get some Element from our pool
 	var t0, t1, A, B, C, D, E, EE, F, G, H, I, J, K fptower.E2
@@ -243,15 +328,15 @@ func (p *g2Proj) DoubleStep(evaluations *lineEvaluation) {
 	p.z.Mul(&B, &H)
 
 	// Line evaluation
-	evaluations.r0.Neg(&H)
-	evaluations.r1.Double(&J).
-		Add(&evaluations.r1, &J)
-	evaluations.r2.Set(&I)
+	evaluations.R0.Neg(&H)
+	evaluations.R1.Double(&J).
+		Add(&evaluations.R1, &J)
+	evaluations.R2.Set(&I)
 }
 
 // AddMixedStep point addition in Mixed Homogenous projective and Affine coordinates
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) AddMixedStep(evaluations *lineEvaluation, a *G2Affine) {
+func (p *g2Proj) AddMixedStep(evaluations *LineEvaluation, a *G2Affine) {
 
 	// get some Element from our pool
 	var Y2Z1, X2Z1, O, L, C, D, E, F, G, H, t0, t1, t2, J fptower.E2
@@ -281,7 +366,7 @@ func (p *g2Proj) AddMixedStep(evaluations *lineEvaluation, a *G2Affine) {
 		Sub(&J, &t2)
 
 	// Line evaluation
-	evaluations.r0.Set(&L)
-	evaluations.r1.Neg(&O)
-	evaluations.r2.Set(&J)
+	evaluations.R0.Set(&L)
+	evaluations.R1.Neg(&O)
+	evaluations.R2.Set(&J)
 }
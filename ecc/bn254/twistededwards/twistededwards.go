@@ -40,3 +40,8 @@ func init() {
 	edwards.Base.X.SetString("9671717474070082183213120605117400219616337014328744928644933853176787189663")
 	edwards.Base.Y.SetString("16950150798460657717958625567821834550301663161624707787222815936182638968203")
 }
+
+// mulByA multiplies x by the curve coefficient a=-1
+func mulByA(x *fr.Element) {
+	x.Neg(x)
+}
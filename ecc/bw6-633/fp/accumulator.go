@@ -0,0 +1,375 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"math/bits"
+)
+
+// WideAccumulator accumulates products of Element on 20 + 1 words, without modular reduction.
+//
+// A sum of products costs one Montgomery reduction (Reduce) instead of one per product:
+//
+//	var acc WideAccumulator
+//	for i := range a {
+//		acc.MulAdd(&a[i], &b[i])
+//	}
+//	res := acc.Reduce()
+//
+// The zero value is an empty accumulator; it can hold up to 2**63 products.
+type WideAccumulator struct {
+	t [21]uint64
+}
+
+// MulAdd sets acc = acc + x * y, without reduction
+func (acc *WideAccumulator) MulAdd(x, y *Element) *WideAccumulator {
+	var p [20]uint64
+	var C uint64
+
+	// p = x * y
+	C, p[0] = bits.Mul64(x[0], y[0])
+	C, p[1] = madd1(x[0], y[1], C)
+	C, p[2] = madd1(x[0], y[2], C)
+	C, p[3] = madd1(x[0], y[3], C)
+	C, p[4] = madd1(x[0], y[4], C)
+	C, p[5] = madd1(x[0], y[5], C)
+	C, p[6] = madd1(x[0], y[6], C)
+	C, p[7] = madd1(x[0], y[7], C)
+	C, p[8] = madd1(x[0], y[8], C)
+	C, p[9] = madd1(x[0], y[9], C)
+	p[10] = C
+	C, p[1] = madd1(x[1], y[0], p[1])
+	C, p[2] = madd2(x[1], y[1], p[2], C)
+	C, p[3] = madd2(x[1], y[2], p[3], C)
+	C, p[4] = madd2(x[1], y[3], p[4], C)
+	C, p[5] = madd2(x[1], y[4], p[5], C)
+	C, p[6] = madd2(x[1], y[5], p[6], C)
+	C, p[7] = madd2(x[1], y[6], p[7], C)
+	C, p[8] = madd2(x[1], y[7], p[8], C)
+	C, p[9] = madd2(x[1], y[8], p[9], C)
+	C, p[10] = madd2(x[1], y[9], p[10], C)
+	p[11] = C
+	C, p[2] = madd1(x[2], y[0], p[2])
+	C, p[3] = madd2(x[2], y[1], p[3], C)
+	C, p[4] = madd2(x[2], y[2], p[4], C)
+	C, p[5] = madd2(x[2], y[3], p[5], C)
+	C, p[6] = madd2(x[2], y[4], p[6], C)
+	C, p[7] = madd2(x[2], y[5], p[7], C)
+	C, p[8] = madd2(x[2], y[6], p[8], C)
+	C, p[9] = madd2(x[2], y[7], p[9], C)
+	C, p[10] = madd2(x[2], y[8], p[10], C)
+	C, p[11] = madd2(x[2], y[9], p[11], C)
+	p[12] = C
+	C, p[3] = madd1(x[3], y[0], p[3])
+	C, p[4] = madd2(x[3], y[1], p[4], C)
+	C, p[5] = madd2(x[3], y[2], p[5], C)
+	C, p[6] = madd2(x[3], y[3], p[6], C)
+	C, p[7] = madd2(x[3], y[4], p[7], C)
+	C, p[8] = madd2(x[3], y[5], p[8], C)
+	C, p[9] = madd2(x[3], y[6], p[9], C)
+	C, p[10] = madd2(x[3], y[7], p[10], C)
+	C, p[11] = madd2(x[3], y[8], p[11], C)
+	C, p[12] = madd2(x[3], y[9], p[12], C)
+	p[13] = C
+	C, p[4] = madd1(x[4], y[0], p[4])
+	C, p[5] = madd2(x[4], y[1], p[5], C)
+	C, p[6] = madd2(x[4], y[2], p[6], C)
+	C, p[7] = madd2(x[4], y[3], p[7], C)
+	C, p[8] = madd2(x[4], y[4], p[8], C)
+	C, p[9] = madd2(x[4], y[5], p[9], C)
+	C, p[10] = madd2(x[4], y[6], p[10], C)
+	C, p[11] = madd2(x[4], y[7], p[11], C)
+	C, p[12] = madd2(x[4], y[8], p[12], C)
+	C, p[13] = madd2(x[4], y[9], p[13], C)
+	p[14] = C
+	C, p[5] = madd1(x[5], y[0], p[5])
+	C, p[6] = madd2(x[5], y[1], p[6], C)
+	C, p[7] = madd2(x[5], y[2], p[7], C)
+	C, p[8] = madd2(x[5], y[3], p[8], C)
+	C, p[9] = madd2(x[5], y[4], p[9], C)
+	C, p[10] = madd2(x[5], y[5], p[10], C)
+	C, p[11] = madd2(x[5], y[6], p[11], C)
+	C, p[12] = madd2(x[5], y[7], p[12], C)
+	C, p[13] = madd2(x[5], y[8], p[13], C)
+	C, p[14] = madd2(x[5], y[9], p[14], C)
+	p[15] = C
+	C, p[6] = madd1(x[6], y[0], p[6])
+	C, p[7] = madd2(x[6], y[1], p[7], C)
+	C, p[8] = madd2(x[6], y[2], p[8], C)
+	C, p[9] = madd2(x[6], y[3], p[9], C)
+	C, p[10] = madd2(x[6], y[4], p[10], C)
+	C, p[11] = madd2(x[6], y[5], p[11], C)
+	C, p[12] = madd2(x[6], y[6], p[12], C)
+	C, p[13] = madd2(x[6], y[7], p[13], C)
+	C, p[14] = madd2(x[6], y[8], p[14], C)
+	C, p[15] = madd2(x[6], y[9], p[15], C)
+	p[16] = C
+	C, p[7] = madd1(x[7], y[0], p[7])
+	C, p[8] = madd2(x[7], y[1], p[8], C)
+	C, p[9] = madd2(x[7], y[2], p[9], C)
+	C, p[10] = madd2(x[7], y[3], p[10], C)
+	C, p[11] = madd2(x[7], y[4], p[11], C)
+	C, p[12] = madd2(x[7], y[5], p[12], C)
+	C, p[13] = madd2(x[7], y[6], p[13], C)
+	C, p[14] = madd2(x[7], y[7], p[14], C)
+	C, p[15] = madd2(x[7], y[8], p[15], C)
+	C, p[16] = madd2(x[7], y[9], p[16], C)
+	p[17] = C
+	C, p[8] = madd1(x[8], y[0], p[8])
+	C, p[9] = madd2(x[8], y[1], p[9], C)
+	C, p[10] = madd2(x[8], y[2], p[10], C)
+	C, p[11] = madd2(x[8], y[3], p[11], C)
+	C, p[12] = madd2(x[8], y[4], p[12], C)
+	C, p[13] = madd2(x[8], y[5], p[13], C)
+	C, p[14] = madd2(x[8], y[6], p[14], C)
+	C, p[15] = madd2(x[8], y[7], p[15], C)
+	C, p[16] = madd2(x[8], y[8], p[16], C)
+	C, p[17] = madd2(x[8], y[9], p[17], C)
+	p[18] = C
+	C, p[9] = madd1(x[9], y[0], p[9])
+	C, p[10] = madd2(x[9], y[1], p[10], C)
+	C, p[11] = madd2(x[9], y[2], p[11], C)
+	C, p[12] = madd2(x[9], y[3], p[12], C)
+	C, p[13] = madd2(x[9], y[4], p[13], C)
+	C, p[14] = madd2(x[9], y[5], p[14], C)
+	C, p[15] = madd2(x[9], y[6], p[15], C)
+	C, p[16] = madd2(x[9], y[7], p[16], C)
+	C, p[17] = madd2(x[9], y[8], p[17], C)
+	C, p[18] = madd2(x[9], y[9], p[18], C)
+	p[19] = C
+
+	// acc = acc + p
+	acc.t[0], C = bits.Add64(acc.t[0], p[0], 0)
+	acc.t[1], C = bits.Add64(acc.t[1], p[1], C)
+	acc.t[2], C = bits.Add64(acc.t[2], p[2], C)
+	acc.t[3], C = bits.Add64(acc.t[3], p[3], C)
+	acc.t[4], C = bits.Add64(acc.t[4], p[4], C)
+	acc.t[5], C = bits.Add64(acc.t[5], p[5], C)
+	acc.t[6], C = bits.Add64(acc.t[6], p[6], C)
+	acc.t[7], C = bits.Add64(acc.t[7], p[7], C)
+	acc.t[8], C = bits.Add64(acc.t[8], p[8], C)
+	acc.t[9], C = bits.Add64(acc.t[9], p[9], C)
+	acc.t[10], C = bits.Add64(acc.t[10], p[10], C)
+	acc.t[11], C = bits.Add64(acc.t[11], p[11], C)
+	acc.t[12], C = bits.Add64(acc.t[12], p[12], C)
+	acc.t[13], C = bits.Add64(acc.t[13], p[13], C)
+	acc.t[14], C = bits.Add64(acc.t[14], p[14], C)
+	acc.t[15], C = bits.Add64(acc.t[15], p[15], C)
+	acc.t[16], C = bits.Add64(acc.t[16], p[16], C)
+	acc.t[17], C = bits.Add64(acc.t[17], p[17], C)
+	acc.t[18], C = bits.Add64(acc.t[18], p[18], C)
+	acc.t[19], C = bits.Add64(acc.t[19], p[19], C)
+	acc.t[20] += C
+
+	return acc
+}
+
+// Reset empties the accumulator
+func (acc *WideAccumulator) Reset() *WideAccumulator {
+	acc.t = [21]uint64{}
+	return acc
+}
+
+// Reduce returns the sum of the accumulated products, as a reduced Element;
+// the accumulator is left unchanged
+func (acc *WideAccumulator) Reduce() (z Element) {
+	t := acc.t
+
+	// the products are in Montgomery form (x*y*R**2), the montgomery reduction
+	// of t gives v = t * R**-1 mod q on 11 words: v < t / R + q
+	montReduceWide(&t)
+
+	// v = vLo + vHi * R with vLo < R, vHi < 2**64
+	// vLo * R**-1 * (R mod q) < 2q
+	var lo [21]uint64
+	var C uint64
+	C, lo[0] = bits.Mul64(t[10], 5665001492438840506)
+	C, lo[1] = madd1(t[10], 16907884053554239805, C)
+	C, lo[2] = madd1(t[10], 17318295036095996852, C)
+	C, lo[3] = madd1(t[10], 12638729832353218866, C)
+	C, lo[4] = madd1(t[10], 12856030952767240260, C)
+	C, lo[5] = madd1(t[10], 15732028589390776959, C)
+	C, lo[6] = madd1(t[10], 1038965607738428109, C)
+	C, lo[7] = madd1(t[10], 8411601626847721258, C)
+	C, lo[8] = madd1(t[10], 7016548280614581879, C)
+	C, lo[9] = madd1(t[10], 51212299585931083, C)
+	lo[10] = C
+	C, lo[1] = madd1(t[11], 5665001492438840506, lo[1])
+	C, lo[2] = madd2(t[11], 16907884053554239805, lo[2], C)
+	C, lo[3] = madd2(t[11], 17318295036095996852, lo[3], C)
+	C, lo[4] = madd2(t[11], 12638729832353218866, lo[4], C)
+	C, lo[5] = madd2(t[11], 12856030952767240260, lo[5], C)
+	C, lo[6] = madd2(t[11], 15732028589390776959, lo[6], C)
+	C, lo[7] = madd2(t[11], 1038965607738428109, lo[7], C)
+	C, lo[8] = madd2(t[11], 8411601626847721258, lo[8], C)
+	C, lo[9] = madd2(t[11], 7016548280614581879, lo[9], C)
+	C, lo[10] = madd2(t[11], 51212299585931083, lo[10], C)
+	lo[11] = C
+	C, lo[2] = madd1(t[12], 5665001492438840506, lo[2])
+	C, lo[3] = madd2(t[12], 16907884053554239805, lo[3], C)
+	C, lo[4] = madd2(t[12], 17318295036095996852, lo[4], C)
+	C, lo[5] = madd2(t[12], 12638729832353218866, lo[5], C)
+	C, lo[6] = madd2(t[12], 12856030952767240260, lo[6], C)
+	C, lo[7] = madd2(t[12], 15732028589390776959, lo[7], C)
+	C, lo[8] = madd2(t[12], 1038965607738428109, lo[8], C)
+	C, lo[9] = madd2(t[12], 8411601626847721258, lo[9], C)
+	C, lo[10] = madd2(t[12], 7016548280614581879, lo[10], C)
+	C, lo[11] = madd2(t[12], 51212299585931083, lo[11], C)
+	lo[12] = C
+	C, lo[3] = madd1(t[13], 5665001492438840506, lo[3])
+	C, lo[4] = madd2(t[13], 16907884053554239805, lo[4], C)
+	C, lo[5] = madd2(t[13], 17318295036095996852, lo[5], C)
+	C, lo[6] = madd2(t[13], 12638729832353218866, lo[6], C)
+	C, lo[7] = madd2(t[13], 12856030952767240260, lo[7], C)
+	C, lo[8] = madd2(t[13], 15732028589390776959, lo[8], C)
+	C, lo[9] = madd2(t[13], 1038965607738428109, lo[9], C)
+	C, lo[10] = madd2(t[13], 8411601626847721258, lo[10], C)
+	C, lo[11] = madd2(t[13], 7016548280614581879, lo[11], C)
+	C, lo[12] = madd2(t[13], 51212299585931083, lo[12], C)
+	lo[13] = C
+	C, lo[4] = madd1(t[14], 5665001492438840506, lo[4])
+	C, lo[5] = madd2(t[14], 16907884053554239805, lo[5], C)
+	C, lo[6] = madd2(t[14], 17318295036095996852, lo[6], C)
+	C, lo[7] = madd2(t[14], 12638729832353218866, lo[7], C)
+	C, lo[8] = madd2(t[14], 12856030952767240260, lo[8], C)
+	C, lo[9] = madd2(t[14], 15732028589390776959, lo[9], C)
+	C, lo[10] = madd2(t[14], 1038965607738428109, lo[10], C)
+	C, lo[11] = madd2(t[14], 8411601626847721258, lo[11], C)
+	C, lo[12] = madd2(t[14], 7016548280614581879, lo[12], C)
+	C, lo[13] = madd2(t[14], 51212299585931083, lo[13], C)
+	lo[14] = C
+	C, lo[5] = madd1(t[15], 5665001492438840506, lo[5])
+	C, lo[6] = madd2(t[15], 16907884053554239805, lo[6], C)
+	C, lo[7] = madd2(t[15], 17318295036095996852, lo[7], C)
+	C, lo[8] = madd2(t[15], 12638729832353218866, lo[8], C)
+	C, lo[9] = madd2(t[15], 12856030952767240260, lo[9], C)
+	C, lo[10] = madd2(t[15], 15732028589390776959, lo[10], C)
+	C, lo[11] = madd2(t[15], 1038965607738428109, lo[11], C)
+	C, lo[12] = madd2(t[15], 8411601626847721258, lo[12], C)
+	C, lo[13] = madd2(t[15], 7016548280614581879, lo[13], C)
+	C, lo[14] = madd2(t[15], 51212299585931083, lo[14], C)
+	lo[15] = C
+	C, lo[6] = madd1(t[16], 5665001492438840506, lo[6])
+	C, lo[7] = madd2(t[16], 16907884053554239805, lo[7], C)
+	C, lo[8] = madd2(t[16], 17318295036095996852, lo[8], C)
+	C, lo[9] = madd2(t[16], 12638729832353218866, lo[9], C)
+	C, lo[10] = madd2(t[16], 12856030952767240260, lo[10], C)
+	C, lo[11] = madd2(t[16], 15732028589390776959, lo[11], C)
+	C, lo[12] = madd2(t[16], 1038965607738428109, lo[12], C)
+	C, lo[13] = madd2(t[16], 8411601626847721258, lo[13], C)
+	C, lo[14] = madd2(t[16], 7016548280614581879, lo[14], C)
+	C, lo[15] = madd2(t[16], 51212299585931083, lo[15], C)
+	lo[16] = C
+	C, lo[7] = madd1(t[17], 5665001492438840506, lo[7])
+	C, lo[8] = madd2(t[17], 16907884053554239805, lo[8], C)
+	C, lo[9] = madd2(t[17], 17318295036095996852, lo[9], C)
+	C, lo[10] = madd2(t[17], 12638729832353218866, lo[10], C)
+	C, lo[11] = madd2(t[17], 12856030952767240260, lo[11], C)
+	C, lo[12] = madd2(t[17], 15732028589390776959, lo[12], C)
+	C, lo[13] = madd2(t[17], 1038965607738428109, lo[13], C)
+	C, lo[14] = madd2(t[17], 8411601626847721258, lo[14], C)
+	C, lo[15] = madd2(t[17], 7016548280614581879, lo[15], C)
+	C, lo[16] = madd2(t[17], 51212299585931083, lo[16], C)
+	lo[17] = C
+	C, lo[8] = madd1(t[18], 5665001492438840506, lo[8])
+	C, lo[9] = madd2(t[18], 16907884053554239805, lo[9], C)
+	C, lo[10] = madd2(t[18], 17318295036095996852, lo[10], C)
+	C, lo[11] = madd2(t[18], 12638729832353218866, lo[11], C)
+	C, lo[12] = madd2(t[18], 12856030952767240260, lo[12], C)
+	C, lo[13] = madd2(t[18], 15732028589390776959, lo[13], C)
+	C, lo[14] = madd2(t[18], 1038965607738428109, lo[14], C)
+	C, lo[15] = madd2(t[18], 8411601626847721258, lo[15], C)
+	C, lo[16] = madd2(t[18], 7016548280614581879, lo[16], C)
+	C, lo[17] = madd2(t[18], 51212299585931083, lo[17], C)
+	lo[18] = C
+	C, lo[9] = madd1(t[19], 5665001492438840506, lo[9])
+	C, lo[10] = madd2(t[19], 16907884053554239805, lo[10], C)
+	C, lo[11] = madd2(t[19], 17318295036095996852, lo[11], C)
+	C, lo[12] = madd2(t[19], 12638729832353218866, lo[12], C)
+	C, lo[13] = madd2(t[19], 12856030952767240260, lo[13], C)
+	C, lo[14] = madd2(t[19], 15732028589390776959, lo[14], C)
+	C, lo[15] = madd2(t[19], 1038965607738428109, lo[15], C)
+	C, lo[16] = madd2(t[19], 8411601626847721258, lo[16], C)
+	C, lo[17] = madd2(t[19], 7016548280614581879, lo[17], C)
+	C, lo[18] = madd2(t[19], 51212299585931083, lo[18], C)
+	lo[19] = C
+	montReduceWide(&lo)
+
+	copy(z[:], lo[10:20])
+	if lo[20] != 0 {
+		// z + 2**640 - q
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 15512955586897510413, 0)
+		z[1], b = bits.Sub64(z[1], 4410884215886313276, b)
+		z[2], b = bits.Sub64(z[2], 15543556715411259941, b)
+		z[3], b = bits.Sub64(z[3], 9083347379620258823, b)
+		z[4], b = bits.Sub64(z[4], 13320134076191308873, b)
+		z[5], b = bits.Sub64(z[5], 9318693926755804304, b)
+		z[6], b = bits.Sub64(z[6], 5645674015335635503, b)
+		z[7], b = bits.Sub64(z[7], 12176845843281334983, b)
+		z[8], b = bits.Sub64(z[8], 18165857675053050549, b)
+		z[9], b = bits.Sub64(z[9], 82862755739295587, b)
+	} else {
+
+		// if z > q --> z -= q
+		// note: this is NOT constant time
+		if !(z[9] < 82862755739295587 || (z[9] == 82862755739295587 && (z[8] < 18165857675053050549 || (z[8] == 18165857675053050549 && (z[7] < 12176845843281334983 || (z[7] == 12176845843281334983 && (z[6] < 5645674015335635503 || (z[6] == 5645674015335635503 && (z[5] < 9318693926755804304 || (z[5] == 9318693926755804304 && (z[4] < 13320134076191308873 || (z[4] == 13320134076191308873 && (z[3] < 9083347379620258823 || (z[3] == 9083347379620258823 && (z[2] < 15543556715411259941 || (z[2] == 15543556715411259941 && (z[1] < 4410884215886313276 || (z[1] == 4410884215886313276 && (z[0] < 15512955586897510413))))))))))))))))))) {
+			var b uint64
+			z[0], b = bits.Sub64(z[0], 15512955586897510413, 0)
+			z[1], b = bits.Sub64(z[1], 4410884215886313276, b)
+			z[2], b = bits.Sub64(z[2], 15543556715411259941, b)
+			z[3], b = bits.Sub64(z[3], 9083347379620258823, b)
+			z[4], b = bits.Sub64(z[4], 13320134076191308873, b)
+			z[5], b = bits.Sub64(z[5], 9318693926755804304, b)
+			z[6], b = bits.Sub64(z[6], 5645674015335635503, b)
+			z[7], b = bits.Sub64(z[7], 12176845843281334983, b)
+			z[8], b = bits.Sub64(z[8], 18165857675053050549, b)
+			z[9], _ = bits.Sub64(z[9], 82862755739295587, b)
+		}
+	}
+
+	// vHi * R mod q is the Montgomery form of vHi
+	if t[20] != 0 {
+		var hi Element
+		hi.SetUint64(t[20])
+		z.Add(&z, &hi)
+	}
+
+	return
+}
+
+// montReduceWide sets t[10:] = t * R**-1 mod q, with t[10:] < t / R + q;
+// t must be smaller than 2**1280 * 2**63 so that the result fits on 11 words
+func montReduceWide(t *[21]uint64) {
+	for i := 0; i < 10; i++ {
+		// m = t[i]n'[0] mod W
+		m := t[i] * 13046692460116554043
+		C := madd0(m, 15512955586897510413, t[i])
+		C, t[i+1] = madd2(m, 4410884215886313276, t[i+1], C)
+		C, t[i+2] = madd2(m, 15543556715411259941, t[i+2], C)
+		C, t[i+3] = madd2(m, 9083347379620258823, t[i+3], C)
+		C, t[i+4] = madd2(m, 13320134076191308873, t[i+4], C)
+		C, t[i+5] = madd2(m, 9318693926755804304, t[i+5], C)
+		C, t[i+6] = madd2(m, 5645674015335635503, t[i+6], C)
+		C, t[i+7] = madd2(m, 12176845843281334983, t[i+7], C)
+		C, t[i+8] = madd2(m, 18165857675053050549, t[i+8], C)
+		C, t[i+9] = madd2(m, 82862755739295587, t[i+9], C)
+		for k := i + 10; k < len(t); k++ {
+			t[k], C = bits.Add64(t[k], C, 0)
+		}
+	}
+}
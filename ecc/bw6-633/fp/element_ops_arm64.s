@@ -0,0 +1,2715 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0xd74916ea4570000d
+DATA q<>+8(SB)/8, $0x3d369bd31147f73c
+DATA q<>+16(SB)/8, $0xd7b5ce7ab839c225
+DATA q<>+24(SB)/8, $0x7e0e8850edbda407
+DATA q<>+32(SB)/8, $0xb8da9f5e83f57c49
+DATA q<>+40(SB)/8, $0x8152a6c0fadea490
+DATA q<>+48(SB)/8, $0x4e59769ad9bbda2f
+DATA q<>+56(SB)/8, $0xa8fcd8c75d79d2c7
+DATA q<>+64(SB)/8, $0xfc1a174f01d72ab5
+DATA q<>+72(SB)/8, $0x0126633cc0f35f63
+GLOBL q<>(SB), (RODATA+NOPTR), $80
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0xb50f29ab0b03b13b
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+// add(res, x, y *Element)
+TEXT ·add(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   32(R0), (R6, R7)
+	LDP   48(R0), (R8, R9)
+	LDP   64(R0), (R10, R11)
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R12, R13)
+	ADDS  R12, R2, R2
+	ADCS  R13, R3, R3
+	LDP   16(R1), (R12, R13)
+	ADCS  R12, R4, R4
+	ADCS  R13, R5, R5
+	LDP   32(R1), (R12, R13)
+	ADCS  R12, R6, R6
+	ADCS  R13, R7, R7
+	LDP   48(R1), (R12, R13)
+	ADCS  R12, R8, R8
+	ADCS  R13, R9, R9
+	LDP   64(R1), (R12, R13)
+	ADCS  R12, R10, R10
+	ADCS  R13, R11, R11
+	MOVD  $q<>(SB), R14
+	LDP   0(R14), (R16, R17)
+	SUBS  R16, R2, R19
+	SBCS  R17, R3, R19
+	LDP   16(R14), (R16, R17)
+	SBCS  R16, R4, R19
+	SBCS  R17, R5, R19
+	LDP   32(R14), (R16, R17)
+	SBCS  R16, R6, R19
+	SBCS  R17, R7, R19
+	LDP   48(R14), (R16, R17)
+	SBCS  R16, R8, R19
+	SBCS  R17, R9, R19
+	LDP   64(R14), (R16, R17)
+	SBCS  R16, R10, R19
+	SBCS  R17, R11, R19
+	CSETM CS, R15
+	LDP   0(R14), (R16, R17)
+	AND   R15, R16, R16
+	SUBS  R16, R2, R2
+	AND   R15, R17, R17
+	SBCS  R17, R3, R3
+	LDP   16(R14), (R16, R17)
+	AND   R15, R16, R16
+	SBCS  R16, R4, R4
+	AND   R15, R17, R17
+	SBCS  R17, R5, R5
+	LDP   32(R14), (R16, R17)
+	AND   R15, R16, R16
+	SBCS  R16, R6, R6
+	AND   R15, R17, R17
+	SBCS  R17, R7, R7
+	LDP   48(R14), (R16, R17)
+	AND   R15, R16, R16
+	SBCS  R16, R8, R8
+	AND   R15, R17, R17
+	SBCS  R17, R9, R9
+	LDP   64(R14), (R16, R17)
+	AND   R15, R16, R16
+	SBCS  R16, R10, R10
+	AND   R15, R17, R17
+	SBCS  R17, R11, R11
+	MOVD  res+0(FP), R20
+	STP   (R2, R3), 0(R20)
+	STP   (R4, R5), 16(R20)
+	STP   (R6, R7), 32(R20)
+	STP   (R8, R9), 48(R20)
+	STP   (R10, R11), 64(R20)
+	RET
+
+// sub(res, x, y *Element)
+TEXT ·sub(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   32(R0), (R6, R7)
+	LDP   48(R0), (R8, R9)
+	LDP   64(R0), (R10, R11)
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R12, R13)
+	SUBS  R12, R2, R2
+	SBCS  R13, R3, R3
+	LDP   16(R1), (R12, R13)
+	SBCS  R12, R4, R4
+	SBCS  R13, R5, R5
+	LDP   32(R1), (R12, R13)
+	SBCS  R12, R6, R6
+	SBCS  R13, R7, R7
+	LDP   48(R1), (R12, R13)
+	SBCS  R12, R8, R8
+	SBCS  R13, R9, R9
+	LDP   64(R1), (R12, R13)
+	SBCS  R12, R10, R10
+	SBCS  R13, R11, R11
+	CSETM CC, R14
+	MOVD  $q<>(SB), R15
+	LDP   0(R15), (R16, R17)
+	AND   R14, R16, R16
+	ADDS  R16, R2, R2
+	AND   R14, R17, R17
+	ADCS  R17, R3, R3
+	LDP   16(R15), (R16, R17)
+	AND   R14, R16, R16
+	ADCS  R16, R4, R4
+	AND   R14, R17, R17
+	ADCS  R17, R5, R5
+	LDP   32(R15), (R16, R17)
+	AND   R14, R16, R16
+	ADCS  R16, R6, R6
+	AND   R14, R17, R17
+	ADCS  R17, R7, R7
+	LDP   48(R15), (R16, R17)
+	AND   R14, R16, R16
+	ADCS  R16, R8, R8
+	AND   R14, R17, R17
+	ADCS  R17, R9, R9
+	LDP   64(R15), (R16, R17)
+	AND   R14, R16, R16
+	ADCS  R16, R10, R10
+	AND   R14, R17, R17
+	ADCS  R17, R11, R11
+	MOVD  res+0(FP), R19
+	STP   (R2, R3), 0(R19)
+	STP   (R4, R5), 16(R19)
+	STP   (R6, R7), 32(R19)
+	STP   (R8, R9), 48(R19)
+	STP   (R10, R11), 64(R19)
+	RET
+
+// double(res, x *Element)
+TEXT ·double(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	LDP   48(R0), (R7, R8)
+	LDP   64(R0), (R9, R10)
+	ADDS  R1, R1, R1
+	ADCS  R2, R2, R2
+	ADCS  R3, R3, R3
+	ADCS  R4, R4, R4
+	ADCS  R5, R5, R5
+	ADCS  R6, R6, R6
+	ADCS  R7, R7, R7
+	ADCS  R8, R8, R8
+	ADCS  R9, R9, R9
+	ADCS  R10, R10, R10
+	MOVD  $q<>(SB), R11
+	LDP   0(R11), (R13, R14)
+	SUBS  R13, R1, R15
+	SBCS  R14, R2, R15
+	LDP   16(R11), (R13, R14)
+	SBCS  R13, R3, R15
+	SBCS  R14, R4, R15
+	LDP   32(R11), (R13, R14)
+	SBCS  R13, R5, R15
+	SBCS  R14, R6, R15
+	LDP   48(R11), (R13, R14)
+	SBCS  R13, R7, R15
+	SBCS  R14, R8, R15
+	LDP   64(R11), (R13, R14)
+	SBCS  R13, R9, R15
+	SBCS  R14, R10, R15
+	CSETM CS, R12
+	LDP   0(R11), (R13, R14)
+	AND   R12, R13, R13
+	SUBS  R13, R1, R1
+	AND   R12, R14, R14
+	SBCS  R14, R2, R2
+	LDP   16(R11), (R13, R14)
+	AND   R12, R13, R13
+	SBCS  R13, R3, R3
+	AND   R12, R14, R14
+	SBCS  R14, R4, R4
+	LDP   32(R11), (R13, R14)
+	AND   R12, R13, R13
+	SBCS  R13, R5, R5
+	AND   R12, R14, R14
+	SBCS  R14, R6, R6
+	LDP   48(R11), (R13, R14)
+	AND   R12, R13, R13
+	SBCS  R13, R7, R7
+	AND   R12, R14, R14
+	SBCS  R14, R8, R8
+	LDP   64(R11), (R13, R14)
+	AND   R12, R13, R13
+	SBCS  R13, R9, R9
+	AND   R12, R14, R14
+	SBCS  R14, R10, R10
+	MOVD  res+0(FP), R16
+	STP   (R1, R2), 0(R16)
+	STP   (R3, R4), 16(R16)
+	STP   (R5, R6), 32(R16)
+	STP   (R7, R8), 48(R16)
+	STP   (R9, R10), 64(R16)
+	RET
+
+// neg(res, x *Element)
+TEXT ·neg(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	LDP   48(R0), (R7, R8)
+	LDP   64(R0), (R9, R10)
+	ORR   R1, R2, R13
+	ORR   R3, R13, R13
+	ORR   R4, R13, R13
+	ORR   R5, R13, R13
+	ORR   R6, R13, R13
+	ORR   R7, R13, R13
+	ORR   R8, R13, R13
+	ORR   R9, R13, R13
+	ORR   R10, R13, R13
+	CMP   $0, R13
+	CSETM NE, R13
+	MOVD  $q<>(SB), R0
+	LDP   0(R0), (R11, R12)
+	SUBS  R1, R11, R1
+	SBCS  R2, R12, R2
+	LDP   16(R0), (R11, R12)
+	SBCS  R3, R11, R3
+	SBCS  R4, R12, R4
+	LDP   32(R0), (R11, R12)
+	SBCS  R5, R11, R5
+	SBCS  R6, R12, R6
+	LDP   48(R0), (R11, R12)
+	SBCS  R7, R11, R7
+	SBCS  R8, R12, R8
+	LDP   64(R0), (R11, R12)
+	SBCS  R9, R11, R9
+	SBCS  R10, R12, R10
+	AND   R13, R1, R1
+	AND   R13, R2, R2
+	AND   R13, R3, R3
+	AND   R13, R4, R4
+	AND   R13, R5, R5
+	AND   R13, R6, R6
+	AND   R13, R7, R7
+	AND   R13, R8, R8
+	AND   R13, R9, R9
+	AND   R13, R10, R10
+	MOVD  res+0(FP), R0
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	STP   (R7, R8), 48(R0)
+	STP   (R9, R10), 64(R0)
+	RET
+
+// reduce(res *Element)
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD  res+0(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	LDP   48(R0), (R7, R8)
+	LDP   64(R0), (R9, R10)
+	MOVD  $q<>(SB), R11
+	LDP   0(R11), (R13, R14)
+	SUBS  R13, R1, R15
+	SBCS  R14, R2, R15
+	LDP   16(R11), (R13, R14)
+	SBCS  R13, R3, R15
+	SBCS  R14, R4, R15
+	LDP   32(R11), (R13, R14)
+	SBCS  R13, R5, R15
+	SBCS  R14, R6, R15
+	LDP   48(R11), (R13, R14)
+	SBCS  R13, R7, R15
+	SBCS  R14, R8, R15
+	LDP   64(R11), (R13, R14)
+	SBCS  R13, R9, R15
+	SBCS  R14, R10, R15
+	CSETM CS, R12
+	LDP   0(R11), (R13, R14)
+	AND   R12, R13, R13
+	SUBS  R13, R1, R1
+	AND   R12, R14, R14
+	SBCS  R14, R2, R2
+	LDP   16(R11), (R13, R14)
+	AND   R12, R13, R13
+	SBCS  R13, R3, R3
+	AND   R12, R14, R14
+	SBCS  R14, R4, R4
+	LDP   32(R11), (R13, R14)
+	AND   R12, R13, R13
+	SBCS  R13, R5, R5
+	AND   R12, R14, R14
+	SBCS  R14, R6, R6
+	LDP   48(R11), (R13, R14)
+	AND   R12, R13, R13
+	SBCS  R13, R7, R7
+	AND   R12, R14, R14
+	SBCS  R14, R8, R8
+	LDP   64(R11), (R13, R14)
+	AND   R12, R13, R13
+	SBCS  R13, R9, R9
+	AND   R12, R14, R14
+	SBCS  R14, R10, R10
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	STP   (R7, R8), 48(R0)
+	STP   (R9, R10), 64(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD  a+0(FP), R0
+	MOVD  b+8(FP), R1
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   32(R0), (R6, R7)
+	LDP   48(R0), (R8, R9)
+	LDP   64(R0), (R10, R11)
+	LDP   0(R1), (R12, R13)
+	ADDS  R12, R2, R12
+	ADCS  R13, R3, R13
+	STP   (R12, R13), 0(R0)
+	LDP   16(R1), (R12, R13)
+	ADCS  R12, R4, R12
+	ADCS  R13, R5, R13
+	STP   (R12, R13), 16(R0)
+	LDP   32(R1), (R12, R13)
+	ADCS  R12, R6, R12
+	ADCS  R13, R7, R13
+	STP   (R12, R13), 32(R0)
+	LDP   48(R1), (R12, R13)
+	ADCS  R12, R8, R12
+	ADCS  R13, R9, R13
+	STP   (R12, R13), 48(R0)
+	LDP   64(R1), (R12, R13)
+	ADCS  R12, R10, R12
+	ADCS  R13, R11, R13
+	STP   (R12, R13), 64(R0)
+	MOVD  $q<>(SB), R14
+	LDP   0(R0), (R16, R17)
+	LDP   0(R14), (R19, R20)
+	SUBS  R19, R16, R16
+	SBCS  R20, R17, R17
+	LDP   16(R0), (R16, R17)
+	LDP   16(R14), (R19, R20)
+	SBCS  R19, R16, R16
+	SBCS  R20, R17, R17
+	LDP   32(R0), (R16, R17)
+	LDP   32(R14), (R19, R20)
+	SBCS  R19, R16, R16
+	SBCS  R20, R17, R17
+	LDP   48(R0), (R16, R17)
+	LDP   48(R14), (R19, R20)
+	SBCS  R19, R16, R16
+	SBCS  R20, R17, R17
+	LDP   64(R0), (R16, R17)
+	LDP   64(R14), (R19, R20)
+	SBCS  R19, R16, R16
+	SBCS  R20, R17, R17
+	CSETM CS, R15
+	LDP   0(R0), (R16, R17)
+	LDP   0(R14), (R19, R20)
+	AND   R15, R19, R19
+	SUBS  R19, R16, R16
+	AND   R15, R20, R20
+	SBCS  R20, R17, R17
+	STP   (R16, R17), 0(R0)
+	LDP   16(R0), (R16, R17)
+	LDP   16(R14), (R19, R20)
+	AND   R15, R19, R19
+	SBCS  R19, R16, R16
+	AND   R15, R20, R20
+	SBCS  R20, R17, R17
+	STP   (R16, R17), 16(R0)
+	LDP   32(R0), (R16, R17)
+	LDP   32(R14), (R19, R20)
+	AND   R15, R19, R19
+	SBCS  R19, R16, R16
+	AND   R15, R20, R20
+	SBCS  R20, R17, R17
+	STP   (R16, R17), 32(R0)
+	LDP   48(R0), (R16, R17)
+	LDP   48(R14), (R19, R20)
+	AND   R15, R19, R19
+	SBCS  R19, R16, R16
+	AND   R15, R20, R20
+	SBCS  R20, R17, R17
+	STP   (R16, R17), 48(R0)
+	LDP   64(R0), (R16, R17)
+	LDP   64(R14), (R19, R20)
+	AND   R15, R19, R19
+	SBCS  R19, R16, R16
+	AND   R15, R20, R20
+	SBCS  R20, R17, R17
+	STP   (R16, R17), 64(R0)
+	LDP   0(R1), (R12, R13)
+	SUBS  R12, R2, R12
+	SBCS  R13, R3, R13
+	STP   (R12, R13), 0(R1)
+	LDP   16(R1), (R12, R13)
+	SBCS  R12, R4, R12
+	SBCS  R13, R5, R13
+	STP   (R12, R13), 16(R1)
+	LDP   32(R1), (R12, R13)
+	SBCS  R12, R6, R12
+	SBCS  R13, R7, R13
+	STP   (R12, R13), 32(R1)
+	LDP   48(R1), (R12, R13)
+	SBCS  R12, R8, R12
+	SBCS  R13, R9, R13
+	STP   (R12, R13), 48(R1)
+	LDP   64(R1), (R12, R13)
+	SBCS  R12, R10, R12
+	SBCS  R13, R11, R13
+	STP   (R12, R13), 64(R1)
+	CSETM CC, R21
+	MOVD  $q<>(SB), R22
+	LDP   0(R1), (R12, R13)
+	LDP   0(R22), (R23, R24)
+	AND   R21, R23, R23
+	ADDS  R23, R12, R12
+	AND   R21, R24, R24
+	ADCS  R24, R13, R13
+	STP   (R12, R13), 0(R1)
+	LDP   16(R1), (R12, R13)
+	LDP   16(R22), (R23, R24)
+	AND   R21, R23, R23
+	ADCS  R23, R12, R12
+	AND   R21, R24, R24
+	ADCS  R24, R13, R13
+	STP   (R12, R13), 16(R1)
+	LDP   32(R1), (R12, R13)
+	LDP   32(R22), (R23, R24)
+	AND   R21, R23, R23
+	ADCS  R23, R12, R12
+	AND   R21, R24, R24
+	ADCS  R24, R13, R13
+	STP   (R12, R13), 32(R1)
+	LDP   48(R1), (R12, R13)
+	LDP   48(R22), (R23, R24)
+	AND   R21, R23, R23
+	ADCS  R23, R12, R12
+	AND   R21, R24, R24
+	ADCS  R24, R13, R13
+	STP   (R12, R13), 48(R1)
+	LDP   64(R1), (R12, R13)
+	LDP   64(R22), (R23, R24)
+	AND   R21, R23, R23
+	ADCS  R23, R12, R12
+	AND   R21, R24, R24
+	ADCS  R24, R13, R13
+	STP   (R12, R13), 64(R1)
+	RET
+
+// mul(res, x, y *Element)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	MOVD y+16(FP), R1
+	MOVD $q<>(SB), R2
+	MOVD qInv0<>(SB), R3
+
+	// round 0
+	MOVD  0(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R21
+	UMULH R14, R15, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+
+	// round 1
+	MOVD  8(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R4, R22, R21
+	ADC   ZR, R23, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R5, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R6, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R7, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R8, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R9, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R10, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R11, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R12, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R13, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+
+	// round 2
+	MOVD  16(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R4, R22, R21
+	ADC   ZR, R23, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R5, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R6, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R7, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R8, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R9, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R10, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R11, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R12, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R13, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+
+	// round 3
+	MOVD  24(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R4, R22, R21
+	ADC   ZR, R23, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R5, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R6, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R7, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R8, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R9, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R10, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R11, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R12, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R13, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+
+	// round 4
+	MOVD  32(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R4, R22, R21
+	ADC   ZR, R23, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R5, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R6, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R7, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R8, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R9, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R10, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R11, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R12, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R13, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+
+	// round 5
+	MOVD  40(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R4, R22, R21
+	ADC   ZR, R23, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R5, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R6, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R7, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R8, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R9, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R10, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R11, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R12, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R13, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+
+	// round 6
+	MOVD  48(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R4, R22, R21
+	ADC   ZR, R23, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R5, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R6, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R7, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R8, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R9, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R10, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R11, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R12, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R13, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+
+	// round 7
+	MOVD  56(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R4, R22, R21
+	ADC   ZR, R23, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R5, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R6, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R7, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R8, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R9, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R10, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R11, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R12, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R13, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+
+	// round 8
+	MOVD  64(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R4, R22, R21
+	ADC   ZR, R23, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R5, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R6, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R7, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R8, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R9, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R10, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R11, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R12, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R13, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+
+	// round 9
+	MOVD  72(R0), R14
+	MOVD  0(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R4, R22, R21
+	ADC   ZR, R23, R19
+	MUL   R3, R21, R17
+	MOVD  0(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R21, R22, R22
+	ADC   ZR, R23, R20
+	MOVD  8(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R5, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  8(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R4
+	ADC   ZR, R23, R20
+	MOVD  16(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R6, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  16(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R5
+	ADC   ZR, R23, R20
+	MOVD  24(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R7, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  24(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R6
+	ADC   ZR, R23, R20
+	MOVD  32(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R8, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  32(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R7
+	ADC   ZR, R23, R20
+	MOVD  40(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R9, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  40(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R8
+	ADC   ZR, R23, R20
+	MOVD  48(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R10, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  48(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R9
+	ADC   ZR, R23, R20
+	MOVD  56(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R11, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  56(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R10
+	ADC   ZR, R23, R20
+	MOVD  64(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R12, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  64(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R11
+	ADC   ZR, R23, R20
+	MOVD  72(R1), R15
+	MUL   R14, R15, R22
+	UMULH R14, R15, R23
+	ADDS  R19, R22, R21
+	ADC   ZR, R23, R23
+	ADDS  R13, R21, R21
+	ADC   ZR, R23, R19
+	MOVD  72(R2), R16
+	MUL   R17, R16, R22
+	UMULH R17, R16, R23
+	ADDS  R20, R22, R22
+	ADC   ZR, R23, R23
+	ADDS  R21, R22, R12
+	ADC   ZR, R23, R20
+	ADD   R19, R20, R13
+	MOVD  $q<>(SB), R24
+	LDP   0(R24), (R26, R0)
+	SUBS  R26, R4, R1
+	SBCS  R0, R5, R1
+	LDP   16(R24), (R26, R0)
+	SBCS  R26, R6, R1
+	SBCS  R0, R7, R1
+	LDP   32(R24), (R26, R0)
+	SBCS  R26, R8, R1
+	SBCS  R0, R9, R1
+	LDP   48(R24), (R26, R0)
+	SBCS  R26, R10, R1
+	SBCS  R0, R11, R1
+	LDP   64(R24), (R26, R0)
+	SBCS  R26, R12, R1
+	SBCS  R0, R13, R1
+	CSETM CS, R25
+	LDP   0(R24), (R26, R0)
+	AND   R25, R26, R26
+	SUBS  R26, R4, R4
+	AND   R25, R0, R0
+	SBCS  R0, R5, R5
+	LDP   16(R24), (R26, R0)
+	AND   R25, R26, R26
+	SBCS  R26, R6, R6
+	AND   R25, R0, R0
+	SBCS  R0, R7, R7
+	LDP   32(R24), (R26, R0)
+	AND   R25, R26, R26
+	SBCS  R26, R8, R8
+	AND   R25, R0, R0
+	SBCS  R0, R9, R9
+	LDP   48(R24), (R26, R0)
+	AND   R25, R26, R26
+	SBCS  R26, R10, R10
+	AND   R25, R0, R0
+	SBCS  R0, R11, R11
+	LDP   64(R24), (R26, R0)
+	AND   R25, R26, R26
+	SBCS  R26, R12, R12
+	AND   R25, R0, R0
+	SBCS  R0, R13, R13
+	MOVD  res+0(FP), R2
+	STP   (R4, R5), 0(R2)
+	STP   (R6, R7), 16(R2)
+	STP   (R8, R9), 32(R2)
+	STP   (R10, R11), 48(R2)
+	STP   (R12, R13), 64(R2)
+	RET
+
+// fromMont(res *Element)
+TEXT ·fromMont(SB), NOSPLIT, $0-8
+	MOVD res+0(FP), R0
+	MOVD $q<>(SB), R1
+	MOVD qInv0<>(SB), R2
+	LDP  0(R0), (R3, R4)
+	LDP  16(R0), (R5, R6)
+	LDP  32(R0), (R7, R8)
+	LDP  48(R0), (R9, R10)
+	LDP  64(R0), (R11, R12)
+
+	// round 0
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+
+	// round 1
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+
+	// round 2
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+
+	// round 3
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+
+	// round 4
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+
+	// round 5
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+
+	// round 6
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+
+	// round 7
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+
+	// round 8
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+
+	// round 9
+	MUL   R2, R3, R14
+	MOVD  0(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R3, R16, R16
+	ADC   ZR, R17, R15
+	MOVD  8(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R4, R16, R3
+	ADC   ZR, R17, R15
+	MOVD  16(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R5, R16, R4
+	ADC   ZR, R17, R15
+	MOVD  24(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R6, R16, R5
+	ADC   ZR, R17, R15
+	MOVD  32(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R7, R16, R6
+	ADC   ZR, R17, R15
+	MOVD  40(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R8, R16, R7
+	ADC   ZR, R17, R15
+	MOVD  48(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R9, R16, R8
+	ADC   ZR, R17, R15
+	MOVD  56(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R10, R16, R9
+	ADC   ZR, R17, R15
+	MOVD  64(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R11, R16, R10
+	ADC   ZR, R17, R15
+	MOVD  72(R1), R13
+	MUL   R14, R13, R16
+	UMULH R14, R13, R17
+	ADDS  R15, R16, R16
+	ADC   ZR, R17, R17
+	ADDS  R12, R16, R11
+	ADC   ZR, R17, R15
+	MOVD  R15, R12
+	MOVD  $q<>(SB), R19
+	LDP   0(R19), (R21, R22)
+	SUBS  R21, R3, R23
+	SBCS  R22, R4, R23
+	LDP   16(R19), (R21, R22)
+	SBCS  R21, R5, R23
+	SBCS  R22, R6, R23
+	LDP   32(R19), (R21, R22)
+	SBCS  R21, R7, R23
+	SBCS  R22, R8, R23
+	LDP   48(R19), (R21, R22)
+	SBCS  R21, R9, R23
+	SBCS  R22, R10, R23
+	LDP   64(R19), (R21, R22)
+	SBCS  R21, R11, R23
+	SBCS  R22, R12, R23
+	CSETM CS, R20
+	LDP   0(R19), (R21, R22)
+	AND   R20, R21, R21
+	SUBS  R21, R3, R3
+	AND   R20, R22, R22
+	SBCS  R22, R4, R4
+	LDP   16(R19), (R21, R22)
+	AND   R20, R21, R21
+	SBCS  R21, R5, R5
+	AND   R20, R22, R22
+	SBCS  R22, R6, R6
+	LDP   32(R19), (R21, R22)
+	AND   R20, R21, R21
+	SBCS  R21, R7, R7
+	AND   R20, R22, R22
+	SBCS  R22, R8, R8
+	LDP   48(R19), (R21, R22)
+	AND   R20, R21, R21
+	SBCS  R21, R9, R9
+	AND   R20, R22, R22
+	SBCS  R22, R10, R10
+	LDP   64(R19), (R21, R22)
+	AND   R20, R21, R21
+	SBCS  R21, R11, R11
+	AND   R20, R22, R22
+	SBCS  R22, R12, R12
+	STP   (R3, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	STP   (R7, R8), 32(R0)
+	STP   (R9, R10), 48(R0)
+	STP   (R11, R12), 64(R0)
+	RET
+
@@ -25,10 +25,12 @@ import (
 // GT target group of the pairing
 type GT = fptower.E6
 
-type lineEvaluation struct {
-	r0 fp.Element
-	r1 fp.Element
-	r2 fp.Element
+// LineEvaluation holds the three non-zero coefficients of a line of the Miller loop,
+// as multiplied into the accumulator with GT.MulBy014
+type LineEvaluation struct {
+	R0 fp.Element
+	R1 fp.Element
+	R2 fp.Element
 }
 
 // Pair calculates the reduced pairing for a set of points
@@ -61,15 +63,31 @@ func FinalExponentiation(z *GT, _z ...*GT) GT {
 		result.Mul(&result, e)
 	}
 
-	var buf GT
+	result = FinalExponentiationEasy(&result)
+	return FinalExponentiationHard(&result)
+}
+
+// FinalExponentiationEasy computes the easy part x**((p**3-1)(p+1)) of the final expo.
+// The result lies in the cyclotomic subgroup of GT.
+func FinalExponentiationEasy(z *GT) GT {
 
-	// easy part exponent: (p**3 - 1)*(p+1)
-	buf.Conjugate(&result)
-	result.Inverse(&result)
+	var result, buf GT
+	buf.Conjugate(z)
+	result.Inverse(z)
 	buf.Mul(&buf, &result)
 	result.Frobenius(&buf).
 		Mul(&result, &buf)
 
+	return result
+}
+
+// FinalExponentiationHard computes the hard part x**(c*(p**2-p+1)/r) of the final expo.
+// z must lie in the cyclotomic subgroup of GT, e.g. be the output of FinalExponentiationEasy.
+func FinalExponentiationHard(z *GT) GT {
+
+	var result, buf GT
+	result.Set(z)
+
 	// hard part exponent: 3(u+1)(p^2-p+1)/r
 	var m [11]GT
 	var f10, _m1, _m3, _m4, _m5, _m7, _m8, _m8m5, _m6, f11, f11f10, f12, f1, f1u, f1q, f1a GT
@@ -199,7 +217,7 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	// f_{u+1,Q}(P)
 	var result1 GT
 	result1.SetOne()
-	var l lineEvaluation
+	var l LineEvaluation
 
 	for i := 31; i >= 0; i-- {
 		result1.Square(&result1)
@@ -207,23 +225,23 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		for k := 0; k < n; k++ {
 			qProj1[k].DoubleStep(&l)
 			// line evaluation
-			l.r1.Mul(&l.r1, &p[k].X)
-			l.r2.Mul(&l.r2, &p[k].Y)
-			result1.MulBy014(&l.r0, &l.r1, &l.r2)
+			l.R1.Mul(&l.R1, &p[k].X)
+			l.R2.Mul(&l.R2, &p[k].Y)
+			result1.MulBy014(&l.R0, &l.R1, &l.R2)
 
 			if loopCounter1[i] == 1 {
 				qProj1[k].AddMixedStep(&l, &q[k])
 				// line evaluation
-				l.r1.Mul(&l.r1, &p[k].X)
-				l.r2.Mul(&l.r2, &p[k].Y)
-				result1.MulBy014(&l.r0, &l.r1, &l.r2)
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result1.MulBy014(&l.R0, &l.R1, &l.R2)
 
 			} else if loopCounter1[i] == -1 {
 				qProj1[k].AddMixedStep(&l, &qNeg[k])
 				// line evaluation
-				l.r1.Mul(&l.r1, &p[k].X)
-				l.r2.Mul(&l.r2, &p[k].Y)
-				result1.MulBy014(&l.r0, &l.r1, &l.r2)
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result1.MulBy014(&l.R0, &l.R1, &l.R2)
 			}
 		}
 	}
@@ -240,23 +258,23 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		for k := 0; k < n; k++ {
 			qProj2[k].DoubleStep(&l)
 			// line evaluation
-			l.r1.Mul(&l.r1, &p[k].X)
-			l.r2.Mul(&l.r2, &p[k].Y)
-			result2.MulBy014(&l.r0, &l.r1, &l.r2)
+			l.R1.Mul(&l.R1, &p[k].X)
+			l.R2.Mul(&l.R2, &p[k].Y)
+			result2.MulBy014(&l.R0, &l.R1, &l.R2)
 
 			if loopCounter2[i] == 1 {
 				qProj2[k].AddMixedStep(&l, &q[k])
 				// line evaluation
-				l.r1.Mul(&l.r1, &p[k].X)
-				l.r2.Mul(&l.r2, &p[k].Y)
-				result2.MulBy014(&l.r0, &l.r1, &l.r2)
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result2.MulBy014(&l.R0, &l.R1, &l.R2)
 
 			} else if loopCounter2[i] == -1 {
 				qProj2[k].AddMixedStep(&l, &qNeg[k])
 				// line evaluation
-				l.r1.Mul(&l.r1, &p[k].X)
-				l.r2.Mul(&l.r2, &p[k].Y)
-				result2.MulBy014(&l.r0, &l.r1, &l.r2)
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result2.MulBy014(&l.R0, &l.R1, &l.R2)
 			}
 		}
 	}
@@ -269,9 +287,95 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	return result1, nil
 }
 
+// MillerLoopStep records a line multiplication of the Miller loop
+type MillerLoopStep struct {
+	Line        LineEvaluation // line as output by the doubling or addition step
+	Evaluation  LineEvaluation // Line evaluated at the G1 point
+	Accumulator GT             // Miller loop accumulator once Evaluation is multiplied in
+}
+
+// MillerLoopSteps runs the Miller loop on a single pair (P, Q) and returns, in order,
+// every line multiplied into the accumulator along with the resulting accumulator.
+// The loop is split in two Miller loops, f_{u+1,Q} then f_{u^5-u^4-u,Q}, each with its own
+// accumulator; they are combined into the result once both are computed.
+// The returned GT equals MillerLoop([]G1Affine{P}, []G2Affine{Q}).
+// If P or Q is the point at infinity, no step is recorded and the result is one.
+func MillerLoopSteps(P *G1Affine, Q *G2Affine) ([]MillerLoopStep, GT) {
+	var result GT
+	result.SetOne()
+	if P.IsInfinity() || Q.IsInfinity() {
+		return nil, result
+	}
+
+	var qProj1, qProj2 g2Proj
+	var qNeg G2Affine
+	qProj1.FromAffine(Q)
+	qProj2.FromAffine(Q)
+	qNeg.Neg(Q)
+
+	var l LineEvaluation
+	steps := make([]MillerLoopStep, 0, 2*(len(loopCounter1)+len(loopCounter2)))
+	mulLine := func(acc *GT) {
+		step := MillerLoopStep{Line: l}
+		l.R1.Mul(&l.R1, &P.X)
+		l.R2.Mul(&l.R2, &P.Y)
+		step.Evaluation = l
+		acc.MulBy014(&l.R0, &l.R1, &l.R2)
+		step.Accumulator = *acc
+		steps = append(steps, step)
+	}
+
+	// f_{u+1,Q}(P)
+	var result1 GT
+	result1.SetOne()
+
+	for i := 31; i >= 0; i-- {
+		result1.Square(&result1)
+
+		qProj1.DoubleStep(&l)
+		mulLine(&result1)
+
+		if loopCounter1[i] == 1 {
+			qProj1.AddMixedStep(&l, Q)
+			mulLine(&result1)
+		} else if loopCounter1[i] == -1 {
+			qProj1.AddMixedStep(&l, &qNeg)
+			mulLine(&result1)
+		}
+	}
+
+	result1.Conjugate(&result1)
+
+	// f_{u^5-u^4-u,Q}(P)
+	var result2 GT
+	result2.SetOne()
+
+	for i := 157; i >= 0; i-- {
+		result2.Square(&result2)
+
+		qProj2.DoubleStep(&l)
+		mulLine(&result2)
+
+		if loopCounter2[i] == 1 {
+			qProj2.AddMixedStep(&l, Q)
+			mulLine(&result2)
+		} else if loopCounter2[i] == -1 {
+			qProj2.AddMixedStep(&l, &qNeg)
+			mulLine(&result2)
+		}
+	}
+
+	result2.Conjugate(&result2)
+
+	result.Frobenius(&result1).
+		Mul(&result, &result2)
+
+	return steps, result
+}
+
 // DoubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) DoubleStep(evaluations *lineEvaluation) {
+func (p *g2Proj) DoubleStep(evaluations *LineEvaluation) {
 
 	// get some Element from our pool
 	var t0, t1, A, B, C, D, E, EE, F, G, H, I, J, K fp.Element
@@ -304,15 +408,15 @@ func (p *g2Proj) DoubleStep(evaluations *lineEvaluation) {
 	p.z.Mul(&B, &H)
 
 	// Line evaluation
-	evaluations.r0.Set(&I)
-	evaluations.r1.Double(&J).
-		Add(&evaluations.r1, &J)
-	evaluations.r2.Neg(&H)
+	evaluations.R0.Set(&I)
+	evaluations.R1.Double(&J).
+		Add(&evaluations.R1, &J)
+	evaluations.R2.Neg(&H)
 }
 
 // AddMixedStep point addition in Mixed Homogenous projective and Affine coordinates
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) AddMixedStep(evaluations *lineEvaluation, a *G2Affine) {
+func (p *g2Proj) AddMixedStep(evaluations *LineEvaluation, a *G2Affine) {
 
 	// get some Element from our pool
 	var Y2Z1, X2Z1, O, L, C, D, E, F, G, H, t0, t1, t2, J fp.Element
@@ -342,7 +446,7 @@ func (p *g2Proj) AddMixedStep(evaluations *lineEvaluation, a *G2Affine) {
 		Sub(&J, &t2)
 
 	// Line evaluation
-	evaluations.r0.Set(&J)
-	evaluations.r1.Neg(&O)
-	evaluations.r2.Set(&L)
+	evaluations.R0.Set(&J)
+	evaluations.R1.Neg(&O)
+	evaluations.R2.Set(&L)
 }
@@ -40,3 +40,8 @@ func init() {
 	edwards.Base.X.SetString("37635937024655419978837220647164498012335808680404874556501960268316961933409049243153117555100")
 	edwards.Base.Y.SetString("23823085625708063001015413934245381846960101450148849601038571303382730455875805408244170280142")
 }
+
+// mulByA multiplies x by the curve coefficient a=-1
+func mulByA(x *fr.Element) {
+	x.Neg(x)
+}
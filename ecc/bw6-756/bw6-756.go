@@ -0,0 +1,150 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bw6756
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fp"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+)
+
+// E: y**2=x**3+1
+// Etwist: y**2 = x**3+33
+// Tower: Fp->Fp6, u**6=33
+// Generator (same as BLS378): x=11045256207009841153
+// optimal Ate loops: x+1, x**3-x**2-x
+// Fp: p=366325390957376286590726555727219947825377821289246188278797409783441745356050456327989347160777465284190855125642086860525706497928518803244008749360363712553766506755227344593404398783886857865261088226271336335268413437902849
+// Fr: r=605248206075306171733248481581800960739847691770924913753520744034740935903401304776283802348837311170974282940417
+// BW6-756 is the outer curve of a 2-chain with BLS12-378, cf https://eprint.iacr.org/2021/1359.pdf
+
+// ID BW6_756 ID
+const ID = ecc.BW6_756
+
+// bCurveCoeff b coeff of the curve
+var bCurveCoeff fp.Element
+
+// bTwistCurveCoeff b coeff of the twist (defined over Fp) curve
+var bTwistCurveCoeff fp.Element
+
+// twoInv 1/2 mod p (needed for DoubleStep in Miller loop)
+var twoInv fp.Element
+
+// generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+var g1Gen G1Jac
+var g2Gen G2Jac
+
+var g1GenAff G1Affine
+var g2GenAff G2Affine
+
+// point at infinity
+var g1Infinity G1Jac
+var g2Infinity G2Jac
+
+// optimal Ate loop counters
+// Miller loop 1: f(P), div(f) = (x+1)(Q)-([x+1]Q)-x(O)
+// Miller loop 2: f(P), div(f) = (x**3-x**2-x)(Q) -([x**3-x**2-x]Q)-(x**3-x**2-x-1)(O)
+var loopCounter1 [64]int8
+var loopCounter2 [191]int8
+
+// Parameters useful for the GLV scalar multiplication. The third roots define the
+//
+//	endomorphisms phi1 and phi2 for <G1Affine> and <G2Affine>. lambda is such that <r, phi-lambda> lies above
+//
+// <r> in the ring Z[phi]. More concretely it's the associated eigenvalue
+// of phi1 (resp phi2) restricted to <G1Affine> (resp <G2Affine>)
+// cf https://www.cosic.esat.kuleuven.be/nessie/reports/phase2/GLV.pdf
+var thirdRootOneG1 fp.Element
+var thirdRootOneG2 fp.Element
+var lambdaGLV big.Int
+
+// glvBasis stores R-linearly independant vectors (a,b), (c,d)
+// in ker((u,v)->u+vlambda[r]), and their determinant
+var glvBasis ecc.Lattice
+
+// c1, c2 such that c1-c2*phi has norm c1**2+c1*c2+c2**2 = r in Z[phi]
+// and c1-c2*lambdaGLV = 0 mod r. Used for subgroup membership testing (c1 < c2).
+// c1 = (xGen**3-xGen**2-2*xGen-1)/3 and c2 = (xGen**3-xGen**2+xGen+2)/3
+var c1SubGroup, c2SubGroup, c2SubGroupNeg big.Int
+
+// (a, b) such that a+b*phi = (pi-1)/(c1-c2*phi) in Z[phi], where pi is the Frobenius
+// endomorphism of E (resp. of the twist). [a]p+[b]phi(p) clears the cofactor of p.
+var g1ClearCofactorA, g1ClearCofactorB big.Int
+var g2ClearCofactorA, g2ClearCofactorB big.Int
+
+// generator of the curve
+var xGen big.Int
+
+func init() {
+
+	bCurveCoeff.SetOne()
+	bTwistCurveCoeff.SetUint64(33)
+
+	twoInv.SetOne().Double(&twoInv).Inverse(&twoInv)
+
+	// E(1,y)*cofactor
+	g1Gen.X.SetString("30018933510436695354752949572691625648965041384514015062633700131182209616808027939447871238044990552985918442775059713330718030242021195712764433245815347020653432591808475549537608786714358728529262311244866198757782727104520")
+	g1Gen.Y.SetString("363248688383036721515614534086139557508902938099351542819621653478455533601673273706272045126029541916461001146796135171538655936067856216970299909363500313338750294842212039724419527889207584320914787168002344578017328278444999")
+	g1Gen.Z.SetString("1")
+
+	// Etwist(1,y)*cofactor
+	g2Gen.X.SetString("270164867145533700243149075881223225204067215320977230235816769808318087164726583740674261721395147407122688542569094772405350936550575160051166652281373572919753182191250641388443572739372443497834910784618354592418817138212395")
+	g2Gen.Y.SetString("296695446824796322573519291690935001172593568823998954880196613542512471119971074118215403545906873458039024520146929054366200365532511334310660691775675887531695313103875249166779149013653038059140912965769351316868363001510735")
+	g2Gen.Z.SetString("1")
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	// NAF decomposition of 11045256207009841154, little endian
+	// xGen+1
+	T1, _ := new(big.Int).SetString("11045256207009841154", 10)
+	ecc.NafDecomposition(T1, loopCounter1[:])
+	// xGen^3-xGen^2-xGen
+	T2, _ := new(big.Int).SetString("1347495683935914696108087318582641220368021451587784278015", 10)
+	ecc.NafDecomposition(T2, loopCounter2[:])
+
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+	g2Infinity.X.SetOne()
+	g2Infinity.Y.SetOne()
+
+	thirdRootOneG1.SetString("366325390957376286491228983894104235578401247995384372024419936067746747087529015954240358818176612192549449571424502639070386820413133427140929911628943581538066452535964329498257769792452876112193060261058496586334166887432191")
+	thirdRootOneG2.Square(&thirdRootOneG1)
+	lambdaGLV.SetString("164391353554439166353793911729193406645071739502673898176639736370075683438438023898983435337729", 10) // (x**5-3*x**4+3*x**3-x+1)
+	_r := fr.Modulus()
+	ecc.PrecomputeLattice(_r, &lambdaGLV, &glvBasis)
+
+	xGen.SetString("11045256207009841153", 10)
+
+	c1SubGroup.SetString("449165227978638232036029106194213740118992065126924812287", 10)
+	c2SubGroup.SetString("449165227978638232036029106194213740130037321333934653441", 10)
+	c2SubGroupNeg.Neg(&c2SubGroup)
+
+	g1ClearCofactorA.SetString("-449165227978638231914031421515724317190613163557661966336", 10)
+	g1ClearCofactorB.SetString("449165227978638232036029106194213740118992065126924812288", 10)
+	g2ClearCofactorA.SetString("-898330455957276463950060527709938057309605228684586778623", 10)
+	g2ClearCofactorB.SetString("-449165227978638232036029106194213740130037321333934653440", 10)
+
+}
+
+// Generators return the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+func Generators() (g1Jac G1Jac, g2Jac G2Jac, g1Aff G1Affine, g2Aff G2Affine) {
+	g1Aff = g1GenAff
+	g2Aff = g2GenAff
+	g1Jac = g1Gen
+	g2Jac = g2Gen
+	return
+}
@@ -0,0 +1,477 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"math/bits"
+)
+
+// WideAccumulator accumulates products of Element on 24 + 1 words, without modular reduction.
+//
+// A sum of products costs one Montgomery reduction (Reduce) instead of one per product:
+//
+//	var acc WideAccumulator
+//	for i := range a {
+//		acc.MulAdd(&a[i], &b[i])
+//	}
+//	res := acc.Reduce()
+//
+// The zero value is an empty accumulator; it can hold up to 2**63 products.
+type WideAccumulator struct {
+	t [25]uint64
+}
+
+// MulAdd sets acc = acc + x * y, without reduction
+func (acc *WideAccumulator) MulAdd(x, y *Element) *WideAccumulator {
+	var p [24]uint64
+	var C uint64
+
+	// p = x * y
+	C, p[0] = bits.Mul64(x[0], y[0])
+	C, p[1] = madd1(x[0], y[1], C)
+	C, p[2] = madd1(x[0], y[2], C)
+	C, p[3] = madd1(x[0], y[3], C)
+	C, p[4] = madd1(x[0], y[4], C)
+	C, p[5] = madd1(x[0], y[5], C)
+	C, p[6] = madd1(x[0], y[6], C)
+	C, p[7] = madd1(x[0], y[7], C)
+	C, p[8] = madd1(x[0], y[8], C)
+	C, p[9] = madd1(x[0], y[9], C)
+	C, p[10] = madd1(x[0], y[10], C)
+	C, p[11] = madd1(x[0], y[11], C)
+	p[12] = C
+	C, p[1] = madd1(x[1], y[0], p[1])
+	C, p[2] = madd2(x[1], y[1], p[2], C)
+	C, p[3] = madd2(x[1], y[2], p[3], C)
+	C, p[4] = madd2(x[1], y[3], p[4], C)
+	C, p[5] = madd2(x[1], y[4], p[5], C)
+	C, p[6] = madd2(x[1], y[5], p[6], C)
+	C, p[7] = madd2(x[1], y[6], p[7], C)
+	C, p[8] = madd2(x[1], y[7], p[8], C)
+	C, p[9] = madd2(x[1], y[8], p[9], C)
+	C, p[10] = madd2(x[1], y[9], p[10], C)
+	C, p[11] = madd2(x[1], y[10], p[11], C)
+	C, p[12] = madd2(x[1], y[11], p[12], C)
+	p[13] = C
+	C, p[2] = madd1(x[2], y[0], p[2])
+	C, p[3] = madd2(x[2], y[1], p[3], C)
+	C, p[4] = madd2(x[2], y[2], p[4], C)
+	C, p[5] = madd2(x[2], y[3], p[5], C)
+	C, p[6] = madd2(x[2], y[4], p[6], C)
+	C, p[7] = madd2(x[2], y[5], p[7], C)
+	C, p[8] = madd2(x[2], y[6], p[8], C)
+	C, p[9] = madd2(x[2], y[7], p[9], C)
+	C, p[10] = madd2(x[2], y[8], p[10], C)
+	C, p[11] = madd2(x[2], y[9], p[11], C)
+	C, p[12] = madd2(x[2], y[10], p[12], C)
+	C, p[13] = madd2(x[2], y[11], p[13], C)
+	p[14] = C
+	C, p[3] = madd1(x[3], y[0], p[3])
+	C, p[4] = madd2(x[3], y[1], p[4], C)
+	C, p[5] = madd2(x[3], y[2], p[5], C)
+	C, p[6] = madd2(x[3], y[3], p[6], C)
+	C, p[7] = madd2(x[3], y[4], p[7], C)
+	C, p[8] = madd2(x[3], y[5], p[8], C)
+	C, p[9] = madd2(x[3], y[6], p[9], C)
+	C, p[10] = madd2(x[3], y[7], p[10], C)
+	C, p[11] = madd2(x[3], y[8], p[11], C)
+	C, p[12] = madd2(x[3], y[9], p[12], C)
+	C, p[13] = madd2(x[3], y[10], p[13], C)
+	C, p[14] = madd2(x[3], y[11], p[14], C)
+	p[15] = C
+	C, p[4] = madd1(x[4], y[0], p[4])
+	C, p[5] = madd2(x[4], y[1], p[5], C)
+	C, p[6] = madd2(x[4], y[2], p[6], C)
+	C, p[7] = madd2(x[4], y[3], p[7], C)
+	C, p[8] = madd2(x[4], y[4], p[8], C)
+	C, p[9] = madd2(x[4], y[5], p[9], C)
+	C, p[10] = madd2(x[4], y[6], p[10], C)
+	C, p[11] = madd2(x[4], y[7], p[11], C)
+	C, p[12] = madd2(x[4], y[8], p[12], C)
+	C, p[13] = madd2(x[4], y[9], p[13], C)
+	C, p[14] = madd2(x[4], y[10], p[14], C)
+	C, p[15] = madd2(x[4], y[11], p[15], C)
+	p[16] = C
+	C, p[5] = madd1(x[5], y[0], p[5])
+	C, p[6] = madd2(x[5], y[1], p[6], C)
+	C, p[7] = madd2(x[5], y[2], p[7], C)
+	C, p[8] = madd2(x[5], y[3], p[8], C)
+	C, p[9] = madd2(x[5], y[4], p[9], C)
+	C, p[10] = madd2(x[5], y[5], p[10], C)
+	C, p[11] = madd2(x[5], y[6], p[11], C)
+	C, p[12] = madd2(x[5], y[7], p[12], C)
+	C, p[13] = madd2(x[5], y[8], p[13], C)
+	C, p[14] = madd2(x[5], y[9], p[14], C)
+	C, p[15] = madd2(x[5], y[10], p[15], C)
+	C, p[16] = madd2(x[5], y[11], p[16], C)
+	p[17] = C
+	C, p[6] = madd1(x[6], y[0], p[6])
+	C, p[7] = madd2(x[6], y[1], p[7], C)
+	C, p[8] = madd2(x[6], y[2], p[8], C)
+	C, p[9] = madd2(x[6], y[3], p[9], C)
+	C, p[10] = madd2(x[6], y[4], p[10], C)
+	C, p[11] = madd2(x[6], y[5], p[11], C)
+	C, p[12] = madd2(x[6], y[6], p[12], C)
+	C, p[13] = madd2(x[6], y[7], p[13], C)
+	C, p[14] = madd2(x[6], y[8], p[14], C)
+	C, p[15] = madd2(x[6], y[9], p[15], C)
+	C, p[16] = madd2(x[6], y[10], p[16], C)
+	C, p[17] = madd2(x[6], y[11], p[17], C)
+	p[18] = C
+	C, p[7] = madd1(x[7], y[0], p[7])
+	C, p[8] = madd2(x[7], y[1], p[8], C)
+	C, p[9] = madd2(x[7], y[2], p[9], C)
+	C, p[10] = madd2(x[7], y[3], p[10], C)
+	C, p[11] = madd2(x[7], y[4], p[11], C)
+	C, p[12] = madd2(x[7], y[5], p[12], C)
+	C, p[13] = madd2(x[7], y[6], p[13], C)
+	C, p[14] = madd2(x[7], y[7], p[14], C)
+	C, p[15] = madd2(x[7], y[8], p[15], C)
+	C, p[16] = madd2(x[7], y[9], p[16], C)
+	C, p[17] = madd2(x[7], y[10], p[17], C)
+	C, p[18] = madd2(x[7], y[11], p[18], C)
+	p[19] = C
+	C, p[8] = madd1(x[8], y[0], p[8])
+	C, p[9] = madd2(x[8], y[1], p[9], C)
+	C, p[10] = madd2(x[8], y[2], p[10], C)
+	C, p[11] = madd2(x[8], y[3], p[11], C)
+	C, p[12] = madd2(x[8], y[4], p[12], C)
+	C, p[13] = madd2(x[8], y[5], p[13], C)
+	C, p[14] = madd2(x[8], y[6], p[14], C)
+	C, p[15] = madd2(x[8], y[7], p[15], C)
+	C, p[16] = madd2(x[8], y[8], p[16], C)
+	C, p[17] = madd2(x[8], y[9], p[17], C)
+	C, p[18] = madd2(x[8], y[10], p[18], C)
+	C, p[19] = madd2(x[8], y[11], p[19], C)
+	p[20] = C
+	C, p[9] = madd1(x[9], y[0], p[9])
+	C, p[10] = madd2(x[9], y[1], p[10], C)
+	C, p[11] = madd2(x[9], y[2], p[11], C)
+	C, p[12] = madd2(x[9], y[3], p[12], C)
+	C, p[13] = madd2(x[9], y[4], p[13], C)
+	C, p[14] = madd2(x[9], y[5], p[14], C)
+	C, p[15] = madd2(x[9], y[6], p[15], C)
+	C, p[16] = madd2(x[9], y[7], p[16], C)
+	C, p[17] = madd2(x[9], y[8], p[17], C)
+	C, p[18] = madd2(x[9], y[9], p[18], C)
+	C, p[19] = madd2(x[9], y[10], p[19], C)
+	C, p[20] = madd2(x[9], y[11], p[20], C)
+	p[21] = C
+	C, p[10] = madd1(x[10], y[0], p[10])
+	C, p[11] = madd2(x[10], y[1], p[11], C)
+	C, p[12] = madd2(x[10], y[2], p[12], C)
+	C, p[13] = madd2(x[10], y[3], p[13], C)
+	C, p[14] = madd2(x[10], y[4], p[14], C)
+	C, p[15] = madd2(x[10], y[5], p[15], C)
+	C, p[16] = madd2(x[10], y[6], p[16], C)
+	C, p[17] = madd2(x[10], y[7], p[17], C)
+	C, p[18] = madd2(x[10], y[8], p[18], C)
+	C, p[19] = madd2(x[10], y[9], p[19], C)
+	C, p[20] = madd2(x[10], y[10], p[20], C)
+	C, p[21] = madd2(x[10], y[11], p[21], C)
+	p[22] = C
+	C, p[11] = madd1(x[11], y[0], p[11])
+	C, p[12] = madd2(x[11], y[1], p[12], C)
+	C, p[13] = madd2(x[11], y[2], p[13], C)
+	C, p[14] = madd2(x[11], y[3], p[14], C)
+	C, p[15] = madd2(x[11], y[4], p[15], C)
+	C, p[16] = madd2(x[11], y[5], p[16], C)
+	C, p[17] = madd2(x[11], y[6], p[17], C)
+	C, p[18] = madd2(x[11], y[7], p[18], C)
+	C, p[19] = madd2(x[11], y[8], p[19], C)
+	C, p[20] = madd2(x[11], y[9], p[20], C)
+	C, p[21] = madd2(x[11], y[10], p[21], C)
+	C, p[22] = madd2(x[11], y[11], p[22], C)
+	p[23] = C
+
+	// acc = acc + p
+	acc.t[0], C = bits.Add64(acc.t[0], p[0], 0)
+	acc.t[1], C = bits.Add64(acc.t[1], p[1], C)
+	acc.t[2], C = bits.Add64(acc.t[2], p[2], C)
+	acc.t[3], C = bits.Add64(acc.t[3], p[3], C)
+	acc.t[4], C = bits.Add64(acc.t[4], p[4], C)
+	acc.t[5], C = bits.Add64(acc.t[5], p[5], C)
+	acc.t[6], C = bits.Add64(acc.t[6], p[6], C)
+	acc.t[7], C = bits.Add64(acc.t[7], p[7], C)
+	acc.t[8], C = bits.Add64(acc.t[8], p[8], C)
+	acc.t[9], C = bits.Add64(acc.t[9], p[9], C)
+	acc.t[10], C = bits.Add64(acc.t[10], p[10], C)
+	acc.t[11], C = bits.Add64(acc.t[11], p[11], C)
+	acc.t[12], C = bits.Add64(acc.t[12], p[12], C)
+	acc.t[13], C = bits.Add64(acc.t[13], p[13], C)
+	acc.t[14], C = bits.Add64(acc.t[14], p[14], C)
+	acc.t[15], C = bits.Add64(acc.t[15], p[15], C)
+	acc.t[16], C = bits.Add64(acc.t[16], p[16], C)
+	acc.t[17], C = bits.Add64(acc.t[17], p[17], C)
+	acc.t[18], C = bits.Add64(acc.t[18], p[18], C)
+	acc.t[19], C = bits.Add64(acc.t[19], p[19], C)
+	acc.t[20], C = bits.Add64(acc.t[20], p[20], C)
+	acc.t[21], C = bits.Add64(acc.t[21], p[21], C)
+	acc.t[22], C = bits.Add64(acc.t[22], p[22], C)
+	acc.t[23], C = bits.Add64(acc.t[23], p[23], C)
+	acc.t[24] += C
+
+	return acc
+}
+
+// Reset empties the accumulator
+func (acc *WideAccumulator) Reset() *WideAccumulator {
+	acc.t = [25]uint64{}
+	return acc
+}
+
+// Reduce returns the sum of the accumulated products, as a reduced Element;
+// the accumulator is left unchanged
+func (acc *WideAccumulator) Reduce() (z Element) {
+	t := acc.t
+
+	// the products are in Montgomery form (x*y*R**2), the montgomery reduction
+	// of t gives v = t * R**-1 mod q on 13 words: v < t / R + q
+	montReduceWide(&t)
+
+	// v = vLo + vHi * R with vLo < R, vHi < 2**64
+	// vLo * R**-1 * (R mod q) < 2q
+	var lo [25]uint64
+	var C uint64
+	C, lo[0] = bits.Mul64(t[12], 18446744073709547378)
+	C, lo[1] = madd1(t[12], 14463961505609547775, C)
+	C, lo[2] = madd1(t[12], 15160016368967634470, C)
+	C, lo[3] = madd1(t[12], 12241294279704278364, C)
+	C, lo[4] = madd1(t[12], 2720419343484222500, C)
+	C, lo[5] = madd1(t[12], 4799902015386277509, C)
+	C, lo[6] = madd1(t[12], 8643488375494563078, C)
+	C, lo[7] = madd1(t[12], 18366804658688562287, C)
+	C, lo[8] = madd1(t[12], 2055362399696866477, C)
+	C, lo[9] = madd1(t[12], 3108243834975866807, C)
+	C, lo[10] = madd1(t[12], 9468215855567529777, C)
+	C, lo[11] = madd1(t[12], 369351476012747, C)
+	lo[12] = C
+	C, lo[1] = madd1(t[13], 18446744073709547378, lo[1])
+	C, lo[2] = madd2(t[13], 14463961505609547775, lo[2], C)
+	C, lo[3] = madd2(t[13], 15160016368967634470, lo[3], C)
+	C, lo[4] = madd2(t[13], 12241294279704278364, lo[4], C)
+	C, lo[5] = madd2(t[13], 2720419343484222500, lo[5], C)
+	C, lo[6] = madd2(t[13], 4799902015386277509, lo[6], C)
+	C, lo[7] = madd2(t[13], 8643488375494563078, lo[7], C)
+	C, lo[8] = madd2(t[13], 18366804658688562287, lo[8], C)
+	C, lo[9] = madd2(t[13], 2055362399696866477, lo[9], C)
+	C, lo[10] = madd2(t[13], 3108243834975866807, lo[10], C)
+	C, lo[11] = madd2(t[13], 9468215855567529777, lo[11], C)
+	C, lo[12] = madd2(t[13], 369351476012747, lo[12], C)
+	lo[13] = C
+	C, lo[2] = madd1(t[14], 18446744073709547378, lo[2])
+	C, lo[3] = madd2(t[14], 14463961505609547775, lo[3], C)
+	C, lo[4] = madd2(t[14], 15160016368967634470, lo[4], C)
+	C, lo[5] = madd2(t[14], 12241294279704278364, lo[5], C)
+	C, lo[6] = madd2(t[14], 2720419343484222500, lo[6], C)
+	C, lo[7] = madd2(t[14], 4799902015386277509, lo[7], C)
+	C, lo[8] = madd2(t[14], 8643488375494563078, lo[8], C)
+	C, lo[9] = madd2(t[14], 18366804658688562287, lo[9], C)
+	C, lo[10] = madd2(t[14], 2055362399696866477, lo[10], C)
+	C, lo[11] = madd2(t[14], 3108243834975866807, lo[11], C)
+	C, lo[12] = madd2(t[14], 9468215855567529777, lo[12], C)
+	C, lo[13] = madd2(t[14], 369351476012747, lo[13], C)
+	lo[14] = C
+	C, lo[3] = madd1(t[15], 18446744073709547378, lo[3])
+	C, lo[4] = madd2(t[15], 14463961505609547775, lo[4], C)
+	C, lo[5] = madd2(t[15], 15160016368967634470, lo[5], C)
+	C, lo[6] = madd2(t[15], 12241294279704278364, lo[6], C)
+	C, lo[7] = madd2(t[15], 2720419343484222500, lo[7], C)
+	C, lo[8] = madd2(t[15], 4799902015386277509, lo[8], C)
+	C, lo[9] = madd2(t[15], 8643488375494563078, lo[9], C)
+	C, lo[10] = madd2(t[15], 18366804658688562287, lo[10], C)
+	C, lo[11] = madd2(t[15], 2055362399696866477, lo[11], C)
+	C, lo[12] = madd2(t[15], 3108243834975866807, lo[12], C)
+	C, lo[13] = madd2(t[15], 9468215855567529777, lo[13], C)
+	C, lo[14] = madd2(t[15], 369351476012747, lo[14], C)
+	lo[15] = C
+	C, lo[4] = madd1(t[16], 18446744073709547378, lo[4])
+	C, lo[5] = madd2(t[16], 14463961505609547775, lo[5], C)
+	C, lo[6] = madd2(t[16], 15160016368967634470, lo[6], C)
+	C, lo[7] = madd2(t[16], 12241294279704278364, lo[7], C)
+	C, lo[8] = madd2(t[16], 2720419343484222500, lo[8], C)
+	C, lo[9] = madd2(t[16], 4799902015386277509, lo[9], C)
+	C, lo[10] = madd2(t[16], 8643488375494563078, lo[10], C)
+	C, lo[11] = madd2(t[16], 18366804658688562287, lo[11], C)
+	C, lo[12] = madd2(t[16], 2055362399696866477, lo[12], C)
+	C, lo[13] = madd2(t[16], 3108243834975866807, lo[13], C)
+	C, lo[14] = madd2(t[16], 9468215855567529777, lo[14], C)
+	C, lo[15] = madd2(t[16], 369351476012747, lo[15], C)
+	lo[16] = C
+	C, lo[5] = madd1(t[17], 18446744073709547378, lo[5])
+	C, lo[6] = madd2(t[17], 14463961505609547775, lo[6], C)
+	C, lo[7] = madd2(t[17], 15160016368967634470, lo[7], C)
+	C, lo[8] = madd2(t[17], 12241294279704278364, lo[8], C)
+	C, lo[9] = madd2(t[17], 2720419343484222500, lo[9], C)
+	C, lo[10] = madd2(t[17], 4799902015386277509, lo[10], C)
+	C, lo[11] = madd2(t[17], 8643488375494563078, lo[11], C)
+	C, lo[12] = madd2(t[17], 18366804658688562287, lo[12], C)
+	C, lo[13] = madd2(t[17], 2055362399696866477, lo[13], C)
+	C, lo[14] = madd2(t[17], 3108243834975866807, lo[14], C)
+	C, lo[15] = madd2(t[17], 9468215855567529777, lo[15], C)
+	C, lo[16] = madd2(t[17], 369351476012747, lo[16], C)
+	lo[17] = C
+	C, lo[6] = madd1(t[18], 18446744073709547378, lo[6])
+	C, lo[7] = madd2(t[18], 14463961505609547775, lo[7], C)
+	C, lo[8] = madd2(t[18], 15160016368967634470, lo[8], C)
+	C, lo[9] = madd2(t[18], 12241294279704278364, lo[9], C)
+	C, lo[10] = madd2(t[18], 2720419343484222500, lo[10], C)
+	C, lo[11] = madd2(t[18], 4799902015386277509, lo[11], C)
+	C, lo[12] = madd2(t[18], 8643488375494563078, lo[12], C)
+	C, lo[13] = madd2(t[18], 18366804658688562287, lo[13], C)
+	C, lo[14] = madd2(t[18], 2055362399696866477, lo[14], C)
+	C, lo[15] = madd2(t[18], 3108243834975866807, lo[15], C)
+	C, lo[16] = madd2(t[18], 9468215855567529777, lo[16], C)
+	C, lo[17] = madd2(t[18], 369351476012747, lo[17], C)
+	lo[18] = C
+	C, lo[7] = madd1(t[19], 18446744073709547378, lo[7])
+	C, lo[8] = madd2(t[19], 14463961505609547775, lo[8], C)
+	C, lo[9] = madd2(t[19], 15160016368967634470, lo[9], C)
+	C, lo[10] = madd2(t[19], 12241294279704278364, lo[10], C)
+	C, lo[11] = madd2(t[19], 2720419343484222500, lo[11], C)
+	C, lo[12] = madd2(t[19], 4799902015386277509, lo[12], C)
+	C, lo[13] = madd2(t[19], 8643488375494563078, lo[13], C)
+	C, lo[14] = madd2(t[19], 18366804658688562287, lo[14], C)
+	C, lo[15] = madd2(t[19], 2055362399696866477, lo[15], C)
+	C, lo[16] = madd2(t[19], 3108243834975866807, lo[16], C)
+	C, lo[17] = madd2(t[19], 9468215855567529777, lo[17], C)
+	C, lo[18] = madd2(t[19], 369351476012747, lo[18], C)
+	lo[19] = C
+	C, lo[8] = madd1(t[20], 18446744073709547378, lo[8])
+	C, lo[9] = madd2(t[20], 14463961505609547775, lo[9], C)
+	C, lo[10] = madd2(t[20], 15160016368967634470, lo[10], C)
+	C, lo[11] = madd2(t[20], 12241294279704278364, lo[11], C)
+	C, lo[12] = madd2(t[20], 2720419343484222500, lo[12], C)
+	C, lo[13] = madd2(t[20], 4799902015386277509, lo[13], C)
+	C, lo[14] = madd2(t[20], 8643488375494563078, lo[14], C)
+	C, lo[15] = madd2(t[20], 18366804658688562287, lo[15], C)
+	C, lo[16] = madd2(t[20], 2055362399696866477, lo[16], C)
+	C, lo[17] = madd2(t[20], 3108243834975866807, lo[17], C)
+	C, lo[18] = madd2(t[20], 9468215855567529777, lo[18], C)
+	C, lo[19] = madd2(t[20], 369351476012747, lo[19], C)
+	lo[20] = C
+	C, lo[9] = madd1(t[21], 18446744073709547378, lo[9])
+	C, lo[10] = madd2(t[21], 14463961505609547775, lo[10], C)
+	C, lo[11] = madd2(t[21], 15160016368967634470, lo[11], C)
+	C, lo[12] = madd2(t[21], 12241294279704278364, lo[12], C)
+	C, lo[13] = madd2(t[21], 2720419343484222500, lo[13], C)
+	C, lo[14] = madd2(t[21], 4799902015386277509, lo[14], C)
+	C, lo[15] = madd2(t[21], 8643488375494563078, lo[15], C)
+	C, lo[16] = madd2(t[21], 18366804658688562287, lo[16], C)
+	C, lo[17] = madd2(t[21], 2055362399696866477, lo[17], C)
+	C, lo[18] = madd2(t[21], 3108243834975866807, lo[18], C)
+	C, lo[19] = madd2(t[21], 9468215855567529777, lo[19], C)
+	C, lo[20] = madd2(t[21], 369351476012747, lo[20], C)
+	lo[21] = C
+	C, lo[10] = madd1(t[22], 18446744073709547378, lo[10])
+	C, lo[11] = madd2(t[22], 14463961505609547775, lo[11], C)
+	C, lo[12] = madd2(t[22], 15160016368967634470, lo[12], C)
+	C, lo[13] = madd2(t[22], 12241294279704278364, lo[13], C)
+	C, lo[14] = madd2(t[22], 2720419343484222500, lo[14], C)
+	C, lo[15] = madd2(t[22], 4799902015386277509, lo[15], C)
+	C, lo[16] = madd2(t[22], 8643488375494563078, lo[16], C)
+	C, lo[17] = madd2(t[22], 18366804658688562287, lo[17], C)
+	C, lo[18] = madd2(t[22], 2055362399696866477, lo[18], C)
+	C, lo[19] = madd2(t[22], 3108243834975866807, lo[19], C)
+	C, lo[20] = madd2(t[22], 9468215855567529777, lo[20], C)
+	C, lo[21] = madd2(t[22], 369351476012747, lo[21], C)
+	lo[22] = C
+	C, lo[11] = madd1(t[23], 18446744073709547378, lo[11])
+	C, lo[12] = madd2(t[23], 14463961505609547775, lo[12], C)
+	C, lo[13] = madd2(t[23], 15160016368967634470, lo[13], C)
+	C, lo[14] = madd2(t[23], 12241294279704278364, lo[14], C)
+	C, lo[15] = madd2(t[23], 2720419343484222500, lo[15], C)
+	C, lo[16] = madd2(t[23], 4799902015386277509, lo[16], C)
+	C, lo[17] = madd2(t[23], 8643488375494563078, lo[17], C)
+	C, lo[18] = madd2(t[23], 18366804658688562287, lo[18], C)
+	C, lo[19] = madd2(t[23], 2055362399696866477, lo[19], C)
+	C, lo[20] = madd2(t[23], 3108243834975866807, lo[20], C)
+	C, lo[21] = madd2(t[23], 9468215855567529777, lo[21], C)
+	C, lo[22] = madd2(t[23], 369351476012747, lo[22], C)
+	lo[23] = C
+	montReduceWide(&lo)
+
+	copy(z[:], lo[12:24])
+	if lo[24] != 0 {
+		// z + 2**768 - q
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 1, 0)
+		z[1], b = bits.Sub64(z[1], 3731203976813871104, b)
+		z[2], b = bits.Sub64(z[2], 15039355238879481536, b)
+		z[3], b = bits.Sub64(z[3], 4828608925799409630, b)
+		z[4], b = bits.Sub64(z[4], 16326337093237622437, b)
+		z[5], b = bits.Sub64(z[5], 756237273905161798, b)
+		z[6], b = bits.Sub64(z[6], 16934317532427647658, b)
+		z[7], b = bits.Sub64(z[7], 14755673041361585881, b)
+		z[8], b = bits.Sub64(z[8], 18154628166362162086, b)
+		z[9], b = bits.Sub64(z[9], 6671956210750770825, b)
+		z[10], b = bits.Sub64(z[10], 16333450281447942351, b)
+		z[11], b = bits.Sub64(z[11], 4352613195430282, b)
+	} else {
+
+		// if z > q --> z -= q
+		// note: this is NOT constant time
+		if !(z[11] < 4352613195430282 || (z[11] == 4352613195430282 && (z[10] < 16333450281447942351 || (z[10] == 16333450281447942351 && (z[9] < 6671956210750770825 || (z[9] == 6671956210750770825 && (z[8] < 18154628166362162086 || (z[8] == 18154628166362162086 && (z[7] < 14755673041361585881 || (z[7] == 14755673041361585881 && (z[6] < 16934317532427647658 || (z[6] == 16934317532427647658 && (z[5] < 756237273905161798 || (z[5] == 756237273905161798 && (z[4] < 16326337093237622437 || (z[4] == 16326337093237622437 && (z[3] < 4828608925799409630 || (z[3] == 4828608925799409630 && (z[2] < 15039355238879481536 || (z[2] == 15039355238879481536 && (z[1] < 3731203976813871104 || (z[1] == 3731203976813871104 && (z[0] < 1))))))))))))))))))))))) {
+			var b uint64
+			z[0], b = bits.Sub64(z[0], 1, 0)
+			z[1], b = bits.Sub64(z[1], 3731203976813871104, b)
+			z[2], b = bits.Sub64(z[2], 15039355238879481536, b)
+			z[3], b = bits.Sub64(z[3], 4828608925799409630, b)
+			z[4], b = bits.Sub64(z[4], 16326337093237622437, b)
+			z[5], b = bits.Sub64(z[5], 756237273905161798, b)
+			z[6], b = bits.Sub64(z[6], 16934317532427647658, b)
+			z[7], b = bits.Sub64(z[7], 14755673041361585881, b)
+			z[8], b = bits.Sub64(z[8], 18154628166362162086, b)
+			z[9], b = bits.Sub64(z[9], 6671956210750770825, b)
+			z[10], b = bits.Sub64(z[10], 16333450281447942351, b)
+			z[11], _ = bits.Sub64(z[11], 4352613195430282, b)
+		}
+	}
+
+	// vHi * R mod q is the Montgomery form of vHi
+	if t[24] != 0 {
+		var hi Element
+		hi.SetUint64(t[24])
+		z.Add(&z, &hi)
+	}
+
+	return
+}
+
+// montReduceWide sets t[12:] = t * R**-1 mod q, with t[12:] < t / R + q;
+// t must be smaller than 2**1536 * 2**63 so that the result fits on 13 words
+func montReduceWide(t *[25]uint64) {
+	for i := 0; i < 12; i++ {
+		// m = t[i]n'[0] mod W
+		m := t[i] * 18446744073709551615
+		C := madd0(m, 1, t[i])
+		C, t[i+1] = madd2(m, 3731203976813871104, t[i+1], C)
+		C, t[i+2] = madd2(m, 15039355238879481536, t[i+2], C)
+		C, t[i+3] = madd2(m, 4828608925799409630, t[i+3], C)
+		C, t[i+4] = madd2(m, 16326337093237622437, t[i+4], C)
+		C, t[i+5] = madd2(m, 756237273905161798, t[i+5], C)
+		C, t[i+6] = madd2(m, 16934317532427647658, t[i+6], C)
+		C, t[i+7] = madd2(m, 14755673041361585881, t[i+7], C)
+		C, t[i+8] = madd2(m, 18154628166362162086, t[i+8], C)
+		C, t[i+9] = madd2(m, 6671956210750770825, t[i+9], C)
+		C, t[i+10] = madd2(m, 16333450281447942351, t[i+10], C)
+		C, t[i+11] = madd2(m, 4352613195430282, t[i+11], C)
+		for k := i + 12; k < len(t); k++ {
+			t[k], C = bits.Add64(t[k], C, 0)
+		}
+	}
+}
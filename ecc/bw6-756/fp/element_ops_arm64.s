@@ -0,0 +1,3743 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x0000000000000001
+DATA q<>+8(SB)/8, $0x33c7e63f86840000
+DATA q<>+16(SB)/8, $0xd0b685e868524ec0
+DATA q<>+24(SB)/8, $0x4302aa3c258de7de
+DATA q<>+32(SB)/8, $0xe292cd15edb646a5
+DATA q<>+40(SB)/8, $0x0a7eb1cb3d06e646
+DATA q<>+48(SB)/8, $0xeb02c812ea04faaa
+DATA q<>+56(SB)/8, $0xccc6ae73c42a46d9
+DATA q<>+64(SB)/8, $0xfbf23221455163a6
+DATA q<>+72(SB)/8, $0x5c978cd2fac2ce89
+DATA q<>+80(SB)/8, $0xe2ac127e1e3568cf
+DATA q<>+88(SB)/8, $0x000f76adbb5bb98a
+GLOBL q<>(SB), (RODATA+NOPTR), $96
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0xffffffffffffffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+// add(res, x, y *Element)
+TEXT ·add(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   32(R0), (R6, R7)
+	LDP   48(R0), (R8, R9)
+	LDP   64(R0), (R10, R11)
+	LDP   80(R0), (R12, R13)
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R14, R15)
+	ADDS  R14, R2, R2
+	ADCS  R15, R3, R3
+	LDP   16(R1), (R14, R15)
+	ADCS  R14, R4, R4
+	ADCS  R15, R5, R5
+	LDP   32(R1), (R14, R15)
+	ADCS  R14, R6, R6
+	ADCS  R15, R7, R7
+	LDP   48(R1), (R14, R15)
+	ADCS  R14, R8, R8
+	ADCS  R15, R9, R9
+	LDP   64(R1), (R14, R15)
+	ADCS  R14, R10, R10
+	ADCS  R15, R11, R11
+	LDP   80(R1), (R14, R15)
+	ADCS  R14, R12, R12
+	ADCS  R15, R13, R13
+	MOVD  $q<>(SB), R16
+	LDP   0(R16), (R19, R20)
+	SUBS  R19, R2, R21
+	SBCS  R20, R3, R21
+	LDP   16(R16), (R19, R20)
+	SBCS  R19, R4, R21
+	SBCS  R20, R5, R21
+	LDP   32(R16), (R19, R20)
+	SBCS  R19, R6, R21
+	SBCS  R20, R7, R21
+	LDP   48(R16), (R19, R20)
+	SBCS  R19, R8, R21
+	SBCS  R20, R9, R21
+	LDP   64(R16), (R19, R20)
+	SBCS  R19, R10, R21
+	SBCS  R20, R11, R21
+	LDP   80(R16), (R19, R20)
+	SBCS  R19, R12, R21
+	SBCS  R20, R13, R21
+	CSETM CS, R17
+	LDP   0(R16), (R19, R20)
+	AND   R17, R19, R19
+	SUBS  R19, R2, R2
+	AND   R17, R20, R20
+	SBCS  R20, R3, R3
+	LDP   16(R16), (R19, R20)
+	AND   R17, R19, R19
+	SBCS  R19, R4, R4
+	AND   R17, R20, R20
+	SBCS  R20, R5, R5
+	LDP   32(R16), (R19, R20)
+	AND   R17, R19, R19
+	SBCS  R19, R6, R6
+	AND   R17, R20, R20
+	SBCS  R20, R7, R7
+	LDP   48(R16), (R19, R20)
+	AND   R17, R19, R19
+	SBCS  R19, R8, R8
+	AND   R17, R20, R20
+	SBCS  R20, R9, R9
+	LDP   64(R16), (R19, R20)
+	AND   R17, R19, R19
+	SBCS  R19, R10, R10
+	AND   R17, R20, R20
+	SBCS  R20, R11, R11
+	LDP   80(R16), (R19, R20)
+	AND   R17, R19, R19
+	SBCS  R19, R12, R12
+	AND   R17, R20, R20
+	SBCS  R20, R13, R13
+	MOVD  res+0(FP), R22
+	STP   (R2, R3), 0(R22)
+	STP   (R4, R5), 16(R22)
+	STP   (R6, R7), 32(R22)
+	STP   (R8, R9), 48(R22)
+	STP   (R10, R11), 64(R22)
+	STP   (R12, R13), 80(R22)
+	RET
+
+// sub(res, x, y *Element)
+TEXT ·sub(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   32(R0), (R6, R7)
+	LDP   48(R0), (R8, R9)
+	LDP   64(R0), (R10, R11)
+	LDP   80(R0), (R12, R13)
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R14, R15)
+	SUBS  R14, R2, R2
+	SBCS  R15, R3, R3
+	LDP   16(R1), (R14, R15)
+	SBCS  R14, R4, R4
+	SBCS  R15, R5, R5
+	LDP   32(R1), (R14, R15)
+	SBCS  R14, R6, R6
+	SBCS  R15, R7, R7
+	LDP   48(R1), (R14, R15)
+	SBCS  R14, R8, R8
+	SBCS  R15, R9, R9
+	LDP   64(R1), (R14, R15)
+	SBCS  R14, R10, R10
+	SBCS  R15, R11, R11
+	LDP   80(R1), (R14, R15)
+	SBCS  R14, R12, R12
+	SBCS  R15, R13, R13
+	CSETM CC, R16
+	MOVD  $q<>(SB), R17
+	LDP   0(R17), (R19, R20)
+	AND   R16, R19, R19
+	ADDS  R19, R2, R2
+	AND   R16, R20, R20
+	ADCS  R20, R3, R3
+	LDP   16(R17), (R19, R20)
+	AND   R16, R19, R19
+	ADCS  R19, R4, R4
+	AND   R16, R20, R20
+	ADCS  R20, R5, R5
+	LDP   32(R17), (R19, R20)
+	AND   R16, R19, R19
+	ADCS  R19, R6, R6
+	AND   R16, R20, R20
+	ADCS  R20, R7, R7
+	LDP   48(R17), (R19, R20)
+	AND   R16, R19, R19
+	ADCS  R19, R8, R8
+	AND   R16, R20, R20
+	ADCS  R20, R9, R9
+	LDP   64(R17), (R19, R20)
+	AND   R16, R19, R19
+	ADCS  R19, R10, R10
+	AND   R16, R20, R20
+	ADCS  R20, R11, R11
+	LDP   80(R17), (R19, R20)
+	AND   R16, R19, R19
+	ADCS  R19, R12, R12
+	AND   R16, R20, R20
+	ADCS  R20, R13, R13
+	MOVD  res+0(FP), R21
+	STP   (R2, R3), 0(R21)
+	STP   (R4, R5), 16(R21)
+	STP   (R6, R7), 32(R21)
+	STP   (R8, R9), 48(R21)
+	STP   (R10, R11), 64(R21)
+	STP   (R12, R13), 80(R21)
+	RET
+
+// double(res, x *Element)
+TEXT ·double(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	LDP   48(R0), (R7, R8)
+	LDP   64(R0), (R9, R10)
+	LDP   80(R0), (R11, R12)
+	ADDS  R1, R1, R1
+	ADCS  R2, R2, R2
+	ADCS  R3, R3, R3
+	ADCS  R4, R4, R4
+	ADCS  R5, R5, R5
+	ADCS  R6, R6, R6
+	ADCS  R7, R7, R7
+	ADCS  R8, R8, R8
+	ADCS  R9, R9, R9
+	ADCS  R10, R10, R10
+	ADCS  R11, R11, R11
+	ADCS  R12, R12, R12
+	MOVD  $q<>(SB), R13
+	LDP   0(R13), (R15, R16)
+	SUBS  R15, R1, R17
+	SBCS  R16, R2, R17
+	LDP   16(R13), (R15, R16)
+	SBCS  R15, R3, R17
+	SBCS  R16, R4, R17
+	LDP   32(R13), (R15, R16)
+	SBCS  R15, R5, R17
+	SBCS  R16, R6, R17
+	LDP   48(R13), (R15, R16)
+	SBCS  R15, R7, R17
+	SBCS  R16, R8, R17
+	LDP   64(R13), (R15, R16)
+	SBCS  R15, R9, R17
+	SBCS  R16, R10, R17
+	LDP   80(R13), (R15, R16)
+	SBCS  R15, R11, R17
+	SBCS  R16, R12, R17
+	CSETM CS, R14
+	LDP   0(R13), (R15, R16)
+	AND   R14, R15, R15
+	SUBS  R15, R1, R1
+	AND   R14, R16, R16
+	SBCS  R16, R2, R2
+	LDP   16(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R3, R3
+	AND   R14, R16, R16
+	SBCS  R16, R4, R4
+	LDP   32(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R5, R5
+	AND   R14, R16, R16
+	SBCS  R16, R6, R6
+	LDP   48(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R7, R7
+	AND   R14, R16, R16
+	SBCS  R16, R8, R8
+	LDP   64(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R9, R9
+	AND   R14, R16, R16
+	SBCS  R16, R10, R10
+	LDP   80(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R11, R11
+	AND   R14, R16, R16
+	SBCS  R16, R12, R12
+	MOVD  res+0(FP), R19
+	STP   (R1, R2), 0(R19)
+	STP   (R3, R4), 16(R19)
+	STP   (R5, R6), 32(R19)
+	STP   (R7, R8), 48(R19)
+	STP   (R9, R10), 64(R19)
+	STP   (R11, R12), 80(R19)
+	RET
+
+// neg(res, x *Element)
+TEXT ·neg(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	LDP   48(R0), (R7, R8)
+	LDP   64(R0), (R9, R10)
+	LDP   80(R0), (R11, R12)
+	ORR   R1, R2, R15
+	ORR   R3, R15, R15
+	ORR   R4, R15, R15
+	ORR   R5, R15, R15
+	ORR   R6, R15, R15
+	ORR   R7, R15, R15
+	ORR   R8, R15, R15
+	ORR   R9, R15, R15
+	ORR   R10, R15, R15
+	ORR   R11, R15, R15
+	ORR   R12, R15, R15
+	CMP   $0, R15
+	CSETM NE, R15
+	MOVD  $q<>(SB), R0
+	LDP   0(R0), (R13, R14)
+	SUBS  R1, R13, R1
+	SBCS  R2, R14, R2
+	LDP   16(R0), (R13, R14)
+	SBCS  R3, R13, R3
+	SBCS  R4, R14, R4
+	LDP   32(R0), (R13, R14)
+	SBCS  R5, R13, R5
+	SBCS  R6, R14, R6
+	LDP   48(R0), (R13, R14)
+	SBCS  R7, R13, R7
+	SBCS  R8, R14, R8
+	LDP   64(R0), (R13, R14)
+	SBCS  R9, R13, R9
+	SBCS  R10, R14, R10
+	LDP   80(R0), (R13, R14)
+	SBCS  R11, R13, R11
+	SBCS  R12, R14, R12
+	AND   R15, R1, R1
+	AND   R15, R2, R2
+	AND   R15, R3, R3
+	AND   R15, R4, R4
+	AND   R15, R5, R5
+	AND   R15, R6, R6
+	AND   R15, R7, R7
+	AND   R15, R8, R8
+	AND   R15, R9, R9
+	AND   R15, R10, R10
+	AND   R15, R11, R11
+	AND   R15, R12, R12
+	MOVD  res+0(FP), R0
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	STP   (R7, R8), 48(R0)
+	STP   (R9, R10), 64(R0)
+	STP   (R11, R12), 80(R0)
+	RET
+
+// reduce(res *Element)
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD  res+0(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	LDP   48(R0), (R7, R8)
+	LDP   64(R0), (R9, R10)
+	LDP   80(R0), (R11, R12)
+	MOVD  $q<>(SB), R13
+	LDP   0(R13), (R15, R16)
+	SUBS  R15, R1, R17
+	SBCS  R16, R2, R17
+	LDP   16(R13), (R15, R16)
+	SBCS  R15, R3, R17
+	SBCS  R16, R4, R17
+	LDP   32(R13), (R15, R16)
+	SBCS  R15, R5, R17
+	SBCS  R16, R6, R17
+	LDP   48(R13), (R15, R16)
+	SBCS  R15, R7, R17
+	SBCS  R16, R8, R17
+	LDP   64(R13), (R15, R16)
+	SBCS  R15, R9, R17
+	SBCS  R16, R10, R17
+	LDP   80(R13), (R15, R16)
+	SBCS  R15, R11, R17
+	SBCS  R16, R12, R17
+	CSETM CS, R14
+	LDP   0(R13), (R15, R16)
+	AND   R14, R15, R15
+	SUBS  R15, R1, R1
+	AND   R14, R16, R16
+	SBCS  R16, R2, R2
+	LDP   16(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R3, R3
+	AND   R14, R16, R16
+	SBCS  R16, R4, R4
+	LDP   32(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R5, R5
+	AND   R14, R16, R16
+	SBCS  R16, R6, R6
+	LDP   48(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R7, R7
+	AND   R14, R16, R16
+	SBCS  R16, R8, R8
+	LDP   64(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R9, R9
+	AND   R14, R16, R16
+	SBCS  R16, R10, R10
+	LDP   80(R13), (R15, R16)
+	AND   R14, R15, R15
+	SBCS  R15, R11, R11
+	AND   R14, R16, R16
+	SBCS  R16, R12, R12
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	STP   (R7, R8), 48(R0)
+	STP   (R9, R10), 64(R0)
+	STP   (R11, R12), 80(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD  a+0(FP), R0
+	MOVD  b+8(FP), R1
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   32(R0), (R6, R7)
+	LDP   48(R0), (R8, R9)
+	LDP   64(R0), (R10, R11)
+	LDP   80(R0), (R12, R13)
+	LDP   0(R1), (R14, R15)
+	ADDS  R14, R2, R14
+	ADCS  R15, R3, R15
+	STP   (R14, R15), 0(R0)
+	LDP   16(R1), (R14, R15)
+	ADCS  R14, R4, R14
+	ADCS  R15, R5, R15
+	STP   (R14, R15), 16(R0)
+	LDP   32(R1), (R14, R15)
+	ADCS  R14, R6, R14
+	ADCS  R15, R7, R15
+	STP   (R14, R15), 32(R0)
+	LDP   48(R1), (R14, R15)
+	ADCS  R14, R8, R14
+	ADCS  R15, R9, R15
+	STP   (R14, R15), 48(R0)
+	LDP   64(R1), (R14, R15)
+	ADCS  R14, R10, R14
+	ADCS  R15, R11, R15
+	STP   (R14, R15), 64(R0)
+	LDP   80(R1), (R14, R15)
+	ADCS  R14, R12, R14
+	ADCS  R15, R13, R15
+	STP   (R14, R15), 80(R0)
+	MOVD  $q<>(SB), R16
+	LDP   0(R0), (R19, R20)
+	LDP   0(R16), (R21, R22)
+	SUBS  R21, R19, R19
+	SBCS  R22, R20, R20
+	LDP   16(R0), (R19, R20)
+	LDP   16(R16), (R21, R22)
+	SBCS  R21, R19, R19
+	SBCS  R22, R20, R20
+	LDP   32(R0), (R19, R20)
+	LDP   32(R16), (R21, R22)
+	SBCS  R21, R19, R19
+	SBCS  R22, R20, R20
+	LDP   48(R0), (R19, R20)
+	LDP   48(R16), (R21, R22)
+	SBCS  R21, R19, R19
+	SBCS  R22, R20, R20
+	LDP   64(R0), (R19, R20)
+	LDP   64(R16), (R21, R22)
+	SBCS  R21, R19, R19
+	SBCS  R22, R20, R20
+	LDP   80(R0), (R19, R20)
+	LDP   80(R16), (R21, R22)
+	SBCS  R21, R19, R19
+	SBCS  R22, R20, R20
+	CSETM CS, R17
+	LDP   0(R0), (R19, R20)
+	LDP   0(R16), (R21, R22)
+	AND   R17, R21, R21
+	SUBS  R21, R19, R19
+	AND   R17, R22, R22
+	SBCS  R22, R20, R20
+	STP   (R19, R20), 0(R0)
+	LDP   16(R0), (R19, R20)
+	LDP   16(R16), (R21, R22)
+	AND   R17, R21, R21
+	SBCS  R21, R19, R19
+	AND   R17, R22, R22
+	SBCS  R22, R20, R20
+	STP   (R19, R20), 16(R0)
+	LDP   32(R0), (R19, R20)
+	LDP   32(R16), (R21, R22)
+	AND   R17, R21, R21
+	SBCS  R21, R19, R19
+	AND   R17, R22, R22
+	SBCS  R22, R20, R20
+	STP   (R19, R20), 32(R0)
+	LDP   48(R0), (R19, R20)
+	LDP   48(R16), (R21, R22)
+	AND   R17, R21, R21
+	SBCS  R21, R19, R19
+	AND   R17, R22, R22
+	SBCS  R22, R20, R20
+	STP   (R19, R20), 48(R0)
+	LDP   64(R0), (R19, R20)
+	LDP   64(R16), (R21, R22)
+	AND   R17, R21, R21
+	SBCS  R21, R19, R19
+	AND   R17, R22, R22
+	SBCS  R22, R20, R20
+	STP   (R19, R20), 64(R0)
+	LDP   80(R0), (R19, R20)
+	LDP   80(R16), (R21, R22)
+	AND   R17, R21, R21
+	SBCS  R21, R19, R19
+	AND   R17, R22, R22
+	SBCS  R22, R20, R20
+	STP   (R19, R20), 80(R0)
+	LDP   0(R1), (R14, R15)
+	SUBS  R14, R2, R14
+	SBCS  R15, R3, R15
+	STP   (R14, R15), 0(R1)
+	LDP   16(R1), (R14, R15)
+	SBCS  R14, R4, R14
+	SBCS  R15, R5, R15
+	STP   (R14, R15), 16(R1)
+	LDP   32(R1), (R14, R15)
+	SBCS  R14, R6, R14
+	SBCS  R15, R7, R15
+	STP   (R14, R15), 32(R1)
+	LDP   48(R1), (R14, R15)
+	SBCS  R14, R8, R14
+	SBCS  R15, R9, R15
+	STP   (R14, R15), 48(R1)
+	LDP   64(R1), (R14, R15)
+	SBCS  R14, R10, R14
+	SBCS  R15, R11, R15
+	STP   (R14, R15), 64(R1)
+	LDP   80(R1), (R14, R15)
+	SBCS  R14, R12, R14
+	SBCS  R15, R13, R15
+	STP   (R14, R15), 80(R1)
+	CSETM CC, R23
+	MOVD  $q<>(SB), R24
+	LDP   0(R1), (R14, R15)
+	LDP   0(R24), (R25, R26)
+	AND   R23, R25, R25
+	ADDS  R25, R14, R14
+	AND   R23, R26, R26
+	ADCS  R26, R15, R15
+	STP   (R14, R15), 0(R1)
+	LDP   16(R1), (R14, R15)
+	LDP   16(R24), (R25, R26)
+	AND   R23, R25, R25
+	ADCS  R25, R14, R14
+	AND   R23, R26, R26
+	ADCS  R26, R15, R15
+	STP   (R14, R15), 16(R1)
+	LDP   32(R1), (R14, R15)
+	LDP   32(R24), (R25, R26)
+	AND   R23, R25, R25
+	ADCS  R25, R14, R14
+	AND   R23, R26, R26
+	ADCS  R26, R15, R15
+	STP   (R14, R15), 32(R1)
+	LDP   48(R1), (R14, R15)
+	LDP   48(R24), (R25, R26)
+	AND   R23, R25, R25
+	ADCS  R25, R14, R14
+	AND   R23, R26, R26
+	ADCS  R26, R15, R15
+	STP   (R14, R15), 48(R1)
+	LDP   64(R1), (R14, R15)
+	LDP   64(R24), (R25, R26)
+	AND   R23, R25, R25
+	ADCS  R25, R14, R14
+	AND   R23, R26, R26
+	ADCS  R26, R15, R15
+	STP   (R14, R15), 64(R1)
+	LDP   80(R1), (R14, R15)
+	LDP   80(R24), (R25, R26)
+	AND   R23, R25, R25
+	ADCS  R25, R14, R14
+	AND   R23, R26, R26
+	ADCS  R26, R15, R15
+	STP   (R14, R15), 80(R1)
+	RET
+
+// mul(res, x, y *Element)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	MOVD y+16(FP), R1
+	MOVD $q<>(SB), R2
+	MOVD qInv0<>(SB), R3
+
+	// round 0
+	MOVD  0(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R23
+	UMULH R16, R17, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 1
+	MOVD  8(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 2
+	MOVD  16(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 3
+	MOVD  24(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 4
+	MOVD  32(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 5
+	MOVD  40(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 6
+	MOVD  48(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 7
+	MOVD  56(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 8
+	MOVD  64(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 9
+	MOVD  72(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 10
+	MOVD  80(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+
+	// round 11
+	MOVD  88(R0), R16
+	MOVD  0(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R4, R24, R23
+	ADC   ZR, R25, R21
+	MUL   R3, R23, R20
+	MOVD  0(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R23, R24, R24
+	ADC   ZR, R25, R22
+	MOVD  8(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R5, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  8(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R4
+	ADC   ZR, R25, R22
+	MOVD  16(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R6, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  16(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R5
+	ADC   ZR, R25, R22
+	MOVD  24(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R7, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  24(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R6
+	ADC   ZR, R25, R22
+	MOVD  32(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R8, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  32(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R7
+	ADC   ZR, R25, R22
+	MOVD  40(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R9, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  40(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R8
+	ADC   ZR, R25, R22
+	MOVD  48(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R10, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  48(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R9
+	ADC   ZR, R25, R22
+	MOVD  56(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R11, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  56(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R10
+	ADC   ZR, R25, R22
+	MOVD  64(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R12, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  64(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R11
+	ADC   ZR, R25, R22
+	MOVD  72(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R13, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  72(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R12
+	ADC   ZR, R25, R22
+	MOVD  80(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R14, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  80(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R13
+	ADC   ZR, R25, R22
+	MOVD  88(R1), R17
+	MUL   R16, R17, R24
+	UMULH R16, R17, R25
+	ADDS  R21, R24, R23
+	ADC   ZR, R25, R25
+	ADDS  R15, R23, R23
+	ADC   ZR, R25, R21
+	MOVD  88(R2), R19
+	MUL   R20, R19, R24
+	UMULH R20, R19, R25
+	ADDS  R22, R24, R24
+	ADC   ZR, R25, R25
+	ADDS  R23, R24, R14
+	ADC   ZR, R25, R22
+	ADD   R21, R22, R15
+	MOVD  $q<>(SB), R26
+	LDP   0(R26), (R1, R2)
+	SUBS  R1, R4, R3
+	SBCS  R2, R5, R3
+	LDP   16(R26), (R1, R2)
+	SBCS  R1, R6, R3
+	SBCS  R2, R7, R3
+	LDP   32(R26), (R1, R2)
+	SBCS  R1, R8, R3
+	SBCS  R2, R9, R3
+	LDP   48(R26), (R1, R2)
+	SBCS  R1, R10, R3
+	SBCS  R2, R11, R3
+	LDP   64(R26), (R1, R2)
+	SBCS  R1, R12, R3
+	SBCS  R2, R13, R3
+	LDP   80(R26), (R1, R2)
+	SBCS  R1, R14, R3
+	SBCS  R2, R15, R3
+	CSETM CS, R0
+	LDP   0(R26), (R1, R2)
+	AND   R0, R1, R1
+	SUBS  R1, R4, R4
+	AND   R0, R2, R2
+	SBCS  R2, R5, R5
+	LDP   16(R26), (R1, R2)
+	AND   R0, R1, R1
+	SBCS  R1, R6, R6
+	AND   R0, R2, R2
+	SBCS  R2, R7, R7
+	LDP   32(R26), (R1, R2)
+	AND   R0, R1, R1
+	SBCS  R1, R8, R8
+	AND   R0, R2, R2
+	SBCS  R2, R9, R9
+	LDP   48(R26), (R1, R2)
+	AND   R0, R1, R1
+	SBCS  R1, R10, R10
+	AND   R0, R2, R2
+	SBCS  R2, R11, R11
+	LDP   64(R26), (R1, R2)
+	AND   R0, R1, R1
+	SBCS  R1, R12, R12
+	AND   R0, R2, R2
+	SBCS  R2, R13, R13
+	LDP   80(R26), (R1, R2)
+	AND   R0, R1, R1
+	SBCS  R1, R14, R14
+	AND   R0, R2, R2
+	SBCS  R2, R15, R15
+	MOVD  res+0(FP), R16
+	STP   (R4, R5), 0(R16)
+	STP   (R6, R7), 16(R16)
+	STP   (R8, R9), 32(R16)
+	STP   (R10, R11), 48(R16)
+	STP   (R12, R13), 64(R16)
+	STP   (R14, R15), 80(R16)
+	RET
+
+// fromMont(res *Element)
+TEXT ·fromMont(SB), NOSPLIT, $0-8
+	MOVD res+0(FP), R0
+	MOVD $q<>(SB), R1
+	MOVD qInv0<>(SB), R2
+	LDP  0(R0), (R3, R4)
+	LDP  16(R0), (R5, R6)
+	LDP  32(R0), (R7, R8)
+	LDP  48(R0), (R9, R10)
+	LDP  64(R0), (R11, R12)
+	LDP  80(R0), (R13, R14)
+
+	// round 0
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 1
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 2
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 3
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 4
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 5
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 6
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 7
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 8
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 9
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 10
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+
+	// round 11
+	MUL   R2, R3, R16
+	MOVD  0(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R3, R19, R19
+	ADC   ZR, R20, R17
+	MOVD  8(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R4, R19, R3
+	ADC   ZR, R20, R17
+	MOVD  16(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R5, R19, R4
+	ADC   ZR, R20, R17
+	MOVD  24(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R6, R19, R5
+	ADC   ZR, R20, R17
+	MOVD  32(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R7, R19, R6
+	ADC   ZR, R20, R17
+	MOVD  40(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R8, R19, R7
+	ADC   ZR, R20, R17
+	MOVD  48(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R9, R19, R8
+	ADC   ZR, R20, R17
+	MOVD  56(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R10, R19, R9
+	ADC   ZR, R20, R17
+	MOVD  64(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R11, R19, R10
+	ADC   ZR, R20, R17
+	MOVD  72(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R12, R19, R11
+	ADC   ZR, R20, R17
+	MOVD  80(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R13, R19, R12
+	ADC   ZR, R20, R17
+	MOVD  88(R1), R15
+	MUL   R16, R15, R19
+	UMULH R16, R15, R20
+	ADDS  R17, R19, R19
+	ADC   ZR, R20, R20
+	ADDS  R14, R19, R13
+	ADC   ZR, R20, R17
+	MOVD  R17, R14
+	MOVD  $q<>(SB), R21
+	LDP   0(R21), (R23, R24)
+	SUBS  R23, R3, R25
+	SBCS  R24, R4, R25
+	LDP   16(R21), (R23, R24)
+	SBCS  R23, R5, R25
+	SBCS  R24, R6, R25
+	LDP   32(R21), (R23, R24)
+	SBCS  R23, R7, R25
+	SBCS  R24, R8, R25
+	LDP   48(R21), (R23, R24)
+	SBCS  R23, R9, R25
+	SBCS  R24, R10, R25
+	LDP   64(R21), (R23, R24)
+	SBCS  R23, R11, R25
+	SBCS  R24, R12, R25
+	LDP   80(R21), (R23, R24)
+	SBCS  R23, R13, R25
+	SBCS  R24, R14, R25
+	CSETM CS, R22
+	LDP   0(R21), (R23, R24)
+	AND   R22, R23, R23
+	SUBS  R23, R3, R3
+	AND   R22, R24, R24
+	SBCS  R24, R4, R4
+	LDP   16(R21), (R23, R24)
+	AND   R22, R23, R23
+	SBCS  R23, R5, R5
+	AND   R22, R24, R24
+	SBCS  R24, R6, R6
+	LDP   32(R21), (R23, R24)
+	AND   R22, R23, R23
+	SBCS  R23, R7, R7
+	AND   R22, R24, R24
+	SBCS  R24, R8, R8
+	LDP   48(R21), (R23, R24)
+	AND   R22, R23, R23
+	SBCS  R23, R9, R9
+	AND   R22, R24, R24
+	SBCS  R24, R10, R10
+	LDP   64(R21), (R23, R24)
+	AND   R22, R23, R23
+	SBCS  R23, R11, R11
+	AND   R22, R24, R24
+	SBCS  R24, R12, R12
+	LDP   80(R21), (R23, R24)
+	AND   R22, R23, R23
+	SBCS  R23, R13, R13
+	AND   R22, R24, R24
+	SBCS  R24, R14, R14
+	STP   (R3, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	STP   (R7, R8), 32(R0)
+	STP   (R9, R10), 48(R0)
+	STP   (R11, R12), 64(R0)
+	STP   (R13, R14), 80(R0)
+	RET
+
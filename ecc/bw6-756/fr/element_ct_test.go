@@ -0,0 +1,193 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestElementInverseCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("InverseCT should output the same result as Inverse", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, d Element
+			c.InverseCT(&a.element)
+			d.Inverse(&a.element)
+			return c.Equal(&d) && !c.biggerOrEqualModulus()
+		},
+		genA,
+	))
+
+	properties.Property("InverseCT should output the same result as big.Int.ModInverse", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			c.InverseCT(&a.element)
+			var expected big.Int
+			if expected.ModInverse(&a.bigint, Modulus()) == nil {
+				expected.SetUint64(0)
+			}
+			var b big.Int
+			return c.ToBigIntRegular(&b).Cmp(&expected) == 0
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for _, a := range staticTestValues {
+		var c, d Element
+		c.InverseCT(&a)
+		d.Inverse(&a)
+		if !c.Equal(&d) {
+			t.Fatal("InverseCT doesn't match Inverse on static test values")
+		}
+	}
+}
+
+func TestElementSqrtCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("SqrtCT should output a square root iff Sqrt does", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, d, square Element
+			r1 := c.SqrtCT(&a.element)
+			r2 := d.Sqrt(&a.element)
+			if (r1 == nil) != (r2 == nil) {
+				return false
+			}
+			if r1 == nil {
+				return true
+			}
+			square.Square(&c)
+			return square.Equal(&a.element) && !c.biggerOrEqualModulus()
+		},
+		genA,
+	))
+
+	properties.Property("SqrtCT of a square should be ± the root", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, square, neg Element
+			square.Square(&a.element)
+			if c.SqrtCT(&square) == nil {
+				return false
+			}
+			neg.Neg(&a.element)
+			return c.Equal(&a.element) || c.Equal(&neg)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementArithmeticCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	properties.Property("mulCT, squareCT, addCT and subCT should match Mul, Square, Add and Sub", prop.ForAll(
+		func(a, b testPairElement) bool {
+			return checkArithmeticCT(&a.element, &b.element)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for i := range staticTestValues {
+		for j := range staticTestValues {
+			if !checkArithmeticCT(&staticTestValues[i], &staticTestValues[j]) {
+				t.Fatal("constant time arithmetic doesn't match on static test values")
+			}
+		}
+	}
+}
+
+func checkArithmeticCT(x, y *Element) bool {
+	var c, d Element
+	c.mulCT(x, y)
+	d.Mul(x, y)
+	if !c.Equal(&d) || c.biggerOrEqualModulus() {
+		return false
+	}
+	c.squareCT(x)
+	d.Square(x)
+	if !c.Equal(&d) || c.biggerOrEqualModulus() {
+		return false
+	}
+	c.addCT(x, y)
+	d.Add(x, y)
+	if !c.Equal(&d) || c.biggerOrEqualModulus() {
+		return false
+	}
+	c.subCT(x, y)
+	d.Sub(x, y)
+	return c.Equal(&d) && !c.biggerOrEqualModulus()
+}
+
+func BenchmarkElementInverseCT(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchResElement.InverseCT(&x)
+	}
+}
+
+func BenchmarkElementSqrtCT(b *testing.B) {
+	var a Element
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.SqrtCT(&a)
+	}
+}
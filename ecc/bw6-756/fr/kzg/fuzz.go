@@ -21,8 +21,8 @@ package kzg
 
 import (
 	"bytes"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fr/polynomial"
 )
 
 const (
@@ -18,15 +18,16 @@ package kzg
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"math/big"
 	"reflect"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr/fft"
-	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fr/polynomial"
 )
 
 // testSRS re-used accross tests of the KZG scheme
@@ -41,12 +42,11 @@ func TestDividePolyByXminusA(t *testing.T) {
 
 	const pSize = 230
 
-	domain := fft.NewDomain(pSize, 0, false)
-
 	// build random polynomial
-	pol := make(polynomial.Polynomial, pSize, domain.Cardinality)
-	for i := 0; i < pSize; i++ {
-		pol[i].SetRandom()
+	pol := make(polynomial.Polynomial, pSize)
+	pol[0].SetRandom()
+	for i := 1; i < pSize; i++ {
+		pol[i] = pol[i-1]
 	}
 
 	// evaluate the polynomial at a random point
@@ -61,7 +61,7 @@ func TestDividePolyByXminusA(t *testing.T) {
 	polRandpoint.Sub(&polRandpoint, &evaluation) // f(rand)-f(point)
 
 	// compute f-f(a)/x-a
-	h := dividePolyByXminusA(domain, pol, evaluation, point)
+	h := dividePolyByXminusA(pol, evaluation, point)
 	pol = nil // h reuses this memory
 
 	if len(h) != 229 {
@@ -121,7 +121,7 @@ func TestCommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	var kzgCommit bw6767.G1Affine
+	var kzgCommit bw6756.G1Affine
 	kzgCommit.Unmarshal(_kzgCommit.Marshal())
 
 	// check commitment using manual commit
@@ -130,7 +130,7 @@ func TestCommit(t *testing.T) {
 	fx := f.Eval(&x)
 	var fxbi big.Int
 	fx.ToBigIntRegular(&fxbi)
-	var manualCommit bw6767.G1Affine
+	var manualCommit bw6756.G1Affine
 	manualCommit.Set(&testSRS.G1[0])
 	manualCommit.ScalarMultiplication(&manualCommit, &fxbi)
 
@@ -189,7 +189,7 @@ func TestBatchVerifySinglePoint(t *testing.T) {
 	// create polynomials
 	f := make([]polynomial.Polynomial, 10)
 	for i := 0; i < 10; i++ {
-		f[i] = randomPolynomial(60)
+		f[i] = randomPolynomial(40 + 2*i)
 	}
 
 	// commit the polynomials
@@ -199,10 +199,13 @@ func TestBatchVerifySinglePoint(t *testing.T) {
 
 	}
 
+	// pick a hash function
+	hf := sha256.New()
+
 	// compute opening proof at a random point
 	var point fr.Element
 	point.SetString("4321")
-	proof, err := BatchOpenSinglePoint(f, digests, &point, domain, testSRS)
+	proof, err := BatchOpenSinglePoint(f, digests, &point, hf, domain, testSRS)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -216,20 +219,78 @@ func TestBatchVerifySinglePoint(t *testing.T) {
 	}
 
 	// verify correct proof
-	err = BatchVerifySinglePoint(digests, &proof, testSRS)
+	err = BatchVerifySinglePoint(digests, &proof, hf, testSRS)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// verify wrong proof
 	proof.ClaimedValues[0].Double(&proof.ClaimedValues[0])
-	err = BatchVerifySinglePoint(digests, &proof, testSRS)
+	err = BatchVerifySinglePoint(digests, &proof, hf, testSRS)
 	if err == nil {
 		t.Fatal("verifying wrong proof should have failed")
 	}
 
 }
 
+func TestBatchVerifyMultiPoints(t *testing.T) {
+
+	domain := fft.NewDomain(64, 0, false)
+
+	// create polynomials
+	f := make([]polynomial.Polynomial, 10)
+	for i := 0; i < 10; i++ {
+		f[i] = randomPolynomial(40 + 2*i)
+	}
+
+	// commit the polynomials
+	digests := make([]Digest, 10)
+	for i := 0; i < 10; i++ {
+		digests[i], _ = Commit(f[i], testSRS)
+	}
+
+	// pick a hash function
+	hf := sha256.New()
+
+	// compute 2 batch opening proofs at 2 random points
+	points := make([]fr.Element, 2)
+	batchProofs := make([]BatchOpeningProof, 2)
+	points[0].SetRandom()
+	batchProofs[0], _ = BatchOpenSinglePoint(f[:5], digests[:5], &points[0], hf, domain, testSRS)
+	points[1].SetRandom()
+	batchProofs[1], _ = BatchOpenSinglePoint(f[5:], digests[5:], &points[1], hf, domain, testSRS)
+
+	// fold the 2 batch opening proofs
+	proofs := make([]OpeningProof, 2)
+	foldedDigests := make([]Digest, 2)
+	proofs[0], foldedDigests[0], _ = FoldProof(digests[:5], &batchProofs[0], hf)
+	proofs[1], foldedDigests[1], _ = FoldProof(digests[5:], &batchProofs[1], hf)
+
+	// check the the individual batch proofs are correct
+	err := Verify(&foldedDigests[0], &proofs[0], testSRS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Verify(&foldedDigests[1], &proofs[1], testSRS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// batch verify correct folded proofs
+	err = BatchVerifyMultiPoints(foldedDigests, proofs, testSRS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// batch verify tampered folded proofs
+	proofs[0].ClaimedValue.Double(&proofs[0].ClaimedValue)
+	err = BatchVerifyMultiPoints(foldedDigests, proofs, testSRS)
+	if err == nil {
+		t.Fatal(err)
+	}
+
+}
+
 const benchSize = 1 << 16
 
 func BenchmarkKZGCommit(b *testing.B) {
@@ -246,6 +307,27 @@ func BenchmarkKZGCommit(b *testing.B) {
 	}
 }
 
+func BenchmarkDivideByXMinusA(b *testing.B) {
+	const pSize = 1 << 22
+
+	// build random polynomial
+	pol := make(polynomial.Polynomial, pSize)
+	pol[0].SetRandom()
+	for i := 1; i < pSize; i++ {
+		pol[i] = pol[i-1]
+	}
+	var a, fa fr.Element
+	a.SetRandom()
+	fa.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dividePolyByXminusA(pol, fa, a)
+		pol = pol[:pSize]
+		pol[pSize-1] = pol[0]
+	}
+}
+
 func BenchmarkKZGOpen(b *testing.B) {
 	benchSRS, err := NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
 	if err != nil {
@@ -314,12 +396,15 @@ func BenchmarkKZGBatchOpen10(b *testing.B) {
 		commitments[i], _ = Commit(ps[i], benchSRS)
 	}
 
+	// pick a hash function
+	hf := sha256.New()
+
 	var r fr.Element
 	r.SetRandom()
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		BatchOpenSinglePoint(ps[:], commitments[:], &r, domain, benchSRS)
+		BatchOpenSinglePoint(ps[:], commitments[:], &r, hf, domain, benchSRS)
 	}
 }
 
@@ -342,20 +427,86 @@ func BenchmarkKZGBatchVerify10(b *testing.B) {
 		commitments[i], _ = Commit(ps[i], benchSRS)
 	}
 
+	// pick a hash function
+	hf := sha256.New()
+
 	var r fr.Element
 	r.SetRandom()
 
-	proof, err := BatchOpenSinglePoint(ps[:], commitments[:], &r, domain, benchSRS)
+	proof, err := BatchOpenSinglePoint(ps[:], commitments[:], &r, hf, domain, benchSRS)
 	if err != nil {
 		b.Fatal(err)
 	}
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		BatchVerifySinglePoint(commitments[:], &proof, benchSRS)
+		BatchVerifySinglePoint(commitments[:], &proof, hf, benchSRS)
 	}
 }
 
+func FuzzKZG(f *testing.F) {
+	f.Add([]byte{1, 2, 3}, []byte{42})
+	f.Add([]byte{}, []byte{0})
+	f.Add(bytes.Repeat([]byte{0xff}, 3*fr.Bytes), []byte{0xff})
+
+	domain := fft.NewDomain(uint64(len(testSRS.G1)), 0, false)
+
+	f.Fuzz(func(t *testing.T, coefficients, at []byte) {
+		// the coefficients are fr.Bytes chunks of the input, reduced mod r
+		p := make(polynomial.Polynomial, 0, len(testSRS.G1))
+		for len(coefficients) > 0 && len(p) < len(testSRS.G1) {
+			n := fr.Bytes
+			if n > len(coefficients) {
+				n = len(coefficients)
+			}
+			var c fr.Element
+			c.SetBytes(coefficients[:n])
+			p = append(p, c)
+			coefficients = coefficients[n:]
+		}
+		// Open commits to the quotient by (X - point), of degree at least 0
+		for len(p) < 2 {
+			p = append(p, fr.Element{})
+		}
+		var point fr.Element
+		point.SetBytes(at)
+
+		// the SRS is built with alpha = 42, so the commitment is [p(42)]G1
+		digest, err := Commit(p, testSRS)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var alpha fr.Element
+		var expected bw6756.G1Affine
+		var b big.Int
+		alpha.SetUint64(42)
+		pAlpha := p.Eval(&alpha)
+		expected.ScalarMultiplication(&testSRS.G1[0], pAlpha.ToBigIntRegular(&b))
+		if !expected.Equal(&digest) {
+			t.Fatal("commitment != [p(alpha)]G1")
+		}
+
+		proof, err := Open(p, &point, domain, testSRS)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pPoint := p.Eval(&point); !proof.ClaimedValue.Equal(&pPoint) {
+			t.Fatal("inconsistant claimed value")
+		}
+		if err := Verify(&digest, &proof, testSRS); err != nil {
+			t.Fatal(err)
+		}
+
+		// a wrong claimed value is rejected
+		var one fr.Element
+		one.SetOne()
+		proof.ClaimedValue.Add(&proof.ClaimedValue, &one)
+		if Verify(&digest, &proof, testSRS) == nil {
+			t.Fatal("verifying wrong proof should have failed")
+		}
+	})
+}
+
 func randomPolynomial(size int) polynomial.Polynomial {
 	f := make(polynomial.Polynomial, size)
 	for i := 0; i < size; i++ {
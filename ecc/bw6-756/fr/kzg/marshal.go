@@ -17,14 +17,14 @@
 package kzg
 
 import (
-	"github.com/consensys/gnark-crypto/ecc/bw6-767"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756"
 	"io"
 )
 
 // WriteTo writes binary encoding of the SRS
 func (srs *SRS) WriteTo(w io.Writer) (int64, error) {
 	// encode the SRS
-	enc := bw6767.NewEncoder(w)
+	enc := bw6756.NewEncoder(w)
 
 	toEncode := []interface{}{
 		&srs.G2[0],
@@ -44,7 +44,7 @@ func (srs *SRS) WriteTo(w io.Writer) (int64, error) {
 // ReadFrom decodes SRS data from reader.
 func (srs *SRS) ReadFrom(r io.Reader) (int64, error) {
 	// decode the SRS
-	dec := bw6767.NewDecoder(r)
+	dec := bw6756.NewDecoder(r)
 
 	toDecode := []interface{}{
 		&srs.G2[0],
@@ -63,7 +63,7 @@ func (srs *SRS) ReadFrom(r io.Reader) (int64, error) {
 
 // WriteTo writes binary encoding of a OpeningProof
 func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
-	enc := bw6767.NewEncoder(w)
+	enc := bw6756.NewEncoder(w)
 
 	toEncode := []interface{}{
 		&proof.H,
@@ -82,7 +82,7 @@ func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
 
 // ReadFrom decodes OpeningProof data from reader.
 func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
-	dec := bw6767.NewDecoder(r)
+	dec := bw6756.NewDecoder(r)
 
 	toDecode := []interface{}{
 		&proof.H,
@@ -101,7 +101,7 @@ func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
 
 // WriteTo writes binary encoding of a BatchOpeningProof
 func (proof *BatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
-	enc := bw6767.NewEncoder(w)
+	enc := bw6756.NewEncoder(w)
 
 	toEncode := []interface{}{
 		&proof.H,
@@ -120,7 +120,7 @@ func (proof *BatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
 
 // ReadFrom decodes BatchOpeningProof data from reader.
 func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
-	dec := bw6767.NewDecoder(r)
+	dec := bw6756.NewDecoder(r)
 
 	toDecode := []interface{}{
 		&proof.H,
@@ -0,0 +1,262 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bw6756
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fp"
+)
+
+// hashToFp hashes msg to count prime field elements.
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5.2
+func hashToFp(msg, dst []byte, count int) ([]fp.Element, error) {
+
+	// 128 bits of security
+	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
+	L := 112
+
+	lenInBytes := count * L
+	pseudoRandomBytes, err := ecc.ExpandMsgXmd(msg, dst, lenInBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]fp.Element, count)
+	for i := 0; i < count; i++ {
+		res[i].SetBytes(pseudoRandomBytes[i*L : (i+1)*L])
+	}
+	return res, nil
+}
+
+// returns false if u>-u when seen as a bigInt
+func sign0(u fp.Element) bool {
+	var a, b big.Int
+	u.ToBigIntRegular(&a)
+	u.Neg(&u)
+	u.ToBigIntRegular(&b)
+	return a.Cmp(&b) <= 0
+}
+
+// ----------------------------------------------------------------------------------------
+// G1Affine
+
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-4.1
+// Shallue and van de Woestijne method, works for any elliptic curve in Weierstrass curve
+func svdwMapG1(u fp.Element) G1Affine {
+
+	var res G1Affine
+
+	// constants
+	// sage script to find z: https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#appendix-E.1
+	var z, c1, c2, c3, c4 fp.Element
+	z.SetOne()
+	c1.SetString("2")
+	c2.SetString("183162695478688143295363277863609973912688910644623094139398704891720872678025228163994673580388732642095427562821043430262853248964259401622004374680181856276883253377613672296702199391943428932630544113135668167634206718951424")
+	c3.SetString("44724918635541747613641388754122468373124590147190123301097028599548226344531294896393070481114654454458200984945494713184328466922237226071123682508438597467447523336749298101207214456752293854953034448110052217888788253840855")
+	c4.SetString("244216927304917524393817703818146631883585214192830792185864939855627830237366970885326231440518310189460570083761391240350470998619012535496005832906909141702511004503484896395602932522591238576840725484180890890178942291935230")
+
+	var tv1, tv2, tv3, tv4, one, x1, gx1, x2, gx2, x3, x, gx, y fp.Element
+	one.SetOne()
+	tv1.Square(&u).Mul(&tv1, &c1)
+	tv2.Add(&one, &tv1)
+	tv1.Sub(&one, &tv1)
+	tv3.Mul(&tv2, &tv1).Inverse(&tv3)
+	tv4.Mul(&u, &tv1)
+	tv4.Mul(&tv4, &tv3)
+	tv4.Mul(&tv4, &c3)
+	x1.Sub(&c2, &tv4)
+	gx1.Square(&x1)
+	// 12. gx1 = gx1 + A
+	gx1.Mul(&gx1, &x1)
+	gx1.Add(&gx1, &bCurveCoeff)
+	e1 := gx1.Legendre()
+	x2.Add(&c2, &tv4)
+	gx2.Square(&x2)
+	// 18. gx2 = gx2 + A
+	gx2.Mul(&gx2, &x2)
+	gx2.Add(&gx2, &bCurveCoeff)
+	E2 := gx2.Legendre() - e1 // 2 if is_square(gx2) AND NOT e1
+	x3.Square(&tv2)
+	x3.Mul(&x3, &tv3)
+	x3.Square(&x3)
+	x3.Mul(&x3, &c4)
+	x3.Add(&x3, &z)
+	if e1 == 1 {
+		x.Set(&x1)
+	} else {
+		x.Set(&x3)
+	}
+	if E2 == 2 {
+		x.Set(&x2)
+	}
+	gx.Square(&x)
+	// gx = gx + A
+	gx.Mul(&gx, &x)
+	gx.Add(&gx, &bCurveCoeff)
+	y.Sqrt(&gx)
+	e3 := sign0(u) && sign0(y)
+	if !e3 {
+		y.Neg(&y)
+	}
+	res.X.Set(&x)
+	res.Y.Set(&y)
+
+	return res
+}
+
+// MapToCurveG1Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.1
+func MapToCurveG1Svdw(t fp.Element) G1Affine {
+	res := svdwMapG1(t)
+	res.ClearCofactor(&res)
+	return res
+}
+
+// EncodeToCurveG1Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.2
+func EncodeToCurveG1Svdw(msg, dst []byte) (G1Affine, error) {
+	var res G1Affine
+	t, err := hashToFp(msg, dst, 1)
+	if err != nil {
+		return res, err
+	}
+	res = MapToCurveG1Svdw(t[0])
+	return res, nil
+}
+
+// HashToCurveG1Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-3
+func HashToCurveG1Svdw(msg, dst []byte) (G1Affine, error) {
+	var res G1Affine
+	u, err := hashToFp(msg, dst, 2)
+	if err != nil {
+		return res, err
+	}
+	Q0 := MapToCurveG1Svdw(u[0])
+	Q1 := MapToCurveG1Svdw(u[1])
+	var _Q0, _Q1, _res G1Jac
+	_Q0.FromAffine(&Q0)
+	_Q1.FromAffine(&Q1)
+	_res.Set(&_Q1).AddAssign(&_Q0)
+	res.FromJacobian(&_res)
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------------------
+// G2Affine
+
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-4.1
+// Shallue and van de Woestijne method, works for any elliptic curve in Weierstrass curve
+func svdwMapG2(u fp.Element) G2Affine {
+
+	var res G2Affine
+
+	// constants
+	// sage script to find z: https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#appendix-E.1
+	var z, c1, c2, c3, c4 fp.Element
+	z.SetOne()
+	c1.SetString("34")
+	c2.SetString("183162695478688143295363277863609973912688910644623094139398704891720872678025228163994673580388732642095427562821043430262853248964259401622004374680181856276883253377613672296702199391943428932630544113135668167634206718951424")
+	c3.SetString("146433077860977604767773731846846066128329453951205949309133381060660557769470436688115507478076238051302460346380778731189432948203027606405253627801253330472118089084289142874549020683770046250396163515340816807916474328196956")
+	c4.SetString("122108463652458762196908851909073315941792607096415396092932469927813915118683485442663115720259155094730285041880695620175235499309506267748002916453454570851255502251742448197801466261295619288420362742090445445089471145967571")
+
+	var tv1, tv2, tv3, tv4, one, x1, gx1, x2, gx2, x3, x, gx, y fp.Element
+	one.SetOne()
+	tv1.Square(&u).Mul(&tv1, &c1)
+	tv2.Add(&one, &tv1)
+	tv1.Sub(&one, &tv1)
+	tv3.Mul(&tv2, &tv1).Inverse(&tv3)
+	tv4.Mul(&u, &tv1)
+	tv4.Mul(&tv4, &tv3)
+	tv4.Mul(&tv4, &c3)
+	x1.Sub(&c2, &tv4)
+	gx1.Square(&x1)
+	// 12. gx1 = gx1 + A
+	gx1.Mul(&gx1, &x1)
+	gx1.Add(&gx1, &bTwistCurveCoeff)
+	e1 := gx1.Legendre()
+	x2.Add(&c2, &tv4)
+	gx2.Square(&x2)
+	// 18. gx2 = gx2 + A
+	gx2.Mul(&gx2, &x2)
+	gx2.Add(&gx2, &bTwistCurveCoeff)
+	E2 := gx2.Legendre() - e1 // 2 if is_square(gx2) AND NOT e1
+	x3.Square(&tv2)
+	x3.Mul(&x3, &tv3)
+	x3.Square(&x3)
+	x3.Mul(&x3, &c4)
+	x3.Add(&x3, &z)
+	if e1 == 1 {
+		x.Set(&x1)
+	} else {
+		x.Set(&x3)
+	}
+	if E2 == 2 {
+		x.Set(&x2)
+	}
+	gx.Square(&x)
+	// gx = gx + A
+	gx.Mul(&gx, &x)
+	gx.Add(&gx, &bTwistCurveCoeff)
+	y.Sqrt(&gx)
+	e3 := sign0(u) && sign0(y)
+	if !e3 {
+		y.Neg(&y)
+	}
+	res.X.Set(&x)
+	res.Y.Set(&y)
+
+	return res
+}
+
+// MapToCurveG2Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.1
+func MapToCurveG2Svdw(t fp.Element) G2Affine {
+	res := svdwMapG2(t)
+	res.ClearCofactor(&res)
+	return res
+}
+
+// EncodeToCurveG2Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.2
+func EncodeToCurveG2Svdw(msg, dst []byte) (G2Affine, error) {
+	var res G2Affine
+	t, err := hashToFp(msg, dst, 1)
+	if err != nil {
+		return res, err
+	}
+	res = MapToCurveG2Svdw(t[0])
+	return res, nil
+}
+
+// HashToCurveG2Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-3
+func HashToCurveG2Svdw(msg, dst []byte) (G2Affine, error) {
+	var res G2Affine
+	u, err := hashToFp(msg, dst, 2)
+	if err != nil {
+		return res, err
+	}
+	Q0 := MapToCurveG2Svdw(u[0])
+	Q1 := MapToCurveG2Svdw(u[1])
+	var _Q0, _Q1, _res G2Jac
+	_Q0.FromAffine(&Q0)
+	_Q1.FromAffine(&Q1)
+	_res.Set(&_Q1).AddAssign(&_Q0)
+	res.FromJacobian(&_res)
+	return res, nil
+}
@@ -0,0 +1,291 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fptower
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fp"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+)
+
+// E6 is a degree two finite field extension of fp3
+type E6 struct {
+	B0, B1 E3
+}
+
+// Equal returns true if z equals x, fasle otherwise
+func (z *E6) Equal(x *E6) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// String puts E6 in string form
+func (z *E6) String() string {
+	return (z.B0.String() + "+(" + z.B1.String() + ")*v")
+}
+
+// SetString sets a E6 from string
+func (z *E6) SetString(s0, s1, s2, s3, s4, s5 string) *E6 {
+	z.B0.SetString(s0, s1, s2)
+	z.B1.SetString(s3, s4, s5)
+	return z
+}
+
+// Set copies x into z and returns z
+func (z *E6) Set(x *E6) *E6 {
+	*z = *x
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E6) SetOne() *E6 {
+	*z = E6{}
+	z.B0.A0.SetOne()
+	return z
+}
+
+// ToMont converts to Mont form
+func (z *E6) ToMont() *E6 {
+	z.B0.ToMont()
+	z.B1.ToMont()
+	return z
+}
+
+// FromMont converts from Mont form
+func (z *E6) FromMont() *E6 {
+	z.B0.FromMont()
+	z.B1.FromMont()
+	return z
+}
+
+// Add set z=x+y in E6 and return z
+func (z *E6) Add(x, y *E6) *E6 {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z to x sub y and return z
+func (z *E6) Sub(x, y *E6) *E6 {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z=2*x and returns z
+func (z *E6) Double(x *E6) *E6 {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// SetRandom used only in tests
+func (z *E6) SetRandom() (*E6, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Mul set z=x*y in E6 and return z
+func (z *E6) Mul(x, y *E6) *E6 {
+	var a, b, c E3
+	a.Add(&x.B0, &x.B1)
+	b.Add(&y.B0, &y.B1)
+	a.Mul(&a, &b)
+	b.Mul(&x.B0, &y.B0)
+	c.Mul(&x.B1, &y.B1)
+	z.B1.Sub(&a, &b).Sub(&z.B1, &c)
+	z.B0.MulByNonResidue(&c).Add(&z.B0, &b)
+	return z
+}
+
+// Square set z=x*x in E6 and return z
+func (z *E6) Square(x *E6) *E6 {
+
+	//Algorithm 22 from https://eprint.iacr.org/2010/354.pdf
+	var c0, c2, c3 E3
+	c0.Sub(&x.B0, &x.B1)
+	c3.MulByNonResidue(&x.B1).Neg(&c3).Add(&x.B0, &c3)
+	c2.Mul(&x.B0, &x.B1)
+	c0.Mul(&c0, &c3).Add(&c0, &c2)
+	z.B1.Double(&c2)
+	c2.MulByNonResidue(&c2)
+	z.B0.Add(&c0, &c2)
+
+	return z
+}
+
+// CyclotomicSquare https://eprint.iacr.org/2009/565.pdf, 3.2
+func (z *E6) CyclotomicSquare(x *E6) *E6 {
+	// x=(x0,x1,x2,x3,x4,x5,x6,x7) in E3^6
+	// cyclosquare(x)=(3*x4^2*u + 3*x0^2 - 2*x0,
+	//					3*x2^2*u + 3*x3^2 - 2*x1,
+	//					3*x5^2*u + 3*x1^2 - 2*x2,
+	//					6*x1*x5*u + 2*x3,
+	//					6*x0*x4 + 2*x4,
+	//					6*x2*x3 + 2*x5)
+
+	var t [9]fp.Element
+
+	t[0].Square(&x.B1.A1)
+	t[1].Square(&x.B0.A0)
+	t[6].Add(&x.B1.A1, &x.B0.A0).Square(&t[6]).Sub(&t[6], &t[0]).Sub(&t[6], &t[1]) // 2*x4*x0
+	t[2].Square(&x.B0.A2)
+	t[3].Square(&x.B1.A0)
+	t[7].Add(&x.B0.A2, &x.B1.A0).Square(&t[7]).Sub(&t[7], &t[2]).Sub(&t[7], &t[3]) // 2*x2*x3
+	t[4].Square(&x.B1.A2)
+	t[5].Square(&x.B0.A1)
+	t[8].Add(&x.B1.A2, &x.B0.A1).Square(&t[8]).Sub(&t[8], &t[4]).Sub(&t[8], &t[5]).MulByNonResidue(&t[8]) // 2*x5*x1*u
+
+	t[0].MulByNonResidue(&t[0]).Add(&t[0], &t[1]) // x4^2*u + x0^2
+	t[2].MulByNonResidue(&t[2]).Add(&t[2], &t[3]) // x2^2*u + x3^2
+	t[4].MulByNonResidue(&t[4]).Add(&t[4], &t[5]) // x5^2*u + x1^2
+
+	z.B0.A0.Sub(&t[0], &x.B0.A0).Double(&z.B0.A0).Add(&z.B0.A0, &t[0])
+	z.B0.A1.Sub(&t[2], &x.B0.A1).Double(&z.B0.A1).Add(&z.B0.A1, &t[2])
+	z.B0.A2.Sub(&t[4], &x.B0.A2).Double(&z.B0.A2).Add(&z.B0.A2, &t[4])
+
+	z.B1.A0.Add(&t[8], &x.B1.A0).Double(&z.B1.A0).Add(&z.B1.A0, &t[8])
+	z.B1.A1.Add(&t[6], &x.B1.A1).Double(&z.B1.A1).Add(&z.B1.A1, &t[6])
+	z.B1.A2.Add(&t[7], &x.B1.A2).Double(&z.B1.A2).Add(&z.B1.A2, &t[7])
+
+	return z
+}
+
+// Inverse set z to the inverse of x in E6 and return z
+func (z *E6) Inverse(x *E6) *E6 {
+	// Algorithm 23 from https://eprint.iacr.org/2010/354.pdf
+
+	var t0, t1, tmp E3
+	t0.Square(&x.B0)
+	t1.Square(&x.B1)
+	tmp.MulByNonResidue(&t1)
+	t0.Sub(&t0, &tmp)
+	t1.Inverse(&t0)
+	z.B0.Mul(&x.B0, &t1)
+	z.B1.Mul(&x.B1, &t1).Neg(&z.B1)
+
+	return z
+}
+
+// Exp sets z=x**e and returns it
+func (z *E6) Exp(x *E6, e big.Int) *E6 {
+	var res E6
+	res.SetOne()
+	b := e.Bytes()
+	for i := range b {
+		w := b[i]
+		mask := byte(0x80)
+		for j := 7; j >= 0; j-- {
+			res.Square(&res)
+			if (w&mask)>>j != 0 {
+				res.Mul(&res, x)
+			}
+			mask = mask >> 1
+		}
+	}
+	z.Set(&res)
+	return z
+}
+
+// InverseUnitary inverse a unitary element
+func (z *E6) InverseUnitary(x *E6) *E6 {
+	return z.Conjugate(x)
+}
+
+// Conjugate set z to x conjugated and return z
+func (z *E6) Conjugate(x *E6) *E6 {
+	*z = *x
+	z.B1.Neg(&z.B1)
+	return z
+}
+
+// SizeOfGT represents the size in bytes that a GT element need in binary form
+const SizeOfGT = fp.Bytes * 6
+
+// Bytes returns the regular (non montgomery) value
+// of z as a big-endian byte array.
+// z.C1.B2.A1 | z.C1.B2.A0 | z.C1.B1.A1 | ...
+func (z *E6) Bytes() (r [SizeOfGT]byte) {
+
+	offset := 0
+	var buf [fp.Bytes]byte
+
+	buf = z.B1.A2.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+	offset += fp.Bytes
+
+	buf = z.B1.A1.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+	offset += fp.Bytes
+
+	buf = z.B1.A0.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+	offset += fp.Bytes
+
+	buf = z.B0.A2.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+	offset += fp.Bytes
+
+	buf = z.B0.A1.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+	offset += fp.Bytes
+
+	buf = z.B0.A0.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+
+	return
+}
+
+// SetBytes interprets e as the bytes of a big-endian GT
+// sets z to that value (in Montgomery form), and returns z.
+// z.C1.B2.A1 | z.C1.B2.A0 | z.C1.B1.A1 | ...
+func (z *E6) SetBytes(e []byte) error {
+	if len(e) != SizeOfGT {
+		return errors.New("invalid buffer size")
+	}
+	offset := 0
+	z.B1.A2.SetBytes(e[offset : offset+fp.Bytes])
+	offset += fp.Bytes
+	z.B1.A1.SetBytes(e[offset : offset+fp.Bytes])
+	offset += fp.Bytes
+	z.B1.A0.SetBytes(e[offset : offset+fp.Bytes])
+	offset += fp.Bytes
+	z.B0.A2.SetBytes(e[offset : offset+fp.Bytes])
+	offset += fp.Bytes
+	z.B0.A1.SetBytes(e[offset : offset+fp.Bytes])
+	offset += fp.Bytes
+	z.B0.A0.SetBytes(e[offset : offset+fp.Bytes])
+	offset += fp.Bytes
+
+	return nil
+}
+
+var frModulus = fr.Modulus()
+
+// IsInSubGroup ensures GT/E12 is in correct sugroup
+func (z *E6) IsInSubGroup() bool {
+	var one, _z E6
+	one.SetOne()
+	_z.Exp(z, *frModulus)
+	return _z.Equal(&one)
+}
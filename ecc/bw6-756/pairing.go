@@ -0,0 +1,394 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bw6756
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fp"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/internal/fptower"
+)
+
+// GT target group of the pairing
+type GT = fptower.E6
+
+// LineEvaluation holds the three non-zero coefficients of a line of the Miller loop,
+// as multiplied into the accumulator with GT.MulBy014
+type LineEvaluation struct {
+	R0 fp.Element
+	R1 fp.Element
+	R2 fp.Element
+}
+
+// Pair calculates the reduced pairing for a set of points
+func Pair(P []G1Affine, Q []G2Affine) (GT, error) {
+	f, err := MillerLoop(P, Q)
+	if err != nil {
+		return GT{}, err
+	}
+	return FinalExponentiation(&f), nil
+}
+
+// PairingCheck calculates the reduced pairing for a set of points and returns True if the result is One
+func PairingCheck(P []G1Affine, Q []G2Affine) (bool, error) {
+	f, err := Pair(P, Q)
+	if err != nil {
+		return false, err
+	}
+	var one GT
+	one.SetOne()
+	return f.Equal(&one), nil
+}
+
+// FinalExponentiation computes the final expo x**(c*(p**3-1)(p+1)(p**2-p+1)/r)
+func FinalExponentiation(z *GT, _z ...*GT) GT {
+
+	var result GT
+	result.Set(z)
+
+	for _, e := range _z {
+		result.Mul(&result, e)
+	}
+
+	result = FinalExponentiationEasy(&result)
+	return FinalExponentiationHard(&result)
+}
+
+// FinalExponentiationEasy computes the easy part x**((p**3-1)(p+1)) of the final expo.
+// The result lies in the cyclotomic subgroup of GT.
+func FinalExponentiationEasy(z *GT) GT {
+
+	var result, buf GT
+	buf.Conjugate(z)
+	result.Inverse(z)
+	buf.Mul(&buf, &result)
+	result.Frobenius(&buf).
+		Mul(&result, &buf)
+
+	return result
+}
+
+// FinalExponentiationHard computes the hard part x**(c*(p**2-p+1)/r) of the final expo.
+// z must lie in the cyclotomic subgroup of GT, e.g. be the output of FinalExponentiationEasy.
+func FinalExponentiationHard(z *GT) GT {
+
+	// hard part exponent: 3(u+1)(p**2-p+1)/r = Σ λ0[i]*u**i + p*Σ λ1[i]*u**i
+	lambda0 := [10]int{9, -19, 22, -6, -16, 28, -26, 16, -6, 1}
+	lambda1 := [8]int{10, -13, 7, 2, -7, 7, -4, 1}
+
+	// m[i] = z**(u**i)
+	var m [10]GT
+	m[0].Set(z)
+	for i := 1; i < len(m); i++ {
+		m[i].Expt(&m[i-1])
+	}
+
+	// bases and absolute exponents of the multi-exponentiation,
+	// negative exponents are handled by conjugating the base (z is unitary)
+	var bases [18]GT
+	var exps [18]int
+	for i := 0; i < len(lambda0); i++ {
+		bases[i].Set(&m[i])
+		exps[i] = lambda0[i]
+		if exps[i] < 0 {
+			bases[i].Conjugate(&bases[i])
+			exps[i] = -exps[i]
+		}
+	}
+	for i := 0; i < len(lambda1); i++ {
+		k := len(lambda0) + i
+		bases[k].Frobenius(&m[i])
+		exps[k] = lambda1[i]
+		if exps[k] < 0 {
+			bases[k].Conjugate(&bases[k])
+			exps[k] = -exps[k]
+		}
+	}
+
+	// all exponents fit on 5 bits
+	var result GT
+	result.SetOne()
+	for b := 4; b >= 0; b-- {
+		result.CyclotomicSquare(&result)
+		for k := 0; k < len(bases); k++ {
+			if (exps[k]>>b)&1 == 1 {
+				result.Mul(&result, &bases[k])
+			}
+		}
+	}
+
+	return result
+}
+
+// MillerLoop Miller loop
+func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
+	// check input size match
+	n := len(P)
+	if n == 0 || n != len(Q) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+
+	// filter infinity points
+	p := make([]G1Affine, 0, n)
+	q := make([]G2Affine, 0, n)
+
+	for k := 0; k < n; k++ {
+		if P[k].IsInfinity() || Q[k].IsInfinity() {
+			continue
+		}
+		p = append(p, P[k])
+		q = append(q, Q[k])
+	}
+
+	n = len(p)
+
+	// projective points for Q
+	qProj1 := make([]g2Proj, n)
+	qProj2 := make([]g2Proj, n)
+	qNeg := make([]G2Affine, n)
+	for k := 0; k < n; k++ {
+		qProj1[k].FromAffine(&q[k])
+		qProj2[k].FromAffine(&q[k])
+		qNeg[k].Neg(&q[k])
+	}
+
+	// f_{u+1,Q}(P)
+	var result1 GT
+	result1.SetOne()
+	var l LineEvaluation
+
+	for i := 62; i >= 0; i-- {
+		result1.Square(&result1)
+
+		for k := 0; k < n; k++ {
+			qProj1[k].DoubleStep(&l)
+			// line evaluation
+			l.R1.Mul(&l.R1, &p[k].X)
+			l.R2.Mul(&l.R2, &p[k].Y)
+			result1.MulBy014(&l.R0, &l.R1, &l.R2)
+
+			if loopCounter1[i] == 1 {
+				qProj1[k].AddMixedStep(&l, &q[k])
+				// line evaluation
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result1.MulBy014(&l.R0, &l.R1, &l.R2)
+
+			} else if loopCounter1[i] == -1 {
+				qProj1[k].AddMixedStep(&l, &qNeg[k])
+				// line evaluation
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result1.MulBy014(&l.R0, &l.R1, &l.R2)
+			}
+		}
+	}
+
+	// f_{u^3-u^2-u,Q}(P)
+	var result2 GT
+	result2.SetOne()
+
+	for i := 189; i >= 0; i-- {
+		result2.Square(&result2)
+
+		for k := 0; k < n; k++ {
+			qProj2[k].DoubleStep(&l)
+			// line evaluation
+			l.R1.Mul(&l.R1, &p[k].X)
+			l.R2.Mul(&l.R2, &p[k].Y)
+			result2.MulBy014(&l.R0, &l.R1, &l.R2)
+
+			if loopCounter2[i] == 1 {
+				qProj2[k].AddMixedStep(&l, &q[k])
+				// line evaluation
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result2.MulBy014(&l.R0, &l.R1, &l.R2)
+
+			} else if loopCounter2[i] == -1 {
+				qProj2[k].AddMixedStep(&l, &qNeg[k])
+				// line evaluation
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result2.MulBy014(&l.R0, &l.R1, &l.R2)
+			}
+		}
+	}
+
+	result2.Frobenius(&result2).
+		Mul(&result2, &result1)
+
+	return result2, nil
+}
+
+// MillerLoopStep records a line multiplication of the Miller loop
+type MillerLoopStep struct {
+	Line        LineEvaluation // line as output by the doubling or addition step
+	Evaluation  LineEvaluation // Line evaluated at the G1 point
+	Accumulator GT             // Miller loop accumulator once Evaluation is multiplied in
+}
+
+// MillerLoopSteps runs the Miller loop on a single pair (P, Q) and returns, in order,
+// every line multiplied into the accumulator along with the resulting accumulator.
+// The loop is split in two Miller loops, f_{u+1,Q} then f_{u^3-u^2-u,Q}, each with its own
+// accumulator; they are combined into the result once both are computed.
+// The returned GT equals MillerLoop([]G1Affine{P}, []G2Affine{Q}).
+// If P or Q is the point at infinity, no step is recorded and the result is one.
+func MillerLoopSteps(P *G1Affine, Q *G2Affine) ([]MillerLoopStep, GT) {
+	var result GT
+	result.SetOne()
+	if P.IsInfinity() || Q.IsInfinity() {
+		return nil, result
+	}
+
+	var qProj1, qProj2 g2Proj
+	var qNeg G2Affine
+	qProj1.FromAffine(Q)
+	qProj2.FromAffine(Q)
+	qNeg.Neg(Q)
+
+	var l LineEvaluation
+	steps := make([]MillerLoopStep, 0, 2*(len(loopCounter1)+len(loopCounter2)))
+	mulLine := func(acc *GT) {
+		step := MillerLoopStep{Line: l}
+		l.R1.Mul(&l.R1, &P.X)
+		l.R2.Mul(&l.R2, &P.Y)
+		step.Evaluation = l
+		acc.MulBy014(&l.R0, &l.R1, &l.R2)
+		step.Accumulator = *acc
+		steps = append(steps, step)
+	}
+
+	// f_{u+1,Q}(P)
+	var result1 GT
+	result1.SetOne()
+
+	for i := 62; i >= 0; i-- {
+		result1.Square(&result1)
+
+		qProj1.DoubleStep(&l)
+		mulLine(&result1)
+
+		if loopCounter1[i] == 1 {
+			qProj1.AddMixedStep(&l, Q)
+			mulLine(&result1)
+		} else if loopCounter1[i] == -1 {
+			qProj1.AddMixedStep(&l, &qNeg)
+			mulLine(&result1)
+		}
+	}
+
+	// f_{u^3-u^2-u,Q}(P)
+	var result2 GT
+	result2.SetOne()
+
+	for i := 189; i >= 0; i-- {
+		result2.Square(&result2)
+
+		qProj2.DoubleStep(&l)
+		mulLine(&result2)
+
+		if loopCounter2[i] == 1 {
+			qProj2.AddMixedStep(&l, Q)
+			mulLine(&result2)
+		} else if loopCounter2[i] == -1 {
+			qProj2.AddMixedStep(&l, &qNeg)
+			mulLine(&result2)
+		}
+	}
+
+	result.Frobenius(&result2).
+		Mul(&result, &result1)
+
+	return steps, result
+}
+
+// DoubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
+// https://eprint.iacr.org/2013/722.pdf (Section 4.3)
+func (p *g2Proj) DoubleStep(evaluations *LineEvaluation) {
+
+	// get some Element from our pool
+	var t0, t1, A, B, C, D, E, EE, F, G, H, I, J, K fp.Element
+	t0.Mul(&p.x, &p.y)
+	A.Mul(&t0, &twoInv)
+	B.Square(&p.y)
+	C.Square(&p.z)
+	D.Double(&C).
+		Add(&D, &C)
+	E.Mul(&D, &bTwistCurveCoeff)
+	F.Double(&E).
+		Add(&F, &E)
+	G.Add(&B, &F)
+	G.Mul(&G, &twoInv)
+	H.Add(&p.y, &p.z).
+		Square(&H)
+	t1.Add(&B, &C)
+	H.Sub(&H, &t1)
+	I.Sub(&E, &B)
+	J.Square(&p.x)
+	EE.Square(&E)
+	K.Double(&EE).
+		Add(&K, &EE)
+
+	// X, Y, Z
+	p.x.Sub(&B, &F).
+		Mul(&p.x, &A)
+	p.y.Square(&G).
+		Sub(&p.y, &K)
+	p.z.Mul(&B, &H)
+
+	// Line evaluation
+	evaluations.R0.Set(&I)
+	evaluations.R1.Double(&J).
+		Add(&evaluations.R1, &J)
+	evaluations.R2.Neg(&H)
+}
+
+// AddMixedStep point addition in Mixed Homogenous projective and Affine coordinates
+// https://eprint.iacr.org/2013/722.pdf (Section 4.3)
+func (p *g2Proj) AddMixedStep(evaluations *LineEvaluation, a *G2Affine) {
+
+	// get some Element from our pool
+	var Y2Z1, X2Z1, O, L, C, D, E, F, G, H, t0, t1, t2, J fp.Element
+	Y2Z1.Mul(&a.Y, &p.z)
+	O.Sub(&p.y, &Y2Z1)
+	X2Z1.Mul(&a.X, &p.z)
+	L.Sub(&p.x, &X2Z1)
+	C.Square(&O)
+	D.Square(&L)
+	E.Mul(&L, &D)
+	F.Mul(&p.z, &C)
+	G.Mul(&p.x, &D)
+	t0.Double(&G)
+	H.Add(&E, &F).
+		Sub(&H, &t0)
+	t1.Mul(&p.y, &E)
+
+	// X, Y, Z
+	p.x.Mul(&L, &H)
+	p.y.Sub(&G, &H).
+		Mul(&p.y, &O).
+		Sub(&p.y, &t1)
+	p.z.Mul(&E, &p.z)
+
+	t2.Mul(&L, &a.Y)
+	J.Mul(&a.X, &O).
+		Sub(&J, &t2)
+
+	// Line evaluation
+	evaluations.R0.Set(&J)
+	evaluations.R1.Neg(&O)
+	evaluations.R2.Set(&L)
+}
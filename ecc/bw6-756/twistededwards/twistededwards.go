@@ -0,0 +1,50 @@
+package twistededwards
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+)
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fr.Element // in Montgomery form
+	Cofactor fr.Element // not in Montgomery form
+	Order    big.Int
+	Base     PointAffine
+}
+
+var edwards CurveParams
+
+// GetEdwardsCurve returns the twisted Edwards curve on BW6-756's Fr
+func GetEdwardsCurve() CurveParams {
+
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&edwards.A)
+	res.D.Set(&edwards.D)
+	res.Cofactor.Set(&edwards.Cofactor)
+	res.Order.Set(&edwards.Order)
+	res.Base.Set(&edwards.Base)
+
+	return res
+}
+
+func init() {
+
+	edwards.A.SetUint64(5)
+	edwards.D.SetString("138447589981846723844948916251443809762074185994874752763378870399632089011935964923033491793629568495439456097277")
+	edwards.Cofactor.SetUint64(4).FromMont()
+	edwards.Order.SetString("151312051518826542933312120395450240184961922942731228438010363309842809080629924523086170342873113433032978443073", 10)
+
+	edwards.Base.X.SetString("53980948778435592162594546839614319117101440468521675755240427281231014516492074725164776608845357574550087083631")
+	edwards.Base.Y.SetString("526438924016457005453596907558466767195980809407592196108052040575566137295457437331686802290698260404722841598273")
+}
+
+// mulByA multiplies x by the curve coefficient a=5
+func mulByA(x *fr.Element) {
+	var tmp fr.Element
+	tmp.Double(x).Double(&tmp)
+	x.Add(x, &tmp)
+}
@@ -22,4 +22,8 @@ package fr
 // note: this is needed for test purposes, as dynamically changing supportAdx doesn't flag
 // certain errors (like fatal error: missing stackmap)
 // this ensures we test all asm path.
-var supportAdx = false
+var (
+	supportAdx    = false
+	supportAvx512 = false
+	supportAvx2   = false
+)
@@ -0,0 +1,1145 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x8508c00000000001
+DATA q<>+8(SB)/8, $0x170b5d4430000000
+DATA q<>+16(SB)/8, $0x1ef3622fba094800
+DATA q<>+24(SB)/8, $0x1a22d9f300f5138f
+DATA q<>+32(SB)/8, $0xc63b05c06ca1493b
+DATA q<>+40(SB)/8, $0x01ae3a4617c510ea
+GLOBL q<>(SB), (RODATA+NOPTR), $48
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x8508bfffffffffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+// add(res, x, y *Element)
+TEXT ·add(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   32(R0), (R6, R7)
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R8, R9)
+	ADDS  R8, R2, R2
+	ADCS  R9, R3, R3
+	LDP   16(R1), (R8, R9)
+	ADCS  R8, R4, R4
+	ADCS  R9, R5, R5
+	LDP   32(R1), (R8, R9)
+	ADCS  R8, R6, R6
+	ADCS  R9, R7, R7
+	MOVD  $q<>(SB), R10
+	LDP   0(R10), (R12, R13)
+	SUBS  R12, R2, R14
+	SBCS  R13, R3, R14
+	LDP   16(R10), (R12, R13)
+	SBCS  R12, R4, R14
+	SBCS  R13, R5, R14
+	LDP   32(R10), (R12, R13)
+	SBCS  R12, R6, R14
+	SBCS  R13, R7, R14
+	CSETM CS, R11
+	LDP   0(R10), (R12, R13)
+	AND   R11, R12, R12
+	SUBS  R12, R2, R2
+	AND   R11, R13, R13
+	SBCS  R13, R3, R3
+	LDP   16(R10), (R12, R13)
+	AND   R11, R12, R12
+	SBCS  R12, R4, R4
+	AND   R11, R13, R13
+	SBCS  R13, R5, R5
+	LDP   32(R10), (R12, R13)
+	AND   R11, R12, R12
+	SBCS  R12, R6, R6
+	AND   R11, R13, R13
+	SBCS  R13, R7, R7
+	MOVD  res+0(FP), R15
+	STP   (R2, R3), 0(R15)
+	STP   (R4, R5), 16(R15)
+	STP   (R6, R7), 32(R15)
+	RET
+
+// sub(res, x, y *Element)
+TEXT ·sub(SB), NOSPLIT, $0-24
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   32(R0), (R6, R7)
+	MOVD  y+16(FP), R1
+	LDP   0(R1), (R8, R9)
+	SUBS  R8, R2, R2
+	SBCS  R9, R3, R3
+	LDP   16(R1), (R8, R9)
+	SBCS  R8, R4, R4
+	SBCS  R9, R5, R5
+	LDP   32(R1), (R8, R9)
+	SBCS  R8, R6, R6
+	SBCS  R9, R7, R7
+	CSETM CC, R10
+	MOVD  $q<>(SB), R11
+	LDP   0(R11), (R12, R13)
+	AND   R10, R12, R12
+	ADDS  R12, R2, R2
+	AND   R10, R13, R13
+	ADCS  R13, R3, R3
+	LDP   16(R11), (R12, R13)
+	AND   R10, R12, R12
+	ADCS  R12, R4, R4
+	AND   R10, R13, R13
+	ADCS  R13, R5, R5
+	LDP   32(R11), (R12, R13)
+	AND   R10, R12, R12
+	ADCS  R12, R6, R6
+	AND   R10, R13, R13
+	ADCS  R13, R7, R7
+	MOVD  res+0(FP), R14
+	STP   (R2, R3), 0(R14)
+	STP   (R4, R5), 16(R14)
+	STP   (R6, R7), 32(R14)
+	RET
+
+// double(res, x *Element)
+TEXT ·double(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	ADDS  R1, R1, R1
+	ADCS  R2, R2, R2
+	ADCS  R3, R3, R3
+	ADCS  R4, R4, R4
+	ADCS  R5, R5, R5
+	ADCS  R6, R6, R6
+	MOVD  $q<>(SB), R7
+	LDP   0(R7), (R9, R10)
+	SUBS  R9, R1, R11
+	SBCS  R10, R2, R11
+	LDP   16(R7), (R9, R10)
+	SBCS  R9, R3, R11
+	SBCS  R10, R4, R11
+	LDP   32(R7), (R9, R10)
+	SBCS  R9, R5, R11
+	SBCS  R10, R6, R11
+	CSETM CS, R8
+	LDP   0(R7), (R9, R10)
+	AND   R8, R9, R9
+	SUBS  R9, R1, R1
+	AND   R8, R10, R10
+	SBCS  R10, R2, R2
+	LDP   16(R7), (R9, R10)
+	AND   R8, R9, R9
+	SBCS  R9, R3, R3
+	AND   R8, R10, R10
+	SBCS  R10, R4, R4
+	LDP   32(R7), (R9, R10)
+	AND   R8, R9, R9
+	SBCS  R9, R5, R5
+	AND   R8, R10, R10
+	SBCS  R10, R6, R6
+	MOVD  res+0(FP), R12
+	STP   (R1, R2), 0(R12)
+	STP   (R3, R4), 16(R12)
+	STP   (R5, R6), 32(R12)
+	RET
+
+// neg(res, x *Element)
+TEXT ·neg(SB), NOSPLIT, $0-16
+	MOVD  x+8(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	ORR   R1, R2, R9
+	ORR   R3, R9, R9
+	ORR   R4, R9, R9
+	ORR   R5, R9, R9
+	ORR   R6, R9, R9
+	CMP   $0, R9
+	CSETM NE, R9
+	MOVD  $q<>(SB), R0
+	LDP   0(R0), (R7, R8)
+	SUBS  R1, R7, R1
+	SBCS  R2, R8, R2
+	LDP   16(R0), (R7, R8)
+	SBCS  R3, R7, R3
+	SBCS  R4, R8, R4
+	LDP   32(R0), (R7, R8)
+	SBCS  R5, R7, R5
+	SBCS  R6, R8, R6
+	AND   R9, R1, R1
+	AND   R9, R2, R2
+	AND   R9, R3, R3
+	AND   R9, R4, R4
+	AND   R9, R5, R5
+	AND   R9, R6, R6
+	MOVD  res+0(FP), R0
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	RET
+
+// reduce(res *Element)
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD  res+0(FP), R0
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	MOVD  $q<>(SB), R7
+	LDP   0(R7), (R9, R10)
+	SUBS  R9, R1, R11
+	SBCS  R10, R2, R11
+	LDP   16(R7), (R9, R10)
+	SBCS  R9, R3, R11
+	SBCS  R10, R4, R11
+	LDP   32(R7), (R9, R10)
+	SBCS  R9, R5, R11
+	SBCS  R10, R6, R11
+	CSETM CS, R8
+	LDP   0(R7), (R9, R10)
+	AND   R8, R9, R9
+	SUBS  R9, R1, R1
+	AND   R8, R10, R10
+	SBCS  R10, R2, R2
+	LDP   16(R7), (R9, R10)
+	AND   R8, R9, R9
+	SBCS  R9, R3, R3
+	AND   R8, R10, R10
+	SBCS  R10, R4, R4
+	LDP   32(R7), (R9, R10)
+	AND   R8, R9, R9
+	SBCS  R9, R5, R5
+	AND   R8, R10, R10
+	SBCS  R10, R6, R6
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD  a+0(FP), R0
+	MOVD  b+8(FP), R1
+	LDP   0(R0), (R2, R3)
+	LDP   16(R0), (R4, R5)
+	LDP   32(R0), (R6, R7)
+	LDP   0(R1), (R8, R9)
+	LDP   16(R1), (R10, R11)
+	LDP   32(R1), (R12, R13)
+	ADDS  R8, R2, R14
+	ADCS  R9, R3, R15
+	ADCS  R10, R4, R16
+	ADCS  R11, R5, R17
+	ADCS  R12, R6, R19
+	ADCS  R13, R7, R20
+	MOVD  $q<>(SB), R21
+	LDP   0(R21), (R23, R24)
+	SUBS  R23, R14, R25
+	SBCS  R24, R15, R25
+	LDP   16(R21), (R23, R24)
+	SBCS  R23, R16, R25
+	SBCS  R24, R17, R25
+	LDP   32(R21), (R23, R24)
+	SBCS  R23, R19, R25
+	SBCS  R24, R20, R25
+	CSETM CS, R22
+	LDP   0(R21), (R23, R24)
+	AND   R22, R23, R23
+	SUBS  R23, R14, R14
+	AND   R22, R24, R24
+	SBCS  R24, R15, R15
+	LDP   16(R21), (R23, R24)
+	AND   R22, R23, R23
+	SBCS  R23, R16, R16
+	AND   R22, R24, R24
+	SBCS  R24, R17, R17
+	LDP   32(R21), (R23, R24)
+	AND   R22, R23, R23
+	SBCS  R23, R19, R19
+	AND   R22, R24, R24
+	SBCS  R24, R20, R20
+	STP   (R14, R15), 0(R0)
+	STP   (R16, R17), 16(R0)
+	STP   (R19, R20), 32(R0)
+	SUBS  R8, R2, R2
+	SBCS  R9, R3, R3
+	SBCS  R10, R4, R4
+	SBCS  R11, R5, R5
+	SBCS  R12, R6, R6
+	SBCS  R13, R7, R7
+	CSETM CC, R26
+	MOVD  $q<>(SB), R21
+	LDP   0(R21), (R22, R23)
+	AND   R26, R22, R22
+	ADDS  R22, R2, R2
+	AND   R26, R23, R23
+	ADCS  R23, R3, R3
+	LDP   16(R21), (R22, R23)
+	AND   R26, R22, R22
+	ADCS  R22, R4, R4
+	AND   R26, R23, R23
+	ADCS  R23, R5, R5
+	LDP   32(R21), (R22, R23)
+	AND   R26, R22, R22
+	ADCS  R22, R6, R6
+	AND   R26, R23, R23
+	ADCS  R23, R7, R7
+	STP   (R2, R3), 0(R1)
+	STP   (R4, R5), 16(R1)
+	STP   (R6, R7), 32(R1)
+	RET
+
+// mul(res, x, y *Element)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	MOVD y+16(FP), R1
+	MOVD $q<>(SB), R2
+	MOVD qInv0<>(SB), R3
+
+	// round 0
+	MOVD  0(R0), R10
+	MOVD  0(R1), R11
+	MUL   R10, R11, R16
+	UMULH R10, R11, R14
+	MUL   R3, R16, R13
+	MOVD  0(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R15
+	MOVD  8(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R14
+	MOVD  8(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R4
+	ADC   ZR, R19, R15
+	MOVD  16(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R14
+	MOVD  16(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R5
+	ADC   ZR, R19, R15
+	MOVD  24(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R14
+	MOVD  24(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R6
+	ADC   ZR, R19, R15
+	MOVD  32(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R14
+	MOVD  32(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R7
+	ADC   ZR, R19, R15
+	MOVD  40(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R14
+	MOVD  40(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R8
+	ADC   ZR, R19, R15
+	ADD   R14, R15, R9
+
+	// round 1
+	MOVD  8(R0), R10
+	MOVD  0(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R4, R17, R16
+	ADC   ZR, R19, R14
+	MUL   R3, R16, R13
+	MOVD  0(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R15
+	MOVD  8(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R5, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  8(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R4
+	ADC   ZR, R19, R15
+	MOVD  16(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R6, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  16(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R5
+	ADC   ZR, R19, R15
+	MOVD  24(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R7, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  24(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R6
+	ADC   ZR, R19, R15
+	MOVD  32(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R8, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  32(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R7
+	ADC   ZR, R19, R15
+	MOVD  40(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R9, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  40(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R8
+	ADC   ZR, R19, R15
+	ADD   R14, R15, R9
+
+	// round 2
+	MOVD  16(R0), R10
+	MOVD  0(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R4, R17, R16
+	ADC   ZR, R19, R14
+	MUL   R3, R16, R13
+	MOVD  0(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R15
+	MOVD  8(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R5, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  8(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R4
+	ADC   ZR, R19, R15
+	MOVD  16(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R6, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  16(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R5
+	ADC   ZR, R19, R15
+	MOVD  24(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R7, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  24(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R6
+	ADC   ZR, R19, R15
+	MOVD  32(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R8, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  32(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R7
+	ADC   ZR, R19, R15
+	MOVD  40(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R9, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  40(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R8
+	ADC   ZR, R19, R15
+	ADD   R14, R15, R9
+
+	// round 3
+	MOVD  24(R0), R10
+	MOVD  0(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R4, R17, R16
+	ADC   ZR, R19, R14
+	MUL   R3, R16, R13
+	MOVD  0(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R15
+	MOVD  8(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R5, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  8(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R4
+	ADC   ZR, R19, R15
+	MOVD  16(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R6, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  16(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R5
+	ADC   ZR, R19, R15
+	MOVD  24(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R7, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  24(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R6
+	ADC   ZR, R19, R15
+	MOVD  32(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R8, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  32(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R7
+	ADC   ZR, R19, R15
+	MOVD  40(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R9, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  40(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R8
+	ADC   ZR, R19, R15
+	ADD   R14, R15, R9
+
+	// round 4
+	MOVD  32(R0), R10
+	MOVD  0(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R4, R17, R16
+	ADC   ZR, R19, R14
+	MUL   R3, R16, R13
+	MOVD  0(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R15
+	MOVD  8(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R5, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  8(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R4
+	ADC   ZR, R19, R15
+	MOVD  16(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R6, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  16(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R5
+	ADC   ZR, R19, R15
+	MOVD  24(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R7, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  24(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R6
+	ADC   ZR, R19, R15
+	MOVD  32(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R8, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  32(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R7
+	ADC   ZR, R19, R15
+	MOVD  40(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R9, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  40(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R8
+	ADC   ZR, R19, R15
+	ADD   R14, R15, R9
+
+	// round 5
+	MOVD  40(R0), R10
+	MOVD  0(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R4, R17, R16
+	ADC   ZR, R19, R14
+	MUL   R3, R16, R13
+	MOVD  0(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R16, R17, R17
+	ADC   ZR, R19, R15
+	MOVD  8(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R5, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  8(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R4
+	ADC   ZR, R19, R15
+	MOVD  16(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R6, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  16(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R5
+	ADC   ZR, R19, R15
+	MOVD  24(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R7, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  24(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R6
+	ADC   ZR, R19, R15
+	MOVD  32(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R8, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  32(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R7
+	ADC   ZR, R19, R15
+	MOVD  40(R1), R11
+	MUL   R10, R11, R17
+	UMULH R10, R11, R19
+	ADDS  R14, R17, R16
+	ADC   ZR, R19, R19
+	ADDS  R9, R16, R16
+	ADC   ZR, R19, R14
+	MOVD  40(R2), R12
+	MUL   R13, R12, R17
+	UMULH R13, R12, R19
+	ADDS  R15, R17, R17
+	ADC   ZR, R19, R19
+	ADDS  R16, R17, R8
+	ADC   ZR, R19, R15
+	ADD   R14, R15, R9
+	MOVD  $q<>(SB), R20
+	LDP   0(R20), (R22, R23)
+	SUBS  R22, R4, R24
+	SBCS  R23, R5, R24
+	LDP   16(R20), (R22, R23)
+	SBCS  R22, R6, R24
+	SBCS  R23, R7, R24
+	LDP   32(R20), (R22, R23)
+	SBCS  R22, R8, R24
+	SBCS  R23, R9, R24
+	CSETM CS, R21
+	LDP   0(R20), (R22, R23)
+	AND   R21, R22, R22
+	SUBS  R22, R4, R4
+	AND   R21, R23, R23
+	SBCS  R23, R5, R5
+	LDP   16(R20), (R22, R23)
+	AND   R21, R22, R22
+	SBCS  R22, R6, R6
+	AND   R21, R23, R23
+	SBCS  R23, R7, R7
+	LDP   32(R20), (R22, R23)
+	AND   R21, R22, R22
+	SBCS  R22, R8, R8
+	AND   R21, R23, R23
+	SBCS  R23, R9, R9
+	MOVD  res+0(FP), R25
+	STP   (R4, R5), 0(R25)
+	STP   (R6, R7), 16(R25)
+	STP   (R8, R9), 32(R25)
+	RET
+
+// fromMont(res *Element)
+TEXT ·fromMont(SB), NOSPLIT, $0-8
+	MOVD res+0(FP), R0
+	MOVD $q<>(SB), R1
+	MOVD qInv0<>(SB), R2
+	LDP  0(R0), (R3, R4)
+	LDP  16(R0), (R5, R6)
+	LDP  32(R0), (R7, R8)
+
+	// round 0
+	MUL   R2, R3, R10
+	MOVD  0(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R3, R12, R12
+	ADC   ZR, R13, R11
+	MOVD  8(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R4, R12, R3
+	ADC   ZR, R13, R11
+	MOVD  16(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R5, R12, R4
+	ADC   ZR, R13, R11
+	MOVD  24(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R6, R12, R5
+	ADC   ZR, R13, R11
+	MOVD  32(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R7, R12, R6
+	ADC   ZR, R13, R11
+	MOVD  40(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R8, R12, R7
+	ADC   ZR, R13, R11
+	MOVD  R11, R8
+
+	// round 1
+	MUL   R2, R3, R10
+	MOVD  0(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R3, R12, R12
+	ADC   ZR, R13, R11
+	MOVD  8(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R4, R12, R3
+	ADC   ZR, R13, R11
+	MOVD  16(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R5, R12, R4
+	ADC   ZR, R13, R11
+	MOVD  24(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R6, R12, R5
+	ADC   ZR, R13, R11
+	MOVD  32(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R7, R12, R6
+	ADC   ZR, R13, R11
+	MOVD  40(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R8, R12, R7
+	ADC   ZR, R13, R11
+	MOVD  R11, R8
+
+	// round 2
+	MUL   R2, R3, R10
+	MOVD  0(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R3, R12, R12
+	ADC   ZR, R13, R11
+	MOVD  8(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R4, R12, R3
+	ADC   ZR, R13, R11
+	MOVD  16(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R5, R12, R4
+	ADC   ZR, R13, R11
+	MOVD  24(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R6, R12, R5
+	ADC   ZR, R13, R11
+	MOVD  32(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R7, R12, R6
+	ADC   ZR, R13, R11
+	MOVD  40(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R8, R12, R7
+	ADC   ZR, R13, R11
+	MOVD  R11, R8
+
+	// round 3
+	MUL   R2, R3, R10
+	MOVD  0(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R3, R12, R12
+	ADC   ZR, R13, R11
+	MOVD  8(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R4, R12, R3
+	ADC   ZR, R13, R11
+	MOVD  16(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R5, R12, R4
+	ADC   ZR, R13, R11
+	MOVD  24(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R6, R12, R5
+	ADC   ZR, R13, R11
+	MOVD  32(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R7, R12, R6
+	ADC   ZR, R13, R11
+	MOVD  40(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R8, R12, R7
+	ADC   ZR, R13, R11
+	MOVD  R11, R8
+
+	// round 4
+	MUL   R2, R3, R10
+	MOVD  0(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R3, R12, R12
+	ADC   ZR, R13, R11
+	MOVD  8(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R4, R12, R3
+	ADC   ZR, R13, R11
+	MOVD  16(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R5, R12, R4
+	ADC   ZR, R13, R11
+	MOVD  24(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R6, R12, R5
+	ADC   ZR, R13, R11
+	MOVD  32(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R7, R12, R6
+	ADC   ZR, R13, R11
+	MOVD  40(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R8, R12, R7
+	ADC   ZR, R13, R11
+	MOVD  R11, R8
+
+	// round 5
+	MUL   R2, R3, R10
+	MOVD  0(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R3, R12, R12
+	ADC   ZR, R13, R11
+	MOVD  8(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R4, R12, R3
+	ADC   ZR, R13, R11
+	MOVD  16(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R5, R12, R4
+	ADC   ZR, R13, R11
+	MOVD  24(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R6, R12, R5
+	ADC   ZR, R13, R11
+	MOVD  32(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R7, R12, R6
+	ADC   ZR, R13, R11
+	MOVD  40(R1), R9
+	MUL   R10, R9, R12
+	UMULH R10, R9, R13
+	ADDS  R11, R12, R12
+	ADC   ZR, R13, R13
+	ADDS  R8, R12, R7
+	ADC   ZR, R13, R11
+	MOVD  R11, R8
+	MOVD  $q<>(SB), R14
+	LDP   0(R14), (R16, R17)
+	SUBS  R16, R3, R19
+	SBCS  R17, R4, R19
+	LDP   16(R14), (R16, R17)
+	SBCS  R16, R5, R19
+	SBCS  R17, R6, R19
+	LDP   32(R14), (R16, R17)
+	SBCS  R16, R7, R19
+	SBCS  R17, R8, R19
+	CSETM CS, R15
+	LDP   0(R14), (R16, R17)
+	AND   R15, R16, R16
+	SUBS  R16, R3, R3
+	AND   R15, R17, R17
+	SBCS  R17, R4, R4
+	LDP   16(R14), (R16, R17)
+	AND   R15, R16, R16
+	SBCS  R16, R5, R5
+	AND   R15, R17, R17
+	SBCS  R17, R6, R6
+	LDP   32(R14), (R16, R17)
+	AND   R15, R16, R16
+	SBCS  R16, R7, R7
+	AND   R15, R17, R17
+	SBCS  R17, R8, R8
+	STP   (R3, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	STP   (R7, R8), 32(R0)
+	RET
+
@@ -206,3 +206,41 @@ func TestPolynomialAdd(t *testing.T) {
 		t.Fatal("side effect, _f2 should not have been modified")
 	}
 }
+
+func TestPolynomialEvalMatchesHorner(t *testing.T) {
+	for _, n := range []int{1, 2, 127, 128, 129, 200, 1000} {
+		f := make(Polynomial, n)
+		for i := 0; i < n; i++ {
+			f[i].SetRandom()
+		}
+		var point fr.Element
+		point.SetRandom()
+
+		got, expected := f.Eval(&point), f.evalHorner(&point)
+		if !got.Equal(&expected) {
+			t.Fatalf("polynomial evaluation of size %d doesn't match Horner's method", n)
+		}
+	}
+}
+
+func BenchmarkPolynomialEval(b *testing.B) {
+	const n = 1 << 10
+	f := make(Polynomial, n)
+	for i := 0; i < n; i++ {
+		f[i].SetRandom()
+	}
+	var point, res fr.Element
+	point.SetRandom()
+
+	b.Run("eval", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res = f.Eval(&point)
+		}
+	})
+	b.Run("horner", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res = f.evalHorner(&point)
+		}
+	})
+	_ = res
+}
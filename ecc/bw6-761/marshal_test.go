@@ -295,6 +295,47 @@ func TestG1AffineSerialization(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func FuzzG1AffineSetBytes(f *testing.F) {
+	var p G1Affine
+	p.ScalarMultiplication(&g1GenAff, big.NewInt(42))
+	for _, q := range []G1Affine{g1GenAff, p, {}} {
+		compressed, raw := q.Bytes(), q.RawBytes()
+		f.Add(compressed[:])
+		f.Add(raw[:])
+	}
+
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		var p, q G1Affine
+		n, err := p.SetBytes(buf)
+		if err != nil {
+			return
+		}
+		if n != SizeOfG1AffineCompressed && n != SizeOfG1AffineUncompressed {
+			t.Fatal("invalid number of bytes consumed in buffer")
+		}
+
+		// a decoded point is on the curve and in the subgroup
+		if !p.IsInfinity() && !(p.IsOnCurve() && p.IsInSubGroup()) {
+			t.Fatal("decoded point is not in the subgroup")
+		}
+
+		// and encodes back to a point decoding to the same point
+		compressed, raw := p.Bytes(), p.RawBytes()
+		if _, err := q.SetBytes(compressed[:]); err != nil || !q.Equal(&p) {
+			t.Fatal("SetBytes(Bytes()) should stay the same")
+		}
+		if _, err := q.SetBytes(raw[:]); err != nil || !q.Equal(&p) {
+			t.Fatal("SetBytes(RawBytes()) should stay the same")
+		}
+
+		// the decoder reads the same point
+		dec := NewDecoder(bytes.NewReader(buf))
+		if err := dec.Decode(&q); err != nil || !q.Equal(&p) {
+			t.Fatal("Decoder and SetBytes mismatch")
+		}
+	})
+}
+
 func TestG2AffineSerialization(t *testing.T) {
 
 	// test round trip serialization of infinity
@@ -388,6 +429,47 @@ func TestG2AffineSerialization(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func FuzzG2AffineSetBytes(f *testing.F) {
+	var p G2Affine
+	p.ScalarMultiplication(&g2GenAff, big.NewInt(42))
+	for _, q := range []G2Affine{g2GenAff, p, {}} {
+		compressed, raw := q.Bytes(), q.RawBytes()
+		f.Add(compressed[:])
+		f.Add(raw[:])
+	}
+
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		var p, q G2Affine
+		n, err := p.SetBytes(buf)
+		if err != nil {
+			return
+		}
+		if n != SizeOfG2AffineCompressed && n != SizeOfG2AffineUncompressed {
+			t.Fatal("invalid number of bytes consumed in buffer")
+		}
+
+		// a decoded point is on the curve and in the subgroup
+		if !p.IsInfinity() && !(p.IsOnCurve() && p.IsInSubGroup()) {
+			t.Fatal("decoded point is not in the subgroup")
+		}
+
+		// and encodes back to a point decoding to the same point
+		compressed, raw := p.Bytes(), p.RawBytes()
+		if _, err := q.SetBytes(compressed[:]); err != nil || !q.Equal(&p) {
+			t.Fatal("SetBytes(Bytes()) should stay the same")
+		}
+		if _, err := q.SetBytes(raw[:]); err != nil || !q.Equal(&p) {
+			t.Fatal("SetBytes(RawBytes()) should stay the same")
+		}
+
+		// the decoder reads the same point
+		dec := NewDecoder(bytes.NewReader(buf))
+		if err := dec.Decode(&q); err != nil || !q.Equal(&p) {
+			t.Fatal("Decoder and SetBytes mismatch")
+		}
+	})
+}
+
 // define Gopters generators
 
 // GenFr generates an Fr element
@@ -24,10 +24,12 @@ import (
 // GT target group of the pairing
 type GT = fptower.E6
 
-type lineEvaluation struct {
-	r0 fp.Element
-	r1 fp.Element
-	r2 fp.Element
+// LineEvaluation holds the three non-zero coefficients of a line of the Miller loop,
+// as multiplied into the accumulator with GT.MulBy014
+type LineEvaluation struct {
+	R0 fp.Element
+	R1 fp.Element
+	R2 fp.Element
 }
 
 // Pair calculates the reduced pairing for a set of points
@@ -60,16 +62,32 @@ func FinalExponentiation(z *GT, _z ...*GT) GT {
 		result.Mul(&result, e)
 	}
 
-	var buf GT
+	result = FinalExponentiationEasy(&result)
+	return FinalExponentiationHard(&result)
+}
+
+// FinalExponentiationEasy computes the easy part x**((p**3-1)(p+1)) of the final expo.
+// The result lies in the cyclotomic subgroup of GT.
+func FinalExponentiationEasy(z *GT) GT {
 
-	// easy part exponent: (p**3 - 1)*(p+1)
-	buf.Conjugate(&result)
-	result.Inverse(&result)
+	var result, buf GT
+	buf.Conjugate(z)
+	result.Inverse(z)
 	buf.Mul(&buf, &result)
 	result.Frobenius(&buf).
 		Mul(&result, &buf)
 
-		// hard part exponent: 12(u+1)(p**2 - p + 1)/r
+	return result
+}
+
+// FinalExponentiationHard computes the hard part x**(c*(p**2-p+1)/r) of the final expo.
+// z must lie in the cyclotomic subgroup of GT, e.g. be the output of FinalExponentiationEasy.
+func FinalExponentiationHard(z *GT) GT {
+
+	var result GT
+	result.Set(z)
+
+	// hard part exponent: 12(u+1)(p**2 - p + 1)/r
 	var m1, _m1, m2, _m2, m3, f0, f0_36, g0, g1, _g1, g2, g3, _g3, g4, _g4, g5, _g5, g6, gA, gB, g034, _g1g2, gC, h1, h2, h2g2C, h4 GT
 	m1.Expt(&result)
 	_m1.Conjugate(&m1)
@@ -173,15 +191,15 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	// f_{u+1,Q}(P)
 	var result1 GT
 	result1.SetOne()
-	var l lineEvaluation
+	var l LineEvaluation
 
 	// i == 62
 	for k := 0; k < n; k++ {
 		qProj1[k].DoubleStep(&l)
 		// line eval
-		l.r1.Mul(&l.r1, &p[k].X)
-		l.r2.Mul(&l.r2, &p[k].Y)
-		result1.MulBy014(&l.r0, &l.r1, &l.r2)
+		l.R1.Mul(&l.R1, &p[k].X)
+		l.R2.Mul(&l.R2, &p[k].Y)
+		result1.MulBy014(&l.R0, &l.R1, &l.R2)
 	}
 
 	for i := 61; i >= 0; i-- {
@@ -190,9 +208,9 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		for k := 0; k < n; k++ {
 			qProj1[k].DoubleStep(&l)
 			// line evaluation
-			l.r1.Mul(&l.r1, &p[k].X)
-			l.r2.Mul(&l.r2, &p[k].Y)
-			result1.MulBy014(&l.r0, &l.r1, &l.r2)
+			l.R1.Mul(&l.R1, &p[k].X)
+			l.R2.Mul(&l.R2, &p[k].Y)
+			result1.MulBy014(&l.R0, &l.R1, &l.R2)
 		}
 
 		if loopCounter1[i] == 0 {
@@ -202,9 +220,9 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		for k := 0; k < n; k++ {
 			qProj1[k].AddMixedStep(&l, &q[k])
 			// line evaluation
-			l.r1.Mul(&l.r1, &p[k].X)
-			l.r2.Mul(&l.r2, &p[k].Y)
-			result1.MulBy014(&l.r0, &l.r1, &l.r2)
+			l.R1.Mul(&l.R1, &p[k].X)
+			l.R2.Mul(&l.R2, &p[k].Y)
+			result1.MulBy014(&l.R0, &l.R1, &l.R2)
 		}
 	}
 
@@ -216,9 +234,9 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	for k := 0; k < n; k++ {
 		qProj2[k].DoubleStep(&l)
 		// line evaluation
-		l.r1.Mul(&l.r1, &p[k].X)
-		l.r2.Mul(&l.r2, &p[k].Y)
-		result2.MulBy014(&l.r0, &l.r1, &l.r2)
+		l.R1.Mul(&l.R1, &p[k].X)
+		l.R2.Mul(&l.R2, &p[k].Y)
+		result2.MulBy014(&l.R0, &l.R1, &l.R2)
 	}
 
 	for i := 187; i >= 0; i-- {
@@ -227,23 +245,23 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		for k := 0; k < n; k++ {
 			qProj2[k].DoubleStep(&l)
 			// line evaluation
-			l.r1.Mul(&l.r1, &p[k].X)
-			l.r2.Mul(&l.r2, &p[k].Y)
-			result2.MulBy014(&l.r0, &l.r1, &l.r2)
+			l.R1.Mul(&l.R1, &p[k].X)
+			l.R2.Mul(&l.R2, &p[k].Y)
+			result2.MulBy014(&l.R0, &l.R1, &l.R2)
 
 			if loopCounter2[i] == 1 {
 				qProj2[k].AddMixedStep(&l, &q[k])
 				// line evaluation
-				l.r1.Mul(&l.r1, &p[k].X)
-				l.r2.Mul(&l.r2, &p[k].Y)
-				result2.MulBy014(&l.r0, &l.r1, &l.r2)
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result2.MulBy014(&l.R0, &l.R1, &l.R2)
 
 			} else if loopCounter2[i] == -1 {
 				qProj2[k].AddMixedStep(&l, &qNeg[k])
 				// line evaluation
-				l.r1.Mul(&l.r1, &p[k].X)
-				l.r2.Mul(&l.r2, &p[k].Y)
-				result2.MulBy014(&l.r0, &l.r1, &l.r2)
+				l.R1.Mul(&l.R1, &p[k].X)
+				l.R2.Mul(&l.R2, &p[k].Y)
+				result2.MulBy014(&l.R0, &l.R1, &l.R2)
 			}
 		}
 	}
@@ -254,9 +272,98 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	return result2, nil
 }
 
+// MillerLoopStep records a line multiplication of the Miller loop
+type MillerLoopStep struct {
+	Line        LineEvaluation // line as output by the doubling or addition step
+	Evaluation  LineEvaluation // Line evaluated at the G1 point
+	Accumulator GT             // Miller loop accumulator once Evaluation is multiplied in
+}
+
+// MillerLoopSteps runs the Miller loop on a single pair (P, Q) and returns, in order,
+// every line multiplied into the accumulator along with the resulting accumulator.
+// The loop is split in two Miller loops, f_{u+1,Q} then f_{u^3-u^2-u,Q}, each with its own
+// accumulator; they are combined into the result once both are computed.
+// The returned GT equals MillerLoop([]G1Affine{P}, []G2Affine{Q}).
+// If P or Q is the point at infinity, no step is recorded and the result is one.
+func MillerLoopSteps(P *G1Affine, Q *G2Affine) ([]MillerLoopStep, GT) {
+	var result GT
+	result.SetOne()
+	if P.IsInfinity() || Q.IsInfinity() {
+		return nil, result
+	}
+
+	var qProj1, qProj2 g2Proj
+	var qNeg G2Affine
+	qProj1.FromAffine(Q)
+	qProj2.FromAffine(Q)
+	qNeg.Neg(Q)
+
+	var l LineEvaluation
+	steps := make([]MillerLoopStep, 0, 2*(len(loopCounter1)+len(loopCounter2)))
+	mulLine := func(acc *GT) {
+		step := MillerLoopStep{Line: l}
+		l.R1.Mul(&l.R1, &P.X)
+		l.R2.Mul(&l.R2, &P.Y)
+		step.Evaluation = l
+		acc.MulBy014(&l.R0, &l.R1, &l.R2)
+		step.Accumulator = *acc
+		steps = append(steps, step)
+	}
+
+	// f_{u+1,Q}(P)
+	var result1 GT
+	result1.SetOne()
+
+	// i == 62
+	qProj1.DoubleStep(&l)
+	mulLine(&result1)
+
+	for i := 61; i >= 0; i-- {
+		result1.Square(&result1)
+
+		qProj1.DoubleStep(&l)
+		mulLine(&result1)
+
+		if loopCounter1[i] == 0 {
+			continue
+		}
+
+		qProj1.AddMixedStep(&l, Q)
+		mulLine(&result1)
+	}
+
+	// f_{u^3-u^2-u,Q}(P)
+	var result2 GT
+	result2.SetOne()
+
+	// i == 187
+	qProj2.DoubleStep(&l)
+	mulLine(&result2)
+
+	for i := 187; i >= 0; i-- {
+		result2.Square(&result2)
+
+		qProj2.DoubleStep(&l)
+		mulLine(&result2)
+
+		if loopCounter2[i] == 1 {
+			qProj2.AddMixedStep(&l, Q)
+			mulLine(&result2)
+		} else if loopCounter2[i] == -1 {
+			qProj2.AddMixedStep(&l, &qNeg)
+			mulLine(&result2)
+		}
+	}
+
+	result.Frobenius(&result2).
+		Mul(&result, &result1)
+
+	return steps, result
+}
+
 // DoubleStep doubles a point in Homogenous projective coordinates, and evaluates the line in Miller loop
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) DoubleStep(evaluations *lineEvaluation) {
+func (p *g2Proj) DoubleStep(evaluations *LineEvaluation) {
 
 	// get some Element from our pool
 	var t0, t1, A, B, C, D, E, EE, F, G, H, I, J, K fp.Element
@@ -289,15 +396,15 @@ func (p *g2Proj) DoubleStep(evaluations *lineEvaluation) {
 	p.z.Mul(&B, &H)
 
 	// Line evaluation
-	evaluations.r0.Set(&I)
-	evaluations.r1.Double(&J).
-		Add(&evaluations.r1, &J)
-	evaluations.r2.Neg(&H)
+	evaluations.R0.Set(&I)
+	evaluations.R1.Double(&J).
+		Add(&evaluations.R1, &J)
+	evaluations.R2.Neg(&H)
 }
 
 // AddMixedStep point addition in Mixed Homogenous projective and Affine coordinates
 // https://eprint.iacr.org/2013/722.pdf (Section 4.3)
-func (p *g2Proj) AddMixedStep(evaluations *lineEvaluation, a *G2Affine) {
+func (p *g2Proj) AddMixedStep(evaluations *LineEvaluation, a *G2Affine) {
 
 	// get some Element from our pool
 	var Y2Z1, X2Z1, O, L, C, D, E, F, G, H, t0, t1, t2, J fp.Element
@@ -327,7 +434,7 @@ func (p *g2Proj) AddMixedStep(evaluations *lineEvaluation, a *G2Affine) {
 		Sub(&J, &t2)
 
 	// Line evaluation
-	evaluations.r0.Set(&J)
-	evaluations.r1.Neg(&O)
-	evaluations.r2.Set(&L)
+	evaluations.R0.Set(&J)
+	evaluations.R1.Neg(&O)
+	evaluations.R2.Set(&L)
 }
@@ -74,6 +74,16 @@ var lambdaGLV big.Int
 // in ker((u,v)->u+vlambda[r]), and their determinant
 var glvBasis ecc.Lattice
 
+// c1, c2 such that c1-c2*phi has norm c1**2+c1*c2+c2**2 = r in Z[phi]
+// and c1-c2*lambdaGLV = 0 mod r. Used for subgroup membership testing (c1 < c2).
+// c1 = (xGen**3+xGen**2-2*xGen+1)/3 and c2 = (xGen**3+xGen**2+xGen-2)/3
+var c1SubGroup, c2SubGroup, c2SubGroupNeg big.Int
+
+// (a, b) such that a+b*phi = (pi-1)/(c1-c2*phi) in Z[phi], where pi is the Frobenius
+// endomorphism of E (resp. of the twist). [a]p+[b]phi(p) clears the cofactor of p.
+var g1ClearCofactorA, g1ClearCofactorB big.Int
+var g2ClearCofactorA, g2ClearCofactorB big.Int
+
 // generator of the curve
 var xGen big.Int
 
@@ -118,6 +128,15 @@ func init() {
 
 	xGen.SetString("15132376222941642752", 10)
 
+	c1SubGroup.SetString("1155048275357884106335086113613464118768280431093290937003", 10)
+	c2SubGroup.SetString("1155048275357884106335086113613464118783412807316232579754", 10)
+	c2SubGroupNeg.Neg(&c2SubGroup)
+
+	g1ClearCofactorA.SetString("-4620193101431536425111355644301206897053665276081594542758", 10)
+	g1ClearCofactorB.SetString("8085337927505188744574591605446898409488213723282255620782", 10)
+	g2ClearCofactorA.SetString("8085337927505188744574591605446898409563875604396963834536", 10)
+	g2ClearCofactorB.SetString("-4620193101431536425111355644301206896962871018743944686251", 10)
+
 }
 
 // Generators return the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
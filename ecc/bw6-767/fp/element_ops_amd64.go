@@ -45,3 +45,6 @@ func fromMont(res *Element)
 
 //go:noescape
 func reduce(res *Element)
+
+//go:noescape
+func Butterfly(a, b *Element)
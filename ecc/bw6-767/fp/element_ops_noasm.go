@@ -1,5 +1,5 @@
-//go:build !amd64
-// +build !amd64
+//go:build !amd64 && !arm64
+// +build !amd64,!arm64
 
 // Copyright 2020 ConsenSys Software Inc.
 //
@@ -40,6 +40,13 @@ func MulBy13(x *Element) {
 	mulByConstant(x, 13)
 }
 
+// Butterfly sets
+// a = a + b
+// b = a - b
+func Butterfly(a, b *Element) {
+	_butterflyGeneric(a, b)
+}
+
 func mul(z, x, y *Element) {
 	_mulGeneric(z, x, y)
 }
@@ -24,7 +24,8 @@ import (
 
 func TestDomainSerialization(t *testing.T) {
 
-	domain := NewDomain(1<<6, 1, true)
+	// fr has 2-adicity 1: a single coset of the trivial domain is all we can build
+	domain := NewDomain(1, 1, true)
 	var reconstructed Domain
 
 	var buf bytes.Buffer
@@ -23,6 +23,8 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/consensys/gnark-crypto/ecc"
+
+	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr"
 )
 
 const (
@@ -17,10 +17,9 @@
 package bw6767
 
 import (
-	"math"
 	"math/big"
+	"runtime"
 
-	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bw6-767/fp"
 	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr"
 	"github.com/consensys/gnark-crypto/internal/parallel"
@@ -366,15 +365,53 @@ func (p *G1Jac) IsOnCurve() bool {
 }
 
 // IsInSubGroup returns true if p is on the r-torsion, false otherwise.
+// c1-c2*phi has norm r in Z[phi] so [c1]p-[c2]phi(p) = 0 iff p is in the r-torsion
+// (see c1SubGroup, c2SubGroup)
 func (p *G1Jac) IsInSubGroup() bool {
 
 	var res G1Jac
-	res.ScalarMultiplication(p, fr.Modulus())
+	res.jointScalarMulPhi(p, &c1SubGroup, &c2SubGroupNeg)
 
 	return res.IsOnCurve() && res.Z.IsZero()
 
 }
 
+// jointScalarMulPhi sets p to [s1]a+[s2]phi(a) using Shamir's trick, s1 and s2 may be negative
+func (p *G1Jac) jointScalarMulPhi(a *G1Jac, s1, s2 *big.Int) *G1Jac {
+
+	var res G1Jac
+	var ops [3]G1Jac
+	var k1, k2 big.Int
+
+	ops[0].Set(a)
+	if s1.Sign() < 0 {
+		ops[0].Neg(&ops[0])
+	}
+	ops[1].phi(a)
+	if s2.Sign() < 0 {
+		ops[1].Neg(&ops[1])
+	}
+	ops[2].Set(&ops[0]).AddAssign(&ops[1])
+	k1.Abs(s1)
+	k2.Abs(s2)
+
+	res.Set(&g1Infinity)
+	n := k1.BitLen()
+	if k2.BitLen() > n {
+		n = k2.BitLen()
+	}
+	for i := n - 1; i >= 0; i-- {
+		res.DoubleAssign()
+		c := k1.Bit(i) | k2.Bit(i)<<1
+		if c != 0 {
+			res.AddAssign(&ops[c-1])
+		}
+	}
+	p.Set(&res)
+
+	return p
+}
+
 // mulWindowed 2-bits windowed exponentiation
 func (p *G1Jac) mulWindowed(a *G1Jac, s *big.Int) *G1Jac {
 
@@ -417,9 +454,8 @@ func (p *G1Jac) phi(a *G1Jac) *G1Jac {
 func (p *G1Jac) mulGLV(a *G1Jac, s *big.Int) *G1Jac {
 
 	var table [15]G1Jac
-	var zero big.Int
 	var res G1Jac
-	var k1, k2 fr.Element
+	var e fr.Element
 
 	res.Set(&g1Infinity)
 
@@ -428,14 +464,13 @@ func (p *G1Jac) mulGLV(a *G1Jac, s *big.Int) *G1Jac {
 	table[3].phi(a)
 
 	// split the scalar, modifies +-a, phi(a) accordingly
-	k := ecc.SplitScalar(s, &glvBasis)
+	e.SetBigInt(s).FromMont()
+	k1, k2, neg1, neg2 := splitScalar((*[fr.Limbs]uint64)(&e))
 
-	if k[0].Cmp(&zero) == -1 {
-		k[0].Neg(&k[0])
+	if neg1 {
 		table[0].Neg(&table[0])
 	}
-	if k[1].Cmp(&zero) == -1 {
-		k[1].Neg(&k[1])
+	if neg2 {
 		table[3].Neg(&table[3])
 	}
 
@@ -455,12 +490,8 @@ func (p *G1Jac) mulGLV(a *G1Jac, s *big.Int) *G1Jac {
 	table[13].Set(&table[11]).AddAssign(&table[1])
 	table[14].Set(&table[11]).AddAssign(&table[2])
 
-	// bounds on the lattice base vectors guarantee that k1, k2 are len(r)/2 bits long max
-	k1.SetBigInt(&k[0]).FromMont()
-	k2.SetBigInt(&k[1]).FromMont()
-
-	// loop starts from len(k1)/2 due to the bounds
-	for i := int(math.Ceil(fr.Limbs/2. - 1)); i >= 0; i-- {
+	// bounds on the lattice base vectors guarantee that k1, k2 are glvLimbs words long max
+	for i := glvLimbs - 1; i >= 0; i-- {
 		mask := uint64(3) << 62
 		for j := 0; j < 32; j++ {
 			res.Double(&res).Double(&res)
@@ -489,39 +520,10 @@ func (p *G1Affine) ClearCofactor(a *G1Affine) *G1Affine {
 
 // ClearCofactor maps a point in E(Fp) to E(Fp)[r]
 func (p *G1Jac) ClearCofactor(a *G1Jac) *G1Jac {
-	var uP, u2P, u3P, L0, L1, t1, t2 G1Jac
-	var d1, d2, d3, ht big.Int
-
-	d1.SetInt64(31)
-	d2.SetInt64(11) // negative
-	d3.SetInt64(7)
-	ht.SetInt64(4) // negative
-
-	uP.ScalarMultiplication(a, &xGen) // negative
-	u2P.ScalarMultiplication(&uP, &xGen)
-	u3P.ScalarMultiplication(&u2P, &xGen) // negative
-
-	L0.Set(a).SubAssign(&uP).
-		ScalarMultiplication(&L0, &d1)
-	t1.ScalarMultiplication(a, &ht)
-	L0.AddAssign(&t1)
-	t1.Set(a).AddAssign(&u2P).
-		AddAssign(&uP).
-		AddAssign(&uP)
-	t2.ScalarMultiplication(&t1, &d2)
-	L0.AddAssign(&t2)
-
-	L1.Set(a).SubAssign(&u3P).
-		SubAssign(&u2P).
-		ScalarMultiplication(&L1, &d1)
-	t2.ScalarMultiplication(&t1, &ht)
-	L1.AddAssign(&t2)
-	t1.ScalarMultiplication(a, &d3)
-	L1.SubAssign(&t1)
-
-	p.phi(&L1).AddAssign(&L0)
+	// [a]p+[b]phi(p) where a+b*phi = (pi-1)/(c1-c2*phi) in Z[phi], pi being the Frobenius
+	// endomorphism. It has norm the cofactor and kills the cofactor part of E(Fp), fixed by pi.
+	return p.jointScalarMulPhi(a, &g1ClearCofactorA, &g1ClearCofactorB)
 
-	return p
 }
 
 // -------------------------------------------------------------------------------------------------
@@ -915,7 +917,7 @@ func BatchScalarMultiplicationG1(base *G1Affine, scalars []fr.Element) []G1Affin
 		baseTable[i].AddMixed(base)
 	}
 
-	pScalars := partitionScalars(scalars, c, false)
+	pScalars := partitionScalars(scalars, c, false, runtime.NumCPU())
 
 	// compute offset and word selector / shift to select the right bits of our windows
 	selectors := make([]selector, nbChunks)
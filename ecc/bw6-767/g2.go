@@ -17,10 +17,9 @@
 package bw6767
 
 import (
-	"math"
 	"math/big"
+	"runtime"
 
-	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bw6-767/fp"
 	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr"
 	"github.com/consensys/gnark-crypto/internal/parallel"
@@ -371,15 +370,53 @@ func (p *G2Jac) IsOnCurve() bool {
 }
 
 // IsInSubGroup returns true if p is on the r-torsion, false otherwise.
+// c1-c2*phi has norm r in Z[phi] so [c1]p-[c2]phi(p) = 0 iff p is in the r-torsion
+// (see c1SubGroup, c2SubGroup)
 func (p *G2Jac) IsInSubGroup() bool {
 
 	var res G2Jac
-	res.ScalarMultiplication(p, fr.Modulus())
+	res.jointScalarMulPhi(p, &c1SubGroup, &c2SubGroupNeg)
 
 	return res.IsOnCurve() && res.Z.IsZero()
 
 }
 
+// jointScalarMulPhi sets p to [s1]a+[s2]phi(a) using Shamir's trick, s1 and s2 may be negative
+func (p *G2Jac) jointScalarMulPhi(a *G2Jac, s1, s2 *big.Int) *G2Jac {
+
+	var res G2Jac
+	var ops [3]G2Jac
+	var k1, k2 big.Int
+
+	ops[0].Set(a)
+	if s1.Sign() < 0 {
+		ops[0].Neg(&ops[0])
+	}
+	ops[1].phi(a)
+	if s2.Sign() < 0 {
+		ops[1].Neg(&ops[1])
+	}
+	ops[2].Set(&ops[0]).AddAssign(&ops[1])
+	k1.Abs(s1)
+	k2.Abs(s2)
+
+	res.Set(&g2Infinity)
+	n := k1.BitLen()
+	if k2.BitLen() > n {
+		n = k2.BitLen()
+	}
+	for i := n - 1; i >= 0; i-- {
+		res.DoubleAssign()
+		c := k1.Bit(i) | k2.Bit(i)<<1
+		if c != 0 {
+			res.AddAssign(&ops[c-1])
+		}
+	}
+	p.Set(&res)
+
+	return p
+}
+
 // mulWindowed 2-bits windowed exponentiation
 func (p *G2Jac) mulWindowed(a *G2Jac, s *big.Int) *G2Jac {
 
@@ -422,9 +459,8 @@ func (p *G2Jac) phi(a *G2Jac) *G2Jac {
 func (p *G2Jac) mulGLV(a *G2Jac, s *big.Int) *G2Jac {
 
 	var table [15]G2Jac
-	var zero big.Int
 	var res G2Jac
-	var k1, k2 fr.Element
+	var e fr.Element
 
 	res.Set(&g2Infinity)
 
@@ -433,14 +469,13 @@ func (p *G2Jac) mulGLV(a *G2Jac, s *big.Int) *G2Jac {
 	table[3].phi(a)
 
 	// split the scalar, modifies +-a, phi(a) accordingly
-	k := ecc.SplitScalar(s, &glvBasis)
+	e.SetBigInt(s).FromMont()
+	k1, k2, neg1, neg2 := splitScalar((*[fr.Limbs]uint64)(&e))
 
-	if k[0].Cmp(&zero) == -1 {
-		k[0].Neg(&k[0])
+	if neg1 {
 		table[0].Neg(&table[0])
 	}
-	if k[1].Cmp(&zero) == -1 {
-		k[1].Neg(&k[1])
+	if neg2 {
 		table[3].Neg(&table[3])
 	}
 
@@ -460,12 +495,8 @@ func (p *G2Jac) mulGLV(a *G2Jac, s *big.Int) *G2Jac {
 	table[13].Set(&table[11]).AddAssign(&table[1])
 	table[14].Set(&table[11]).AddAssign(&table[2])
 
-	// bounds on the lattice base vectors guarantee that k1, k2 are len(r)/2 bits long max
-	k1.SetBigInt(&k[0]).FromMont()
-	k2.SetBigInt(&k[1]).FromMont()
-
-	// loop starts from len(k1)/2 due to the bounds
-	for i := int(math.Ceil(fr.Limbs/2. - 1)); i >= 0; i-- {
+	// bounds on the lattice base vectors guarantee that k1, k2 are glvLimbs words long max
+	for i := glvLimbs - 1; i >= 0; i-- {
 		mask := uint64(3) << 62
 		for j := 0; j < 32; j++ {
 			res.Double(&res).Double(&res)
@@ -494,43 +525,10 @@ func (p *G2Affine) ClearCofactor(a *G2Affine) *G2Affine {
 
 // ClearCofactor maps a point in curve to r-torsion
 func (p *G2Jac) ClearCofactor(a *G2Jac) *G2Jac {
-	var uP, u2P, u3P, L0, L1, tmp G2Jac
-	var d1, d2, ht big.Int
-
-	d1.SetInt64(31)
-	d2.SetInt64(11)
-	ht.SetInt64(7)
-
-	uP.ScalarMultiplication(a, &xGen) // negative
-	u2P.ScalarMultiplication(&uP, &xGen)
-	u3P.ScalarMultiplication(&u2P, &xGen) // negative
+	// [a]p+[b]phi(p) where a+b*phi = (pi-1)/(c1-c2*phi) in Z[phi], pi being the Frobenius
+	// endomorphism. It has norm the cofactor and kills the cofactor part of E(Fp), fixed by pi.
+	return p.jointScalarMulPhi(a, &g2ClearCofactorA, &g2ClearCofactorB)
 
-	L0.Set(&uP).SubAssign(a).
-		ScalarMultiplication(&L0, &d1)
-	tmp.Set(&uP).AddAssign(a).
-		Double(&tmp).
-		AddAssign(&u2P).
-		ScalarMultiplication(&tmp, &d2)
-	L0.SubAssign(&tmp)
-	tmp.ScalarMultiplication(a, &ht)
-	L0.AddAssign(&tmp)
-
-	L1.Set(&uP).SubAssign(&u3P).
-		SubAssign(&u2P).
-		ScalarMultiplication(&L1, &d1)
-	tmp.Set(a).AddAssign(&u2P).
-		SubAssign(&uP).
-		SubAssign(&uP).
-		ScalarMultiplication(&L1, &ht)
-	L0.SubAssign(&tmp)
-	tmp.ScalarMultiplication(a, &d2)
-	L0.SubAssign(&tmp)
-
-	p.phi(&L1).AddAssign(&L0)
-
-	return p
-
-	return p
 }
 
 // -------------------------------------------------------------------------------------------------
@@ -911,7 +909,7 @@ func BatchScalarMultiplicationG2(base *G2Affine, scalars []fr.Element) []G2Affin
 		baseTable[i].AddMixed(base)
 	}
 
-	pScalars := partitionScalars(scalars, c, false)
+	pScalars := partitionScalars(scalars, c, false, runtime.NumCPU())
 
 	// compute offset and word selector / shift to select the right bits of our windows
 	selectors := make([]selector, nbChunks)
@@ -28,46 +28,57 @@ import (
 	"github.com/leanovate/gopter/prop"
 )
 
-// ------------------------------------------------------------
-// utils
-func fuzzJacobianG2Affine(p *G2Jac, f fp.Element) G2Jac {
-	var res G2Jac
-	res.X.Mul(&p.X, &f).Mul(&res.X, &f)
-	res.Y.Mul(&p.Y, &f).Mul(&res.Y, &f).Mul(&res.Y, &f)
-	res.Z.Mul(&p.Z, &f)
-	return res
-}
+func TestG2AffineEndomorphism(t *testing.T) {
 
-func fuzzExtendedJacobianG2Affine(p *g2JacExtended, f fp.Element) g2JacExtended {
-	var res g2JacExtended
-	var ff, fff fp.Element
-	ff.Square(&f)
-	fff.Mul(&ff, &f)
-	res.X.Mul(&p.X, &ff)
-	res.Y.Mul(&p.Y, &fff)
-	res.ZZ.Mul(&p.ZZ, &ff)
-	res.ZZZ.Mul(&p.ZZZ, &fff)
-	return res
-}
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
 
-// ------------------------------------------------------------
-// tests
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[BW6-767] check that phi(P) = lambdaGLV * P", prop.ForAll(
+		func(a fp.Element) bool {
+			var p, res1, res2 G2Jac
+			g := MapToCurveG2Svdw(a)
+			p.FromAffine(&g)
+			res1.phi(&p)
+			res2.mulWindowed(&p, &lambdaGLV)
+
+			return p.IsInSubGroup() && res1.Equal(&res2)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[BW6-767] check that phi^2(P) + phi(P) + P = 0", prop.ForAll(
+		func(a fp.Element) bool {
+			var p, res, tmp G2Jac
+			g := MapToCurveG2Svdw(a)
+			p.FromAffine(&g)
+			tmp.phi(&p)
+			res.phi(&tmp).
+				AddAssign(&tmp).
+				AddAssign(&p)
+
+			return res.Z.IsZero()
+		},
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
 
-/*
 func TestMapToCurveG2(t *testing.T) {
 
 	parameters := gopter.DefaultTestParameters()
 	parameters.MinSuccessfulTests = 10
 
 	properties := gopter.NewProperties(parameters)
-	genFuzz1 := GenFp()
 
 	properties.Property("[G2] Svsw mapping should output point on the curve", prop.ForAll(
 		func(a fp.Element) bool {
 			g := MapToCurveG2Svdw(a)
-			return g.IsOnCurve()
+			return g.IsInSubGroup()
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.Property("[G2] Svsw mapping should be deterministic", prop.ForAll(
@@ -76,10 +87,11 @@ func TestMapToCurveG2(t *testing.T) {
 			g2 := MapToCurveG2Svdw(a)
 			return g1.Equal(&g2)
 		},
-		genFuzz1,
+		GenFp(),
 	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
-*/
 
 func TestG2AffineIsOnCurve(t *testing.T) {
 
@@ -87,7 +99,7 @@ func TestG2AffineIsOnCurve(t *testing.T) {
 	parameters.MinSuccessfulTests = 10
 
 	properties := gopter.NewProperties(parameters)
-	genFuzz1 := GenFp()
+
 	properties.Property("[BW6-767] g2Gen (affine) should be on the curve", prop.ForAll(
 		func(a fp.Element) bool {
 			var op1, op2 G2Affine
@@ -96,7 +108,7 @@ func TestG2AffineIsOnCurve(t *testing.T) {
 			op2.Y.Mul(&op2.Y, &a)
 			return op1.IsOnCurve() && !op2.IsOnCurve()
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] g2Gen (Jacobian) should be on the curve", prop.ForAll(
@@ -109,7 +121,7 @@ func TestG2AffineIsOnCurve(t *testing.T) {
 			op3.Y.Mul(&op3.Y, &a)
 			return op1.IsOnCurve() && op2.IsOnCurve() && !op3.IsOnCurve()
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
@@ -121,8 +133,6 @@ func TestG2AffineConversions(t *testing.T) {
 	parameters.MinSuccessfulTests = 100
 
 	properties := gopter.NewProperties(parameters)
-	genFuzz1 := GenFp()
-	genFuzz2 := GenFp()
 
 	properties.Property("[BW6-767] Affine representation should be independent of the Jacobian representative", prop.ForAll(
 		func(a fp.Element) bool {
@@ -131,7 +141,7 @@ func TestG2AffineConversions(t *testing.T) {
 			op1.FromJacobian(&g)
 			return op1.X.Equal(&g2Gen.X) && op1.Y.Equal(&g2Gen.Y)
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] Affine representation should be independent of a Extended Jacobian representative", prop.ForAll(
@@ -147,7 +157,7 @@ func TestG2AffineConversions(t *testing.T) {
 			op1.fromJacExtended(&gfuzz)
 			return op1.X.Equal(&g2Gen.X) && op1.Y.Equal(&g2Gen.Y)
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] Jacobian representation should be the same as the affine representative", prop.ForAll(
@@ -164,7 +174,7 @@ func TestG2AffineConversions(t *testing.T) {
 
 			return g.X.Equal(&g2Gen.X) && g.Y.Equal(&g2Gen.Y) && g.Z.Equal(&one)
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] Converting affine symbol for infinity to Jacobian should output correct infinity in Jacobian", prop.ForAll(
@@ -211,8 +221,8 @@ func TestG2AffineConversions(t *testing.T) {
 			op2 := fuzzJacobianG2Affine(&g2Gen, b)
 			return op1.Equal(&op2)
 		},
-		genFuzz1,
-		genFuzz2,
+		GenFp(),
+		GenFp(),
 	))
 
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
@@ -224,8 +234,6 @@ func TestG2AffineOps(t *testing.T) {
 	parameters.MinSuccessfulTests = 10
 
 	properties := gopter.NewProperties(parameters)
-	genFuzz1 := GenFp()
-	genFuzz2 := GenFp()
 
 	genScalar := GenFr()
 
@@ -238,8 +246,8 @@ func TestG2AffineOps(t *testing.T) {
 			op2.Double(&fop2)
 			return op1.Equal(&op2)
 		},
-		genFuzz1,
-		genFuzz2,
+		GenFp(),
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] [Jacobian] Adding the opposite of a point to itself should output inf", prop.ForAll(
@@ -250,8 +258,8 @@ func TestG2AffineOps(t *testing.T) {
 			fop1.AddAssign(&fop2)
 			return fop1.Equal(&g2Infinity)
 		},
-		genFuzz1,
-		genFuzz2,
+		GenFp(),
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] [Jacobian] Adding the inf to a point should not modify the point", prop.ForAll(
@@ -263,7 +271,7 @@ func TestG2AffineOps(t *testing.T) {
 			op2.AddAssign(&g2Gen)
 			return fop1.Equal(&g2Gen) && op2.Equal(&g2Gen)
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] [Jacobian Extended] addMixed (-G) should equal subMixed(G)", prop.ForAll(
@@ -282,7 +290,7 @@ func TestG2AffineOps(t *testing.T) {
 				o1.ZZ.Equal(&o2.ZZ) &&
 				o1.ZZZ.Equal(&o2.ZZZ)
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] [Jacobian Extended] doubleMixed (-G) should equal doubleNegMixed(G)", prop.ForAll(
@@ -301,7 +309,7 @@ func TestG2AffineOps(t *testing.T) {
 				o1.ZZ.Equal(&o2.ZZ) &&
 				o1.ZZZ.Equal(&o2.ZZZ)
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] [Jacobian] Addmix the negation to itself should output 0", prop.ForAll(
@@ -313,7 +321,7 @@ func TestG2AffineOps(t *testing.T) {
 			fop1.AddMixed(&op2)
 			return fop1.Equal(&g2Infinity)
 		},
-		genFuzz1,
+		GenFp(),
 	))
 
 	properties.Property("[BW6-767] scalar multiplication (double and add) should depend only on the scalar mod r", prop.ForAll(
@@ -410,6 +418,58 @@ func TestG2AffineCofactorCleaning(t *testing.T) {
 			return point.IsOnCurve() && pointCleared.IsInSubGroup() && !pointCleared.Equal(&infinity)
 		},
 	))
+
+	properties.Property("[BW6-767] IsInSubGroup should match the [r]p == 0 check on random, cofactor-cleared and small torsion points", prop.ForAll(
+		func(s fr.Element) bool {
+			var a, x, b fp.Element
+			a.SetRandom()
+
+			x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+
+			for x.Legendre() != 1 {
+				a.SetRandom()
+
+				x.Square(&a).Mul(&x, &a).Add(&x, &bTwistCurveCoeff)
+
+			}
+
+			b.Sqrt(&x)
+			var point, pointCleared, rp G2Jac
+			point.X.Set(&a)
+			point.Y.Set(&b)
+			point.Z.SetOne()
+			pointCleared.ClearCofactor(&point)
+			r := fr.Modulus()
+			rp.mulWindowed(&point, r)
+			if point.IsInSubGroup() != rp.Z.IsZero() {
+				return false
+			}
+
+			// [k]g2Gen and cleared points are in the r-torsion
+			var k big.Int
+			var kGen G2Jac
+			kGen.mulWindowed(&g2Gen, s.ToBigIntRegular(&k))
+			if !kGen.IsInSubGroup() || !pointCleared.IsInSubGroup() {
+				return false
+			}
+			rp.mulWindowed(&pointCleared, r)
+			if !rp.Z.IsZero() {
+				return false
+			}
+
+			// [r]point is in the torsion of order dividing the cofactor, coprime to r:
+			// it is not in the r-torsion unless it is the infinity, and neither is its sum with kGen
+			torsion := rp
+			torsion.mulWindowed(&point, r)
+			if torsion.Z.IsZero() {
+				return true
+			}
+			var mixed G2Jac
+			mixed.Set(&kGen).AddAssign(&torsion)
+			return !torsion.IsInSubGroup() && !mixed.IsInSubGroup()
+		},
+		GenFr(),
+	))
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
@@ -464,6 +524,16 @@ func TestG2AffineBatchScalarMultiplication(t *testing.T) {
 // ------------------------------------------------------------
 // benches
 
+func BenchmarkG2JacIsInSubGroup(b *testing.B) {
+	var a G2Jac
+	a.Set(&g2Gen)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.IsInSubGroup()
+	}
+
+}
+
 func BenchmarkG2AffineBatchScalarMul(b *testing.B) {
 	// ensure every words of the scalars are filled
 	var mixer fr.Element
@@ -626,3 +696,136 @@ func BenchmarkG2JacExtDouble(b *testing.B) {
 		a.double(&a)
 	}
 }
+
+func fuzzJacobianG2Affine(p *G2Jac, f fp.Element) G2Jac {
+	var res G2Jac
+	res.X.Mul(&p.X, &f).Mul(&res.X, &f)
+	res.Y.Mul(&p.Y, &f).Mul(&res.Y, &f).Mul(&res.Y, &f)
+	res.Z.Mul(&p.Z, &f)
+	return res
+}
+
+func fuzzExtendedJacobianG2Affine(p *g2JacExtended, f fp.Element) g2JacExtended {
+	var res g2JacExtended
+	var ff, fff fp.Element
+	ff.Square(&f)
+	fff.Mul(&ff, &f)
+	res.X.Mul(&p.X, &ff)
+	res.Y.Mul(&p.Y, &fff)
+	res.ZZ.Mul(&p.ZZ, &ff)
+	res.ZZZ.Mul(&p.ZZZ, &fff)
+	return res
+}
+
+func FuzzG2Jac(f *testing.F) {
+	f.Add([]byte{1}, []byte{2})
+	f.Add([]byte{}, []byte{42})
+	f.Add([]byte{3}, []byte{3})
+	{
+		var r, rMinusOne big.Int
+		r.Set(fr.Modulus())
+		rMinusOne.Sub(&r, big.NewInt(1))
+		f.Add(r.Bytes(), rMinusOne.Bytes())
+	}
+	var b big.Int
+	bTwistCurveCoeff.ToBigIntRegular(&b)
+	curve := newRefCurve(fp.Modulus(), &b)
+
+	toRef := func(p *G2Jac) refPoint {
+		var a G2Affine
+		var res refPoint
+		a.FromJacobian(p)
+		res.inf = a.IsInfinity()
+		a.X.ToBigIntRegular(&res.x)
+		a.Y.ToBigIntRegular(&res.y)
+		return res
+	}
+	refGen := toRef(&g2Gen)
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		var s1, s2, sum big.Int
+		s1.SetBytes(a)
+		s2.SetBytes(b)
+		sum.Add(&s1, &s2)
+
+		var p1, p2, p, q G2Jac
+		p1.ScalarMultiplication(&g2Gen, &s1)
+		p2.ScalarMultiplication(&g2Gen, &s2)
+		if !p1.IsOnCurve() || !p1.IsInSubGroup() {
+			t.Fatal("[s1]G is not in the subgroup")
+		}
+
+		// [s1]G + [s2]G = [s1+s2]G
+		p.Set(&p1).AddAssign(&p2)
+		q.mulWindowed(&g2Gen, &sum)
+		if !p.Equal(&q) {
+			t.Fatal("[s1]G + [s2]G != [s1+s2]G")
+		}
+
+		// GLV and windowed scalar multiplications agree
+		q.mulWindowed(&g2Gen, &s1)
+		if !p1.Equal(&q) {
+			t.Fatal("GLV and windowed scalar multiplications mismatch")
+		}
+
+		// Double and AddAssign agree, SubAssign and Neg agree
+		p.Double(&p1)
+		q.Set(&p1).AddAssign(&p1)
+		if !p.Equal(&q) {
+			t.Fatal("2P != P + P")
+		}
+		p.Set(&p1).SubAssign(&p2)
+		q.Neg(&p2).AddAssign(&p1)
+		if !p.Equal(&q) {
+			t.Fatal("P - Q != -Q + P")
+		}
+
+		// mixed and extended Jacobian additions agree
+		var a2 G2Affine
+		var e g2JacExtended
+		a2.FromJacobian(&p2)
+		p.Set(&p1).AddMixed(&a2)
+		e.setInfinity()
+		e.addMixed(&a2)
+		{
+			var e1 g2JacExtended
+			var a1 G2Affine
+			a1.FromJacobian(&p1)
+			e1.setInfinity()
+			e1.addMixed(&a1)
+			e.add(&e1)
+		}
+		q.fromJacExtended(&e)
+		if !p.Equal(&q) {
+			t.Fatal("AddMixed and extended Jacobian add mismatch")
+		}
+
+		// compare with the math/big reference
+		r1 := curve.scalarMul(&refGen, &s1)
+		r2 := curve.scalarMul(&refGen, &s2)
+		if !curve.isOnCurve(&r1) {
+			t.Fatal("reference point is not on the curve")
+		}
+		check := func(op string, p *G2Jac, expected *refPoint) {
+			t.Helper()
+			res := toRef(p)
+			if !curve.equal(&res, expected) {
+				t.Fatalf("%s doesn't match the math/big reference", op)
+			}
+		}
+		check("ScalarMultiplication", &p1, &r1)
+		check("ScalarMultiplication", &p2, &r2)
+
+		expected := curve.add(&r1, &r2)
+		check("AddAssign", p.Set(&p1).AddAssign(&p2), &expected)
+		check("AddMixed", p.Set(&p1).AddMixed(&a2), &expected)
+
+		expected = curve.double(&r1)
+		check("Double", p.Double(&p1), &expected)
+		check("DoubleAssign", p.Set(&p1).DoubleAssign(), &expected)
+
+		expected = curve.neg(&r2)
+		expected = curve.add(&r1, &expected)
+		check("SubAssign", p.Set(&p1).SubAssign(&p2), &expected)
+	})
+}
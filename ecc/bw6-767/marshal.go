@@ -33,6 +33,7 @@ import (
 // metadata needed for point (de)compression
 // we have less than 3 bits available on the msw, so we can't follow BLS12-381 style encoding.
 // the difference is the case where a point is infinity and uncompressed is not flagged
+// we have less than 2 bits available on the msw, so the mask is stored in an additional leading byte.
 const (
 	mMask               byte = 0b11 << 6
 	mUncompressed       byte = 0b00 << 6
@@ -575,10 +576,12 @@ func (enc *Encoder) encodeRaw(v interface{}) (err error) {
 }
 
 // SizeOfG1AffineCompressed represents the size in bytes that a G1Affine need in binary form, compressed
-const SizeOfG1AffineCompressed = 96
+// (coordinates plus a leading metadata byte)
+const SizeOfG1AffineCompressed = 96 + 1
 
 // SizeOfG1AffineUncompressed represents the size in bytes that a G1Affine need in binary form, uncompressed
-const SizeOfG1AffineUncompressed = SizeOfG1AffineCompressed * 2
+// (coordinates plus a leading metadata byte)
+const SizeOfG1AffineUncompressed = SizeOfG1AffineCompressed*2 - 1
 
 // Marshal converts p to a byte slice (without point compression)
 func (p *G1Affine) Marshal() []byte {
@@ -622,18 +625,18 @@ func (p *G1Affine) Bytes() (res [SizeOfG1AffineCompressed]byte) {
 	// we store X  and mask the most significant word with our metadata mask
 	tmp = p.X
 	tmp.FromMont()
-	binary.BigEndian.PutUint64(res[88:96], tmp[0])
-	binary.BigEndian.PutUint64(res[80:88], tmp[1])
-	binary.BigEndian.PutUint64(res[72:80], tmp[2])
-	binary.BigEndian.PutUint64(res[64:72], tmp[3])
-	binary.BigEndian.PutUint64(res[56:64], tmp[4])
-	binary.BigEndian.PutUint64(res[48:56], tmp[5])
-	binary.BigEndian.PutUint64(res[40:48], tmp[6])
-	binary.BigEndian.PutUint64(res[32:40], tmp[7])
-	binary.BigEndian.PutUint64(res[24:32], tmp[8])
-	binary.BigEndian.PutUint64(res[16:24], tmp[9])
-	binary.BigEndian.PutUint64(res[8:16], tmp[10])
-	binary.BigEndian.PutUint64(res[0:8], tmp[11])
+	binary.BigEndian.PutUint64(res[89:97], tmp[0])
+	binary.BigEndian.PutUint64(res[81:89], tmp[1])
+	binary.BigEndian.PutUint64(res[73:81], tmp[2])
+	binary.BigEndian.PutUint64(res[65:73], tmp[3])
+	binary.BigEndian.PutUint64(res[57:65], tmp[4])
+	binary.BigEndian.PutUint64(res[49:57], tmp[5])
+	binary.BigEndian.PutUint64(res[41:49], tmp[6])
+	binary.BigEndian.PutUint64(res[33:41], tmp[7])
+	binary.BigEndian.PutUint64(res[25:33], tmp[8])
+	binary.BigEndian.PutUint64(res[17:25], tmp[9])
+	binary.BigEndian.PutUint64(res[9:17], tmp[10])
+	binary.BigEndian.PutUint64(res[1:9], tmp[11])
 
 	res[0] |= msbMask
 
@@ -659,34 +662,34 @@ func (p *G1Affine) RawBytes() (res [SizeOfG1AffineUncompressed]byte) {
 	// we store the Y coordinate
 	tmp = p.Y
 	tmp.FromMont()
-	binary.BigEndian.PutUint64(res[184:192], tmp[0])
-	binary.BigEndian.PutUint64(res[176:184], tmp[1])
-	binary.BigEndian.PutUint64(res[168:176], tmp[2])
-	binary.BigEndian.PutUint64(res[160:168], tmp[3])
-	binary.BigEndian.PutUint64(res[152:160], tmp[4])
-	binary.BigEndian.PutUint64(res[144:152], tmp[5])
-	binary.BigEndian.PutUint64(res[136:144], tmp[6])
-	binary.BigEndian.PutUint64(res[128:136], tmp[7])
-	binary.BigEndian.PutUint64(res[120:128], tmp[8])
-	binary.BigEndian.PutUint64(res[112:120], tmp[9])
-	binary.BigEndian.PutUint64(res[104:112], tmp[10])
-	binary.BigEndian.PutUint64(res[96:104], tmp[11])
+	binary.BigEndian.PutUint64(res[185:193], tmp[0])
+	binary.BigEndian.PutUint64(res[177:185], tmp[1])
+	binary.BigEndian.PutUint64(res[169:177], tmp[2])
+	binary.BigEndian.PutUint64(res[161:169], tmp[3])
+	binary.BigEndian.PutUint64(res[153:161], tmp[4])
+	binary.BigEndian.PutUint64(res[145:153], tmp[5])
+	binary.BigEndian.PutUint64(res[137:145], tmp[6])
+	binary.BigEndian.PutUint64(res[129:137], tmp[7])
+	binary.BigEndian.PutUint64(res[121:129], tmp[8])
+	binary.BigEndian.PutUint64(res[113:121], tmp[9])
+	binary.BigEndian.PutUint64(res[105:113], tmp[10])
+	binary.BigEndian.PutUint64(res[97:105], tmp[11])
 
 	// we store X  and mask the most significant word with our metadata mask
 	tmp = p.X
 	tmp.FromMont()
-	binary.BigEndian.PutUint64(res[88:96], tmp[0])
-	binary.BigEndian.PutUint64(res[80:88], tmp[1])
-	binary.BigEndian.PutUint64(res[72:80], tmp[2])
-	binary.BigEndian.PutUint64(res[64:72], tmp[3])
-	binary.BigEndian.PutUint64(res[56:64], tmp[4])
-	binary.BigEndian.PutUint64(res[48:56], tmp[5])
-	binary.BigEndian.PutUint64(res[40:48], tmp[6])
-	binary.BigEndian.PutUint64(res[32:40], tmp[7])
-	binary.BigEndian.PutUint64(res[24:32], tmp[8])
-	binary.BigEndian.PutUint64(res[16:24], tmp[9])
-	binary.BigEndian.PutUint64(res[8:16], tmp[10])
-	binary.BigEndian.PutUint64(res[0:8], tmp[11])
+	binary.BigEndian.PutUint64(res[89:97], tmp[0])
+	binary.BigEndian.PutUint64(res[81:89], tmp[1])
+	binary.BigEndian.PutUint64(res[73:81], tmp[2])
+	binary.BigEndian.PutUint64(res[65:73], tmp[3])
+	binary.BigEndian.PutUint64(res[57:65], tmp[4])
+	binary.BigEndian.PutUint64(res[49:57], tmp[5])
+	binary.BigEndian.PutUint64(res[41:49], tmp[6])
+	binary.BigEndian.PutUint64(res[33:41], tmp[7])
+	binary.BigEndian.PutUint64(res[25:33], tmp[8])
+	binary.BigEndian.PutUint64(res[17:25], tmp[9])
+	binary.BigEndian.PutUint64(res[9:17], tmp[10])
+	binary.BigEndian.PutUint64(res[1:9], tmp[11])
 
 	res[0] |= mUncompressed
 
@@ -721,6 +724,9 @@ func (p *G1Affine) SetBytes(buf []byte) (int, error) {
 		return SizeOfG1AffineCompressed, nil
 	}
 
+	// skip the metadata byte
+	buf = buf[1:]
+
 	// uncompressed point
 	if mData == mUncompressed {
 		// read X and Y coordinates
@@ -742,7 +748,6 @@ func (p *G1Affine) SetBytes(buf []byte) (int, error) {
 
 	var bufX [fp.Bytes]byte
 	copy(bufX[:fp.Bytes], buf[:fp.Bytes])
-	bufX[0] &= ^mMask
 
 	// read X coordinate
 	p.X.SetBytes(bufX[:fp.Bytes])
@@ -831,10 +836,12 @@ func (p *G1Affine) unsafeSetCompressedBytes(buf []byte) (isInfinity bool) {
 		return
 	}
 
+	// skip the metadata byte
+	buf = buf[1:]
+
 	// we need to copy the input buffer (to keep this method thread safe)
 	var bufX [fp.Bytes]byte
 	copy(bufX[:fp.Bytes], buf[:fp.Bytes])
-	bufX[0] &= ^mMask
 
 	// read X coordinate
 	p.X.SetBytes(bufX[:fp.Bytes])
@@ -846,10 +853,12 @@ func (p *G1Affine) unsafeSetCompressedBytes(buf []byte) (isInfinity bool) {
 }
 
 // SizeOfG2AffineCompressed represents the size in bytes that a G2Affine need in binary form, compressed
-const SizeOfG2AffineCompressed = 96
+// (coordinates plus a leading metadata byte)
+const SizeOfG2AffineCompressed = 96 + 1
 
 // SizeOfG2AffineUncompressed represents the size in bytes that a G2Affine need in binary form, uncompressed
-const SizeOfG2AffineUncompressed = SizeOfG2AffineCompressed * 2
+// (coordinates plus a leading metadata byte)
+const SizeOfG2AffineUncompressed = SizeOfG2AffineCompressed*2 - 1
 
 // Marshal converts p to a byte slice (without point compression)
 func (p *G2Affine) Marshal() []byte {
@@ -893,18 +902,18 @@ func (p *G2Affine) Bytes() (res [SizeOfG2AffineCompressed]byte) {
 	// we store X  and mask the most significant word with our metadata mask
 	tmp = p.X
 	tmp.FromMont()
-	binary.BigEndian.PutUint64(res[88:96], tmp[0])
-	binary.BigEndian.PutUint64(res[80:88], tmp[1])
-	binary.BigEndian.PutUint64(res[72:80], tmp[2])
-	binary.BigEndian.PutUint64(res[64:72], tmp[3])
-	binary.BigEndian.PutUint64(res[56:64], tmp[4])
-	binary.BigEndian.PutUint64(res[48:56], tmp[5])
-	binary.BigEndian.PutUint64(res[40:48], tmp[6])
-	binary.BigEndian.PutUint64(res[32:40], tmp[7])
-	binary.BigEndian.PutUint64(res[24:32], tmp[8])
-	binary.BigEndian.PutUint64(res[16:24], tmp[9])
-	binary.BigEndian.PutUint64(res[8:16], tmp[10])
-	binary.BigEndian.PutUint64(res[0:8], tmp[11])
+	binary.BigEndian.PutUint64(res[89:97], tmp[0])
+	binary.BigEndian.PutUint64(res[81:89], tmp[1])
+	binary.BigEndian.PutUint64(res[73:81], tmp[2])
+	binary.BigEndian.PutUint64(res[65:73], tmp[3])
+	binary.BigEndian.PutUint64(res[57:65], tmp[4])
+	binary.BigEndian.PutUint64(res[49:57], tmp[5])
+	binary.BigEndian.PutUint64(res[41:49], tmp[6])
+	binary.BigEndian.PutUint64(res[33:41], tmp[7])
+	binary.BigEndian.PutUint64(res[25:33], tmp[8])
+	binary.BigEndian.PutUint64(res[17:25], tmp[9])
+	binary.BigEndian.PutUint64(res[9:17], tmp[10])
+	binary.BigEndian.PutUint64(res[1:9], tmp[11])
 
 	res[0] |= msbMask
 
@@ -930,34 +939,34 @@ func (p *G2Affine) RawBytes() (res [SizeOfG2AffineUncompressed]byte) {
 	// we store the Y coordinate
 	tmp = p.Y
 	tmp.FromMont()
-	binary.BigEndian.PutUint64(res[184:192], tmp[0])
-	binary.BigEndian.PutUint64(res[176:184], tmp[1])
-	binary.BigEndian.PutUint64(res[168:176], tmp[2])
-	binary.BigEndian.PutUint64(res[160:168], tmp[3])
-	binary.BigEndian.PutUint64(res[152:160], tmp[4])
-	binary.BigEndian.PutUint64(res[144:152], tmp[5])
-	binary.BigEndian.PutUint64(res[136:144], tmp[6])
-	binary.BigEndian.PutUint64(res[128:136], tmp[7])
-	binary.BigEndian.PutUint64(res[120:128], tmp[8])
-	binary.BigEndian.PutUint64(res[112:120], tmp[9])
-	binary.BigEndian.PutUint64(res[104:112], tmp[10])
-	binary.BigEndian.PutUint64(res[96:104], tmp[11])
+	binary.BigEndian.PutUint64(res[185:193], tmp[0])
+	binary.BigEndian.PutUint64(res[177:185], tmp[1])
+	binary.BigEndian.PutUint64(res[169:177], tmp[2])
+	binary.BigEndian.PutUint64(res[161:169], tmp[3])
+	binary.BigEndian.PutUint64(res[153:161], tmp[4])
+	binary.BigEndian.PutUint64(res[145:153], tmp[5])
+	binary.BigEndian.PutUint64(res[137:145], tmp[6])
+	binary.BigEndian.PutUint64(res[129:137], tmp[7])
+	binary.BigEndian.PutUint64(res[121:129], tmp[8])
+	binary.BigEndian.PutUint64(res[113:121], tmp[9])
+	binary.BigEndian.PutUint64(res[105:113], tmp[10])
+	binary.BigEndian.PutUint64(res[97:105], tmp[11])
 
 	// we store X  and mask the most significant word with our metadata mask
 	tmp = p.X
 	tmp.FromMont()
-	binary.BigEndian.PutUint64(res[88:96], tmp[0])
-	binary.BigEndian.PutUint64(res[80:88], tmp[1])
-	binary.BigEndian.PutUint64(res[72:80], tmp[2])
-	binary.BigEndian.PutUint64(res[64:72], tmp[3])
-	binary.BigEndian.PutUint64(res[56:64], tmp[4])
-	binary.BigEndian.PutUint64(res[48:56], tmp[5])
-	binary.BigEndian.PutUint64(res[40:48], tmp[6])
-	binary.BigEndian.PutUint64(res[32:40], tmp[7])
-	binary.BigEndian.PutUint64(res[24:32], tmp[8])
-	binary.BigEndian.PutUint64(res[16:24], tmp[9])
-	binary.BigEndian.PutUint64(res[8:16], tmp[10])
-	binary.BigEndian.PutUint64(res[0:8], tmp[11])
+	binary.BigEndian.PutUint64(res[89:97], tmp[0])
+	binary.BigEndian.PutUint64(res[81:89], tmp[1])
+	binary.BigEndian.PutUint64(res[73:81], tmp[2])
+	binary.BigEndian.PutUint64(res[65:73], tmp[3])
+	binary.BigEndian.PutUint64(res[57:65], tmp[4])
+	binary.BigEndian.PutUint64(res[49:57], tmp[5])
+	binary.BigEndian.PutUint64(res[41:49], tmp[6])
+	binary.BigEndian.PutUint64(res[33:41], tmp[7])
+	binary.BigEndian.PutUint64(res[25:33], tmp[8])
+	binary.BigEndian.PutUint64(res[17:25], tmp[9])
+	binary.BigEndian.PutUint64(res[9:17], tmp[10])
+	binary.BigEndian.PutUint64(res[1:9], tmp[11])
 
 	res[0] |= mUncompressed
 
@@ -992,6 +1001,9 @@ func (p *G2Affine) SetBytes(buf []byte) (int, error) {
 		return SizeOfG2AffineCompressed, nil
 	}
 
+	// skip the metadata byte
+	buf = buf[1:]
+
 	// uncompressed point
 	if mData == mUncompressed {
 		// read X and Y coordinates
@@ -1013,7 +1025,6 @@ func (p *G2Affine) SetBytes(buf []byte) (int, error) {
 
 	var bufX [fp.Bytes]byte
 	copy(bufX[:fp.Bytes], buf[:fp.Bytes])
-	bufX[0] &= ^mMask
 
 	// read X coordinate
 	p.X.SetBytes(bufX[:fp.Bytes])
@@ -1102,10 +1113,12 @@ func (p *G2Affine) unsafeSetCompressedBytes(buf []byte) (isInfinity bool) {
 		return
 	}
 
+	// skip the metadata byte
+	buf = buf[1:]
+
 	// we need to copy the input buffer (to keep this method thread safe)
 	var bufX [fp.Bytes]byte
 	copy(bufX[:fp.Bytes], buf[:fp.Bytes])
-	bufX[0] &= ^mMask
 
 	// read X coordinate
 	p.X.SetBytes(bufX[:fp.Bytes])
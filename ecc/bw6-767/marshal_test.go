@@ -17,19 +17,20 @@
 package bw6767
 
 import (
-	// "bytes"
-	// "io"
-	// "math/big"
-	// "math/rand"
+	"bytes"
+	"io"
+	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/leanovate/gopter"
-	// "github.com/leanovate/gopter/prop"
-	// "github.com/consensys/gnark-crypto/ecc/bw6-767/fp"
-	// "github.com/consensys/gnark-crypto/ecc/bw6-767/fr"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/consensys/gnark-crypto/ecc/bw6-767/fp"
+	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr"
+	"github.com/consensys/gnark-crypto/ecc/bw6-767/internal/fptower"
 )
 
-/*
 func TestEncoder(t *testing.T) {
 
 	// TODO need proper fuzz testing here
@@ -135,7 +136,6 @@ func TestEncoder(t *testing.T) {
 	testDecode(t, &bufRaw, encRaw.BytesWritten())
 
 }
-*/
 
 func TestIsCompressed(t *testing.T) {
 	var g1Inf, g1 G1Affine
@@ -252,51 +252,90 @@ func TestG1AffineSerialization(t *testing.T) {
 
 	properties := gopter.NewProperties(parameters)
 
-	/*
-		properties.Property("[G1] Affine SetBytes(RawBytes) should stay the same", prop.ForAll(
-			func(a fp.Element) bool {
-				var start, end G1Affine
-				var ab big.Int
-				a.ToBigIntRegular(&ab)
-				start.ScalarMultiplication(&g1GenAff, &ab)
-
-				buf := start.RawBytes()
-				n, err := end.SetBytes(buf[:])
-				if err != nil {
-					return false
-				}
-				if n != SizeOfG1AffineUncompressed {
-					return false
-				}
-				return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
-			},
-			GenFp(),
-		))
-
-		properties.Property("[G1] Affine SetBytes(Bytes()) should stay the same", prop.ForAll(
-			func(a fp.Element) bool {
-				var start, end G1Affine
-				var ab big.Int
-				a.ToBigIntRegular(&ab)
-				start.ScalarMultiplication(&g1GenAff, &ab)
-
-				buf := start.Bytes()
-				n, err := end.SetBytes(buf[:])
-				if err != nil {
-					return false
-				}
-				if n != SizeOfG1AffineCompressed {
-					return false
-				}
-				return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
-			},
-			GenFp(),
-		))
-	*/
+	properties.Property("[G1] Affine SetBytes(RawBytes) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.ToBigIntRegular(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			buf := start.RawBytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != SizeOfG1AffineUncompressed {
+				return false
+			}
+			return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G1] Affine SetBytes(Bytes()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.ToBigIntRegular(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			buf := start.Bytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != SizeOfG1AffineCompressed {
+				return false
+			}
+			return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
+		},
+		GenFp(),
+	))
 
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func FuzzG1AffineSetBytes(f *testing.F) {
+	var p G1Affine
+	p.ScalarMultiplication(&g1GenAff, big.NewInt(42))
+	for _, q := range []G1Affine{g1GenAff, p, {}} {
+		compressed, raw := q.Bytes(), q.RawBytes()
+		f.Add(compressed[:])
+		f.Add(raw[:])
+	}
+
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		var p, q G1Affine
+		n, err := p.SetBytes(buf)
+		if err != nil {
+			return
+		}
+		if n != SizeOfG1AffineCompressed && n != SizeOfG1AffineUncompressed {
+			t.Fatal("invalid number of bytes consumed in buffer")
+		}
+
+		// a decoded point is on the curve and in the subgroup
+		if !p.IsInfinity() && !(p.IsOnCurve() && p.IsInSubGroup()) {
+			t.Fatal("decoded point is not in the subgroup")
+		}
+
+		// and encodes back to a point decoding to the same point
+		compressed, raw := p.Bytes(), p.RawBytes()
+		if _, err := q.SetBytes(compressed[:]); err != nil || !q.Equal(&p) {
+			t.Fatal("SetBytes(Bytes()) should stay the same")
+		}
+		if _, err := q.SetBytes(raw[:]); err != nil || !q.Equal(&p) {
+			t.Fatal("SetBytes(RawBytes()) should stay the same")
+		}
+
+		// the decoder reads the same point
+		dec := NewDecoder(bytes.NewReader(buf))
+		if err := dec.Decode(&q); err != nil || !q.Equal(&p) {
+			t.Fatal("Decoder and SetBytes mismatch")
+		}
+	})
+}
+
 func TestG2AffineSerialization(t *testing.T) {
 
 	// test round trip serialization of infinity
@@ -346,47 +385,155 @@ func TestG2AffineSerialization(t *testing.T) {
 	}
 
 	properties := gopter.NewProperties(parameters)
-	/*
-		properties.Property("[G2] Affine SetBytes(RawBytes) should stay the same", prop.ForAll(
-			func(a fp.Element) bool {
-				var start, end G2Affine
-				var ab big.Int
-				a.ToBigIntRegular(&ab)
-				start.ScalarMultiplication(&g2GenAff, &ab)
-
-				buf := start.RawBytes()
-				n, err := end.SetBytes(buf[:])
-				if err != nil {
-					return false
-				}
-				if n != SizeOfG2AffineUncompressed {
-					return false
-				}
-				return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
-			},
-			GenFp(),
-		))
-
-		properties.Property("[G2] Affine SetBytes(Bytes()) should stay the same", prop.ForAll(
-			func(a fp.Element) bool {
-				var start, end G2Affine
-				var ab big.Int
-				a.ToBigIntRegular(&ab)
-				start.ScalarMultiplication(&g2GenAff, &ab)
-
-				buf := start.Bytes()
-				n, err := end.SetBytes(buf[:])
-				if err != nil {
-					return false
-				}
-				if n != SizeOfG2AffineCompressed {
-					return false
-				}
-				return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
-			},
-			GenFp(),
-		))
-	*/
+
+	properties.Property("[G2] Affine SetBytes(RawBytes) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G2Affine
+			var ab big.Int
+			a.ToBigIntRegular(&ab)
+			start.ScalarMultiplication(&g2GenAff, &ab)
+
+			buf := start.RawBytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != SizeOfG2AffineUncompressed {
+				return false
+			}
+			return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G2] Affine SetBytes(Bytes()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G2Affine
+			var ab big.Int
+			a.ToBigIntRegular(&ab)
+			start.ScalarMultiplication(&g2GenAff, &ab)
+
+			buf := start.Bytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != SizeOfG2AffineCompressed {
+				return false
+			}
+			return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
+		},
+		GenFp(),
+	))
 
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
+
+func FuzzG2AffineSetBytes(f *testing.F) {
+	var p G2Affine
+	p.ScalarMultiplication(&g2GenAff, big.NewInt(42))
+	for _, q := range []G2Affine{g2GenAff, p, {}} {
+		compressed, raw := q.Bytes(), q.RawBytes()
+		f.Add(compressed[:])
+		f.Add(raw[:])
+	}
+
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		var p, q G2Affine
+		n, err := p.SetBytes(buf)
+		if err != nil {
+			return
+		}
+		if n != SizeOfG2AffineCompressed && n != SizeOfG2AffineUncompressed {
+			t.Fatal("invalid number of bytes consumed in buffer")
+		}
+
+		// a decoded point is on the curve and in the subgroup
+		if !p.IsInfinity() && !(p.IsOnCurve() && p.IsInSubGroup()) {
+			t.Fatal("decoded point is not in the subgroup")
+		}
+
+		// and encodes back to a point decoding to the same point
+		compressed, raw := p.Bytes(), p.RawBytes()
+		if _, err := q.SetBytes(compressed[:]); err != nil || !q.Equal(&p) {
+			t.Fatal("SetBytes(Bytes()) should stay the same")
+		}
+		if _, err := q.SetBytes(raw[:]); err != nil || !q.Equal(&p) {
+			t.Fatal("SetBytes(RawBytes()) should stay the same")
+		}
+
+		// the decoder reads the same point
+		dec := NewDecoder(bytes.NewReader(buf))
+		if err := dec.Decode(&q); err != nil || !q.Equal(&p) {
+			t.Fatal("Decoder and SetBytes mismatch")
+		}
+	})
+}
+
+// define Gopters generators
+
+// GenFr generates an Fr element
+func GenFr() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var elmt fr.Element
+		var b [fr.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		elmt.SetBytes(b[:])
+		genResult := gopter.NewGenResult(elmt, gopter.NoShrinker)
+		return genResult
+	}
+}
+
+// GenFp generates an Fp element
+func GenFp() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var elmt fp.Element
+		var b [fp.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		elmt.SetBytes(b[:])
+		genResult := gopter.NewGenResult(elmt, gopter.NoShrinker)
+		return genResult
+	}
+}
+
+// GenE3 generates an E3 elmt
+func GenE3() gopter.Gen {
+	return gopter.CombineGens(
+		GenFp(),
+		GenFp(),
+		GenFp(),
+	).Map(func(values []interface{}) fptower.E3 {
+		return fptower.E3{A0: values[0].(fp.Element), A1: values[1].(fp.Element), A2: values[2].(fp.Element)}
+	})
+}
+
+// E6 generates an E6 elmt
+func GenE6() gopter.Gen {
+	return gopter.CombineGens(
+		GenE3(),
+		GenE3(),
+	).Map(func(values []interface{}) fptower.E6 {
+		return fptower.E6{B0: values[0].(fptower.E3), B1: values[1].(fptower.E3)}
+	})
+}
+
+// GenBigInt generates a big.Int
+func GenBigInt() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var s big.Int
+		var b [fp.Bytes]byte
+		_, err := rand.Read(b[:])
+		if err != nil {
+			panic(err)
+		}
+		s.SetBytes(b[:])
+		genResult := gopter.NewGenResult(s, gopter.NoShrinker)
+		return genResult
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"math/big"
 	"math/bits"
 	"runtime"
+	"sync"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
@@ -58,13 +59,47 @@ func TestMultiExpG1(t *testing.T) {
 	scalar.Mul(&scalar, new(big.Int).SetInt64(2*nbSamples+1))
 	scalar.Div(&scalar, new(big.Int).SetInt64(6))
 
+	// ensure a multiexp that's splitted has the same result as a non-splitted one..
+	properties.Property("[G1] Multi exponentation (c=16) should be consistant with splitted multiexp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var samplePointsLarge [nbSamples * 13]G1Affine
+			for i := 0; i < 13; i++ {
+				copy(samplePointsLarge[i*nbSamples:], samplePoints[:])
+			}
+
+			var r16, splitted1, splitted2 G1Jac
+
+			// mixer ensures that all the words of a fpElement are set
+			var sampleScalars [nbSamples * 13]fr.Element
+
+			for i := 1; i <= nbSamples; i++ {
+				sampleScalars[i-1].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i-1], &mixer).
+					FromMont()
+			}
+
+			scalars16 := partitionScalars(sampleScalars[:], 16, false, runtime.NumCPU())
+			r16.msmC16(samplePoints[:], scalars16, runtime.NumCPU())
+
+			splitted1.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: 128})
+			splitted2.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: 51})
+			return r16.Equal(&splitted1) && r16.Equal(&splitted2)
+		},
+		genScalar,
+	))
+
 	if testing.Short() {
 		// we test only c = 5 and c = 16
 
 		properties.Property("[G1] Multi exponentation (c=5, c=16) should be consistant with sum of square", prop.ForAll(
 			func(mixer fr.Element) bool {
 
-				var r5, r16, expected G1Jac
+				var expected G1Jac
+
+				// compute expected result with double and add
+				var finalScalar, mixerBigInt big.Int
+				finalScalar.Mul(&scalar, mixer.ToBigIntRegular(&mixerBigInt))
+				expected.ScalarMultiplication(&g1Gen, &finalScalar)
 
 				// mixer ensures that all the words of a fpElement are set
 				var sampleScalars [nbSamples]fr.Element
@@ -75,23 +110,23 @@ func TestMultiExpG1(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
+				scalars5 := partitionScalars(sampleScalars[:], 5, false, runtime.NumCPU())
+				scalars16 := partitionScalars(sampleScalars[:], 16, false, runtime.NumCPU())
 
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 5, false)
-				r5.msmC5(samplePoints[:], scalars, opt)
+				nbTasks := []int{1, 2, 3, runtime.NumCPU()}
 
-				opt.Lock.Lock()
-				scalars = partitionScalars(sampleScalars[:], 16, false)
-				r16.msmC16(samplePoints[:], scalars, opt)
-
-				// compute expected result with double and add
-				var finalScalar, mixerBigInt big.Int
-				finalScalar.Mul(&scalar, mixer.ToBigIntRegular(&mixerBigInt))
-				expected.ScalarMultiplication(&g1Gen, &finalScalar)
+				// we can have less tasks than chunks
+				// in which case each task may process multiple chunks
+				for i := 0; i < len(nbTasks); i++ {
+					var r5, r16 G1Jac
+					r5.msmC5(samplePoints[:], scalars5, nbTasks[i])
+					r16.msmC16(samplePoints[:], scalars16, nbTasks[i])
+					if !(r5.Equal(&expected) && r16.Equal(&expected)) {
+						return false
+					}
+				}
 
-				return r5.Equal(&expected) && r16.Equal(&expected)
+				return true
 			},
 			genScalar,
 		))
@@ -111,11 +146,8 @@ func TestMultiExpG1(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 4, false)
-				result.msmC4(samplePoints[:], scalars, opt)
+				scalars := partitionScalars(sampleScalars[:], 4, false, runtime.NumCPU())
+				result.msmC4(samplePoints[:], scalars, runtime.NumCPU())
 
 				// compute expected result with double and add
 				var finalScalar, mixerBigInt big.Int
@@ -141,11 +173,8 @@ func TestMultiExpG1(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 5, false)
-				result.msmC5(samplePoints[:], scalars, opt)
+				scalars := partitionScalars(sampleScalars[:], 5, false, runtime.NumCPU())
+				result.msmC5(samplePoints[:], scalars, runtime.NumCPU())
 
 				// compute expected result with double and add
 				var finalScalar, mixerBigInt big.Int
@@ -171,11 +200,8 @@ func TestMultiExpG1(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 8, false)
-				result.msmC8(samplePoints[:], scalars, opt)
+				scalars := partitionScalars(sampleScalars[:], 8, false, runtime.NumCPU())
+				result.msmC8(samplePoints[:], scalars, runtime.NumCPU())
 
 				// compute expected result with double and add
 				var finalScalar, mixerBigInt big.Int
@@ -201,11 +227,8 @@ func TestMultiExpG1(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 16, false)
-				result.msmC16(samplePoints[:], scalars, opt)
+				scalars := partitionScalars(sampleScalars[:], 16, false, runtime.NumCPU())
+				result.msmC16(samplePoints[:], scalars, runtime.NumCPU())
 
 				// compute expected result with double and add
 				var finalScalar, mixerBigInt big.Int
@@ -314,6 +337,44 @@ func BenchmarkMultiExpG1Reference(b *testing.B) {
 	}
 }
 
+func BenchmarkManyMultiExpG1Reference(b *testing.B) {
+	// ensure every words of the scalars are filled
+	var mixer fr.Element
+	mixer.SetString("7716837800905789770901243404444209691916730933998574719964609384059111546487")
+
+	const nbSamples = 1 << 20
+
+	var samplePoints [nbSamples]G1Affine
+	var sampleScalars [nbSamples]fr.Element
+
+	for i := 1; i <= nbSamples; i++ {
+		sampleScalars[i-1].SetUint64(uint64(i)).
+			Mul(&sampleScalars[i-1], &mixer).
+			FromMont()
+		samplePoints[i-1] = g1GenAff
+	}
+
+	var t1, t2, t3 G1Affine
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		var wg sync.WaitGroup
+		wg.Add(3)
+		go func() {
+			t1.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+			wg.Done()
+		}()
+		go func() {
+			t2.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+			wg.Done()
+		}()
+		go func() {
+			t3.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+			wg.Done()
+		}()
+		wg.Wait()
+	}
+}
+
 func TestMultiExpG2(t *testing.T) {
 
 	parameters := gopter.DefaultTestParameters()
@@ -343,13 +404,47 @@ func TestMultiExpG2(t *testing.T) {
 	scalar.Mul(&scalar, new(big.Int).SetInt64(2*nbSamples+1))
 	scalar.Div(&scalar, new(big.Int).SetInt64(6))
 
+	// ensure a multiexp that's splitted has the same result as a non-splitted one..
+	properties.Property("[G2] Multi exponentation (c=16) should be consistant with splitted multiexp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var samplePointsLarge [nbSamples * 13]G2Affine
+			for i := 0; i < 13; i++ {
+				copy(samplePointsLarge[i*nbSamples:], samplePoints[:])
+			}
+
+			var r16, splitted1, splitted2 G2Jac
+
+			// mixer ensures that all the words of a fpElement are set
+			var sampleScalars [nbSamples * 13]fr.Element
+
+			for i := 1; i <= nbSamples; i++ {
+				sampleScalars[i-1].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i-1], &mixer).
+					FromMont()
+			}
+
+			scalars16 := partitionScalars(sampleScalars[:], 16, false, runtime.NumCPU())
+			r16.msmC16(samplePoints[:], scalars16, runtime.NumCPU())
+
+			splitted1.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: 128})
+			splitted2.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: 51})
+			return r16.Equal(&splitted1) && r16.Equal(&splitted2)
+		},
+		genScalar,
+	))
+
 	if testing.Short() {
 		// we test only c = 5 and c = 16
 
 		properties.Property("[G2] Multi exponentation (c=5, c=16) should be consistant with sum of square", prop.ForAll(
 			func(mixer fr.Element) bool {
 
-				var r5, r16, expected G2Jac
+				var expected G2Jac
+
+				// compute expected result with double and add
+				var finalScalar, mixerBigInt big.Int
+				finalScalar.Mul(&scalar, mixer.ToBigIntRegular(&mixerBigInt))
+				expected.ScalarMultiplication(&g2Gen, &finalScalar)
 
 				// mixer ensures that all the words of a fpElement are set
 				var sampleScalars [nbSamples]fr.Element
@@ -360,23 +455,23 @@ func TestMultiExpG2(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
+				scalars5 := partitionScalars(sampleScalars[:], 5, false, runtime.NumCPU())
+				scalars16 := partitionScalars(sampleScalars[:], 16, false, runtime.NumCPU())
 
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 5, false)
-				r5.msmC5(samplePoints[:], scalars, opt)
+				nbTasks := []int{1, 2, 3, runtime.NumCPU()}
 
-				opt.Lock.Lock()
-				scalars = partitionScalars(sampleScalars[:], 16, false)
-				r16.msmC16(samplePoints[:], scalars, opt)
-
-				// compute expected result with double and add
-				var finalScalar, mixerBigInt big.Int
-				finalScalar.Mul(&scalar, mixer.ToBigIntRegular(&mixerBigInt))
-				expected.ScalarMultiplication(&g2Gen, &finalScalar)
+				// we can have less tasks than chunks
+				// in which case each task may process multiple chunks
+				for i := 0; i < len(nbTasks); i++ {
+					var r5, r16 G2Jac
+					r5.msmC5(samplePoints[:], scalars5, nbTasks[i])
+					r16.msmC16(samplePoints[:], scalars16, nbTasks[i])
+					if !(r5.Equal(&expected) && r16.Equal(&expected)) {
+						return false
+					}
+				}
 
-				return r5.Equal(&expected) && r16.Equal(&expected)
+				return true
 			},
 			genScalar,
 		))
@@ -396,11 +491,8 @@ func TestMultiExpG2(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 4, false)
-				result.msmC4(samplePoints[:], scalars, opt)
+				scalars := partitionScalars(sampleScalars[:], 4, false, runtime.NumCPU())
+				result.msmC4(samplePoints[:], scalars, runtime.NumCPU())
 
 				// compute expected result with double and add
 				var finalScalar, mixerBigInt big.Int
@@ -426,11 +518,8 @@ func TestMultiExpG2(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 5, false)
-				result.msmC5(samplePoints[:], scalars, opt)
+				scalars := partitionScalars(sampleScalars[:], 5, false, runtime.NumCPU())
+				result.msmC5(samplePoints[:], scalars, runtime.NumCPU())
 
 				// compute expected result with double and add
 				var finalScalar, mixerBigInt big.Int
@@ -456,11 +545,8 @@ func TestMultiExpG2(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 8, false)
-				result.msmC8(samplePoints[:], scalars, opt)
+				scalars := partitionScalars(sampleScalars[:], 8, false, runtime.NumCPU())
+				result.msmC8(samplePoints[:], scalars, runtime.NumCPU())
 
 				// compute expected result with double and add
 				var finalScalar, mixerBigInt big.Int
@@ -486,11 +572,8 @@ func TestMultiExpG2(t *testing.T) {
 						FromMont()
 				}
 
-				// semaphore to limit number of cpus
-				opt := ecc.NewCPUSemaphore(runtime.NumCPU())
-				opt.Lock.Lock()
-				scalars := partitionScalars(sampleScalars[:], 16, false)
-				result.msmC16(samplePoints[:], scalars, opt)
+				scalars := partitionScalars(sampleScalars[:], 16, false, runtime.NumCPU())
+				result.msmC16(samplePoints[:], scalars, runtime.NumCPU())
 
 				// compute expected result with double and add
 				var finalScalar, mixerBigInt big.Int
@@ -598,3 +681,41 @@ func BenchmarkMultiExpG2Reference(b *testing.B) {
 		testPoint.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
 	}
 }
+
+func BenchmarkManyMultiExpG2Reference(b *testing.B) {
+	// ensure every words of the scalars are filled
+	var mixer fr.Element
+	mixer.SetString("7716837800905789770901243404444209691916730933998574719964609384059111546487")
+
+	const nbSamples = 1 << 20
+
+	var samplePoints [nbSamples]G2Affine
+	var sampleScalars [nbSamples]fr.Element
+
+	for i := 1; i <= nbSamples; i++ {
+		sampleScalars[i-1].SetUint64(uint64(i)).
+			Mul(&sampleScalars[i-1], &mixer).
+			FromMont()
+		samplePoints[i-1] = g2GenAff
+	}
+
+	var t1, t2, t3 G2Affine
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		var wg sync.WaitGroup
+		wg.Add(3)
+		go func() {
+			t1.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+			wg.Done()
+		}()
+		go func() {
+			t2.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+			wg.Done()
+		}()
+		go func() {
+			t3.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+			wg.Done()
+		}()
+		wg.Wait()
+	}
+}
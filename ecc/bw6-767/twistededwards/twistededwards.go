@@ -0,0 +1,47 @@
+package twistededwards
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bw6-767/fr"
+)
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fr.Element // in Montgomery form
+	Cofactor fr.Element // not in Montgomery form
+	Order    big.Int
+	Base     PointAffine
+}
+
+var edwards CurveParams
+
+// GetEdwardsCurve returns the twisted Edwards curve on BW6-767's Fr
+func GetEdwardsCurve() CurveParams {
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&edwards.A)
+	res.D.Set(&edwards.D)
+	res.Cofactor.Set(&edwards.Cofactor)
+	res.Order.Set(&edwards.Order)
+	res.Base.Set(&edwards.Base)
+
+	return res
+}
+
+func init() {
+
+	edwards.A.SetOne().Neg(&edwards.A)
+	edwards.D.SetString("14828789715808314045394280718520159454135873293407698185119515909379654922069438675604160376375614245741048248585")
+	edwards.Cofactor.SetUint64(4).FromMont()
+	edwards.Order.SetString("1000602388805416848354447456433976039139220704984751971332306863355868725954795623813287242373498371184246492027547", 10)
+
+	edwards.Base.X.SetString("3361231424942996138628042480354472018106719432593216254940970082610199795660392654563863524335582357606093828205953")
+	edwards.Base.Y.SetString("2162384239202787109962984451632744228648996604581461978466506002657697187401780917172917032773670843581109334976481")
+}
+
+// mulByA multiplies x by the curve coefficient a=-1
+func mulByA(x *fr.Element) {
+	x.Neg(x)
+}
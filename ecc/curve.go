@@ -0,0 +1,173 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecc
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Curve gives access to the groups and the pairing of a pairing-friendly curve
+// without importing its package at compile time, so that the curve can be
+// selected at runtime from its ID.
+//
+// Points and GT elements returned by a Curve only interoperate with elements of the
+// same group of the same curve; mixing them panics.
+type Curve interface {
+	// ID returns the ID of the curve
+	ID() ID
+
+	// BaseFieldModulus returns the modulus p of the base field Fp
+	BaseFieldModulus() *big.Int
+
+	// ScalarFieldModulus returns the modulus r of the scalar field Fr,
+	// which is also the order of G1, G2 and GT
+	ScalarFieldModulus() *big.Int
+
+	// NewG1 returns the point at infinity of G1
+	NewG1() Point
+
+	// NewG2 returns the point at infinity of G2
+	NewG2() Point
+
+	// NewGT returns the identity of GT
+	NewGT() GTElement
+
+	// G1Generator returns the generator of G1
+	G1Generator() Point
+
+	// G2Generator returns the generator of G2
+	G2Generator() Point
+
+	// Pair computes the reduced pairing of the pairs (P[i], Q[i])
+	// where the P[i] are in G1 and the Q[i] in G2
+	Pair(P, Q []Point) (GTElement, error)
+
+	// PairingCheck returns true if the reduced pairing of the pairs (P[i], Q[i]) is one
+	PairingCheck(P, Q []Point) (bool, error)
+
+	// SizeOfG1AffineCompressed returns the size in bytes of a compressed G1 point
+	SizeOfG1AffineCompressed() int
+
+	// SizeOfG1AffineUncompressed returns the size in bytes of an uncompressed G1 point
+	SizeOfG1AffineUncompressed() int
+
+	// SizeOfG2AffineCompressed returns the size in bytes of a compressed G2 point
+	SizeOfG2AffineCompressed() int
+
+	// SizeOfG2AffineUncompressed returns the size in bytes of an uncompressed G2 point
+	SizeOfG2AffineUncompressed() int
+
+	// SizeOfGT returns the size in bytes of a GT element
+	SizeOfGT() int
+}
+
+// Point is a point of G1 or G2 of a Curve, in affine coordinates.
+// Like the concrete point types, methods set the receiver and return it.
+type Point interface {
+	// Set sets p to a and returns p
+	Set(a Point) Point
+
+	// Add sets p to a+b and returns p
+	Add(a, b Point) Point
+
+	// Sub sets p to a-b and returns p
+	Sub(a, b Point) Point
+
+	// Neg sets p to -a and returns p
+	Neg(a Point) Point
+
+	// ScalarMultiplication sets p to [s]a and returns p
+	ScalarMultiplication(a Point, s *big.Int) Point
+
+	// MultiExp sets p to the sum of the [scalars[i]]points[i] and returns p.
+	// config.ScalarsMont is ignored.
+	MultiExp(points []Point, scalars []*big.Int, config MultiExpConfig) (Point, error)
+
+	// Equal returns true if p and a are the same point
+	Equal(a Point) bool
+
+	// IsInfinity returns true if p is the point at infinity
+	IsInfinity() bool
+
+	// IsOnCurve returns true if p is on the curve
+	IsOnCurve() bool
+
+	// IsInSubGroup returns true if p is in the prime order subgroup
+	IsInSubGroup() bool
+
+	// Bytes returns the compressed binary representation of p
+	Bytes() []byte
+
+	// RawBytes returns the uncompressed binary representation of p
+	RawBytes() []byte
+
+	// SetBytes sets p from a compressed or uncompressed binary representation,
+	// and returns the number of bytes read
+	SetBytes(buf []byte) (int, error)
+
+	String() string
+}
+
+// GTElement is an element of the target group GT of a Curve
+type GTElement interface {
+	// Set sets z to a and returns z
+	Set(a GTElement) GTElement
+
+	// Mul sets z to a*b and returns z
+	Mul(a, b GTElement) GTElement
+
+	// Inverse sets z to a**-1 and returns z
+	Inverse(a GTElement) GTElement
+
+	// Exp sets z to a**e and returns z
+	Exp(a GTElement, e *big.Int) GTElement
+
+	// Equal returns true if z and a are equal
+	Equal(a GTElement) bool
+
+	// IsOne returns true if z is the identity of GT
+	IsOne() bool
+
+	// Bytes returns the binary representation of z
+	Bytes() []byte
+
+	// SetBytes sets z from its binary representation
+	SetBytes(buf []byte) error
+
+	String() string
+}
+
+var curves = make(map[ID]Curve)
+
+// RegisterCurve registers the runtime implementation of a curve.
+func RegisterCurve(c Curve) {
+	// we cannot import the curve packages directly due to import cycles,
+	// they register themselves when imported.
+	curves[c.ID()] = c
+}
+
+// Curve returns the runtime implementation of the curve. The curve package,
+// e.g. github.com/consensys/gnark-crypto/ecc/bn254, must be imported
+// (possibly as _) for its implementation to be registered.
+func (id ID) Curve() (Curve, error) {
+	c, ok := curves[id]
+	if !ok {
+		return nil, errors.New("curve not registered: it has no pairing or its package is not imported")
+	}
+	return c, nil
+}
@@ -14,16 +14,24 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package ecc provides bls12-381, bls12-377, bn254, bw6-761, bls24-315 and bw6-633 elliptic curves implementation (+pairing).
+// Package ecc provides bls12-381, bls12-377, bls12-378, bn254, bw6-761, bls24-315, bw6-633, bw6-756 and bw6-767 elliptic curves implementation (+pairing).
+//
+// It also provides secp256k1 (G1 arithmetic, multi exponentiation and ECDSA) and grumpkin,
+// the curve forming a cycle with bn254 (G1 arithmetic and multi exponentiation), and the
+// pallas/vesta cycle (G1 arithmetic, multi exponentiation and FFT).
 //
 // Also
 //
 //   - Multi exponentiation
 //   - FFT
 //   - Polynomial commitment schemes
-//   - MiMC
+//   - MiMC, MiMC-7 and Poseidon (circomlib compatible, on bn254's fr)
 //   - twisted edwards "companion curves"
+//   - bandersnatch (on bls12-381's fr, with GLV and the banderwagon quotient group)
+//   - jubjub (on bls12-381's fr, with the Zcash group hash and RedJubjub signatures)
+//   - baby jubjub with circomlib's parameters (on bn254's fr, with EdDSA-Poseidon and EdDSA-MiMC-7)
 //   - EdDSA (on the "companion" twisted edwards curves)
+//   - ECDSA (on secp256k1)
 package ecc
 
 import "sync"
@@ -41,11 +49,17 @@ const (
 	BW6_761
 	BW6_633
 	BW6_767
+	SECP256K1
+	GRUMPKIN
+	PALLAS
+	VESTA
+	BLS12_378
+	BW6_756
 )
 
 // Implemented return the list of curves fully implemented in gnark-crypto
 func Implemented() []ID {
-	return []ID{BN254, BLS12_377, BLS12_381, BW6_761, BLS24_315, BW6_767}
+	return []ID{BN254, BLS12_377, BLS12_381, BW6_761, BW6_633, BLS24_315, BW6_767, BLS12_378, BW6_756}
 }
 
 func (id ID) String() string {
@@ -64,6 +78,18 @@ func (id ID) String() string {
 		return "bls24_315"
 	case BW6_767:
 		return "bw6_767"
+	case SECP256K1:
+		return "secp256k1"
+	case GRUMPKIN:
+		return "grumpkin"
+	case PALLAS:
+		return "pallas"
+	case VESTA:
+		return "vesta"
+	case BLS12_378:
+		return "bls12_378"
+	case BW6_756:
+		return "bw6_756"
 	default:
 		panic("unimplemented ecc ID")
 	}
@@ -0,0 +1,70 @@
+package grumpkin
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	fp "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// E: y**2=x**3-17
+// grumpkin forms a cycle with bn254: its base field is the scalar field of bn254
+// and its scalar field is the base field of bn254, so the fields are not regenerated
+// here but imported from the bn254 package.
+// Fp: p=21888242871839275222246405745257275088548364400416034343698204186575808495617
+// Fr: r=21888242871839275222246405745257275088696311157297823662689037894645226208583
+// the curve has prime order, E(Fp) is cyclic of order r
+
+// ID grumpkin ID
+const ID = ecc.GRUMPKIN
+
+// bCurveCoeff b coeff of the curve
+var bCurveCoeff fp.Element
+
+// generator of the curve
+var g1Gen G1Jac
+
+var g1GenAff G1Affine
+
+// point at infinity
+var g1Infinity G1Jac
+
+// Parameters useful for the GLV scalar multiplication. The third root defines the
+// endomorphism phi for <G1Affine>. lambda is such that <r, phi-lambda> lies above
+// <r> in the ring Z[phi]. More concretely it's the associated eigenvalue
+// of phi restricted to <G1Affine>
+// cf https://www.cosic.esat.kuleuven.be/nessie/reports/phase2/GLV.pdf
+var thirdRootOneG1 fp.Element
+var lambdaGLV big.Int
+
+// glvBasis stores R-linearly independant vectors (a,b), (c,d)
+// in ker((u,v)->u+vlambda[r]), and their determinant
+var glvBasis ecc.Lattice
+
+func init() {
+
+	bCurveCoeff.SetUint64(17).Neg(&bCurveCoeff)
+
+	g1Gen.X.SetString("1")
+	g1Gen.Y.SetString("17631683881184975370165255887551781615748388533673675138860")
+	g1Gen.Z.SetString("1")
+
+	g1GenAff.FromJacobian(&g1Gen)
+
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+
+	thirdRootOneG1.SetString("4407920970296243842393367215006156084916469457145843978461")
+	lambdaGLV.SetString("2203960485148121921418603742825762020974279258880205651966", 10)
+	_r := fr.Modulus()
+	ecc.PrecomputeLattice(_r, &lambdaGLV, &glvBasis)
+
+}
+
+// Generators return the generator of the curve
+func Generators() (g1Jac G1Jac, g1Aff G1Affine) {
+	g1Aff = g1GenAff
+	g1Jac = g1Gen
+	return
+}
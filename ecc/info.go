@@ -0,0 +1,295 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecc
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// Info describes a curve. It is available without importing the curve package.
+type Info struct {
+	// EmbeddingDegree is the embedding degree of the curve, or 0 if the curve has no pairing
+	EmbeddingDegree int
+
+	// Fp is the base field, Fr the scalar field
+	Fp, Fr FieldInfo
+
+	// G1Cofactor is the cofactor of G1 in E(Fp)
+	G1Cofactor *big.Int
+
+	// G2Cofactor is the cofactor of G2 in the twist of E, or nil if the curve has no pairing
+	G2Cofactor *big.Int
+
+	// sizes in bytes of the point encodings, 0 for G2 and GT if the curve has no pairing
+	SizeOfG1AffineCompressed   int
+	SizeOfG1AffineUncompressed int
+	SizeOfG2AffineCompressed   int
+	SizeOfG2AffineUncompressed int
+	SizeOfGT                   int
+
+	// features implemented in gnark-crypto for the curve
+	HasPairing     bool
+	HasEdDSA       bool // EdDSA on a twisted Edwards curve defined on Fr
+	HasECDSA       bool
+	HasKZG         bool
+	HasHashToCurve bool
+}
+
+// FieldInfo describes a prime field
+type FieldInfo struct {
+	Modulus *big.Int
+	Bits    int // bit size of the modulus
+	Bytes   int // size in bytes of an encoded element
+
+	// TwoAdicity is the largest n such that 2**n divides Modulus-1,
+	// that is the log2 of the largest FFT domain on the field
+	TwoAdicity int
+}
+
+// curveInfo is the static description of a curve, from which Info is built
+type curveInfo struct {
+	k                              int
+	fp, fr                         string
+	h1, h2                         string
+	g1Compressed, g1Uncompressed   int
+	g2Compressed, g2Uncompressed   int
+	eddsa, ecdsa, kzg, hashToCurve bool
+}
+
+var curveInfos = map[ID]curveInfo{
+	BN254: {
+		k:              12,
+		fp:             "21888242871839275222246405745257275088696311157297823662689037894645226208583",
+		fr:             "21888242871839275222246405745257275088548364400416034343698204186575808495617",
+		h1:             "1",
+		h2:             "21888242871839275222246405745257275088844257914179612981679871602714643921549",
+		g1Compressed:   32,
+		g1Uncompressed: 64,
+		g2Compressed:   64,
+		g2Uncompressed: 128,
+		eddsa:          true,
+		kzg:            true,
+		hashToCurve:    true,
+	},
+	BLS12_377: {
+		k:              12,
+		fp:             "258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458177",
+		fr:             "8444461749428370424248824938781546531375899335154063827935233455917409239041",
+		h1:             "30631250834960419227450344600217059328",
+		h2:             "7923214915284317143930293550643874566881017850177945424769256759165301436616933228209277966774092486467289478618404761412630691835764674559376407658497",
+		g1Compressed:   48,
+		g1Uncompressed: 96,
+		g2Compressed:   96,
+		g2Uncompressed: 192,
+		eddsa:          true,
+		kzg:            true,
+		hashToCurve:    true,
+	},
+	BLS12_378: {
+		k:              12,
+		fp:             "605248206075306171733248481581800960739847691770924913753520744034740935903401304776283802348837311170974282940417",
+		fr:             "14883435066912132899950318861128167269793560281114003360875131245101026639873",
+		h1:             "40665894892829807646474719258757562368",
+		h2:             "24612959932332196205839579906571768134135922624206710918257425694576233199085164797278946087292475043118880443890928096078712781000497620919226233520129",
+		g1Compressed:   48,
+		g1Uncompressed: 96,
+		g2Compressed:   96,
+		g2Uncompressed: 192,
+		eddsa:          true,
+		kzg:            true,
+		hashToCurve:    true,
+	},
+	BLS12_381: {
+		k:              12,
+		fp:             "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559787",
+		fr:             "52435875175126190479447740508185965837690552500527637822603658699938581184513",
+		h1:             "76329603384216526031706109802092473003",
+		h2:             "305502333931268344200999753193121504214466019254188142667664032982267604182971884026507427359259977847832272839041616661285803823378372096355777062779109",
+		g1Compressed:   48,
+		g1Uncompressed: 96,
+		g2Compressed:   96,
+		g2Uncompressed: 192,
+		eddsa:          true,
+		kzg:            true,
+		hashToCurve:    true,
+	},
+	BLS24_315: {
+		k:              24,
+		fp:             "39705142709513438335025689890408969744933502416914749335064285505637884093126342347073617133569",
+		fr:             "11502027791375260645628074404575422495959608200132055716665986169834464870401",
+		h1:             "3452012412914368512",
+		h2:             "216079035500590602943546242140422432107555648541092228905249925297233022840522997069049628086159486821981928133195442045258836056038368698198752015929588430502672406127261882483243231901352617383373863699144968206692699635819037532045432968648848220192219321417343498967027189130043882684380082463571969",
+		g1Compressed:   40,
+		g1Uncompressed: 80,
+		g2Compressed:   160,
+		g2Uncompressed: 320,
+		eddsa:          true,
+		kzg:            true,
+		hashToCurve:    true,
+	},
+	BW6_633: {
+		k:              6,
+		fp:             "20494478644167774678813387386538961497669590920908778075528754551012016751717791778743535050360001387419576570244406805463255765034468441182772056330021723098661967429339971741066259394985997",
+		fr:             "39705142709513438335025689890408969744933502416914749335064285505637884093126342347073617133569",
+		h1:             "516166855112631370346774477030598579858367278343565509012644853411927535599366632765988905418773",
+		h2:             "516166855112631370346774477030598579858367278343565509012644853411927535599366632765988905418768",
+		g1Compressed:   80,
+		g1Uncompressed: 160,
+		g2Compressed:   80,
+		g2Uncompressed: 160,
+		eddsa:          true,
+		kzg:            true,
+		hashToCurve:    true,
+	},
+	BW6_756: {
+		k:              6,
+		fp:             "366325390957376286590726555727219947825377821289246188278797409783441745356050456327989347160777465284190855125642086860525706497928518803244008749360363712553766506755227344593404398783886857865261088226271336335268413437902849",
+		fr:             "605248206075306171733248481581800960739847691770924913753520744034740935903401304776283802348837311170974282940417",
+		h1:             "605248206075306171568857128027361794400937215108643640003009340657451546212610770151705515081537938829431808196608",
+		h2:             "605248206075306171568857128027361794400937215108643640003009340657451546212610770151705515081537938829431808196609",
+		g1Compressed:   96,
+		g1Uncompressed: 192,
+		g2Compressed:   96,
+		g2Uncompressed: 192,
+		eddsa:          true,
+		kzg:            true,
+		hashToCurve:    true,
+	},
+	BW6_761: {
+		k:              6,
+		fp:             "6891450384315732539396789682275657542479668912536150109513790160209623422243491736087683183289411687640864567753786613451161759120554247759349511699125301598951605099378508850372543631423596795951899700429969112842764913119068299",
+		fr:             "258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458177",
+		h1:             "26642435879335816683987677701488073867751118270052650655942102502312977592501693353047140953112195348280268661194876",
+		h2:             "26642435879335816683987677701488073867751118270052650655942102502312977592501693353047140953112195348280268661194869",
+		g1Compressed:   96,
+		g1Uncompressed: 192,
+		g2Compressed:   96,
+		g2Uncompressed: 192,
+		eddsa:          true,
+		kzg:            true,
+		hashToCurve:    true,
+	},
+	BW6_767: {
+		k:              6,
+		fp:             "496597749679620867773432037469214230242402307330180853437434581099336634619713640485778675608223760166307530047354464605410050411581079376994803852937842168733702867087556948851016246640584660942486895230518034810309227309966899431",
+		fr:             "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559787",
+		h1:             "124074696211871689196744963988542244365937182994917792082847997279938522233341057826255097957635256182243502012934844",
+		h2:             "124074696211871689196744963988542244365937182994917792082847997279938522233341057826255097957635256182243502012934833",
+		g1Compressed:   97,
+		g1Uncompressed: 193,
+		g2Compressed:   97,
+		g2Uncompressed: 193,
+		eddsa:          true,
+		hashToCurve:    true,
+	},
+	SECP256K1: {
+		fp:             "115792089237316195423570985008687907853269984665640564039457584007908834671663",
+		fr:             "115792089237316195423570985008687907852837564279074904382605163141518161494337",
+		h1:             "1",
+		g1Compressed:   33,
+		g1Uncompressed: 65,
+		ecdsa:          true,
+		hashToCurve:    true,
+	},
+	GRUMPKIN: {
+		fp:             "21888242871839275222246405745257275088548364400416034343698204186575808495617",
+		fr:             "21888242871839275222246405745257275088696311157297823662689037894645226208583",
+		h1:             "1",
+		g1Compressed:   32,
+		g1Uncompressed: 64,
+		hashToCurve:    true,
+	},
+	PALLAS: {
+		fp:             "28948022309329048855892746252171976963363056481941560715954676764349967630337",
+		fr:             "28948022309329048855892746252171976963363056481941647379679742748393362948097",
+		h1:             "1",
+		g1Compressed:   33,
+		g1Uncompressed: 65,
+		hashToCurve:    true,
+	},
+	VESTA: {
+		fp:             "28948022309329048855892746252171976963363056481941647379679742748393362948097",
+		fr:             "28948022309329048855892746252171976963363056481941560715954676764349967630337",
+		h1:             "1",
+		g1Compressed:   33,
+		g1Uncompressed: 65,
+		hashToCurve:    true,
+	},
+}
+
+// Info returns the description of the curve.
+// It panics if the ID is not a curve implemented in gnark-crypto.
+func (id ID) Info() Info {
+	c, ok := curveInfos[id]
+	if !ok {
+		panic("unimplemented ecc ID")
+	}
+	res := Info{
+		EmbeddingDegree:            c.k,
+		Fp:                         newFieldInfo(c.fp),
+		Fr:                         newFieldInfo(c.fr),
+		G1Cofactor:                 mustBigInt(c.h1),
+		SizeOfG1AffineCompressed:   c.g1Compressed,
+		SizeOfG1AffineUncompressed: c.g1Uncompressed,
+		SizeOfG2AffineCompressed:   c.g2Compressed,
+		SizeOfG2AffineUncompressed: c.g2Uncompressed,
+		HasPairing:                 c.k != 0,
+		HasEdDSA:                   c.eddsa,
+		HasECDSA:                   c.ecdsa,
+		HasKZG:                     c.kzg,
+		HasHashToCurve:             c.hashToCurve,
+	}
+	if res.HasPairing {
+		res.G2Cofactor = mustBigInt(c.h2)
+		res.SizeOfGT = c.k * res.Fp.Bytes
+	}
+	return res
+}
+
+// IDFromString returns the ID of a curve from its name as output by ID.String.
+// The match is case insensitive and accepts "-" in place of "_", e.g. "BLS12-381".
+func IDFromString(s string) (ID, error) {
+	s = strings.ReplaceAll(strings.ToLower(s), "-", "_")
+	for id := range curveInfos {
+		if id.String() == s {
+			return id, nil
+		}
+	}
+	return UNKNOWN, errors.New("unknown curve " + s)
+}
+
+func newFieldInfo(modulus string) FieldInfo {
+	var res FieldInfo
+	res.Modulus = mustBigInt(modulus)
+	res.Bits = res.Modulus.BitLen()
+	res.Bytes = (res.Bits + 63) / 64 * 8
+
+	var qMinusOne big.Int
+	qMinusOne.Sub(res.Modulus, big.NewInt(1))
+	res.TwoAdicity = int(qMinusOne.TrailingZeroBits())
+	return res
+}
+
+func mustBigInt(s string) *big.Int {
+	res, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid big.Int " + s)
+	}
+	return res
+}
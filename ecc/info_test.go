@@ -0,0 +1,103 @@
+package ecc_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	bls12378 "github.com/consensys/gnark-crypto/ecc/bls12-378"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633"
+	bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	bw6767 "github.com/consensys/gnark-crypto/ecc/bw6-767"
+	"github.com/consensys/gnark-crypto/ecc/grumpkin"
+	"github.com/consensys/gnark-crypto/ecc/pallas"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/vesta"
+)
+
+func TestInfo(t *testing.T) {
+	// sizes of the encodings as declared by the curve packages
+	sizes := map[ecc.ID][5]int{
+		ecc.BN254:     {bn254.SizeOfG1AffineCompressed, bn254.SizeOfG1AffineUncompressed, bn254.SizeOfG2AffineCompressed, bn254.SizeOfG2AffineUncompressed, bn254.SizeOfGT},
+		ecc.BLS12_377: {bls12377.SizeOfG1AffineCompressed, bls12377.SizeOfG1AffineUncompressed, bls12377.SizeOfG2AffineCompressed, bls12377.SizeOfG2AffineUncompressed, bls12377.SizeOfGT},
+		ecc.BLS12_378: {bls12378.SizeOfG1AffineCompressed, bls12378.SizeOfG1AffineUncompressed, bls12378.SizeOfG2AffineCompressed, bls12378.SizeOfG2AffineUncompressed, bls12378.SizeOfGT},
+		ecc.BLS12_381: {bls12381.SizeOfG1AffineCompressed, bls12381.SizeOfG1AffineUncompressed, bls12381.SizeOfG2AffineCompressed, bls12381.SizeOfG2AffineUncompressed, bls12381.SizeOfGT},
+		ecc.BLS24_315: {bls24315.SizeOfG1AffineCompressed, bls24315.SizeOfG1AffineUncompressed, bls24315.SizeOfG2AffineCompressed, bls24315.SizeOfG2AffineUncompressed, bls24315.SizeOfGT},
+		ecc.BW6_633:   {bw6633.SizeOfG1AffineCompressed, bw6633.SizeOfG1AffineUncompressed, bw6633.SizeOfG2AffineCompressed, bw6633.SizeOfG2AffineUncompressed, bw6633.SizeOfGT},
+		ecc.BW6_756:   {bw6756.SizeOfG1AffineCompressed, bw6756.SizeOfG1AffineUncompressed, bw6756.SizeOfG2AffineCompressed, bw6756.SizeOfG2AffineUncompressed, bw6756.SizeOfGT},
+		ecc.BW6_761:   {bw6761.SizeOfG1AffineCompressed, bw6761.SizeOfG1AffineUncompressed, bw6761.SizeOfG2AffineCompressed, bw6761.SizeOfG2AffineUncompressed, bw6761.SizeOfGT},
+		ecc.BW6_767:   {bw6767.SizeOfG1AffineCompressed, bw6767.SizeOfG1AffineUncompressed, bw6767.SizeOfG2AffineCompressed, bw6767.SizeOfG2AffineUncompressed, bw6767.SizeOfGT},
+		ecc.SECP256K1: {secp256k1.SizeOfG1AffineCompressed, secp256k1.SizeOfG1AffineUncompressed},
+		ecc.GRUMPKIN:  {grumpkin.SizeOfG1AffineCompressed, grumpkin.SizeOfG1AffineUncompressed},
+		ecc.PALLAS:    {pallas.SizeOfG1AffineCompressed, pallas.SizeOfG1AffineUncompressed},
+		ecc.VESTA:     {vesta.SizeOfG1AffineCompressed, vesta.SizeOfG1AffineUncompressed},
+	}
+
+	for id, s := range sizes {
+		info := id.Info()
+		if got := [5]int{info.SizeOfG1AffineCompressed, info.SizeOfG1AffineUncompressed, info.SizeOfG2AffineCompressed, info.SizeOfG2AffineUncompressed, info.SizeOfGT}; got != s {
+			t.Errorf("%s: sizes %v, expected %v", id, got, s)
+		}
+		if info.HasPairing != (info.G2Cofactor != nil) {
+			t.Errorf("%s: G2 cofactor and pairing mismatch", id)
+		}
+
+		// the number of points on E(Fp) satisfies the Hasse bound
+		var order, trace big.Int
+		order.Mul(info.G1Cofactor, info.Fr.Modulus)
+		trace.Add(info.Fp.Modulus, big.NewInt(1)).Sub(&trace, &order)
+		trace.Mul(&trace, &trace)
+		var bound big.Int
+		bound.Lsh(info.Fp.Modulus, 2)
+		if trace.Cmp(&bound) > 0 {
+			t.Errorf("%s: #E(Fp) is out of the Hasse bound", id)
+		}
+	}
+
+	// the runtime API and Info describe the same curves
+	for _, id := range ecc.Implemented() {
+		c, err := id.Curve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		info := id.Info()
+		if c.BaseFieldModulus().Cmp(info.Fp.Modulus) != 0 || c.ScalarFieldModulus().Cmp(info.Fr.Modulus) != 0 {
+			t.Errorf("%s: moduli mismatch", id)
+		}
+	}
+
+	twoAdicity := map[ecc.ID]int{
+		ecc.BN254:     28,
+		ecc.BLS12_377: 47,
+		ecc.BLS12_381: 32,
+		ecc.BLS12_378: 42,
+		ecc.BW6_756:   41,
+		ecc.PALLAS:    32,
+	}
+	for id, n := range twoAdicity {
+		if got := id.Info().Fr.TwoAdicity; got != n {
+			t.Errorf("%s: 2-adicity %d, expected %d", id, got, n)
+		}
+	}
+}
+
+func TestIDFromString(t *testing.T) {
+	for _, id := range []ecc.ID{ecc.BN254, ecc.BLS12_377, ecc.BLS12_378, ecc.BLS12_381, ecc.BLS24_315, ecc.BW6_633, ecc.BW6_756, ecc.BW6_761, ecc.BW6_767, ecc.SECP256K1, ecc.GRUMPKIN, ecc.PALLAS, ecc.VESTA} {
+		got, err := ecc.IDFromString(id.String())
+		if err != nil || got != id {
+			t.Errorf("%s: parsed as %d (%v)", id, got, err)
+		}
+	}
+
+	if got, err := ecc.IDFromString("BLS12-381"); err != nil || got != ecc.BLS12_381 {
+		t.Error("IDFromString should be case insensitive and accept -")
+	}
+	if _, err := ecc.IDFromString("bls12_380"); err == nil {
+		t.Error("IDFromString should fail on unknown curves")
+	}
+}
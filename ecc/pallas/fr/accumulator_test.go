@@ -0,0 +1,124 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	ggen "github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestWideAccumulator(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genN := ggen.IntRange(0, 50)
+
+	properties.Property("WideAccumulator should match a sum of reduced products", prop.ForAll(
+		func(n int) bool {
+			a, b := randomVector(n), randomVector(n)
+			var acc WideAccumulator
+			var expected, t Element
+			for i := 0; i < n; i++ {
+				acc.MulAdd(&a[i], &b[i])
+				t.Mul(&a[i], &b[i])
+				expected.Add(&expected, &t)
+			}
+			got := acc.Reduce()
+			return got.Equal(&expected) && !got.biggerOrEqualModulus()
+		},
+		genN,
+	))
+
+	properties.Property("WideAccumulator should handle q-1 operands", prop.ForAll(
+		func(n int) bool {
+			var minusOne, expected, t Element
+			minusOne.SetOne().Neg(&minusOne)
+			var acc WideAccumulator
+			for i := 0; i < n; i++ {
+				acc.MulAdd(&minusOne, &minusOne)
+				t.Mul(&minusOne, &minusOne)
+				expected.Add(&expected, &t)
+			}
+			got := acc.Reduce()
+			return got.Equal(&expected) && !got.biggerOrEqualModulus()
+		},
+		genN,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestWideAccumulatorLargeTopWord(t *testing.T) {
+	// fill the top word beyond what a test can accumulate, and check the reduction against math/big
+	var acc WideAccumulator
+	for i := range acc.t {
+		acc.t[i] = ^uint64(0)
+	}
+	acc.t[len(acc.t)-1] = (1 << 63) - 1
+
+	var v, r, rInv big.Int
+	for i := len(acc.t) - 1; i >= 0; i-- {
+		v.Lsh(&v, 64).Add(&v, new(big.Int).SetUint64(acc.t[i]))
+	}
+	r.Lsh(big.NewInt(1), Limbs*64)
+	rInv.ModInverse(&r, Modulus())
+	v.Mul(&v, &rInv).Mod(&v, Modulus())
+
+	var expected Element
+	expected.SetBigInt(&v)
+	expected.FromMont()
+
+	got := acc.Reduce()
+	if !got.Equal(&expected) {
+		t.Fatal("WideAccumulator.Reduce doesn't match math/big")
+	}
+}
+
+func BenchmarkWideAccumulator(b *testing.B) {
+	const n = 256
+	x, y := randomVector(n), randomVector(n)
+
+	b.Run("mulAdd+reduce", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var acc WideAccumulator
+			for j := 0; j < n; j++ {
+				acc.MulAdd(&x[j], &y[j])
+			}
+			benchResElement = acc.Reduce()
+		}
+	})
+	b.Run("mul+add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var res, t Element
+			for j := 0; j < n; j++ {
+				t.Mul(&x[j], &y[j])
+				res.Add(&res, &t)
+			}
+			benchResElement = res
+		}
+	})
+}
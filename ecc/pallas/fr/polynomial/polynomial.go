@@ -0,0 +1,157 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/pallas/fr"
+)
+
+// Polynomial polynomial represented by coefficients bn254 fr field.
+type Polynomial []fr.Element
+
+// Degree returns the degree of the polynomial, which is the length of Data.
+func (p *Polynomial) Degree() uint64 {
+	return uint64(len(*p) - 1)
+}
+
+// evalChunkSize is the number of coefficients of p summed with a WideAccumulator in Eval
+const evalChunkSize = 64
+
+// Eval evaluates p at v
+// returns a fr.Element
+func (p *Polynomial) Eval(v *fr.Element) fr.Element {
+	if len(*p) < 2*evalChunkSize {
+		return p.evalHorner(v)
+	}
+
+	// p(v) = sum_c (sum_j p[c+j] * v**j) * (v**evalChunkSize)**(c/evalChunkSize): the inner sums
+	// are accumulated without reduction, the outer sum is evaluated with Horner's method
+	var powers [evalChunkSize]fr.Element
+	powers[0].SetOne()
+	for j := 1; j < evalChunkSize; j++ {
+		powers[j].Mul(&powers[j-1], v)
+	}
+	var vChunk fr.Element
+	vChunk.Mul(&powers[evalChunkSize-1], v)
+
+	var res fr.Element
+	var acc fr.WideAccumulator
+	for c := (len(*p) - 1) / evalChunkSize * evalChunkSize; c >= 0; c -= evalChunkSize {
+		chunk := (*p)[c:]
+		if len(chunk) > evalChunkSize {
+			chunk = chunk[:evalChunkSize]
+		}
+		acc.Reset()
+		for j := range chunk {
+			acc.MulAdd(&chunk[j], &powers[j])
+		}
+		sum := acc.Reduce()
+		res.Mul(&res, &vChunk)
+		res.Add(&res, &sum)
+	}
+
+	return res
+}
+
+// evalHorner evaluates p at v with Horner's method
+func (p *Polynomial) evalHorner(v *fr.Element) fr.Element {
+
+	res := (*p)[len(*p)-1]
+	for i := len(*p) - 2; i >= 0; i-- {
+		res.Mul(&res, v)
+		res.Add(&res, &(*p)[i])
+	}
+
+	return res
+}
+
+// Clone returns a copy of the polynomial
+func (p *Polynomial) Clone() Polynomial {
+	_p := make(Polynomial, len(*p))
+	copy(_p, *p)
+	return _p
+}
+
+// AddConstantInPlace adds a constant to the polynomial, modifying p
+func (p *Polynomial) AddConstantInPlace(c *fr.Element) {
+	for i := 0; i < len(*p); i++ {
+		(*p)[i].Add(&(*p)[i], c)
+	}
+}
+
+// SubConstantInPlace subs a constant to the polynomial, modifying p
+func (p *Polynomial) SubConstantInPlace(c *fr.Element) {
+	for i := 0; i < len(*p); i++ {
+		(*p)[i].Sub(&(*p)[i], c)
+	}
+}
+
+// ScaleInPlace multiplies p by v, modifying p
+func (p *Polynomial) ScaleInPlace(c *fr.Element) {
+	v := fr.Vector(*p)
+	v.ScalarMul(v, c)
+}
+
+// Add adds p1 to p2
+// This function allocates a new slice unless p == p1 or p == p2
+func (p *Polynomial) Add(p1, p2 Polynomial) *Polynomial {
+
+	bigger := p1
+	smaller := p2
+	if len(bigger) < len(smaller) {
+		bigger, smaller = smaller, bigger
+	}
+
+	if len(*p) == len(bigger) && (&(*p)[0] == &bigger[0]) {
+		v := fr.Vector((*p)[:len(smaller)])
+		v.Add(v, fr.Vector(smaller))
+		return p
+	}
+
+	if len(*p) == len(smaller) && (&(*p)[0] == &smaller[0]) {
+		v := fr.Vector(*p)
+		v.Add(v, fr.Vector(bigger[:len(smaller)]))
+		*p = append(*p, bigger[len(smaller):]...)
+		return p
+	}
+
+	res := make(Polynomial, len(bigger))
+	copy(res, bigger)
+	v := fr.Vector(res[:len(smaller)])
+	v.Add(v, fr.Vector(smaller))
+	*p = res
+	return p
+}
+
+// Equal checks equality between two polynomials
+func (p *Polynomial) Equal(p1 Polynomial) bool {
+	if (*p == nil) != (p1 == nil) {
+		return false
+	}
+
+	if len(*p) != len(p1) {
+		return false
+	}
+
+	for i := range p1 {
+		if !(*p)[i].Equal(&p1[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pallas
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/pallas/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMapToCurveG1Sswu(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[PALLAS] sswu should output a point on the isogenous curve", prop.ForAll(
+		func(a fp.Element) bool {
+			x, y := sswuMapG1(a)
+			var lhs, rhs fp.Element
+			lhs.Square(&y)
+			rhs.Square(&x).
+				Add(&rhs, &isoG1.a).
+				Mul(&rhs, &x).
+				Add(&rhs, &isoG1.b)
+			return lhs.Equal(&rhs)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[PALLAS] MapToCurveG1Sswu should output a point on the curve", prop.ForAll(
+		func(a fp.Element) bool {
+			g := MapToCurveG1Sswu(a)
+			return g.IsInSubGroup()
+		},
+		GenFp(),
+	))
+
+	properties.Property("[PALLAS] HashToCurveG1Sswu should output a point on the curve", prop.ForAll(
+		func(a fp.Element) bool {
+			b := a.Bytes()
+			g, err := HashToCurveG1Sswu(b[:], []byte("QUUX-V01-CS02-with-PALLAS_XMD:SHA-256_SSWU_RO_"))
+			return err == nil && g.IsInSubGroup()
+		},
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// regression vectors of MapToCurveG1Sswu, produced by this implementation: they pin the isogeny
+// and the sign of y (the parity of u, as in RFC 9380), but they are not test vectors of pasta_curves
+func TestMapToCurveG1SswuRegression(t *testing.T) {
+
+	vectors := []struct {
+		u, x, y string
+	}{
+		{
+			u: "0",
+			x: "14112575670838628591395785475694768936308567885718537240490226054717243891261",
+			y: "20156134037284699412015504472250216177377414813341900124882954019056035362943",
+		},
+		{
+			u: "1",
+			x: "27469957588089876665738679154554870941926895326097893922041812618604714393046",
+			y: "26215557410278291503393162369155505984805506793831218460599831098898793977715",
+		},
+		{
+			u: "2",
+			x: "27758634890984116282212057974868924563430645238464799342532203177745698676318",
+			y: "25816508541030240449734199624741930352933914123711903946699037900665183814128",
+		},
+		{
+			u: "3",
+			x: "3064632321842188951096900604675552348055583913008717766668255152622057036426",
+			y: "4938612060365597931431659020348248931249290506968287973039515010578018451790",
+		},
+		{
+			u: "7",
+			x: "2994198621272478149347508694232175140033671038196139100450576200326418359829",
+			y: "5553306981987086542553927848033386936832354929867421194350837433875244093580",
+		},
+		{
+			u: "14474011154664524427946373126085988481681528240970780357977338382174983815168",
+			x: "9345794694024613773140989680089036973025644969344290719493983972451511201486",
+			y: "12365903189611318255631586219226547656844183129761776542239950601709042528020",
+		},
+		{
+			u: "28948022309329048855892746252171976963363056481941560715954676764349967630336",
+			x: "27469957588089876665738679154554870941926895326097893922041812618604714393046",
+			y: "2732464899050757352499583883016470978557549688110342255354845665451173652622",
+		},
+		{
+			u: "11920928955078125",
+			x: "2537518040038500172857996190162863532485753097953270297728360789324812814538",
+			y: "10192491572172107534499380758938638246176330640200462756986199646106807685576",
+		},
+	}
+
+	for _, v := range vectors {
+		var u, x, y fp.Element
+		u.SetString(v.u)
+		x.SetString(v.x)
+		y.SetString(v.y)
+		g := MapToCurveG1Sswu(u)
+		if !g.X.Equal(&x) || !g.Y.Equal(&y) {
+			t.Fatalf("[PALLAS] wrong MapToCurveG1Sswu(%s)", v.u)
+		}
+	}
+}
+
+// regression vectors of RFC 9380 hash_to_curve (_RO_) and encode_to_curve (_NU_) with expand_message_xmd(SHA-256),
+// produced by this implementation; pasta_curves hashes to the field with BLAKE2b, so its outputs differ
+func TestHashToCurveG1SswuRegression(t *testing.T) {
+
+	vectors := []struct {
+		dst, msg, x, y string
+	}{
+		{
+			dst: "QUUX-V01-CS02-with-PALLAS_XMD:SHA-256_SSWU_RO_",
+			msg: "",
+			x:   "18129297499670634039492469836433583703160309183039165718343443373856423206770",
+			y:   "23178831021492991060168727939923025350927370729592858410742852431530579717024",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-PALLAS_XMD:SHA-256_SSWU_RO_",
+			msg: "abc",
+			x:   "25184861894905024950205497346797499190146225010566361628019317816200881851195",
+			y:   "11388832320908150077111499896743917459343140439489945327087698322526847109436",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-PALLAS_XMD:SHA-256_SSWU_RO_",
+			msg: "abcdef0123456789",
+			x:   "7963260742363246031794527459300207687489638237837577647293269281352758719895",
+			y:   "2495512797179705919390629402688149384259147547290249331883509097031162890363",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-PALLAS_XMD:SHA-256_SSWU_RO_",
+			msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+			x:   "15075948406651144482309157132246756046426184320650595694734717855482960258777",
+			y:   "13697642562218119673486355822242372596508920983244109274372491939097668013738",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-PALLAS_XMD:SHA-256_SSWU_NU_",
+			msg: "",
+			x:   "9885827467506445945300154390395196097438738102655878018611895531325246137010",
+			y:   "19231485715484570640234805364990480876963957256525419491516290023013119396474",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-PALLAS_XMD:SHA-256_SSWU_NU_",
+			msg: "abc",
+			x:   "18771606807392276695743694643176962466904299034043056451162042563774708208762",
+			y:   "21933319092932272026549586837545189000604054133452154024458382871911529031989",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-PALLAS_XMD:SHA-256_SSWU_NU_",
+			msg: "abcdef0123456789",
+			x:   "9730327552627620447913270874150691915681856218454416600418947900853935236238",
+			y:   "4334248273170132160280140141284241558941668064010875951643724877034693628052",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-PALLAS_XMD:SHA-256_SSWU_NU_",
+			msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+			x:   "9812096479099650098474186343690508112298288794839150390453639540063274944097",
+			y:   "19820285126128601866409575467043679792499842484595106096187175702694573062761",
+		},
+	}
+
+	for _, v := range vectors {
+		var g G1Affine
+		var err error
+		if strings.HasSuffix(v.dst, "_RO_") {
+			g, err = HashToCurveG1Sswu([]byte(v.msg), []byte(v.dst))
+		} else {
+			g, err = EncodeToCurveG1Sswu([]byte(v.msg), []byte(v.dst))
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var x, y fp.Element
+		x.SetString(v.x)
+		y.SetString(v.y)
+		if !g.X.Equal(&x) || !g.Y.Equal(&y) {
+			t.Fatalf("wrong point for %q with %s", v.msg, v.dst)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ecdsa provides ECDSA signature scheme on the secp256k1 curve.
+//
+// The signatures are normalized to a low s (s <= r/2, where r is the order of the
+// curve), and Sign can also return the recovery identifier v needed to recover
+// the public key from a signature, as done in Ethereum.
+//
+// # See also
+//
+// https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm
+//
+// https://www.secg.org/sec1-v2.pdf
+package ecdsa
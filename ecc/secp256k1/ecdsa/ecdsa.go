@@ -0,0 +1,381 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecdsa
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+var (
+	errNotOnCurve        = errors.New("point not on curve")
+	errInvalidSignature  = errors.New("invalid signature")
+	errInvalidRecoveryID = errors.New("invalid recovery identifier")
+)
+
+const (
+	sizeFr         = fr.Bytes
+	sizePublicKey  = secp256k1.SizeOfG1AffineCompressed
+	sizePrivateKey = sizePublicKey + sizeFr
+	sizeSignature  = 2 * sizeFr
+)
+
+// PublicKey ecdsa public key
+type PublicKey struct {
+	A secp256k1.G1Affine
+}
+
+// PrivateKey private key of an ecdsa instance
+type PrivateKey struct {
+	PublicKey PublicKey    // copy of the associated public key
+	scalar    [sizeFr]byte // secret scalar, in big Endian
+}
+
+// Signature represents an ecdsa signature (r, s)
+// cf https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm for notation
+type Signature struct {
+	R, S [sizeFr]byte
+}
+
+func init() {
+	signature.Register(signature.ECDSA_SECP256K1, GenerateKeyInterfaces)
+}
+
+// GenerateKey generates a public and private key pair.
+func GenerateKey(r io.Reader) (PrivateKey, error) {
+
+	var priv PrivateKey
+
+	// sample 64 more bits than needed so that the reduction bias is negligible
+	var seed [sizeFr + 8]byte
+	if _, err := io.ReadFull(r, seed[:]); err != nil {
+		return priv, err
+	}
+
+	// scalar = seed mod (r-1) + 1, in [1, r-1]
+	var scalar, one, orderMinusOne big.Int
+	one.SetUint64(1)
+	orderMinusOne.Sub(fr.Modulus(), &one)
+	scalar.SetBytes(seed[:]).
+		Mod(&scalar, &orderMinusOne).
+		Add(&scalar, &one)
+	scalar.FillBytes(priv.scalar[:])
+
+	_, g := secp256k1.Generators()
+	priv.PublicKey.A.ScalarMultiplication(&g, &scalar)
+
+	return priv, nil
+}
+
+// GenerateKeyInterfaces generate interfaces for the public/private key.
+// This purpose of this function is to be registered in the list of signature schemes.
+func GenerateKeyInterfaces(r io.Reader) (signature.Signer, error) {
+	priv, err := GenerateKey(r)
+	return &priv, err
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(other signature.PublicKey) bool {
+	bpk := pub.Bytes()
+	bother := other.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bother) == 1
+}
+
+// Public returns the public key associated to the private key.
+// From Signer interface defined in gnark/crypto/signature.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Sign signs a message. If hFunc is nil, the message is considered to be
+// already hashed.
+// The returned signature is r||s, with s <= r/2.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	sig, _, err := privKey.sign(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	return sig.Bytes(), nil
+}
+
+// SignForRecover signs a message as Sign, and also returns the recovery
+// identifier v, such that the public key can be recovered from (v, r, s) and
+// the message (see PublicKey.RecoverFrom).
+// The lowest bit of v is the parity of the y coordinate of k*G, the second bit
+// is set if its x coordinate was larger than the order of the curve.
+func (privKey *PrivateKey) SignForRecover(message []byte, hFunc hash.Hash) (v uint, r, s *big.Int, err error) {
+	sig, v, err := privKey.sign(message, hFunc)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	r = new(big.Int).SetBytes(sig.R[:])
+	s = new(big.Int).SetBytes(sig.S[:])
+	return v, r, s, nil
+}
+
+func (privKey *PrivateKey) sign(message []byte, hFunc hash.Hash) (sig Signature, v uint, err error) {
+
+	e, err := hashToInt(message, hFunc)
+	if err != nil {
+		return
+	}
+
+	var d big.Int
+	d.SetBytes(privKey.scalar[:])
+
+	for {
+		var k *big.Int
+		k, err = privKey.nonce(e)
+		if err != nil {
+			return
+		}
+
+		var ok bool
+		if sig, v, ok = signWithNonce(e, &d, k); ok {
+			return
+		}
+	}
+}
+
+// signWithNonce signs the hashed message e with the private scalar d and the nonce k.
+// It returns false if k yields r == 0 or s == 0, in which case a new nonce must be drawn.
+func signWithNonce(e, d, k *big.Int) (sig Signature, v uint, ok bool) {
+
+	order := fr.Modulus()
+	var halfOrder big.Int
+	halfOrder.Rsh(order, 1)
+
+	_, g := secp256k1.Generators()
+
+	// R = k*G, r = R.x mod order
+	var R secp256k1.G1Affine
+	var r, s, rx, ry, kInv big.Int
+	R.ScalarMultiplication(&g, k)
+	R.X.ToBigIntRegular(&rx)
+	R.Y.ToBigIntRegular(&ry)
+	r.Mod(&rx, order)
+	if r.Sign() == 0 {
+		return
+	}
+	v = ry.Bit(0)
+	if rx.Cmp(order) >= 0 {
+		v |= 2
+	}
+
+	// s = k**-1 * (e + r*d) mod order
+	kInv.ModInverse(k, order)
+	s.Mul(&r, d).
+		Add(&s, e).
+		Mul(&s, &kInv).
+		Mod(&s, order)
+	if s.Sign() == 0 {
+		return
+	}
+
+	// (r, -s) is also a valid signature, corresponding to -R.
+	// We return the smallest s so that signatures are not malleable.
+	if s.Cmp(&halfOrder) > 0 {
+		s.Sub(order, &s)
+		v ^= 1
+	}
+
+	r.FillBytes(sig.R[:])
+	s.FillBytes(sig.S[:])
+	return sig, v, true
+}
+
+// Verify verifies an ecdsa signature. If hFunc is nil, the message is considered
+// to be already hashed.
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+
+	order := fr.Modulus()
+
+	if !pub.A.IsOnCurve() || pub.A.IsInfinity() {
+		return false, errNotOnCurve
+	}
+
+	// Deserialize the signature
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	var r, s big.Int
+	r.SetBytes(sig.R[:])
+	s.SetBytes(sig.S[:])
+	if r.Sign() == 0 || r.Cmp(order) >= 0 || s.Sign() == 0 || s.Cmp(order) >= 0 {
+		return false, nil
+	}
+
+	e, err := hashToInt(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+
+	// u1 = e/s, u2 = r/s
+	var w, u1, u2 big.Int
+	w.ModInverse(&s, order)
+	u1.Mul(e, &w).Mod(&u1, order)
+	u2.Mul(&r, &w).Mod(&u2, order)
+
+	// R = u1*G + u2*A
+	var R, A secp256k1.G1Jac
+	g, _ := secp256k1.Generators()
+	A.FromAffine(&pub.A)
+	R.ScalarMultiplication(&g, &u1)
+	A.ScalarMultiplication(&A, &u2)
+	R.AddAssign(&A)
+
+	var RAff secp256k1.G1Affine
+	RAff.FromJacobian(&R)
+	if RAff.IsInfinity() {
+		return false, nil
+	}
+
+	// R.x mod order == r
+	var rx big.Int
+	RAff.X.ToBigIntRegular(&rx)
+	rx.Mod(&rx, order)
+
+	return rx.Cmp(&r) == 0, nil
+}
+
+// RecoverFrom sets pub to the public key that produced the signature (v, r, s)
+// on message, as returned by SignForRecover. If hFunc is nil, the message is
+// considered to be already hashed.
+// cf https://www.secg.org/sec1-v2.pdf section 4.1.6
+func (pub *PublicKey) RecoverFrom(message []byte, v uint, r, s *big.Int, hFunc hash.Hash) error {
+
+	order := fr.Modulus()
+
+	if v > 3 {
+		return errInvalidRecoveryID
+	}
+	if r.Sign() <= 0 || r.Cmp(order) >= 0 || s.Sign() <= 0 || s.Cmp(order) >= 0 {
+		return errInvalidSignature
+	}
+
+	// x coordinate of R = k*G
+	var x big.Int
+	x.Set(r)
+	if v&2 != 0 {
+		x.Add(&x, order)
+	}
+	if x.Cmp(fp.Modulus()) >= 0 {
+		return errInvalidSignature
+	}
+
+	// solve the curve equation y**2 = x**3 + 7 for y, and choose its parity from v
+	var R secp256k1.G1Affine
+	var b fp.Element
+	b.SetUint64(7)
+	R.X.SetBigInt(&x)
+	R.Y.Square(&R.X).
+		Mul(&R.Y, &R.X).
+		Add(&R.Y, &b)
+	if R.Y.Sqrt(&R.Y) == nil {
+		return errNotOnCurve
+	}
+	var y big.Int
+	R.Y.ToBigIntRegular(&y)
+	if y.Bit(0) != v&1 {
+		R.Y.Neg(&R.Y)
+	}
+
+	e, err := hashToInt(message, hFunc)
+	if err != nil {
+		return err
+	}
+
+	// A = r**-1 * (s*R - e*G)
+	var rInv, u1, u2 big.Int
+	rInv.ModInverse(r, order)
+	u1.Mul(e, &rInv).
+		Neg(&u1).
+		Mod(&u1, order)
+	u2.Mul(s, &rInv).Mod(&u2, order)
+
+	var A, RJac secp256k1.G1Jac
+	g, _ := secp256k1.Generators()
+	RJac.FromAffine(&R)
+	A.ScalarMultiplication(&g, &u1)
+	RJac.ScalarMultiplication(&RJac, &u2)
+	A.AddAssign(&RJac)
+
+	pub.A.FromJacobian(&A)
+	if pub.A.IsInfinity() {
+		return errInvalidSignature
+	}
+
+	return nil
+}
+
+// hashToInt hashes message with hFunc (unless hFunc is nil) and converts
+// the leftmost bits of the digest to an integer modulo the order of the curve
+func hashToInt(message []byte, hFunc hash.Hash) (*big.Int, error) {
+	digest := message
+	if hFunc != nil {
+		hFunc.Reset()
+		if _, err := hFunc.Write(message); err != nil {
+			return nil, err
+		}
+		digest = hFunc.Sum(nil)
+	}
+
+	// the order of the curve is exactly sizeFr bytes long
+	if len(digest) > sizeFr {
+		digest = digest[:sizeFr]
+	}
+
+	e := new(big.Int).SetBytes(digest)
+	return e.Mod(e, fr.Modulus()), nil
+}
+
+// nonce returns a non-zero scalar derived from the private key, the hashed message and
+// fresh randomness (hedged nonce), so that a weak random source doesn't leak the private key
+func (privKey *PrivateKey) nonce(e *big.Int) (*big.Int, error) {
+	var entropy [32]byte
+	if _, err := io.ReadFull(rand.Reader, entropy[:]); err != nil {
+		return nil, err
+	}
+
+	var eBin [sizeFr]byte
+	e.FillBytes(eBin[:])
+
+	h := sha512.New()
+	h.Write(privKey.scalar[:])
+	h.Write(entropy[:])
+	h.Write(eBin[:])
+
+	// 512 bits reduced modulo a 256-bit order, the bias is negligible
+	order := fr.Modulus()
+	k := new(big.Int).SetBytes(h.Sum(nil))
+	k.Mod(k, order)
+	if k.Sign() == 0 {
+		return privKey.nonce(e)
+	}
+	return k, nil
+}
@@ -0,0 +1,365 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecdsa
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	crand "crypto/rand"
+
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+func Example() {
+	// instantiate hash function
+	hFunc := sha256.New()
+
+	// create a ecdsa key pair
+	privateKey, _ := signature.ECDSA_SECP256K1.New(crand.Reader)
+	publicKey := privateKey.Public()
+
+	// note that the message is on 4 bytes
+	msg := []byte{0xde, 0xad, 0xf0, 0x0d}
+
+	// sign the message
+	signature, _ := privateKey.Sign(msg, hFunc)
+
+	// verifies signature
+	isValid, _ := publicKey.Verify(signature, msg, hFunc)
+	if !isValid {
+		fmt.Println("1. invalid signature")
+	} else {
+		fmt.Println("1. valid signature")
+	}
+
+	// Output: 1. valid signature
+}
+
+func TestSerialization(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	privKey1, err := signature.ECDSA_SECP256K1.New(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey1 := privKey1.Public()
+
+	privKey2, err := signature.ECDSA_SECP256K1.New(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey2 := privKey2.Public()
+
+	pubKeyBin1 := pubKey1.Bytes()
+	if _, err := pubKey2.SetBytes(pubKeyBin1); err != nil {
+		t.Fatal(err)
+	}
+	pubKeyBin2 := pubKey2.Bytes()
+	if len(pubKeyBin1) != len(pubKeyBin2) {
+		t.Fatal("Inconistent size")
+	}
+	for i := 0; i < len(pubKeyBin1); i++ {
+		if pubKeyBin1[i] != pubKeyBin2[i] {
+			t.Fatal("Error serialize(deserialize(.))")
+		}
+	}
+
+	privKeyBin1 := privKey1.Bytes()
+	if _, err := privKey2.SetBytes(privKeyBin1); err != nil {
+		t.Fatal(err)
+	}
+	privKeyBin2 := privKey2.Bytes()
+	if len(privKeyBin1) != len(privKeyBin2) {
+		t.Fatal("Inconistent size")
+	}
+	for i := 0; i < len(privKeyBin1); i++ {
+		if privKeyBin1[i] != privKeyBin2[i] {
+			t.Fatal("Error serialize(deserialize(.))")
+		}
+	}
+}
+
+func TestEcdsaSHA256(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	hFunc := sha256.New()
+
+	// create ecdsa obj and sign a message
+	privKey, err := signature.ECDSA_SECP256K1.New(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.Public()
+
+	signature, err := privKey.Sign([]byte("message"), hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// verifies correct msg
+	res, err := pubKey.Verify(signature, []byte("message"), hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res {
+		t.Fatal("Verifiy correct signature should return true")
+	}
+
+	// verifies wrong msg
+	res, err = pubKey.Verify(signature, []byte("wrong_message"), hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res {
+		t.Fatal("Verfiy wrong signature should be false")
+	}
+
+}
+
+func TestEcdsaPreHashed(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte("message"))
+	signature, err := privKey.Sign(digest[:], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// hashing the message in Verify must give the same result
+	res, err := privKey.PublicKey.Verify(signature, []byte("message"), sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res {
+		t.Fatal("Verifiy correct signature should return true")
+	}
+}
+
+func TestEcdsaRecover(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	hFunc := sha256.New()
+	var halfOrder big.Int
+	halfOrder.Rsh(fr.Modulus(), 1)
+
+	for i := 0; i < 10; i++ {
+		privKey, err := GenerateKey(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := []byte(fmt.Sprintf("message %d", i))
+
+		v, sigR, sigS, err := privKey.SignForRecover(msg, hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sigS.Cmp(&halfOrder) > 0 {
+			t.Fatal("s should be normalized to the lower half of the scalar field")
+		}
+
+		var pub PublicKey
+		if err := pub.RecoverFrom(msg, v, sigR, sigS, hFunc); err != nil {
+			t.Fatal(err)
+		}
+		if !pub.Equal(&privKey.PublicKey) {
+			t.Fatal("recovered public key doesn't match")
+		}
+
+		// a wrong recovery identifier gives another key
+		if err := pub.RecoverFrom(msg, v^1, sigR, sigS, hFunc); err == nil && pub.Equal(&privKey.PublicKey) {
+			t.Fatal("recovered public key with a wrong identifier should not match")
+		}
+	}
+}
+
+// RFC 6979 deterministic signatures on secp256k1 with SHA-256, as used in the test suites of
+// bitcoin libraries; the nonces are the ones derived by RFC 6979 from the key and the message
+func TestEcdsaKnownAnswer(t *testing.T) {
+
+	vectors := []struct {
+		d, msg, k, r, s string
+		v               uint
+	}{
+		{
+			d:   "1",
+			msg: "Satoshi Nakamoto",
+			k:   "8f8a276c19f4149656b280621e358cce24f5f52542772691ee69063b74f15d15",
+			r:   "934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d8",
+			s:   "2442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5",
+			v:   1,
+		},
+		{
+			d:   "1",
+			msg: "All those moments will be lost in time, like tears in rain. Time to die...",
+			k:   "38aa22d72376b4dbc472e06c3ba403ee0a394da63fc58d88686c611aba98d6b3",
+			r:   "8600dbd41e348fe5c9465ab92d23e3db8b98b873beecd930736488696438cb6b",
+			s:   "547fe64427496db33bf66019dacbf0039c04199abb0122918601db38a72cfc21",
+			v:   0,
+		},
+	}
+
+	hFunc := sha256.New()
+	for _, vector := range vectors {
+		privKey := privateKeyFromHex(t, vector.d)
+		e, err := hashToInt([]byte(vector.msg), hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sig, v, ok := signWithNonce(e, hexToInt(t, vector.d), hexToInt(t, vector.k))
+		if !ok {
+			t.Fatal("signing with a valid nonce should succeed")
+		}
+		var expected Signature
+		hexToInt(t, vector.r).FillBytes(expected.R[:])
+		hexToInt(t, vector.s).FillBytes(expected.S[:])
+		if sig != expected || v != vector.v {
+			t.Fatalf("wrong signature of %q", vector.msg)
+		}
+
+		res, err := privKey.PublicKey.Verify(expected.Bytes(), []byte(vector.msg), hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res {
+			t.Fatalf("signature of %q should verify", vector.msg)
+		}
+		res, err = privKey.PublicKey.Verify(expected.Bytes(), []byte("wrong_message"), hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res {
+			t.Fatal("Verfiy wrong signature should be false")
+		}
+	}
+}
+
+// transaction signature of EIP-155 (https://eips.ethereum.org/EIPS/eip-155), signed with
+// libsecp256k1: v = 37 on chain 1, that is the recovery identifier 0
+func TestEcdsaRecoverKnownAnswer(t *testing.T) {
+
+	privKey := privateKeyFromHex(t, "4646464646464646464646464646464646464646464646464646464646464646")
+	digest, _ := new(big.Int).SetString("daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53", 16)
+	r, _ := new(big.Int).SetString("18515461264373351373200002665853028612451056578545711640558177340181847433846", 10)
+	s, _ := new(big.Int).SetString("46948507304638947509940763649030358759909902576025900602547168820602576006531", 10)
+	const v = 37 - 35 - 2*1
+
+	var expected PublicKey
+	expected.A.X.SetBigInt(hexToInt(t, "4bc2a31265153f07e70e0bab08724e6b85e217f8cd628ceb62974247bb493382"))
+	expected.A.Y.SetBigInt(hexToInt(t, "ce28cab79ad7119ee1ad3ebcdb98a16805211530ecc6cfefa1b88e6dff99232a"))
+	if !expected.Equal(&privKey.PublicKey) {
+		t.Fatal("wrong public key")
+	}
+
+	var pub PublicKey
+	if err := pub.RecoverFrom(digest.Bytes(), v, r, s, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !pub.Equal(&expected) {
+		t.Fatal("recovered public key doesn't match")
+	}
+	if err := pub.RecoverFrom(digest.Bytes(), v^1, r, s, nil); err == nil && pub.Equal(&expected) {
+		t.Fatal("recovered public key with a wrong identifier should not match")
+	}
+
+	var sig Signature
+	r.FillBytes(sig.R[:])
+	s.FillBytes(sig.S[:])
+	res, err := expected.Verify(sig.Bytes(), digest.Bytes(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res {
+		t.Fatal("Verifiy correct signature should return true")
+	}
+}
+
+func hexToInt(t *testing.T, s string) *big.Int {
+	i, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		t.Fatalf("invalid hexadecimal %q", s)
+	}
+	return i
+}
+
+func privateKeyFromHex(t *testing.T, d string) PrivateKey {
+	var privKey PrivateKey
+	scalar := hexToInt(t, d)
+	scalar.FillBytes(privKey.scalar[:])
+	_, g := secp256k1.Generators()
+	privKey.PublicKey.A.ScalarMultiplication(&g, scalar)
+	return privKey
+}
+
+// benchmarks
+
+func BenchmarkSign(b *testing.B) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	hFunc := sha256.New()
+
+	privKey, err := signature.ECDSA_SECP256K1.New(r)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		privKey.Sign([]byte("message"), hFunc)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	hFunc := sha256.New()
+
+	privKey, err := signature.ECDSA_SECP256K1.New(r)
+	if err != nil {
+		b.Fatal(err)
+	}
+	pubKey := privKey.Public()
+
+	signature, _ := privKey.Sign([]byte("message"), hFunc)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pubKey.Verify(signature, []byte("message"), hFunc)
+	}
+}
@@ -0,0 +1,67 @@
+package secp256k1
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// E: y**2=x**3+7
+// Fp: p=115792089237316195423570985008687907853269984665640564039457584007908834671663
+// Fr: r=115792089237316195423570985008687907852837564279074904382605163141518161494337
+// the curve has prime order, E(Fp) is cyclic of order r
+
+// ID secp256k1 ID
+const ID = ecc.SECP256K1
+
+// bCurveCoeff b coeff of the curve
+var bCurveCoeff fp.Element
+
+// generator of the curve
+var g1Gen G1Jac
+
+var g1GenAff G1Affine
+
+// point at infinity
+var g1Infinity G1Jac
+
+// Parameters useful for the GLV scalar multiplication. The third root defines the
+// endomorphism phi for <G1Affine>. lambda is such that <r, phi-lambda> lies above
+// <r> in the ring Z[phi]. More concretely it's the associated eigenvalue
+// of phi restricted to <G1Affine>
+// cf https://www.cosic.esat.kuleuven.be/nessie/reports/phase2/GLV.pdf
+var thirdRootOneG1 fp.Element
+var lambdaGLV big.Int
+
+// glvBasis stores R-linearly independant vectors (a,b), (c,d)
+// in ker((u,v)->u+vlambda[r]), and their determinant
+var glvBasis ecc.Lattice
+
+func init() {
+
+	bCurveCoeff.SetUint64(7)
+
+	g1Gen.X.SetString("55066263022277343669578718895168534326250603453777594175500187360389116729240")
+	g1Gen.Y.SetString("32670510020758816978083085130507043184471273380659243275938904335757337482424")
+	g1Gen.Z.SetString("1")
+
+	g1GenAff.FromJacobian(&g1Gen)
+
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+
+	thirdRootOneG1.SetString("55594575648329892869085402983802832744385952214688224221778511981742606582254")
+	lambdaGLV.SetString("37718080363155996902926221483475020450927657555482586988616620542887997980018", 10)
+	_r := fr.Modulus()
+	ecc.PrecomputeLattice(_r, &lambdaGLV, &glvBasis)
+
+}
+
+// Generators return the generator of the curve
+func Generators() (g1Jac G1Jac, g1Aff G1Affine) {
+	g1Aff = g1GenAff
+	g1Jac = g1Gen
+	return
+}
@@ -134,6 +134,9 @@ func PrecomputeLattice(r, lambda *big.Int, res *Lattice) {
 // ker((a,b)->a+blambda[r]): then (u,v)=w-(s,0), and
 // u+vlambda=s[r].
 // cf https://www.iacr.org/archive/crypto2001/21390189.pdf
+//
+// The curve packages use an allocation free version of SplitScalar,
+// generated with the lattice and rounding constants of the curve.
 func SplitScalar(s *big.Int, l *Lattice) [2]big.Int {
 
 	var k1, k2 big.Int
@@ -224,7 +227,7 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 	b1 := h.Sum(nil)
 
 	res := make([]byte, lenInBytes)
-	copy(res[:h.Size()], b1)
+	copy(res, b1)
 
 	for i := 2; i <= ell; i++ {
 		// b_i = H(strxor(b_0, b_(i - 1)) || I2OSP(i, 1) || DST_prime)
@@ -246,7 +249,7 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 			return nil, err
 		}
 		b1 = h.Sum(nil)
-		copy(res[h.Size()*(i-1):h.Size()*i], b1)
+		copy(res[h.Size()*(i-1):], b1)
 	}
 	return res, nil
 }
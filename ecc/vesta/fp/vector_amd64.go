@@ -0,0 +1,223 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"math/bits"
+)
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	checkLengths("Add", len(*vector), len(a), len(b))
+	if len(a) == 0 {
+		return
+	}
+	addVec(&(*vector)[0], &a[0], &b[0], uint64(len(a)))
+}
+
+//go:noescape
+func addVec(res, a, b *Element, n uint64)
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	checkLengths("Sub", len(*vector), len(a), len(b))
+	if len(a) == 0 {
+		return
+	}
+	subVec(&(*vector)[0], &a[0], &b[0], uint64(len(a)))
+}
+
+//go:noescape
+func subVec(res, a, b *Element, n uint64)
+
+// blockSize is the number of elements processed at once by the AVX-512 IFMA kernels
+const blockSize = 8
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	checkLengths("Mul", len(*vector), len(a), len(b))
+	n := len(a)
+	if !supportAvx512 || n < blockSize {
+		mulVecGeneric(*vector, a, b)
+		return
+	}
+	nbBlocks := n / blockSize
+	mulVec(&(*vector)[0], &a[0], &b[0], uint64(nbBlocks))
+	if r := nbBlocks * blockSize; r < n {
+		mulVecGeneric((*vector)[r:], a[r:], b[r:])
+	}
+}
+
+//go:noescape
+func mulVec(res, a, b *Element, n uint64)
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *Element) {
+	checkLengths("ScalarMul", len(*vector), len(a))
+	n := len(a)
+	if !supportAvx512 || n < blockSize {
+		scalarMulVecGeneric(*vector, a, b)
+		return
+	}
+	nbBlocks := n / blockSize
+	scalarMulVec(&(*vector)[0], &a[0], b, uint64(nbBlocks))
+	if r := nbBlocks * blockSize; r < n {
+		scalarMulVecGeneric((*vector)[r:], a[r:], b)
+	}
+}
+
+//go:noescape
+func scalarMulVec(res, a, b *Element, n uint64)
+
+// MulAccumulate sets self[i] += a[i] * b[i].
+// It panics if the vectors don't have the same length.
+func (vector *Vector) MulAccumulate(a, b Vector) {
+	checkLengths("MulAccumulate", len(*vector), len(a), len(b))
+	if !supportAvx512 {
+		mulAccVecGeneric(*vector, a, b)
+		return
+	}
+	// multiply by chunks in a buffer on the stack, then add
+	const chunkSize = 32 * blockSize
+	var buf [chunkSize]Element
+	v := *vector
+	for start := 0; start < len(v); start += chunkSize {
+		end := start + chunkSize
+		if end > len(v) {
+			end = len(v)
+		}
+		t := Vector(buf[:end-start])
+		t.Mul(a[start:end], b[start:end])
+		addVec(&v[start], &v[start], &t[0], uint64(end-start))
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res Element) {
+	if !supportAvx2 {
+		sumVecGeneric(&res, *vector)
+		return
+	}
+	// sumVec accumulates the 32-bit half words without carries, which is safe
+	// up to 2**32 - 1 elements per call
+	const maxN = (1 << 32) - 1
+	v := *vector
+	for len(v) != 0 {
+		n := len(v)
+		if n > maxN {
+			n = maxN
+		}
+		var acc [8]uint64
+		sumVec(&acc, &v[0], uint64(n))
+
+		var t [6]uint64
+		for j := 0; j < len(acc); j++ {
+			addShifted(t[:], 0, acc[j], 32*j)
+		}
+		var partial Element
+		partial.setWide(t[:])
+		res.Add(&res, &partial)
+		v = v[n:]
+	}
+	return
+}
+
+//go:noescape
+func sumVec(res *[8]uint64, a *Element, n uint64)
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res Element) {
+	checkLengths("InnerProduct", len(*vector), len(other))
+	v := *vector
+	n := len(v)
+	if !supportAvx512 || n < blockSize {
+		innerProductVecGeneric(&res, v, other)
+		return
+	}
+	// innerProdVec accumulates 5 limbs of 52 bits without carries, which is safe
+	// up to 4096 blocks per call
+	const maxBlocks = 1 << 12
+	nbBlocks := n / blockSize
+	for start := 0; start < nbBlocks; start += maxBlocks {
+		end := start + maxBlocks
+		if end > nbBlocks {
+			end = nbBlocks
+		}
+		var acc [40]uint64
+		innerProdVec(&acc, &v[start*blockSize], &other[start*blockSize], uint64(end-start))
+
+		// acc[8k+j] holds the limb k of the lane j
+		var t [6]uint64
+		for k := 0; k < 5; k++ {
+			var hi, lo, c uint64
+			for j := 0; j < 8; j++ {
+				lo, c = bits.Add64(lo, acc[8*k+j], 0)
+				hi += c
+			}
+			addShifted(t[:], hi, lo, 52*k)
+		}
+		var partial Element
+		partial.setWide(t[:])
+		res.Add(&res, &partial)
+	}
+	if r := nbBlocks * blockSize; r < n {
+		innerProductVecGeneric(&res, v[r:], other[r:])
+	}
+	return
+}
+
+//go:noescape
+func innerProdVec(res *[40]uint64, a, b *Element, n uint64)
+
+// addShifted adds (hi || lo) << shift to the little endian words of t
+func addShifted(t []uint64, hi, lo uint64, shift int) {
+	w, s := shift/64, uint(shift%64)
+	v0, v1, v2 := lo<<s, hi<<s, uint64(0)
+	if s != 0 {
+		v1 |= lo >> (64 - s)
+		v2 = hi >> (64 - s)
+	}
+	var c uint64
+	t[w], c = bits.Add64(t[w], v0, 0)
+	t[w+1], c = bits.Add64(t[w+1], v1, c)
+	for i := w + 2; i < len(t); i++ {
+		t[i], c = bits.Add64(t[i], v2, c)
+		v2 = 0
+	}
+}
+
+// setWide sets z such that its montgomery representation is the integer in the
+// little endian words of t, mod q; this is how sums of montgomery representations
+// accumulated without reduction are brought back to a Element
+func (z *Element) setWide(t []uint64) {
+	// radix = 2**64
+	var radix Element
+	radix.SetUint64(1 << 63)
+	radix.Double(&radix)
+
+	z.SetZero()
+	for i := len(t) - 1; i >= 0; i-- {
+		z.Mul(z, &radix)
+		w := Element{t[i]}
+		z.Add(z, &w)
+	}
+}
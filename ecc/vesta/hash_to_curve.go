@@ -0,0 +1,325 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vesta
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/vesta/fp"
+)
+
+// hashToFp hashes msg to count prime field elements.
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5.2
+func hashToFp(msg, dst []byte, count int) ([]fp.Element, error) {
+
+	// 128 bits of security
+	// L = ceil((ceil(log2(p)) + k) / 8), where k is the security parameter = 128
+	L := 48
+
+	lenInBytes := count * L
+	pseudoRandomBytes, err := ecc.ExpandMsgXmd(msg, dst, lenInBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]fp.Element, count)
+	for i := 0; i < count; i++ {
+		res[i].SetBytes(pseudoRandomBytes[i*L : (i+1)*L])
+	}
+	return res, nil
+}
+
+// isoG1 stores the coefficients of the 3-isogeny from E': y**2=x**3+A'x+B' to E,
+// as x -> xNum(x)/xDen(x), y -> y*yNum(x)/yDen(x), where xDen and yDen are monic;
+// they are those of pasta_curves (https://github.com/zcash/pasta_curves)
+var isoG1 struct {
+	a, b fp.Element // coefficients of E'
+	z    fp.Element // non-square used by the simplified SWU map
+	xNum [4]fp.Element
+	xDen [2]fp.Element
+	yNum [4]fp.Element
+	yDen [3]fp.Element
+}
+
+func init() {
+	isoG1.a.SetString("17413348858408915339762682399132325137863850198379221683097628341577494210225")
+	isoG1.b.SetUint64(1265)
+	isoG1.z.SetUint64(13).Neg(&isoG1.z)
+	isoG1.xNum[0].SetString("22515128462811482443472135973911537638171266152621281295306466582083726737451")
+	isoG1.xNum[1].SetString("11064082577423419940183149293632076317553812518550871517841037420579891210813")
+	isoG1.xNum[2].SetString("13377367003779316331268047403600734872799183885837485433911493934102207511749")
+	isoG1.xNum[3].SetString("25731575386070265649682441113041757300767161317281464337493104665238544842753")
+	isoG1.xDen[0].SetString("9250006497141849826017568406346290940322373181457057184910582871723433210981")
+	isoG1.xDen[1].SetString("4604213796697651557841441623718706001740429044770779386484474413346415813353")
+	isoG1.yNum[0].SetString("13937936667454727226911322269564285204582212380194126516142098360337545123123")
+	isoG1.yNum[1].SetString("11620280474556824258112134491145636201000922752744881519070727793732904824884")
+	isoG1.yNum[2].SetString("21162694656554182593580396827886355918081120183889566406795618341247785229923")
+	isoG1.yNum[3].SetString("8577191795356755216560813704347252433589053772427154779164368221746181614251")
+	isoG1.yDen[0].SetString("28948022309329048855892746252171976963363056481941647379679742748393362947557")
+	isoG1.yDen[1].SetString("27750019491425549478052705219038872820967119544371171554731748615170299632943")
+	isoG1.yDen[2].SetString("21380331849711001764708535561664047484292171808126992769566582994216305194078")
+}
+
+// returns false if u>-u when seen as a bigInt
+func sign0(u fp.Element) bool {
+	var a, b big.Int
+	u.ToBigIntRegular(&a)
+	u.Neg(&u)
+	u.ToBigIntRegular(&b)
+	return a.Cmp(&b) <= 0
+}
+
+// sgn0 returns the parity of u
+// https://datatracker.ietf.org/doc/html/rfc9380#section-4.1
+func sgn0(u *fp.Element) uint64 {
+	v := *u
+	v.FromMont()
+	return v[0] & 1
+}
+
+// ----------------------------------------------------------------------------------------
+// G1Affine
+
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-4.1
+// Shallue and van de Woestijne method, works for any elliptic curve in Weierstrass curve
+func svdwMapG1(u fp.Element) G1Affine {
+
+	var res G1Affine
+
+	// constants
+	// sage script to find z: https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#appendix-E.1
+	var z, c1, c2, c3, c4 fp.Element
+	z.SetOne()
+	c1.SetString("6")
+	c2.SetString("14474011154664524427946373126085988481681528240970823689839871374196681474048")
+	c3.SetString("22058986881797234787318281584519821686177467010939559888476455170070973936824")
+	c4.SetString("28948022309329048855892746252171976963363056481941647379679742748393362948089")
+
+	var tv1, tv2, tv3, tv4, one, x1, gx1, x2, gx2, x3, x, gx, y fp.Element
+	one.SetOne()
+	tv1.Square(&u).Mul(&tv1, &c1)
+	tv2.Add(&one, &tv1)
+	tv1.Sub(&one, &tv1)
+	tv3.Mul(&tv2, &tv1).Inverse(&tv3)
+	tv4.Mul(&u, &tv1)
+	tv4.Mul(&tv4, &tv3)
+	tv4.Mul(&tv4, &c3)
+	x1.Sub(&c2, &tv4)
+	gx1.Square(&x1)
+	// 12. gx1 = gx1 + A
+	gx1.Mul(&gx1, &x1)
+	gx1.Add(&gx1, &bCurveCoeff)
+	e1 := gx1.Legendre()
+	x2.Add(&c2, &tv4)
+	gx2.Square(&x2)
+	// 18. gx2 = gx2 + A
+	gx2.Mul(&gx2, &x2)
+	gx2.Add(&gx2, &bCurveCoeff)
+	e2 := gx2.Legendre() - e1 // 2 if is_square(gx2) AND NOT e1
+	x3.Square(&tv2)
+	x3.Mul(&x3, &tv3)
+	x3.Square(&x3)
+	x3.Mul(&x3, &c4)
+	x3.Add(&x3, &z)
+	if e1 == 1 {
+		x.Set(&x1)
+	} else {
+		x.Set(&x3)
+	}
+	if e2 == 2 {
+		x.Set(&x2)
+	}
+	gx.Square(&x)
+	// gx = gx + A
+	gx.Mul(&gx, &x)
+	gx.Add(&gx, &bCurveCoeff)
+	y.Sqrt(&gx)
+	e3 := sign0(u) && sign0(y)
+	if !e3 {
+		y.Neg(&y)
+	}
+	res.X.Set(&x)
+	res.Y.Set(&y)
+
+	return res
+}
+
+// MapToCurveG1Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.1
+func MapToCurveG1Svdw(t fp.Element) G1Affine {
+	res := svdwMapG1(t)
+	return res
+}
+
+// EncodeToCurveG1Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-2.2.2
+func EncodeToCurveG1Svdw(msg, dst []byte) (G1Affine, error) {
+	var res G1Affine
+	t, err := hashToFp(msg, dst, 1)
+	if err != nil {
+		return res, err
+	}
+	res = MapToCurveG1Svdw(t[0])
+	return res, nil
+}
+
+// HashToCurveG1Svdw maps an fp.Element to a point on the curve using the Shallue and van de Woestijne map
+// https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-3
+func HashToCurveG1Svdw(msg, dst []byte) (G1Affine, error) {
+	var res G1Affine
+	u, err := hashToFp(msg, dst, 2)
+	if err != nil {
+		return res, err
+	}
+	Q0 := MapToCurveG1Svdw(u[0])
+	Q1 := MapToCurveG1Svdw(u[1])
+	var _Q0, _Q1, _res G1Jac
+	_Q0.FromAffine(&Q0)
+	_Q1.FromAffine(&Q1)
+	_res.Set(&_Q1).AddAssign(&_Q0)
+	res.FromJacobian(&_res)
+	return res, nil
+}
+
+// https://datatracker.ietf.org/doc/html/rfc9380#section-6.6.2
+// Simplified Shallue-van de Woestijne-Ulas method, maps u to a point (x, y) on E',
+// which is 3-isogenous to E (E has A=0, so the method doesn't apply to E directly)
+func sswuMapG1(u fp.Element) (x, y fp.Element) {
+
+	var tv1, tv2, one, x1, gx1, x2, gx2 fp.Element
+	one.SetOne()
+
+	// tv1 = Z**2*u**4 + Z*u**2
+	tv2.Square(&u).Mul(&tv2, &isoG1.z)
+	tv1.Square(&tv2).Add(&tv1, &tv2)
+	if tv1.IsZero() {
+		// x1 = B/(Z*A)
+		x1.Mul(&isoG1.z, &isoG1.a).
+			Inverse(&x1).
+			Mul(&x1, &isoG1.b)
+	} else {
+		// x1 = (-B/A)*(1+1/tv1)
+		tv1.Inverse(&tv1).Add(&tv1, &one)
+		x1.Inverse(&isoG1.a).
+			Mul(&x1, &isoG1.b).
+			Neg(&x1).
+			Mul(&x1, &tv1)
+	}
+	// gx1 = x1**3 + A*x1 + B
+	gx1.Square(&x1).
+		Add(&gx1, &isoG1.a).
+		Mul(&gx1, &x1).
+		Add(&gx1, &isoG1.b)
+
+	// x2 = Z*u**2*x1, gx2 = x2**3 + A*x2 + B
+	x2.Mul(&tv2, &x1)
+	gx2.Square(&x2).
+		Add(&gx2, &isoG1.a).
+		Mul(&gx2, &x2).
+		Add(&gx2, &isoG1.b)
+
+	if gx1.Legendre() != -1 {
+		x.Set(&x1)
+		y.Sqrt(&gx1)
+	} else {
+		x.Set(&x2)
+		y.Sqrt(&gx2)
+	}
+	if sgn0(&u) != sgn0(&y) {
+		y.Neg(&y)
+	}
+
+	return
+}
+
+// isogenyG1 maps a point (x, y) of E' to E
+func isogenyG1(x, y *fp.Element) G1Affine {
+
+	var res G1Affine
+	var xNum, xDen, yNum, yDen fp.Element
+
+	// Horner evaluation of the numerators and (monic) denominators
+	xNum.Mul(&isoG1.xNum[3], x).
+		Add(&xNum, &isoG1.xNum[2]).
+		Mul(&xNum, x).
+		Add(&xNum, &isoG1.xNum[1]).
+		Mul(&xNum, x).
+		Add(&xNum, &isoG1.xNum[0])
+	xDen.Add(x, &isoG1.xDen[1]).
+		Mul(&xDen, x).
+		Add(&xDen, &isoG1.xDen[0])
+	yNum.Mul(&isoG1.yNum[3], x).
+		Add(&yNum, &isoG1.yNum[2]).
+		Mul(&yNum, x).
+		Add(&yNum, &isoG1.yNum[1]).
+		Mul(&yNum, x).
+		Add(&yNum, &isoG1.yNum[0])
+	yDen.Add(x, &isoG1.yDen[2]).
+		Mul(&yDen, x).
+		Add(&yDen, &isoG1.yDen[1]).
+		Mul(&yDen, x).
+		Add(&yDen, &isoG1.yDen[0])
+
+	// the denominators vanish on the kernel of the isogeny only, which is mapped to infinity
+	if xDen.IsZero() || yDen.IsZero() {
+		return res
+	}
+
+	res.X.Div(&xNum, &xDen)
+	res.Y.Div(&yNum, &yDen).Mul(&res.Y, y)
+
+	return res
+}
+
+// MapToCurveG1Sswu maps an fp.Element to a point on the curve using the simplified
+// Shallue-van de Woestijne-Ulas map on a 3-isogenous curve, followed by the isogeny
+// https://datatracker.ietf.org/doc/html/rfc9380#section-6.6.3
+func MapToCurveG1Sswu(t fp.Element) G1Affine {
+	x, y := sswuMapG1(t)
+	return isogenyG1(&x, &y)
+}
+
+// EncodeToCurveG1Sswu hashes msg to a point on the curve using the simplified
+// Shallue-van de Woestijne-Ulas map (non uniform encoding)
+// https://datatracker.ietf.org/doc/html/rfc9380#section-3
+func EncodeToCurveG1Sswu(msg, dst []byte) (G1Affine, error) {
+	var res G1Affine
+	t, err := hashToFp(msg, dst, 1)
+	if err != nil {
+		return res, err
+	}
+	res = MapToCurveG1Sswu(t[0])
+	return res, nil
+}
+
+// HashToCurveG1Sswu hashes msg to a point on the curve using the simplified
+// Shallue-van de Woestijne-Ulas map (random oracle)
+// https://datatracker.ietf.org/doc/html/rfc9380#section-3
+func HashToCurveG1Sswu(msg, dst []byte) (G1Affine, error) {
+	var res G1Affine
+	u, err := hashToFp(msg, dst, 2)
+	if err != nil {
+		return res, err
+	}
+	Q0 := MapToCurveG1Sswu(u[0])
+	Q1 := MapToCurveG1Sswu(u[1])
+	var _Q0, _Q1, _res G1Jac
+	_Q0.FromAffine(&Q0)
+	_Q1.FromAffine(&Q1)
+	_res.Set(&_Q1).AddAssign(&_Q0)
+	res.FromJacobian(&_res)
+	return res, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vesta
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/vesta/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMapToCurveG1Sswu(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 10
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[VESTA] sswu should output a point on the isogenous curve", prop.ForAll(
+		func(a fp.Element) bool {
+			x, y := sswuMapG1(a)
+			var lhs, rhs fp.Element
+			lhs.Square(&y)
+			rhs.Square(&x).
+				Add(&rhs, &isoG1.a).
+				Mul(&rhs, &x).
+				Add(&rhs, &isoG1.b)
+			return lhs.Equal(&rhs)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[VESTA] MapToCurveG1Sswu should output a point on the curve", prop.ForAll(
+		func(a fp.Element) bool {
+			g := MapToCurveG1Sswu(a)
+			return g.IsInSubGroup()
+		},
+		GenFp(),
+	))
+
+	properties.Property("[VESTA] HashToCurveG1Sswu should output a point on the curve", prop.ForAll(
+		func(a fp.Element) bool {
+			b := a.Bytes()
+			g, err := HashToCurveG1Sswu(b[:], []byte("QUUX-V01-CS02-with-VESTA_XMD:SHA-256_SSWU_RO_"))
+			return err == nil && g.IsInSubGroup()
+		},
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// regression vectors of MapToCurveG1Sswu, produced by this implementation: they pin the isogeny
+// and the sign of y (the parity of u, as in RFC 9380), but they are not test vectors of pasta_curves
+func TestMapToCurveG1SswuRegression(t *testing.T) {
+
+	vectors := []struct {
+		u, x, y string
+	}{
+		{
+			u: "0",
+			x: "1342421292546696445152146458844712680550581799189796045684773018906630410550",
+			y: "20862333249151173891223692183240358031427448260645396869029634873678366712631",
+		},
+		{
+			u: "1",
+			x: "24828921502218716184080059557607987242388401572472105681456008330559554877993",
+			y: "8572286863198885661233128575102668312684304062826369700031049683463144346731",
+		},
+		{
+			u: "2",
+			x: "26899281684694246650449016261264073355724984962681583991052220974876921251080",
+			y: "26639225546603223824983370382798238449518234075964033069207377178736169293146",
+		},
+		{
+			u: "3",
+			x: "5675722731265415422423310470250484902210944598982651346074321015314166630094",
+			y: "13098164914166595530475426165638220423277928990080603313785194185323267646406",
+		},
+		{
+			u: "7",
+			x: "28502483328516177888655045244924609492300689382049423646727213051232919203796",
+			y: "3293750245639959888925773857178753917085199846678760552507755131765666043279",
+		},
+		{
+			u: "14474011154664524427946373126085988481681528240970823689839871374196681474048",
+			x: "17881641119807855273838313028298638097332945750091601704360968546978165282338",
+			y: "28416141564392455801354978736446605963134975218555143863122372526759995608017",
+		},
+		{
+			u: "28948022309329048855892746252171976963363056481941647379679742748393362948096",
+			x: "24828921502218716184080059557607987242388401572472105681456008330559554877993",
+			y: "20375735446130163194659617677069308650678752419115277679648693064930218601366",
+		},
+		{
+			u: "11920928955078125",
+			x: "12960670835528504679190579919445153750187767495403960506678879188483003727722",
+			y: "18961907047352370581063947790258035482988608120672617379219788448769589222188",
+		},
+	}
+
+	for _, v := range vectors {
+		var u, x, y fp.Element
+		u.SetString(v.u)
+		x.SetString(v.x)
+		y.SetString(v.y)
+		g := MapToCurveG1Sswu(u)
+		if !g.X.Equal(&x) || !g.Y.Equal(&y) {
+			t.Fatalf("[VESTA] wrong MapToCurveG1Sswu(%s)", v.u)
+		}
+	}
+}
+
+// regression vectors of RFC 9380 hash_to_curve (_RO_) and encode_to_curve (_NU_) with expand_message_xmd(SHA-256),
+// produced by this implementation; pasta_curves hashes to the field with BLAKE2b, so its outputs differ
+func TestHashToCurveG1SswuRegression(t *testing.T) {
+
+	vectors := []struct {
+		dst, msg, x, y string
+	}{
+		{
+			dst: "QUUX-V01-CS02-with-VESTA_XMD:SHA-256_SSWU_RO_",
+			msg: "",
+			x:   "8369411242720721290013972970258881511843873526348416321208355088133077061147",
+			y:   "22920276787981971506856602955235520833773664669652865393250673121696102543750",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-VESTA_XMD:SHA-256_SSWU_RO_",
+			msg: "abc",
+			x:   "11871439936897017102018916770870851896330390795105857449356518594328550688164",
+			y:   "641447626988097102917096809023592543469917679444221474308787202511379185352",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-VESTA_XMD:SHA-256_SSWU_RO_",
+			msg: "abcdef0123456789",
+			x:   "22877759762243212944872973665256013337425285540747534911907405880097896706247",
+			y:   "491949937048456071471912325392326326960838389590292262168719126612503053928",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-VESTA_XMD:SHA-256_SSWU_RO_",
+			msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+			x:   "18939116934833153037148224698597819583675188553883252949393470407438857957658",
+			y:   "21467060402018589237421545921795552067438367413688573882674070399251307367157",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-VESTA_XMD:SHA-256_SSWU_NU_",
+			msg: "",
+			x:   "2447259478321963405187655465705917449795736422914368614719172287801243081019",
+			y:   "4459027263015082647901491865736053391675082724184835398281524914739175289185",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-VESTA_XMD:SHA-256_SSWU_NU_",
+			msg: "abc",
+			x:   "6215978659339063324356554349878725851141878667951787972243244222131485452951",
+			y:   "28679186436173133981031611854674028264112780093211034255145020636141233987534",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-VESTA_XMD:SHA-256_SSWU_NU_",
+			msg: "abcdef0123456789",
+			x:   "13058581502343746950003802080763717383131333329135135311793704276110727439088",
+			y:   "18285511835102124418390045128772734627642286869114007244355754158461561757213",
+		},
+		{
+			dst: "QUUX-V01-CS02-with-VESTA_XMD:SHA-256_SSWU_NU_",
+			msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+			x:   "7525940716487608219757433184511365082441370787040942414105164906580222654810",
+			y:   "8129601556937361026192829625737721290337405413180153499788624233452322972877",
+		},
+	}
+
+	for _, v := range vectors {
+		var g G1Affine
+		var err error
+		if strings.HasSuffix(v.dst, "_RO_") {
+			g, err = HashToCurveG1Sswu([]byte(v.msg), []byte(v.dst))
+		} else {
+			g, err = EncodeToCurveG1Sswu([]byte(v.msg), []byte(v.dst))
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var x, y fp.Element
+		x.SetString(v.x)
+		y.SetString(v.y)
+		if !g.X.Equal(&x) || !g.Y.Equal(&y) {
+			t.Fatalf("wrong point for %q with %s", v.msg, v.dst)
+		}
+	}
+}
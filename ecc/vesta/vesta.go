@@ -0,0 +1,68 @@
+package vesta
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/vesta/fp"
+	"github.com/consensys/gnark-crypto/ecc/vesta/fr"
+)
+
+// E: y**2=x**3+5
+// Fp: p=28948022309329048855892746252171976963363056481941647379679742748393362948097
+// Fr: r=28948022309329048855892746252171976963363056481941560715954676764349967630337
+// the curve has prime order, E(Fp) is cyclic of order r
+// vesta and pallas form a cycle: the base field of one is the scalar field of the other
+
+// ID vesta ID
+const ID = ecc.VESTA
+
+// bCurveCoeff b coeff of the curve
+var bCurveCoeff fp.Element
+
+// generator of the curve
+var g1Gen G1Jac
+
+var g1GenAff G1Affine
+
+// point at infinity
+var g1Infinity G1Jac
+
+// Parameters useful for the GLV scalar multiplication. The third root defines the
+// endomorphism phi for <G1Affine>. lambda is such that <r, phi-lambda> lies above
+// <r> in the ring Z[phi]. More concretely it's the associated eigenvalue
+// of phi restricted to <G1Affine>
+// cf https://www.cosic.esat.kuleuven.be/nessie/reports/phase2/GLV.pdf
+var thirdRootOneG1 fp.Element
+var lambdaGLV big.Int
+
+// glvBasis stores R-linearly independant vectors (a,b), (c,d)
+// in ker((u,v)->u+vlambda[r]), and their determinant
+var glvBasis ecc.Lattice
+
+func init() {
+
+	bCurveCoeff.SetUint64(5)
+
+	g1Gen.X.SetString("28948022309329048855892746252171976963363056481941647379679742748393362948096")
+	g1Gen.Y.SetString("2")
+	g1Gen.Z.SetString("1")
+
+	g1GenAff.FromJacobian(&g1Gen)
+
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+
+	thirdRootOneG1.SetString("26005156700822196841419187675678338661165322343552424574062261873906994770353")
+	lambdaGLV.SetString("20444556541222657078399132219657928148671392403212669005631716460534733845831", 10)
+	_r := fr.Modulus()
+	ecc.PrecomputeLattice(_r, &lambdaGLV, &glvBasis)
+
+}
+
+// Generators return the generator of the curve
+func Generators() (g1Jac G1Jac, g1Aff G1Affine) {
+	g1Aff = g1GenAff
+	g1Jac = g1Gen
+	return
+}
@@ -0,0 +1,521 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amd64
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/bavard/amd64"
+	"github.com/consensys/gnark-crypto/field"
+)
+
+// the AVX-512 IFMA kernels process 8 elements at a time, one per 64-bit lane, with the
+// elements represented in radix 2**52 on 5 limbs; that is only implemented for 4-word moduli
+const (
+	nbLanes    = 8
+	nbLimbs52  = 5
+	vecIdxName = "vecIdx<>(SB)"
+)
+
+// GenerateVector generates the assembly kernels used by the Vector type (see internal/templates/element/vector*)
+func GenerateVector(w io.Writer, F *field.Field) error {
+	if F.NbWords != 4 {
+		return fmt.Errorf("amd64: vector kernels need a 4-word modulus, got %d words", F.NbWords)
+	}
+	f := NewFFAmd64(w, F)
+	f.WriteLn(bavard.Apache2Header("ConsenSys Software Inc.", 2020))
+
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("")
+
+	f.GenerateDefines()
+	f.generateVectorDefines()
+
+	f.generateAddVec()
+	f.generateSubVec()
+	f.generateSumVec()
+	f.generateMulVec(false)
+	f.generateMulVec(true)
+	f.generateInnerProdVec()
+
+	return nil
+}
+
+// vop writes a vector instruction; operands follow the Go assembler order (sources first)
+func (f *FFAmd64) vop(instruction string, operands ...interface{}) {
+	args := make([]string, len(operands))
+	for i, o := range operands {
+		args[i] = fmt.Sprint(o)
+	}
+	f.WriteLn("    " + instruction + " " + strings.Join(args, ", "))
+}
+
+func (f *FFAmd64) generateVectorDefines() {
+	// q in radix 2**52
+	var q52 [nbLimbs52]uint64
+	for i := range q52 {
+		q52[i] = f.word52(f.Q, i)
+	}
+	f.Comment("q in radix 2**52")
+	for i, w := range q52 {
+		f.WriteLn(fmt.Sprintf("DATA q52<>+%d(SB)/8, $%#016x", i*8, w))
+	}
+	f.WriteLn(fmt.Sprintf("GLOBL q52<>(SB), (RODATA+NOPTR), $%d", 8*nbLimbs52))
+	f.WriteLn("")
+
+	f.Comment("qInv52 -q**-1 mod 2**52")
+	f.WriteLn(fmt.Sprintf("DATA qInv52<>(SB)/8, $%#016x", f.QInverse[0]&mask52))
+	f.WriteLn("GLOBL qInv52<>(SB), (RODATA+NOPTR), $8")
+	f.WriteLn("")
+
+	f.WriteLn(fmt.Sprintf("DATA mask52<>(SB)/8, $%#016x", uint64(mask52)))
+	f.WriteLn("GLOBL mask52<>(SB), (RODATA+NOPTR), $8")
+	f.WriteLn(fmt.Sprintf("DATA mask48<>(SB)/8, $%#016x", uint64(mask48)))
+	f.WriteLn("GLOBL mask48<>(SB), (RODATA+NOPTR), $8")
+	f.WriteLn("")
+
+	f.Comment("vecIdx gathers the word k of 8 consecutive elements (in qwords)")
+	for i := 0; i < nbLanes; i++ {
+		f.WriteLn(fmt.Sprintf("DATA %s+%d(SB)/8, $%d", "vecIdx<>", i*8, i*f.NbWords))
+	}
+	f.WriteLn(fmt.Sprintf("GLOBL vecIdx<>(SB), (RODATA+NOPTR), $%d", 8*nbLanes))
+	f.WriteLn("")
+}
+
+const (
+	mask52 = (1 << 52) - 1
+	mask48 = (1 << 48) - 1
+)
+
+// word52 returns the limb i of w in radix 2**52
+func (f *FFAmd64) word52(w []uint64, i int) uint64 {
+	bit := 52 * i
+	word, shift := bit/64, bit%64
+	r := w[word] >> shift
+	if shift > 12 && word+1 < len(w) {
+		r |= w[word+1] << (64 - shift)
+	}
+	return r & mask52
+}
+
+// generateAddVec and generateSubVec write a scalar loop on n elements
+func (f *FFAmd64) generateAddVec() {
+	f.Comment("addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]")
+	registers := f.FnHeader("addVec", 0, 32)
+	defer f.AssertCleanStack(0, 0)
+
+	res := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	n := registers.Pop()
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+	t := registers.PopN(f.NbWords)
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Mov(a, t)
+	f.Add(b, t)
+	f.Reduce(&registers, t)
+	f.Mov(t, res)
+
+	f.advance(res, a, b)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	registers.Push(t...)
+	registers.Push(res, a, b, n)
+}
+
+func (f *FFAmd64) generateSubVec() {
+	f.Comment("subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]")
+	registers := f.FnHeader("subVec", 0, 32)
+	defer f.AssertCleanStack(0, 0)
+
+	res := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	n := registers.Pop()
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+	t := registers.PopN(f.NbWords)
+	q := registers.PopN(f.NbWords)
+	zero := registers.Pop()
+	f.XORQ(zero, zero)
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.Mov(a, t)
+	f.Sub(b, t)
+
+	// t = t + (q or 0)
+	f.Mov(f.Q, q)
+	for i := 0; i < f.NbWords; i++ {
+		f.CMOVQCC(zero, q[i])
+	}
+	f.Add(q, t)
+	f.Mov(t, res)
+
+	f.advance(res, a, b)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	registers.Push(t...)
+	registers.Push(q...)
+	registers.Push(res, a, b, n, zero)
+}
+
+// advance moves the pointers to the next element
+func (f *FFAmd64) advance(ptrs ...amd64.Register) {
+	for _, p := range ptrs {
+		f.ADDQ(fmt.Sprintf("$%d", 8*f.NbWords), p)
+	}
+}
+
+// generateSumVec writes sumVec(res *[8]uint64, a *Element, n uint64); it adds the 32-bit halves
+// of the words of the n elements at a, zero-extended to 64 bits, without carries:
+// res[j] accumulates the half-word j of all the elements, which doesn't overflow for n < 2**32
+func (f *FFAmd64) generateSumVec() {
+	f.Comment("sumVec(res *[8]uint64, a *Element, n uint64) res = sum of the 32-bit half words of a[0...n]")
+	registers := f.FnHeader("sumVec", 0, 24)
+	defer f.AssertCleanStack(0, 0)
+
+	res := registers.Pop()
+	a := registers.Pop()
+	n := registers.Pop()
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("n+16(FP)", n)
+
+	// Y0, Y1 accumulate the half words 0..3 and 4..7
+	f.vop("VPXOR", "Y0", "Y0", "Y0")
+	f.vop("VPXOR", "Y1", "Y1", "Y1")
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.vop("VPMOVZXDQ", "0("+string(a)+")", "Y2")
+	f.vop("VPMOVZXDQ", "16("+string(a)+")", "Y3")
+	f.vop("VPADDQ", "Y2", "Y0", "Y0")
+	f.vop("VPADDQ", "Y3", "Y1", "Y1")
+
+	f.advance(a)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.MOVQ("res+0(FP)", res)
+	f.vop("VMOVDQU", "Y0", "0("+string(res)+")")
+	f.vop("VMOVDQU", "Y1", "32("+string(res)+")")
+	f.vop("VZEROUPPER")
+	f.RET()
+
+	registers.Push(res, a, n)
+}
+
+// zmm register allocation shared by the IFMA kernels
+type ifmaRegisters struct {
+	a, b, t, acc                        []string
+	qInv, m52, m48, m, tmp, idx, borrow string
+}
+
+func newIfmaRegisters() ifmaRegisters {
+	z := func(from, n int) []string {
+		r := make([]string, n)
+		for i := range r {
+			r[i] = fmt.Sprintf("Z%d", from+i)
+		}
+		return r
+	}
+	return ifmaRegisters{
+		a:      z(0, nbLimbs52),
+		b:      z(5, nbLimbs52),
+		t:      z(10, 2*nbLimbs52),
+		acc:    z(20, nbLimbs52),
+		qInv:   "Z25",
+		m52:    "Z26",
+		m48:    "Z27",
+		m:      "Z28",
+		tmp:    "Z29",
+		idx:    "Z30",
+		borrow: "Z31",
+	}
+}
+
+func q52At(i int) string {
+	return fmt.Sprintf("q52<>+%d(SB)", 8*i)
+}
+
+func (f *FFAmd64) ifmaLoadConstants(z ifmaRegisters) {
+	f.vop("VPBROADCASTQ", "qInv52<>(SB)", z.qInv)
+	f.vop("VPBROADCASTQ", "mask52<>(SB)", z.m52)
+	f.vop("VPBROADCASTQ", "mask48<>(SB)", z.m48)
+	f.vop("VMOVDQU64", vecIdxName, z.idx)
+}
+
+// ifmaGather loads 8 consecutive elements at ptr in radix 2**52 in dst
+func (f *FFAmd64) ifmaGather(z ifmaRegisters, ptr amd64.Register, dst []string) {
+	for k := 0; k < f.NbWords; k++ {
+		f.vop("KXNORW", "K1", "K1", "K1")
+		f.vop("VPGATHERQQ", fmt.Sprintf("%d(%s)(%s*8)", 8*k, ptr, z.idx), "K1", dst[k])
+	}
+	f.to52(z, dst)
+}
+
+// ifmaBroadcast loads the element at ptr in radix 2**52 in all the lanes of dst
+func (f *FFAmd64) ifmaBroadcast(z ifmaRegisters, ptr amd64.Register, dst []string) {
+	for k := 0; k < f.NbWords; k++ {
+		f.vop("VPBROADCASTQ", fmt.Sprintf("%d(%s)", 8*k, ptr), dst[k])
+	}
+	f.to52(z, dst)
+}
+
+// ifmaScatter stores the 8 elements in radix 2**52 in src at ptr; src is clobbered
+func (f *FFAmd64) ifmaScatter(z ifmaRegisters, src []string, ptr amd64.Register) {
+	f.from52(z, src)
+	for k := 0; k < f.NbWords; k++ {
+		f.vop("KXNORW", "K1", "K1", "K1")
+		f.vop("VPSCATTERQQ", src[k], "K1", fmt.Sprintf("%d(%s)(%s*8)", 8*k, ptr, z.idx))
+	}
+}
+
+// to52 converts in place 4 words of 64 bits to 5 limbs of 52 bits
+func (f *FFAmd64) to52(z ifmaRegisters, w []string) {
+	f.vop("VPSRLQ", "$16", w[3], w[4])
+	for k := 3; k >= 1; k-- {
+		// w[k] = (w[k] << 12k | w[k-1] >> (64 - 12k)) & mask52
+		f.vop("VPSLLQ", fmt.Sprintf("$%d", 12*k), w[k], w[k])
+		f.vop("VPSRLQ", fmt.Sprintf("$%d", 64-12*k), w[k-1], z.tmp)
+		f.vop("VPORQ", z.tmp, w[k], w[k])
+		f.vop("VPANDQ", z.m52, w[k], w[k])
+	}
+	f.vop("VPANDQ", z.m52, w[0], w[0])
+}
+
+// from52 converts in place 5 limbs of 52 bits to 4 words of 64 bits
+func (f *FFAmd64) from52(z ifmaRegisters, w []string) {
+	for k := 0; k < 4; k++ {
+		// w[k] = w[k] >> 12k | w[k+1] << (52 - 12k)
+		if k != 0 {
+			f.vop("VPSRLQ", fmt.Sprintf("$%d", 12*k), w[k], w[k])
+		}
+		f.vop("VPSLLQ", fmt.Sprintf("$%d", 52-12*k), w[k+1], z.tmp)
+		f.vop("VPORQ", z.tmp, w[k], w[k])
+	}
+}
+
+// ifmaMontMul sets r = a * b * 2**-256 mod q, with r < 2q (or r < q if reduce is set),
+// for a, b < q in radix 2**52; r is z.t[:5]
+func (f *FFAmd64) ifmaMontMul(z ifmaRegisters, a, b []string, reduce bool) []string {
+	t := z.t
+	for _, r := range t {
+		f.vop("VPXORQ", r, r, r)
+	}
+
+	// t = a * b
+	for i := 0; i < nbLimbs52; i++ {
+		for j := 0; j < nbLimbs52; j++ {
+			f.vop("VPMADD52LUQ", b[j], a[i], t[i+j])
+			f.vop("VPMADD52HUQ", b[j], a[i], t[i+j+1])
+		}
+	}
+
+	// montgomery reduction, 52 bits at a time, then 48 bits for the last round (R = 2**256)
+	for i := 0; i < nbLimbs52; i++ {
+		f.vop("VPXORQ", z.m, z.m, z.m)
+		f.vop("VPMADD52LUQ", z.qInv, t[i], z.m)
+		if i == nbLimbs52-1 {
+			f.vop("VPANDQ", z.m48, z.m, z.m)
+		}
+		for k := 0; k < nbLimbs52; k++ {
+			f.vop("VPMADD52LUQ.BCST", q52At(k), z.m, t[i+k])
+			f.vop("VPMADD52HUQ.BCST", q52At(k), z.m, t[i+k+1])
+		}
+		if i != nbLimbs52-1 {
+			f.vop("VPSRLQ", "$52", t[i], z.tmp)
+			f.vop("VPADDQ", z.tmp, t[i+1], t[i+1])
+		}
+	}
+
+	// normalize t[4...9]
+	for k := nbLimbs52 - 1; k < 2*nbLimbs52-1; k++ {
+		f.vop("VPSRLQ", "$52", t[k], z.tmp)
+		f.vop("VPADDQ", z.tmp, t[k+1], t[k+1])
+		f.vop("VPANDQ", z.m52, t[k], t[k])
+	}
+
+	// r = t[4...9] >> 48; t[4] has its 48 low bits cleared by the last round
+	r := t[:nbLimbs52]
+	for k := 0; k < nbLimbs52; k++ {
+		f.vop("VPSRLQ", "$48", t[4+k], r[k])
+		f.vop("VPSLLQ", "$4", t[5+k], z.tmp)
+		f.vop("VPANDQ", z.m52, z.tmp, z.tmp)
+		f.vop("VPORQ", z.tmp, r[k], r[k])
+	}
+
+	if reduce {
+		// s = r - q, kept in t[5...9], borrow in the sign bit
+		s := t[nbLimbs52:]
+		for k := 0; k < nbLimbs52; k++ {
+			f.vop("VPSUBQ.BCST", q52At(k), r[k], s[k])
+			if k != 0 {
+				f.vop("VPSUBQ", z.borrow, s[k], s[k])
+			}
+			f.vop("VPSRLQ", "$63", s[k], z.borrow)
+			f.vop("VPANDQ", z.m52, s[k], s[k])
+		}
+		// r = s if r >= q
+		f.vop("VPTESTNMQ", z.borrow, z.borrow, "K2")
+		for k := 0; k < nbLimbs52; k++ {
+			f.vop("VMOVDQU64", s[k], "K2", r[k])
+		}
+	}
+	return r
+}
+
+// generateMulVec writes mulVec(res, a, b *Element, n uint64) res[i] = a[i] * b[i],
+// or scalarMulVec(res, a, b *Element, n uint64) res[i] = a[i] * b, for n blocks of 8 elements
+func (f *FFAmd64) generateMulVec(scalar bool) {
+	name := "mulVec"
+	if scalar {
+		name = "scalarMulVec"
+		f.Comment("scalarMulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b")
+	} else {
+		f.Comment("mulVec(res, a, b *Element, n uint64) res[0...8n] = a[0...8n] * b[0...8n]")
+	}
+	f.Comment("requires AVX-512 IFMA; the elements are processed in radix 2**52, 8 at a time")
+	registers := f.FnHeader(name, 0, 32)
+	defer f.AssertCleanStack(0, 0)
+
+	res := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	n := registers.Pop()
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	z := newIfmaRegisters()
+	f.ifmaLoadConstants(z)
+	if scalar {
+		f.ifmaBroadcast(z, b, z.b)
+	}
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.ifmaGather(z, a, z.a)
+	if !scalar {
+		f.ifmaGather(z, b, z.b)
+	}
+	r := f.ifmaMontMul(z, z.a, z.b, true)
+	f.ifmaScatter(z, r, res)
+
+	f.ADDQ(fmt.Sprintf("$%d", nbLanes*8*f.NbWords), res)
+	f.ADDQ(fmt.Sprintf("$%d", nbLanes*8*f.NbWords), a)
+	if !scalar {
+		f.ADDQ(fmt.Sprintf("$%d", nbLanes*8*f.NbWords), b)
+	}
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.vop("VZEROUPPER")
+	f.RET()
+
+	registers.Push(res, a, b, n)
+}
+
+// generateInnerProdVec writes innerProdVec(res *[40]uint64, a, b *Element, n uint64);
+// res[8k+j] accumulates the limb k (radix 2**52) of the products a[i] * b[i] * 2**-256 < 2q
+// in the lane j, for n blocks of 8 elements. This doesn't overflow for n <= 4096.
+func (f *FFAmd64) generateInnerProdVec() {
+	f.Comment("innerProdVec(res *[40]uint64, a, b *Element, n uint64) res = sum of a[0...8n] * b[0...8n], unreduced")
+	f.Comment("requires AVX-512 IFMA; the elements are processed in radix 2**52, 8 at a time")
+	registers := f.FnHeader("innerProdVec", 0, 32)
+	defer f.AssertCleanStack(0, 0)
+
+	res := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	n := registers.Pop()
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	z := newIfmaRegisters()
+	f.ifmaLoadConstants(z)
+	for _, r := range z.acc {
+		f.vop("VPXORQ", r, r, r)
+	}
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.ifmaGather(z, a, z.a)
+	f.ifmaGather(z, b, z.b)
+	r := f.ifmaMontMul(z, z.a, z.b, false)
+	for k := 0; k < nbLimbs52; k++ {
+		f.vop("VPADDQ", r[k], z.acc[k], z.acc[k])
+	}
+
+	f.ADDQ(fmt.Sprintf("$%d", nbLanes*8*f.NbWords), a)
+	f.ADDQ(fmt.Sprintf("$%d", nbLanes*8*f.NbWords), b)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.MOVQ("res+0(FP)", res)
+	for k := 0; k < nbLimbs52; k++ {
+		f.vop("VMOVDQU64", z.acc[k], fmt.Sprintf("%d(%s)", 8*nbLanes*k, res))
+	}
+	f.vop("VZEROUPPER")
+	f.RET()
+
+	registers.Push(res, a, b, n)
+}
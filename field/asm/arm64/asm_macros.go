@@ -0,0 +1,270 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Register is an arm64 general purpose register
+type Register string
+
+// ZR is the zero register
+const ZR = Register("ZR")
+
+// Registers is the pool of registers a function can clobber:
+// R18 (platform), R27 (REGTMP), R28 (g), R29 (frame pointer) and R30 (link register) are reserved
+type Registers struct {
+	available []Register
+}
+
+func NewRegisters() Registers {
+	r := Registers{}
+	for i := 0; i <= 26; i++ {
+		if i == 18 {
+			continue
+		}
+		r.available = append(r.available, Register(fmt.Sprintf("R%d", i)))
+	}
+	return r
+}
+
+func (r *Registers) Available() int {
+	return len(r.available)
+}
+
+func (r *Registers) Pop() Register {
+	if len(r.available) == 0 {
+		panic("arm64: out of registers")
+	}
+	res := r.available[0]
+	r.available = r.available[1:]
+	return res
+}
+
+func (r *Registers) PopN(n int) []Register {
+	res := make([]Register, n)
+	for i := range res {
+		res[i] = r.Pop()
+	}
+	return res
+}
+
+func (r *Registers) Push(rIn ...Register) {
+	r.available = append(r.available, rIn...)
+}
+
+func (f *FFArm64) WriteLn(s string) {
+	_, _ = io.WriteString(f.w, s+"\n")
+}
+
+func (f *FFArm64) Comment(s string) {
+	f.WriteLn("// " + s)
+}
+
+// FnHeader writes the TEXT directive of a leaf function without local frame
+// and returns the registers it can use
+func (f *FFArm64) FnHeader(name string, argSize int) Registers {
+	f.WriteLn(fmt.Sprintf("TEXT ·%s(SB), NOSPLIT, $0-%d", name, argSize))
+	return NewRegisters()
+}
+
+func (f *FFArm64) RET() {
+	f.op("RET")
+	f.WriteLn("")
+}
+
+func (f *FFArm64) op(instruction string, operands ...interface{}) {
+	args := make([]string, len(operands))
+	for i, o := range operands {
+		args[i] = fmt.Sprint(o)
+	}
+	f.WriteLn("\t" + instruction + "\t" + strings.Join(args, ", "))
+}
+
+// note: operands follow the Go assembler order, sources first
+
+func (f *FFArm64) MOVD(src, dst interface{})       { f.op("MOVD", src, dst) }
+func (f *FFArm64) ADD(a, b, dst Register)          { f.op("ADD", a, b, dst) }
+func (f *FFArm64) ADDS(a, b, dst Register)         { f.op("ADDS", a, b, dst) }
+func (f *FFArm64) ADCS(a, b, dst Register)         { f.op("ADCS", a, b, dst) }
+func (f *FFArm64) ADC(a, b, dst Register)          { f.op("ADC", a, b, dst) }
+func (f *FFArm64) SUBS(a, b, dst Register)         { f.op("SUBS", a, b, dst) } // dst = b - a
+func (f *FFArm64) SBCS(a, b, dst Register)         { f.op("SBCS", a, b, dst) } // dst = b - a - borrow
+func (f *FFArm64) MUL(a, b, dst Register)          { f.op("MUL", a, b, dst) }
+func (f *FFArm64) UMULH(a, b, dst Register)        { f.op("UMULH", a, b, dst) }
+func (f *FFArm64) AND(a, b, dst Register)          { f.op("AND", a, b, dst) }
+func (f *FFArm64) ORR(a, b, dst Register)          { f.op("ORR", a, b, dst) }
+func (f *FFArm64) CMP(a interface{}, b Register)   { f.op("CMP", a, b) }
+func (f *FFArm64) CSETM(cond string, dst Register) { f.op("CSETM", cond, dst) }
+
+func (f *FFArm64) LDP(src string, a, b Register) {
+	f.op("LDP", src, fmt.Sprintf("(%s, %s)", a, b))
+}
+
+func (f *FFArm64) STP(a, b Register, dst string) {
+	f.op("STP", fmt.Sprintf("(%s, %s)", a, b), dst)
+}
+
+func at(r Register, offset int) string {
+	return fmt.Sprintf("%d(%s)", offset, r)
+}
+
+// load sets t to the words at [ptr]
+func (f *FFArm64) load(ptr Register, t []Register) {
+	for i := 0; i+1 < len(t); i += 2 {
+		f.LDP(at(ptr, 8*i), t[i], t[i+1])
+	}
+	if len(t)%2 == 1 {
+		f.MOVD(at(ptr, 8*(len(t)-1)), t[len(t)-1])
+	}
+}
+
+// store writes t at [ptr]
+func (f *FFArm64) store(t []Register, ptr Register) {
+	for i := 0; i+1 < len(t); i += 2 {
+		f.STP(t[i], t[i+1], at(ptr, 8*i))
+	}
+	if len(t)%2 == 1 {
+		f.MOVD(t[len(t)-1], at(ptr, 8*(len(t)-1)))
+	}
+}
+
+// carryChain applies first (ADDS or SUBS) then next (ADCS or SBCS) on the words of a, b,
+// loading the words of a from memory by pairs into scratch when a is a pointer.
+// If mask is not ZR, the words of a are and-ed with mask first.
+// Loads and the non flag setting AND don't break the chain.
+func (f *FFArm64) carryChain(first, next string, a interface{}, b, dst []Register, scratch [2]Register, mask Register) {
+	instruction := func(i int) string {
+		if i == 0 {
+			return first
+		}
+		return next
+	}
+	for i := 0; i < len(b); i += 2 {
+		var w [2]Register
+		nb := 2
+		if i+1 == len(b) {
+			nb = 1
+		}
+		switch a := a.(type) {
+		case Register:
+			f.loadPair(a, i, scratch[:])
+			w = scratch
+		case []Register:
+			w[0] = a[i]
+			if nb == 2 {
+				w[1] = a[i+1]
+			}
+		}
+		for k := 0; k < nb; k++ {
+			if mask != ZR {
+				f.AND(mask, w[k], scratch[k])
+				w[k] = scratch[k]
+			}
+			f.op(instruction(i+k), w[k], b[i+k], dst[i+k])
+		}
+	}
+}
+
+// reduce sets t = t - q if t >= q, for t < 2q
+func (f *FFArm64) reduce(registers *Registers, t []Register) {
+	pq := registers.Pop()
+	mask := registers.Pop()
+	scratch := [2]Register{registers.Pop(), registers.Pop()}
+	probe := registers.Pop()
+
+	f.MOVD("$q<>(SB)", pq)
+
+	// borrow of t - q
+	probes := make([]Register, len(t))
+	for i := range probes {
+		probes[i] = probe
+	}
+	f.carryChain("SUBS", "SBCS", pq, t, probes, scratch, ZR)
+
+	// mask = t >= q ? 0xFFFFFFFFFFFFFFFF : 0
+	f.CSETM("CS", mask)
+
+	// t = t - (q & mask)
+	f.carryChain("SUBS", "SBCS", pq, t, t, scratch, mask)
+
+	registers.Push(pq, mask, scratch[0], scratch[1], probe)
+}
+
+// reduceMem sets the words at [ptr] to [ptr] - q if [ptr] >= q, for [ptr] < 2q
+func (f *FFArm64) reduceMem(registers *Registers, ptr Register) {
+	pq := registers.Pop()
+	mask := registers.Pop()
+	u := registers.PopN(2)
+	scratch := [2]Register{registers.Pop(), registers.Pop()}
+
+	f.MOVD("$q<>(SB)", pq)
+
+	// borrow of [ptr] - q
+	for i := 0; i < f.NbWords; i += 2 {
+		nb := f.loadPair(ptr, i, u)
+		f.carryChainStep(i, nb, pq, u, scratch, ZR, "SUBS", "SBCS")
+	}
+
+	// mask = [ptr] >= q ? 0xFFFFFFFFFFFFFFFF : 0
+	f.CSETM("CS", mask)
+
+	// [ptr] = [ptr] - (q & mask)
+	for i := 0; i < f.NbWords; i += 2 {
+		nb := f.loadPair(ptr, i, u)
+		f.carryChainStep(i, nb, pq, u, scratch, mask, "SUBS", "SBCS")
+		f.storePair(u, ptr, i)
+	}
+
+	registers.Push(pq, mask, u[0], u[1], scratch[0], scratch[1])
+}
+
+// loadPair sets u to the words i and i+1 at [ptr] and returns the number of words loaded
+func (f *FFArm64) loadPair(ptr Register, i int, u []Register) int {
+	if i+1 < f.NbWords {
+		f.LDP(at(ptr, 8*i), u[0], u[1])
+		return 2
+	}
+	f.MOVD(at(ptr, 8*i), u[0])
+	return 1
+}
+
+// storePair writes u at the words i and i+1 at [ptr]
+func (f *FFArm64) storePair(u []Register, ptr Register, i int) {
+	if i+1 < f.NbWords {
+		f.STP(u[0], u[1], at(ptr, 8*i))
+		return
+	}
+	f.MOVD(u[0], at(ptr, 8*i))
+}
+
+// carryChainStep applies the words i and i+1 (if nb == 2) of ([a] & mask) to u[0], u[1]
+// with first (for the word 0) or next; mask is ignored if ZR
+func (f *FFArm64) carryChainStep(i, nb int, a Register, u []Register, scratch [2]Register, mask Register, first, next string) {
+	f.loadPair(a, i, scratch[:])
+	for k := 0; k < nb; k++ {
+		if mask != ZR {
+			f.AND(mask, scratch[k], scratch[k])
+		}
+		if i+k == 0 {
+			f.op(first, scratch[k], u[k], u[k])
+		} else {
+			f.op(next, scratch[k], u[k], u[k])
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package arm64 contains syntactic sugar to generate arm64 assembly code
+package arm64
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field"
+)
+
+// SmallModulus is the largest number of words for which Butterfly keeps both
+// operands, their sum and their difference in registers
+const SmallModulus = 6
+
+func NewFFArm64(w io.Writer, F *field.Field) *FFArm64 {
+	return &FFArm64{F, w}
+}
+
+type FFArm64 struct {
+	*field.Field
+	w io.Writer
+}
+
+// Generate generates arm64 assembly code for the base field provided to goff
+// see internal/templates/ops*
+func Generate(w io.Writer, F *field.Field) error {
+	if F.NbWords < 2 {
+		return fmt.Errorf("arm64: unsupported number of words: %d", F.NbWords)
+	}
+	f := NewFFArm64(w, F)
+	f.WriteLn(bavard.Apache2Header("ConsenSys Software Inc.", 2020))
+
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("")
+
+	f.generateDefines()
+
+	// add
+	f.generateAdd()
+
+	// sub
+	f.generateSub()
+
+	// double
+	f.generateDouble()
+
+	// neg
+	f.generateNeg()
+
+	// reduce
+	f.generateReduce()
+
+	// fft butterflies
+	f.generateButterfly()
+
+	// mul
+	f.generateMul()
+
+	// from mont
+	f.generateFromMont()
+
+	return nil
+}
+
+func (f *FFArm64) generateDefines() {
+	f.Comment("modulus q")
+	for i, w := range f.Q {
+		f.WriteLn(fmt.Sprintf("DATA q<>+%d(SB)/8, $%#016x", i*8, w))
+	}
+	f.WriteLn(fmt.Sprintf("GLOBL q<>(SB), (RODATA+NOPTR), $%d", 8*f.NbWords))
+	f.WriteLn("")
+	f.Comment("qInv0 q'[0]")
+	f.WriteLn(fmt.Sprintf("DATA qInv0<>(SB)/8, $%#016x", f.QInverse[0]))
+	f.WriteLn("GLOBL qInv0<>(SB), (RODATA+NOPTR), $8")
+	f.WriteLn("")
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+func (f *FFArm64) generateAdd() {
+	f.Comment("add(res, x, y *Element)")
+	registers := f.FnHeader("add", 24)
+
+	// registers
+	x := registers.Pop()
+	y := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	scratch := [2]Register{registers.Pop(), registers.Pop()}
+
+	// t = x + y
+	f.MOVD("x+8(FP)", x)
+	f.load(x, t)
+	f.MOVD("y+16(FP)", y)
+	f.carryChain("ADDS", "ADCS", y, t, t, scratch, ZR)
+	registers.Push(x, y, scratch[0], scratch[1])
+
+	// reduce t
+	f.reduce(&registers, t)
+
+	r := registers.Pop()
+	f.MOVD("res+0(FP)", r)
+	f.store(t, r)
+
+	f.RET()
+}
+
+func (f *FFArm64) generateDouble() {
+	f.Comment("double(res, x *Element)")
+	registers := f.FnHeader("double", 16)
+
+	// registers
+	x := registers.Pop()
+	t := registers.PopN(f.NbWords)
+
+	// t = x + x
+	f.MOVD("x+8(FP)", x)
+	f.load(x, t)
+	f.carryChain("ADDS", "ADCS", t, t, t, [2]Register{}, ZR)
+	registers.Push(x)
+
+	// reduce t
+	f.reduce(&registers, t)
+
+	r := registers.Pop()
+	f.MOVD("res+0(FP)", r)
+	f.store(t, r)
+
+	f.RET()
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+func (f *FFArm64) generateButterfly() {
+	f.Comment("Butterfly(a, b *Element) sets a = a + b; b = a - b")
+	registers := f.FnHeader("Butterfly", 16)
+
+	// registers
+	a := registers.Pop()
+	b := registers.Pop()
+	ta := registers.PopN(f.NbWords)
+
+	f.MOVD("a+0(FP)", a)
+	f.MOVD("b+8(FP)", b)
+	f.load(a, ta)
+
+	if f.NbWords <= SmallModulus {
+		// a, b, a + b and a - b fit in registers
+		tb := registers.PopN(f.NbWords)
+		t := registers.PopN(f.NbWords)
+		f.load(b, tb)
+
+		// t = a + b
+		f.carryChain("ADDS", "ADCS", tb, ta, t, [2]Register{}, ZR)
+		f.reduce(&registers, t)
+		f.store(t, a)
+		registers.Push(t...)
+
+		// ta = a - b
+		f.carryChain("SUBS", "SBCS", tb, ta, ta, [2]Register{}, ZR)
+		registers.Push(tb...)
+		f.addQIfBorrow(&registers, ta)
+		f.store(ta, b)
+
+		f.RET()
+		return
+	}
+
+	// a + b and a - b are computed in memory, word pair by word pair
+	u := registers.PopN(2)
+
+	// [a] = a + b
+	for i := 0; i < f.NbWords; i += 2 {
+		nb := f.loadPair(b, i, u)
+		f.pairStep(i, nb, u, ta, "ADDS", "ADCS")
+		f.storePair(u, a, i)
+	}
+	f.reduceMem(&registers, a)
+
+	// [b] = a - b
+	for i := 0; i < f.NbWords; i += 2 {
+		nb := f.loadPair(b, i, u)
+		f.pairStep(i, nb, u, ta, "SUBS", "SBCS")
+		f.storePair(u, b, i)
+	}
+
+	// [b] = [b] + (q & mask), mask = a < b ? 0xFFFFFFFFFFFFFFFF : 0
+	mask := registers.Pop()
+	q := registers.Pop()
+	scratch := [2]Register{registers.Pop(), registers.Pop()}
+	f.CSETM("CC", mask)
+	f.MOVD("$q<>(SB)", q)
+	for i := 0; i < f.NbWords; i += 2 {
+		nb := f.loadPair(b, i, u)
+		f.carryChainStep(i, nb, q, u, scratch, mask, "ADDS", "ADCS")
+		f.storePair(u, b, i)
+	}
+
+	f.RET()
+}
+
+// pairStep sets u[k] = t[i+k] op u[k] for the nb words of the pair i
+func (f *FFArm64) pairStep(i, nb int, u, t []Register, first, next string) {
+	for k := 0; k < nb; k++ {
+		if i+k == 0 {
+			f.op(first, u[k], t[i+k], u[k])
+		} else {
+			f.op(next, u[k], t[i+k], u[k])
+		}
+	}
+}
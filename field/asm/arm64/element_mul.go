@@ -0,0 +1,168 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+import "fmt"
+
+// generateMul generates the Montgomery multiplication, with the "no-carry" variant of CIOS
+// (see field/internal/templates/element/mul_nocarry.go); q must have a spare bit in its last word.
+//
+// t is kept in registers; words of x, y and q are loaded as needed.
+func (f *FFArm64) generateMul() {
+	f.Comment("mul(res, x, y *Element)")
+	registers := f.FnHeader("mul", 24)
+
+	// registers
+	x := registers.Pop()
+	y := registers.Pop()
+	q := registers.Pop()
+	qInv0 := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	v := registers.Pop()  // x[i]
+	yj := registers.Pop() // y[j]
+	qj := registers.Pop() // q[j]
+	m := registers.Pop()
+	A := registers.Pop()
+	C := registers.Pop()
+	c0 := registers.Pop()
+	lo := registers.Pop()
+	hi := registers.Pop()
+
+	f.MOVD("x+8(FP)", x)
+	f.MOVD("y+16(FP)", y)
+	f.MOVD("$q<>(SB)", q)
+	f.MOVD("qInv0<>(SB)", qInv0)
+
+	for i := 0; i < f.NbWords; i++ {
+		f.Comment(fmt.Sprintf("round %d", i))
+		f.MOVD(at(x, 8*i), v)
+
+		// (A, c0) = v * y[0] + t[0]
+		f.MOVD(at(y, 0), yj)
+		if i == 0 {
+			f.MUL(v, yj, c0)
+			f.UMULH(v, yj, A)
+		} else {
+			f.MUL(v, yj, lo)
+			f.UMULH(v, yj, hi)
+			f.ADDS(t[0], lo, c0)
+			f.ADC(ZR, hi, A)
+		}
+
+		// m = c0 * q'[0] mod 2**64
+		f.MUL(qInv0, c0, m)
+
+		// C = hi(m * q[0] + c0)
+		f.MOVD(at(q, 0), qj)
+		f.MUL(m, qj, lo)
+		f.UMULH(m, qj, hi)
+		f.ADDS(c0, lo, lo)
+		f.ADC(ZR, hi, C)
+
+		for j := 1; j < f.NbWords; j++ {
+			// (A, c0) = v * y[j] + A + t[j]
+			f.MOVD(at(y, 8*j), yj)
+			f.MUL(v, yj, lo)
+			f.UMULH(v, yj, hi)
+			f.ADDS(A, lo, c0)
+			if i == 0 {
+				f.ADC(ZR, hi, A)
+			} else {
+				f.ADC(ZR, hi, hi)
+				f.ADDS(t[j], c0, c0)
+				f.ADC(ZR, hi, A)
+			}
+
+			// (C, t[j-1]) = m * q[j] + C + c0
+			f.MOVD(at(q, 8*j), qj)
+			f.MUL(m, qj, lo)
+			f.UMULH(m, qj, hi)
+			f.ADDS(C, lo, lo)
+			f.ADC(ZR, hi, hi)
+			f.ADDS(c0, lo, t[j-1])
+			f.ADC(ZR, hi, C)
+		}
+
+		// t[n-1] = C + A
+		f.ADD(A, C, t[f.NbWords-1])
+	}
+	registers.Push(x, y, q, qInv0, v, yj, qj, m, A, C, c0, lo, hi)
+
+	// reduce t
+	f.reduce(&registers, t)
+
+	r := registers.Pop()
+	f.MOVD("res+0(FP)", r)
+	f.store(t, r)
+
+	f.RET()
+}
+
+// generateFromMont generates res = res * 1 (Montgomery reduction)
+func (f *FFArm64) generateFromMont() {
+	f.Comment("fromMont(res *Element)")
+	registers := f.FnHeader("fromMont", 8)
+
+	// registers
+	r := registers.Pop()
+	q := registers.Pop()
+	qInv0 := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	qj := registers.Pop()
+	m := registers.Pop()
+	C := registers.Pop()
+	lo := registers.Pop()
+	hi := registers.Pop()
+
+	f.MOVD("res+0(FP)", r)
+	f.MOVD("$q<>(SB)", q)
+	f.MOVD("qInv0<>(SB)", qInv0)
+	f.load(r, t)
+
+	for i := 0; i < f.NbWords; i++ {
+		f.Comment(fmt.Sprintf("round %d", i))
+
+		// m = t[0] * q'[0] mod 2**64
+		f.MUL(qInv0, t[0], m)
+
+		// C = hi(m * q[0] + t[0])
+		f.MOVD(at(q, 0), qj)
+		f.MUL(m, qj, lo)
+		f.UMULH(m, qj, hi)
+		f.ADDS(t[0], lo, lo)
+		f.ADC(ZR, hi, C)
+
+		for j := 1; j < f.NbWords; j++ {
+			// (C, t[j-1]) = m * q[j] + t[j] + C
+			f.MOVD(at(q, 8*j), qj)
+			f.MUL(m, qj, lo)
+			f.UMULH(m, qj, hi)
+			f.ADDS(C, lo, lo)
+			f.ADC(ZR, hi, hi)
+			f.ADDS(t[j], lo, t[j-1])
+			f.ADC(ZR, hi, C)
+		}
+
+		// t[n-1] = C
+		f.MOVD(C, t[f.NbWords-1])
+	}
+	registers.Push(q, qInv0, qj, m, C, lo, hi)
+
+	// reduce t
+	f.reduce(&registers, t)
+	f.store(t, r)
+
+	f.RET()
+}
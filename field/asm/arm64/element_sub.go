@@ -0,0 +1,104 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+func (f *FFArm64) generateSub() {
+	f.Comment("sub(res, x, y *Element)")
+	registers := f.FnHeader("sub", 24)
+
+	// registers
+	x := registers.Pop()
+	y := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	scratch := [2]Register{registers.Pop(), registers.Pop()}
+
+	// t = x - y
+	f.MOVD("x+8(FP)", x)
+	f.load(x, t)
+	f.MOVD("y+16(FP)", y)
+	f.carryChain("SUBS", "SBCS", y, t, t, scratch, ZR)
+
+	registers.Push(x, y, scratch[0], scratch[1])
+
+	// t = x < y ? t + q : t
+	f.addQIfBorrow(&registers, t)
+
+	r := registers.Pop()
+	f.MOVD("res+0(FP)", r)
+	f.store(t, r)
+
+	f.RET()
+}
+
+// addQIfBorrow sets t = t + q if the carry flag is clear (i.e. the last subtraction borrowed)
+func (f *FFArm64) addQIfBorrow(registers *Registers, t []Register) {
+	mask := registers.Pop()
+	q := registers.Pop()
+	scratch := [2]Register{registers.Pop(), registers.Pop()}
+
+	// mask = borrow ? 0xFFFFFFFFFFFFFFFF : 0
+	f.CSETM("CC", mask)
+
+	// t = t + (q & mask)
+	f.MOVD("$q<>(SB)", q)
+	f.carryChain("ADDS", "ADCS", q, t, t, scratch, mask)
+
+	registers.Push(mask, q, scratch[0], scratch[1])
+}
+
+func (f *FFArm64) generateNeg() {
+	f.Comment("neg(res, x *Element)")
+	registers := f.FnHeader("neg", 16)
+
+	// registers
+	x := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	scratch := [2]Register{registers.Pop(), registers.Pop()}
+	mask := registers.Pop()
+
+	f.MOVD("x+8(FP)", x)
+	f.load(x, t)
+
+	// mask = x != 0 ? 0xFFFFFFFFFFFFFFFF : 0
+	f.ORR(t[0], t[1], mask)
+	for i := 2; i < f.NbWords; i++ {
+		f.ORR(t[i], mask, mask)
+	}
+	f.CMP("$0", mask)
+	f.CSETM("NE", mask)
+
+	// t = (q - x) & mask
+	q := x
+	f.MOVD("$q<>(SB)", q)
+	for i := 0; i < f.NbWords; i += 2 {
+		nb := f.loadPair(q, i, scratch[:])
+		for k := 0; k < nb; k++ {
+			if i+k == 0 {
+				f.SUBS(t[i+k], scratch[k], t[i+k])
+			} else {
+				f.SBCS(t[i+k], scratch[k], t[i+k])
+			}
+		}
+	}
+	for i := 0; i < f.NbWords; i++ {
+		f.AND(mask, t[i], t[i])
+	}
+
+	r := x
+	f.MOVD("res+0(FP)", r)
+	f.store(t, r)
+
+	f.RET()
+}
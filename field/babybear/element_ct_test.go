@@ -0,0 +1,164 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+import (
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestElementInverseCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("InverseCT should output the same result as Inverse", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, d Element
+			c.InverseCT(&a.element)
+			d.Inverse(&a.element)
+			return c.Equal(&d) && !c.biggerOrEqualModulus()
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for _, a := range staticTestValues {
+		var c, d Element
+		c.InverseCT(&a)
+		d.Inverse(&a)
+		if !c.Equal(&d) {
+			t.Fatal("InverseCT doesn't match Inverse on static test values")
+		}
+	}
+}
+
+func TestElementSqrtCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("SqrtCT should output the same result as Sqrt", prop.ForAll(
+		func(a testPairElement) bool {
+			for _, x := range genTestValues(a) {
+				var c, d Element
+				r1 := c.SqrtCT(&x.element)
+				r2 := d.Sqrt(&x.element)
+				if (r1 == nil) != (r2 == nil) {
+					return false
+				}
+				if r1 != nil && (!c.Equal(&d) || c.biggerOrEqualModulus()) {
+					return false
+				}
+			}
+			return true
+		},
+		genA,
+	))
+
+	properties.Property("SqrtCT of a square should be ± the root", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, square, neg Element
+			square.Square(&a.element)
+			if c.SqrtCT(&square) == nil {
+				return false
+			}
+			neg.Neg(&a.element)
+			return c.Equal(&a.element) || c.Equal(&neg)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementMulCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	properties.Property("mulCT and squareCT should match Mul and Square", prop.ForAll(
+		func(a, b testPairElement) bool {
+			for _, x := range genTestValues(a) {
+				for _, y := range genTestValues(b) {
+					var c, d Element
+					c.mulCT(&x.element, &y.element)
+					d.Mul(&x.element, &y.element)
+					if !c.Equal(&d) || c.biggerOrEqualModulus() {
+						return false
+					}
+					c.squareCT(&x.element)
+					d.Square(&x.element)
+					if !c.Equal(&d) || c.biggerOrEqualModulus() {
+						return false
+					}
+				}
+			}
+			return true
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func BenchmarkElementInverseCT(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchResElement.InverseCT(&x)
+	}
+}
+
+func BenchmarkElementSqrtCT(b *testing.B) {
+	var a Element
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.SqrtCT(&a)
+	}
+}
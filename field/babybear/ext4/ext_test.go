@@ -0,0 +1,279 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ext4
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// refMul is a slow math/big implementation of the product in babybear[X]/(X**degree - nonResidue)
+func refMul(x, y *Ext) Ext {
+	q := babybear.Modulus()
+	var w big.Int
+	nonResidue.ToBigIntRegular(&w)
+
+	var coeffs [degree]big.Int
+	for i := 0; i < degree; i++ {
+		for j := 0; j < degree; j++ {
+			var a, b big.Int
+			x[i].ToBigIntRegular(&a)
+			y[j].ToBigIntRegular(&b)
+			a.Mul(&a, &b)
+			if i+j >= degree {
+				a.Mul(&a, &w)
+			}
+			k := (i + j) % degree
+			coeffs[k].Add(&coeffs[k], &a).Mod(&coeffs[k], q)
+		}
+	}
+	var res Ext
+	for i := range res {
+		res[i].SetBigInt(&coeffs[i])
+	}
+	return res
+}
+
+func genExt() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var a Ext
+		for i := 0; i < degree; i++ {
+			v := new(big.Int).SetUint64(genParams.NextUint64())
+			if genParams.NextUint64()%4 == 0 {
+				v.SetUint64(0)
+			}
+			a[i].SetBigInt(v)
+		}
+		return gopter.NewGenResult(a, gopter.NoShrinker)
+	}
+}
+
+func TestExtArithmetic(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 20
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := genExt()
+	genB := genExt()
+
+	properties.Property("[Ext4] Mul should match the math/big product", prop.ForAll(
+		func(a, b Ext) bool {
+			var c Ext
+			c.Mul(&a, &b)
+			expected := refMul(&a, &b)
+			return c.Equal(&expected)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[Ext4] Square and Mul should output the same result", prop.ForAll(
+		func(a Ext) bool {
+			var b, c Ext
+			b.Mul(&a, &a)
+			c.Square(&a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[Ext4] having the receiver as operand should output the same result", prop.ForAll(
+		func(a, b Ext) bool {
+			var c Ext
+			c.Mul(&a, &b)
+			d, e := a, b
+			d.Mul(&d, &b)
+			e.Mul(&a, &e)
+			return c.Equal(&d) && c.Equal(&e)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[Ext4] Add, Sub, Neg and Double should be consistent", prop.ForAll(
+		func(a, b Ext) bool {
+			var c, d Ext
+			c.Add(&a, &b).Sub(&c, &b)
+			d.Neg(&a).Add(&d, &a)
+			var e, f Ext
+			e.Double(&a)
+			f.Add(&a, &a)
+			return c.Equal(&a) && d.IsZero() && e.Equal(&f)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[Ext4] Inverse: x * x**-1 should be 1", prop.ForAll(
+		func(a Ext) bool {
+			if a.IsZero() {
+				var b Ext
+				return b.Inverse(&a).IsZero()
+			}
+			var b Ext
+			b.Inverse(&a).Mul(&b, &a)
+			return b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[Ext4] Div: (x / y) * y should be x", prop.ForAll(
+		func(a, b Ext) bool {
+			if b.IsZero() {
+				return true
+			}
+			var c Ext
+			c.Div(&a, &b).Mul(&c, &b)
+			return c.Equal(&a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[Ext4] Frobenius should be x**q", prop.ForAll(
+		func(a Ext) bool {
+			var b, c Ext
+			b.Frobenius(&a)
+			c.Exp(a, babybear.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[Ext4] Frobenius**degree should be the identity", prop.ForAll(
+		func(a Ext) bool {
+			b := a
+			for i := 0; i < degree; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[Ext4] Norm should be multiplicative", prop.ForAll(
+		func(a, b Ext) bool {
+			var c Ext
+			var na, nb, nc babybear.Element
+			c.Mul(&a, &b).Norm(&nc)
+			a.Norm(&na)
+			b.Norm(&nb)
+			na.Mul(&na, &nb)
+			return na.Equal(&nc)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[Ext4] Legendre should match x**((q**degree-1)/2)", prop.ForAll(
+		func(a Ext) bool {
+			var e big.Int
+			var b Ext
+			e.Exp(babybear.Modulus(), big.NewInt(degree), nil).Rsh(&e, 1)
+			b.Exp(a, &e)
+			switch a.Legendre() {
+			case 0:
+				return a.IsZero()
+			case 1:
+				return b.IsOne()
+			default:
+				var minusOne Ext
+				minusOne.SetOne().Neg(&minusOne)
+				return b.Equal(&minusOne)
+			}
+		},
+		genA,
+	))
+
+	properties.Property("[Ext4] Sqrt should output a square root of squares and nil otherwise", prop.ForAll(
+		func(a Ext) bool {
+			var b, c Ext
+			b.Square(&a)
+			if c.Sqrt(&b) == nil || !c.Square(&c).Equal(&b) {
+				return false
+			}
+			if a.Legendre() == -1 {
+				return c.Sqrt(&a) == nil
+			}
+			return true
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestExtSqrtG(t *testing.T) {
+	// sqrtG has order 2**sqrtE
+	g := sqrtG
+	for i := uint64(0); i < sqrtE-1; i++ {
+		g.Square(&g)
+	}
+	var minusOne Ext
+	minusOne.SetOne().Neg(&minusOne)
+	if !g.Equal(&minusOne) {
+		t.Fatal("sqrtG doesn't have order 2**sqrtE")
+	}
+}
+
+func BenchmarkExtMul(b *testing.B) {
+	var x, y Ext
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkExtSquare(b *testing.B) {
+	var x Ext
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Square(&x)
+	}
+}
+
+func BenchmarkExtInverse(b *testing.B) {
+	var x Ext
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+func BenchmarkExtSqrt(b *testing.B) {
+	var x Ext
+	x.SetRandom()
+	x.Square(&x)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var y Ext
+		y.Sqrt(&x)
+	}
+}
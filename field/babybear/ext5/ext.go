@@ -0,0 +1,370 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ext5
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+)
+
+// degree of the extension
+const degree = 5
+
+// Ext is an element of babybear[X]/(X**5 - 2), where Ext[i] is the coefficient of X**i
+type Ext [degree]babybear.Element
+
+var (
+	// nonResidue = X**degree
+	nonResidue babybear.Element
+
+	// frobeniusCoefficients[i] = nonResidue**(i*(q-1)/degree)
+	frobeniusCoefficients [degree]babybear.Element
+
+	// q**degree - 1 = 2**sqrtE * s with s odd
+	sqrtE              = uint64(27)
+	sqrtSMinusOneOver2 big.Int
+
+	// sqrtG = g**s for a quadratic non-residue g
+	sqrtG Ext
+)
+
+func init() {
+	nonResidue.SetString("2")
+	frobeniusCoefficients[0].SetString("1")
+	frobeniusCoefficients[1].SetString("815036133")
+	frobeniusCoefficients[2].SetString("609564788")
+	frobeniusCoefficients[3].SetString("1956349769")
+	frobeniusCoefficients[4].SetString("645581151")
+	sqrtSMinusOneOver2.SetString("5cb27803dcc500107ac0002328000025", 16)
+	sqrtG[0].SetString("329330628")
+	sqrtG[1].SetString("0")
+	sqrtG[2].SetString("0")
+	sqrtG[3].SetString("0")
+	sqrtG[4].SetString("0")
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *Ext) Equal(x *Ext) bool {
+	for i := 0; i < degree; i++ {
+		if !z[i].Equal(&x[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *Ext) IsZero() bool {
+	for i := 0; i < degree; i++ {
+		if !z[i].IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *Ext) IsOne() bool {
+	one := babybear.One()
+	if !z[0].Equal(&one) {
+		return false
+	}
+	for i := 1; i < degree; i++ {
+		if !z[i].IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// SetZero sets z to zero and returns z
+func (z *Ext) SetZero() *Ext {
+	*z = Ext{}
+	return z
+}
+
+// SetOne sets z to one and returns z
+func (z *Ext) SetOne() *Ext {
+	*z = Ext{}
+	z[0].SetOne()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *Ext) Set(x *Ext) *Ext {
+	*z = *x
+	return z
+}
+
+// SetElement sets z to the element x of the base field and returns z
+func (z *Ext) SetElement(x *babybear.Element) *Ext {
+	*z = Ext{}
+	z[0].Set(x)
+	return z
+}
+
+// SetRandom sets the coefficients of z to random values and returns z
+func (z *Ext) SetRandom() (*Ext, error) {
+	for i := 0; i < degree; i++ {
+		if _, err := z[i].SetRandom(); err != nil {
+			return nil, err
+		}
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *Ext) Add(x, y *Ext) *Ext {
+	for i := 0; i < degree; i++ {
+		z[i].Add(&x[i], &y[i])
+	}
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *Ext) Sub(x, y *Ext) *Ext {
+	for i := 0; i < degree; i++ {
+		z[i].Sub(&x[i], &y[i])
+	}
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *Ext) Double(x *Ext) *Ext {
+	for i := 0; i < degree; i++ {
+		z[i].Double(&x[i])
+	}
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *Ext) Neg(x *Ext) *Ext {
+	for i := 0; i < degree; i++ {
+		z[i].Neg(&x[i])
+	}
+	return z
+}
+
+// MulByElement sets z = x * y, for y in the base field, and returns z
+func (z *Ext) MulByElement(x *Ext, y *babybear.Element) *Ext {
+	yCopy := *y
+	for i := 0; i < degree; i++ {
+		z[i].Mul(&x[i], &yCopy)
+	}
+	return z
+}
+
+// mulByNonResidue sets z = x * nonResidue
+func mulByNonResidue(z, x *babybear.Element) {
+	z.Mul(x, &nonResidue)
+}
+
+// Mul sets z = x * y and returns z
+func (z *Ext) Mul(x, y *Ext) *Ext {
+	// schoolbook product, the coefficients of X**k for k >= degree are reduced with X**degree = nonResidue
+	var lo, hi Ext
+	var t babybear.Element
+	for i := 0; i < degree; i++ {
+		for j := 0; j < degree; j++ {
+			t.Mul(&x[i], &y[j])
+			if k := i + j; k < degree {
+				lo[k].Add(&lo[k], &t)
+			} else {
+				hi[k-degree].Add(&hi[k-degree], &t)
+			}
+		}
+	}
+	return z.reduce(&lo, &hi)
+}
+
+// Square sets z = x * x and returns z
+func (z *Ext) Square(x *Ext) *Ext {
+	var lo, hi Ext
+	var t babybear.Element
+	for i := 0; i < degree; i++ {
+		for j := i; j < degree; j++ {
+			if i == j {
+				t.Square(&x[i])
+			} else {
+				t.Mul(&x[i], &x[j]).Double(&t)
+			}
+			if k := i + j; k < degree {
+				lo[k].Add(&lo[k], &t)
+			} else {
+				hi[k-degree].Add(&hi[k-degree], &t)
+			}
+		}
+	}
+	return z.reduce(&lo, &hi)
+}
+
+// reduce sets z = lo + nonResidue * hi, that is lo + X**degree * hi mod X**degree - nonResidue
+func (z *Ext) reduce(lo, hi *Ext) *Ext {
+	for i := 0; i < degree-1; i++ {
+		mulByNonResidue(&hi[i], &hi[i])
+		z[i].Add(&lo[i], &hi[i])
+	}
+	z[degree-1] = lo[degree-1]
+	return z
+}
+
+// Frobenius sets z = x**q and returns z
+func (z *Ext) Frobenius(x *Ext) *Ext {
+	z[0] = x[0]
+	for i := 1; i < degree; i++ {
+		z[i].Mul(&x[i], &frobeniusCoefficients[i])
+	}
+	return z
+}
+
+// conjugates sets z = φ(x) * ... * φ**(degree-1)(x), where φ is the Frobenius map
+func (z *Ext) conjugates(x *Ext) *Ext {
+	var t Ext
+	t.Frobenius(x)
+	z.Set(&t)
+	for i := 2; i < degree; i++ {
+		t.Frobenius(&t)
+		z.Mul(z, &t)
+	}
+	return z
+}
+
+// constantCoefficient returns the coefficient of X**0 of x * y
+func constantCoefficient(x, y *Ext) babybear.Element {
+	var res, sum, t babybear.Element
+	for i := 1; i < degree; i++ {
+		t.Mul(&x[i], &y[degree-i])
+		sum.Add(&sum, &t)
+	}
+	mulByNonResidue(&sum, &sum)
+	res.Mul(&x[0], &y[0]).Add(&res, &sum)
+	return res
+}
+
+// Norm sets res to the norm x * φ(x) * ... * φ**(degree-1)(x) of x, where φ is the Frobenius map,
+// and returns res. The norm is in the base field.
+func (z *Ext) Norm(res *babybear.Element) *babybear.Element {
+	var c Ext
+	c.conjugates(z)
+	*res = constantCoefficient(z, &c)
+	return res
+}
+
+// Inverse sets z = x**-1 and returns z, using x**-1 = φ(x) * ... * φ**(degree-1)(x) / Norm(x)
+// if x == 0, sets and returns z = x
+func (z *Ext) Inverse(x *Ext) *Ext {
+	var c Ext
+	c.conjugates(x)
+	n := constantCoefficient(x, &c)
+	n.Inverse(&n)
+	return z.MulByElement(&c, &n)
+}
+
+// Div sets z = x * y**-1 and returns z
+func (z *Ext) Div(x, y *Ext) *Ext {
+	var yInv Ext
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp sets z = x**exponent and returns z
+func (z *Ext) Exp(x Ext, exponent *big.Int) *Ext {
+	z.SetOne()
+	for i := exponent.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if exponent.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+// x**((q**degree-1)/2) is the Legendre symbol of the norm of x in the base field
+func (z *Ext) Legendre() int {
+	var n babybear.Element
+	z.Norm(&n)
+	return n.Legendre()
+}
+
+// Sqrt z = √x
+// if the square root doesn't exist (x is not a square)
+// Sqrt leaves z unchanged and returns nil
+func (z *Ext) Sqrt(x *Ext) *Ext {
+	switch x.Legendre() {
+	case 0:
+		return z.SetZero()
+	case -1:
+		return nil
+	}
+
+	// Tonelli-Shanks, see the Sqrt method of the base field
+	var y, b, t, w Ext
+	// w = x^((s-1)/2))
+	w.Exp(*x, &sqrtSMinusOneOver2)
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, &w)
+
+	// b = x^s = w * w * x = y * x
+	b.Mul(&w, &y)
+
+	g := sqrtG
+	r := sqrtE
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// String returns the string form of z, a0+a1*X+...
+func (z *Ext) String() string {
+	var sb strings.Builder
+	sb.WriteString(z[0].String())
+	for i := 1; i < degree; i++ {
+		sb.WriteString("+" + z[i].String() + "*X")
+		if i > 1 {
+			sb.WriteString("**" + strconv.Itoa(i))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"github.com/consensys/gnark-crypto/field/babybear"
+	generic "github.com/consensys/gnark-crypto/field/fft"
+)
+
+// Domain with a power of 2 cardinality, over babybear.Element
+type Domain = generic.Domain[babybear.Element, *babybear.Element]
+
+// Decimation is used in the FFT call to select decimation in time or in frequency
+type Decimation = generic.Decimation
+
+const (
+	DIT = generic.DIT
+	DIF = generic.DIF
+)
+
+// maxOrderRoot is the 2-adicity of q-1, the largest domain has 2**maxOrderRoot elements
+const maxOrderRoot = 27
+
+// rootOfUnity is a generator of the subgroup of order 2**maxOrderRoot (regular form)
+const rootOfUnity = 1227303670
+
+// NewDomain returns a subgroup with a power of 2 cardinality
+// cardinality >= m
+// If depth>0, the Domain will also store a primitive (2**depth)*m root
+// of 1, with associated precomputed data. This allows to perform shifted
+// FFT/FFTInv.
+// If precomputeReversedCosetTable is set, the bit reversed cosetTable/cosetTableInv are precomputed.
+func NewDomain(m, depth uint64, precomputeReversedTable bool) *Domain {
+	var root babybear.Element
+	root.SetUint64(rootOfUnity)
+	return generic.NewDomain[babybear.Element](m, depth, root, maxOrderRoot, precomputeReversedTable)
+}
+
+// BitReverse applies the bit-reversal permutation to a.
+// len(a) must be a power of 2 (as in every single function in this file)
+func BitReverse(a []babybear.Element) {
+	generic.BitReverse(a)
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestRootOfUnity(t *testing.T) {
+	var root, one babybear.Element
+	root.SetUint64(rootOfUnity)
+	one.SetOne()
+
+	// root**(2**(maxOrderRoot-1)) = -1
+	for i := 0; i < maxOrderRoot-1; i++ {
+		root.Square(&root)
+	}
+	if root.Equal(&one) {
+		t.Fatal("root of unity has order smaller than 2**maxOrderRoot")
+	}
+	if !root.Square(&root).Equal(&one) {
+		t.Fatal("root of unity doesn't have order 2**maxOrderRoot")
+	}
+}
+
+func TestFFT(t *testing.T) {
+	const maxSize = 1 << 10
+
+	domain := NewDomain(maxSize, 1, true)
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 5
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("DIF FFT on every coset should be consistent with dual basis", prop.ForAll(
+
+		// checks that a random evaluation of a dual function eval(gen**ithpower) is consistent with the FFT result
+		func(ithpower int) bool {
+			for coset := uint64(0); coset < 2; coset++ {
+				pol := make([]babybear.Element, maxSize)
+				backupPol := make([]babybear.Element, maxSize)
+
+				for i := 0; i < maxSize; i++ {
+					pol[i].SetRandom()
+				}
+				copy(backupPol, pol)
+
+				domain.FFT(pol, DIF, coset)
+				BitReverse(pol)
+
+				sample := domain.Generator
+				sample.Exp(sample, big.NewInt(int64(ithpower)))
+				if coset == 1 {
+					sample.Mul(&sample, &domain.FinerGenerator)
+				}
+
+				eval := evaluatePolynomial(backupPol, sample)
+				if !eval.Equal(&pol[ithpower]) {
+					return false
+				}
+			}
+			return true
+		},
+		gen.IntRange(0, maxSize-1),
+	))
+
+	properties.Property("FFTInverse(FFT(P)) should be P", prop.ForAll(
+		func(decimation int) bool {
+			pol := make([]babybear.Element, maxSize)
+			backupPol := make([]babybear.Element, maxSize)
+
+			for i := 0; i < maxSize; i++ {
+				pol[i].SetRandom()
+			}
+			copy(backupPol, pol)
+
+			if Decimation(decimation) == DIF {
+				domain.FFT(pol, DIF, 1)
+				domain.FFTInverse(pol, DIT, 1)
+			} else {
+				BitReverse(pol)
+				domain.FFT(pol, DIT, 0)
+				domain.FFTInverse(pol, DIF, 0)
+				BitReverse(pol)
+			}
+
+			for i := 0; i < maxSize; i++ {
+				if !pol[i].Equal(&backupPol[i]) {
+					return false
+				}
+			}
+			return true
+		},
+		gen.IntRange(int(DIT), int(DIF)),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func BenchmarkFFT(b *testing.B) {
+	const maxSize = 1 << 20
+
+	pol := make([]babybear.Element, maxSize)
+	for i := 0; i < maxSize; i++ {
+		pol[i].SetRandom()
+	}
+	domain := NewDomain(maxSize, 0, false)
+
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		domain.FFT(pol, DIF, 0)
+	}
+}
+
+func evaluatePolynomial(pol []babybear.Element, val babybear.Element) babybear.Element {
+	var acc, res, tmp babybear.Element
+	acc.SetOne()
+	for i := 0; i < len(pol); i++ {
+		tmp.Mul(&pol[i], &acc)
+		res.Add(&res, &tmp)
+		acc.Mul(&acc, &val)
+	}
+	return res
+}
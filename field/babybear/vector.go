@@ -0,0 +1,245 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Vector represents a slice of Element.
+//
+// The arithmetic methods operate on whole slices and panic if the lengths of the
+// operands don't match.
+// The methods suffixed by Parallel split the work between runtime.NumCPU() goroutines.
+type Vector []Element
+
+// AddParallel is the parallel variant of Add
+func (vector *Vector) AddParallel(a, b Vector) {
+	checkLengths("AddParallel", len(*vector), len(a), len(b))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.Add(a[start:end], b[start:end])
+	})
+}
+
+// SubParallel is the parallel variant of Sub
+func (vector *Vector) SubParallel(a, b Vector) {
+	checkLengths("SubParallel", len(*vector), len(a), len(b))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.Sub(a[start:end], b[start:end])
+	})
+}
+
+// MulParallel is the parallel variant of Mul
+func (vector *Vector) MulParallel(a, b Vector) {
+	checkLengths("MulParallel", len(*vector), len(a), len(b))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.Mul(a[start:end], b[start:end])
+	})
+}
+
+// ScalarMulParallel is the parallel variant of ScalarMul
+func (vector *Vector) ScalarMulParallel(a Vector, b *Element) {
+	checkLengths("ScalarMulParallel", len(*vector), len(a))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.ScalarMul(a[start:end], b)
+	})
+}
+
+// MulAccumulateParallel is the parallel variant of MulAccumulate
+func (vector *Vector) MulAccumulateParallel(a, b Vector) {
+	checkLengths("MulAccumulateParallel", len(*vector), len(a), len(b))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.MulAccumulate(a[start:end], b[start:end])
+	})
+}
+
+// SumParallel is the parallel variant of Sum
+func (vector *Vector) SumParallel() (res Element) {
+	var lock sync.Mutex
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		partial := chunk.Sum()
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	})
+	return
+}
+
+// InnerProductParallel is the parallel variant of InnerProduct
+func (vector *Vector) InnerProductParallel(other Vector) (res Element) {
+	checkLengths("InnerProductParallel", len(*vector), len(other))
+	var lock sync.Mutex
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		partial := chunk.InnerProduct(other[start:end])
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	})
+	return
+}
+
+func addVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(res); i++ {
+		res[i].Add(&a[i], &b[i])
+	}
+}
+
+func subVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(res); i++ {
+		res[i].Sub(&a[i], &b[i])
+	}
+}
+
+func mulVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(res); i++ {
+		res[i].Mul(&a[i], &b[i])
+	}
+}
+
+func scalarMulVecGeneric(res, a Vector, b *Element) {
+	for i := 0; i < len(res); i++ {
+		res[i].Mul(&a[i], b)
+	}
+}
+
+func mulAccVecGeneric(res, a, b Vector) {
+	var t Element
+	for i := 0; i < len(res); i++ {
+		t.Mul(&a[i], &b[i])
+		res[i].Add(&res[i], &t)
+	}
+}
+
+func sumVecGeneric(res *Element, a Vector) {
+	for i := 0; i < len(a); i++ {
+		res.Add(res, &a[i])
+	}
+}
+
+func innerProductVecGeneric(res *Element, a, b Vector) {
+	var acc WideAccumulator
+	for i := 0; i < len(a); i++ {
+		acc.MulAdd(&a[i], &b[i])
+	}
+	t := acc.Reduce()
+	res.Add(res, &t)
+}
+
+func checkLengths(method string, n int, others ...int) {
+	for _, m := range others {
+		if m != n {
+			panic("vector." + method + ": vectors don't have the same length")
+		}
+	}
+}
+
+// minParallelChunk is the minimal number of elements processed by a goroutine
+// in the Parallel methods
+const minParallelChunk = 1 << 12
+
+// execute splits [0, n) in chunks of at least minParallelChunk elements (multiple of 8,
+// the block size of the vector kernels) and processes them concurrently with work
+func execute(n int, work func(start, end int)) {
+	nbTasks := runtime.NumCPU()
+	if max := n / minParallelChunk; nbTasks > max {
+		nbTasks = max
+	}
+	if nbTasks <= 1 {
+		work(0, n)
+		return
+	}
+	chunk := (n + nbTasks - 1) / nbTasks
+	chunk = (chunk + 7) &^ 7
+
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			work(start, end)
+			wg.Done()
+		}(start, end)
+	}
+	wg.Wait()
+}
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	checkLengths("Add", len(*vector), len(a), len(b))
+	addVecGeneric(*vector, a, b)
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	checkLengths("Sub", len(*vector), len(a), len(b))
+	subVecGeneric(*vector, a, b)
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	checkLengths("Mul", len(*vector), len(a), len(b))
+	mulVecGeneric(*vector, a, b)
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *Element) {
+	checkLengths("ScalarMul", len(*vector), len(a))
+	scalarMulVecGeneric(*vector, a, b)
+}
+
+// MulAccumulate sets self[i] += a[i] * b[i].
+// It panics if the vectors don't have the same length.
+func (vector *Vector) MulAccumulate(a, b Vector) {
+	checkLengths("MulAccumulate", len(*vector), len(a), len(b))
+	mulAccVecGeneric(*vector, a, b)
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res Element) {
+	sumVecGeneric(&res, *vector)
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res Element) {
+	checkLengths("InnerProduct", len(*vector), len(other))
+	innerProductVecGeneric(&res, *vector, other)
+	return
+}
@@ -0,0 +1,231 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+import (
+	"testing"
+
+	"github.com/leanovate/gopter"
+	ggen "github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestVectorOps(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	// lengths cover the tails of the kernels processing 8 elements at a time
+	genN := ggen.IntRange(0, 100)
+
+	properties.Property("vector operations should match element-wise operations", prop.ForAll(
+		func(n int) bool {
+			a, b, c := randomVector(n), randomVector(n), randomVector(n)
+			var s Element
+			s.SetRandom()
+			return checkVectorOps(a, b, c, &s)
+		},
+		genN,
+	))
+
+	properties.Property("vector operations should handle q-1 operands", prop.ForAll(
+		func(n int) bool {
+			a, b, c := make(Vector, n), make(Vector, n), make(Vector, n)
+			for i := 0; i < n; i++ {
+				a[i].SetOne()
+				a[i].Neg(&a[i])
+				b[i] = a[i]
+				c[i] = a[i]
+			}
+			return checkVectorOps(a, b, c, &a[0])
+		},
+		ggen.IntRange(1, 100),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestVectorOpsParallel(t *testing.T) {
+	const n = 3*minParallelChunk + 5
+	a, b, c := randomVector(n), randomVector(n), randomVector(n)
+	var s Element
+	s.SetRandom()
+
+	var expected, got Vector
+	expected, got = make(Vector, n), make(Vector, n)
+
+	expected.Add(a, b)
+	got.AddParallel(a, b)
+	assertVectorEqual(t, "AddParallel", expected, got)
+
+	expected.Sub(a, b)
+	got.SubParallel(a, b)
+	assertVectorEqual(t, "SubParallel", expected, got)
+
+	expected.Mul(a, b)
+	got.MulParallel(a, b)
+	assertVectorEqual(t, "MulParallel", expected, got)
+
+	expected.ScalarMul(a, &s)
+	got.ScalarMulParallel(a, &s)
+	assertVectorEqual(t, "ScalarMulParallel", expected, got)
+
+	copy(expected, c)
+	copy(got, c)
+	expected.MulAccumulate(a, b)
+	got.MulAccumulateParallel(a, b)
+	assertVectorEqual(t, "MulAccumulateParallel", expected, got)
+
+	if sum, sumParallel := a.Sum(), a.SumParallel(); !sum.Equal(&sumParallel) {
+		t.Fatal("SumParallel doesn't match Sum")
+	}
+	if ip, ipParallel := a.InnerProduct(b), a.InnerProductParallel(b); !ip.Equal(&ipParallel) {
+		t.Fatal("InnerProductParallel doesn't match InnerProduct")
+	}
+}
+
+func TestVectorLengthMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic on vectors of different lengths")
+		}
+	}()
+	v := make(Vector, 2)
+	v.Add(make(Vector, 2), make(Vector, 3))
+}
+
+func checkVectorOps(a, b, c Vector, s *Element) bool {
+	n := len(a)
+	res := make(Vector, n)
+	ok := true
+	check := func(expected func(i int) Element) {
+		for i := 0; i < n; i++ {
+			e := expected(i)
+			ok = ok && res[i].Equal(&e)
+		}
+	}
+
+	res.Add(a, b)
+	check(func(i int) (e Element) { return *e.Add(&a[i], &b[i]) })
+
+	res.Sub(a, b)
+	check(func(i int) (e Element) { return *e.Sub(&a[i], &b[i]) })
+
+	res.Mul(a, b)
+	check(func(i int) (e Element) { return *e.Mul(&a[i], &b[i]) })
+
+	res.ScalarMul(a, s)
+	check(func(i int) (e Element) { return *e.Mul(&a[i], s) })
+
+	copy(res, c)
+	res.MulAccumulate(a, b)
+	check(func(i int) (e Element) {
+		e.Mul(&a[i], &b[i])
+		return *e.Add(&e, &c[i])
+	})
+
+	// in place
+	copy(res, a)
+	res.Mul(res, b)
+	check(func(i int) (e Element) { return *e.Mul(&a[i], &b[i]) })
+
+	var sum, innerProduct, t Element
+	for i := 0; i < n; i++ {
+		sum.Add(&sum, &a[i])
+		t.Mul(&a[i], &b[i])
+		innerProduct.Add(&innerProduct, &t)
+	}
+	gotSum := a.Sum()
+	gotInnerProduct := a.InnerProduct(b)
+
+	return ok && gotSum.Equal(&sum) && gotInnerProduct.Equal(&innerProduct)
+}
+
+func assertVectorEqual(t *testing.T, method string, expected, got Vector) {
+	for i := range expected {
+		if !expected[i].Equal(&got[i]) {
+			t.Fatalf("%s: mismatch at index %d", method, i)
+		}
+	}
+}
+
+func randomVector(n int) Vector {
+	v := make(Vector, n)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const n = 1 << 16
+	a, c := randomVector(n), randomVector(n)
+	res := make(Vector, n)
+	var s Element
+	s.SetRandom()
+
+	b.Run("add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run("sub", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Sub(a, c)
+		}
+	})
+	b.Run("mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run("scalarMul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMul(a, &s)
+		}
+	})
+	b.Run("mulAccumulate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.MulAccumulate(a, c)
+		}
+	})
+	b.Run("sum", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchResElement = a.Sum()
+		}
+	})
+	b.Run("innerProduct", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchResElement = a.InnerProduct(c)
+		}
+	})
+	b.Run("mulParallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.MulParallel(a, c)
+		}
+	})
+	b.Run("innerProductParallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchResElement = a.InnerProductParallel(c)
+		}
+	})
+}
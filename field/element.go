@@ -0,0 +1,63 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package field
+
+import "math/big"
+
+// Element is the type constraint satisfied by the pointer to every generated Element,
+// such as *fr.Element of any curve. It allows writing algorithms once for all fields:
+//
+//	func Sum[T any, PT field.Element[T]](v []T) T {
+//		var res T
+//		for i := range v {
+//			PT(&res).Add(&res, &v[i])
+//		}
+//		return res
+//	}
+//
+// T is the element type and PT = *T carries the methods.
+type Element[T any] interface {
+	*T
+
+	Set(x *T) *T
+	SetZero() *T
+	SetOne() *T
+	SetUint64(v uint64) *T
+	SetBigInt(v *big.Int) *T
+	SetString(s string) *T
+	SetBytes(e []byte) *T
+	SetRandom() (*T, error)
+
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Mul(x, y *T) *T
+	Div(x, y *T) *T
+	Double(x *T) *T
+	Square(x *T) *T
+	Neg(x *T) *T
+	Inverse(x *T) *T
+	Exp(x T, exponent *big.Int) *T
+	Sqrt(x *T) *T
+	Legendre() int
+	Butterfly(b *T)
+
+	Equal(x *T) bool
+	IsZero() bool
+	Cmp(x *T) int
+
+	Marshal() []byte
+	ToBigIntRegular(res *big.Int) *big.Int
+	String() string
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package field_test
+
+import (
+	"testing"
+
+	fpbls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	frbls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	frbn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	frbw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	fpsecp256k1 "github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/field"
+)
+
+func sum[T any, PT field.Element[T]](v []T) T {
+	var res T
+	for i := range v {
+		PT(&res).Add(&res, &v[i])
+	}
+	return res
+}
+
+// checkSum checks sum(1, ..., n) == n(n+1)/2 on the field of T
+func checkSum[T any, PT field.Element[T]](t *testing.T, n uint64) {
+	v := make([]T, n)
+	for i := range v {
+		PT(&v[i]).SetUint64(uint64(i + 1))
+	}
+	res := sum[T, PT](v)
+
+	var expected T
+	PT(&expected).SetUint64(n * (n + 1) / 2)
+	if !PT(&res).Equal(&expected) {
+		t.Fatal("generic sum failed")
+	}
+}
+
+func TestElementConstraint(t *testing.T) {
+	checkSum[frbn254.Element](t, 100)
+	checkSum[frbls12381.Element](t, 100)
+	checkSum[fpbls12381.Element](t, 100)
+	checkSum[frbw6761.Element](t, 100)
+	checkSum[fpsecp256k1.Element](t, 100)
+}
@@ -0,0 +1,255 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fft provides in-place discrete Fourier transform over any field satisfying field.Element.
+//
+// It has the same API as the per-curve packages (e.g. ecc/bn254/fr/fft), which remain the
+// fastest option for a given field, except that NewDomain takes the 2-adic root of unity
+// of the field as a parameter.
+package fft
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"runtime"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field"
+)
+
+// Domain with a power of 2 cardinality, over the field of T
+// compute a field element of order 2x and store it in FinerGenerator
+// all other values can be derived from x, GeneratorSqrt
+type Domain[T any, PT field.Element[T]] struct {
+	Cardinality             uint64
+	Depth                   uint64
+	PrecomputeReversedTable uint64
+	CardinalityInv          T
+	Generator               T
+	GeneratorInv            T
+	FinerGenerator          T
+	FinerGeneratorInv       T
+
+	// the following slices are computed through domain.preComputeTwiddles()
+
+	// Twiddles factor for the FFT using Generator for each stage of the recursive FFT
+	Twiddles [][]T
+
+	// Twiddles factor for the FFT using GeneratorInv for each stage of the recursive FFT
+	TwiddlesInv [][]T
+
+	// we precompute these mostly to avoid the memory intensive bit reverse permutation in the groth16.Prover
+
+	// CosetTable[i][j] = domain.Generator(i-th)Sqrt ^ j
+	// CosetTable = fft.BitReverse(CosetTable)
+	CosetTable         [][]T
+	CosetTableReversed [][]T // optional, this is computed on demand at the creation of the domain
+
+	// CosetTable[i][j] = domain.Generator(i-th)SqrtInv ^ j
+	// CosetTableInv = fft.BitReverse(CosetTableInv)
+	CosetTableInv         [][]T
+	CosetTableInvReversed [][]T // optional, this is computed on demand at the creation of the domain
+}
+
+// NewDomain returns a subgroup with a power of 2 cardinality
+// cardinality >= m
+// rootOfUnity is a generator of the largest 2-adic subgroup of the field, of order 2**maxOrderRoot.
+// If depth>0, the Domain will also store a primitive (2**depth)*m root
+// of 1, with associated precomputed data. This allows to perform shifted
+// FFT/FFTInv.
+// If precomputeReversedCosetTable is set, the bit reversed cosetTable/cosetTableInv are precomputed.
+//
+// example:
+// --------
+//
+// * NewDomain[T, PT](m, 0, root, maxOrder, false) outputs a new domain to perform the fft on Z/mZ.
+// * NewDomain[T, PT](m, 2, root, maxOrder, false) outputs a new domain to perform fft on Z/mZ, plus a primitive
+// 2**2*m=4m-th root of 1 and associated data to compute fft/fftinv on the cosets of
+// (Z/4mZ)/(Z/mZ).
+func NewDomain[T any, PT field.Element[T]](m, depth uint64, rootOfUnity T, maxOrderRoot uint64, precomputeReversedTable bool) *Domain[T, PT] {
+
+	domain := &Domain[T, PT]{}
+	x := ecc.NextPowerOfTwo(m)
+	domain.Cardinality = uint64(x)
+	domain.Depth = depth
+	if precomputeReversedTable {
+		domain.PrecomputeReversedTable = 1
+	}
+
+	// find generator for Z/2^(log(m))Z  and Z/2^(log(m)+cosets)Z
+	logx := uint64(bits.TrailingZeros64(x))
+	if logx > maxOrderRoot {
+		panic(fmt.Sprintf("m (%d) is too big: the required root of unity does not exist", m))
+	}
+	logGen := logx + depth
+	if logGen > maxOrderRoot {
+		panic("log(m) + cosets is too big: the required root of unity does not exist")
+	}
+
+	expo := uint64(1 << (maxOrderRoot - logGen))
+	bExpo := new(big.Int).SetUint64(expo)
+	PT(&domain.FinerGenerator).Exp(rootOfUnity, bExpo)
+	PT(&domain.FinerGeneratorInv).Inverse(&domain.FinerGenerator)
+
+	// Generator = FinerGenerator^2 has order x
+	expo = uint64(1 << (maxOrderRoot - logx))
+	bExpo.SetUint64(expo)
+	PT(&domain.Generator).Exp(rootOfUnity, bExpo) // order x
+	PT(&domain.GeneratorInv).Inverse(&domain.Generator)
+	PT(&domain.CardinalityInv).SetUint64(uint64(x))
+	PT(&domain.CardinalityInv).Inverse(&domain.CardinalityInv)
+
+	// twiddle factors
+	domain.preComputeTwiddles()
+
+	// store the bit reversed coset tables if needed
+	if depth > 0 && precomputeReversedTable {
+		domain.reverseCosetTables()
+	}
+
+	return domain
+}
+
+func (d *Domain[T, PT]) reverseCosetTables() {
+	nbCosets := (1 << d.Depth) - 1
+	d.CosetTableReversed = make([][]T, nbCosets)
+	d.CosetTableInvReversed = make([][]T, nbCosets)
+	for i := 0; i < nbCosets; i++ {
+		d.CosetTableReversed[i] = make([]T, d.Cardinality)
+		d.CosetTableInvReversed[i] = make([]T, d.Cardinality)
+		copy(d.CosetTableReversed[i], d.CosetTable[i])
+		copy(d.CosetTableInvReversed[i], d.CosetTableInv[i])
+		BitReverse[T](d.CosetTableReversed[i])
+		BitReverse[T](d.CosetTableInvReversed[i])
+	}
+}
+
+func (d *Domain[T, PT]) preComputeTwiddles() {
+
+	// nb fft stages
+	nbStages := uint64(bits.TrailingZeros64(d.Cardinality))
+	nbCosets := (1 << d.Depth) - 1
+
+	d.Twiddles = make([][]T, nbStages)
+	d.TwiddlesInv = make([][]T, nbStages)
+	d.CosetTable = make([][]T, nbCosets)
+	d.CosetTableInv = make([][]T, nbCosets)
+	for i := 0; i < nbCosets; i++ {
+		d.CosetTable[i] = make([]T, d.Cardinality)
+		d.CosetTableInv[i] = make([]T, d.Cardinality)
+	}
+
+	var wg sync.WaitGroup
+
+	// for each fft stage, we pre compute the twiddle factors
+	twiddles := func(t [][]T, omega T) {
+		for i := uint64(0); i < nbStages; i++ {
+			t[i] = make([]T, 1+(1<<(nbStages-i-1)))
+			var w T
+			if i == 0 {
+				w = omega
+			} else {
+				w = t[i-1][2]
+			}
+			PT(&t[i][0]).SetOne()
+			t[i][1] = w
+			for j := 2; j < len(t[i]); j++ {
+				PT(&t[i][j]).Mul(&t[i][j-1], &w)
+			}
+		}
+		wg.Done()
+	}
+
+	expTable := func(sqrt T, t []T) {
+		PT(&t[0]).SetOne()
+		precomputeExpTable[T, PT](sqrt, t)
+		wg.Done()
+	}
+
+	if nbCosets > 0 {
+		cosetGens := make([]T, nbCosets)
+		cosetGensInv := make([]T, nbCosets)
+		PT(&cosetGens[0]).Set(&d.FinerGenerator)
+		PT(&cosetGensInv[0]).Set(&d.FinerGeneratorInv)
+		for i := 1; i < nbCosets; i++ {
+			PT(&cosetGens[i]).Mul(&cosetGens[i-1], &d.FinerGenerator)
+			PT(&cosetGensInv[i]).Mul(&cosetGensInv[i-1], &d.FinerGeneratorInv)
+		}
+		wg.Add(2 + 2*nbCosets)
+		go twiddles(d.Twiddles, d.Generator)
+		go twiddles(d.TwiddlesInv, d.GeneratorInv)
+		for i := 0; i < nbCosets-1; i++ {
+			go expTable(cosetGens[i], d.CosetTable[i])
+			go expTable(cosetGensInv[i], d.CosetTableInv[i])
+		}
+		go expTable(cosetGens[nbCosets-1], d.CosetTable[nbCosets-1])
+		expTable(cosetGensInv[nbCosets-1], d.CosetTableInv[nbCosets-1])
+
+		wg.Wait()
+
+	} else {
+		wg.Add(2)
+		go twiddles(d.Twiddles, d.Generator)
+		twiddles(d.TwiddlesInv, d.GeneratorInv)
+		wg.Wait()
+	}
+
+}
+
+func precomputeExpTable[T any, PT field.Element[T]](w T, table []T) {
+	n := len(table)
+
+	// see if it makes sense to parallelize exp tables pre-computation
+	interval := 0
+	if runtime.NumCPU() >= 4 {
+		interval = (n - 1) / (runtime.NumCPU() / 4)
+	}
+
+	// this ratio roughly correspond to the number of multiplication one can do in place of a Exp operation
+	const ratioExpMul = 6000 / 17
+
+	if interval < ratioExpMul {
+		precomputeExpTableChunk[T, PT](w, 1, table[1:])
+		return
+	}
+
+	// we parallelize
+	var wg sync.WaitGroup
+	for i := 1; i < n; i += interval {
+		start := i
+		end := i + interval
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func() {
+			precomputeExpTableChunk[T, PT](w, uint64(start), table[start:end])
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+}
+
+func precomputeExpTableChunk[T any, PT field.Element[T]](w T, power uint64, table []T) {
+
+	// this condition ensures that creating a domain of size 1 with cosets don't fail
+	if len(table) > 0 {
+		PT(&table[0]).Exp(w, new(big.Int).SetUint64(power))
+		for i := 1; i < len(table); i++ {
+			PT(&table[i]).Mul(&table[i-1], &w)
+		}
+	}
+}
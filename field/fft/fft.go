@@ -0,0 +1,322 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+import (
+	"math/bits"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// Decimation is used in the FFT call to select decimation in time or in frequency
+type Decimation uint8
+
+const (
+	DIT Decimation = iota
+	DIF
+)
+
+// parallelize threshold for a single butterfly op, if the fft stage is not parallelized already
+const butterflyThreshold = 16
+
+// FFT computes (recursively) the discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// coset sets the shift of the fft (0 = no shift, standard fft)
+// len(a) must be a power of 2, and w must be a len(a)th root of unity in field F.
+//
+// example:
+// -------
+// domain := NewDomain(m, 2) -->  contains precomputed data for Z/mZ, and Z/4mZ
+// FFT(pol, DIT, 1) --> evaluates pol on the coset 1 in (Z/4mZ)/(Z/mZ)
+func (domain *Domain[T, PT]) FFT(a []T, decimation Decimation, coset uint64) {
+
+	numCPU := uint64(runtime.NumCPU())
+
+	// if coset != 0, scale by coset table
+	if coset != 0 {
+		scale := func(cosetTable []T) {
+			parallel.Execute(len(a), func(start, end int) {
+				for i := start; i < end; i++ {
+					PT(&a[i]).Mul(&a[i], &cosetTable[i])
+				}
+			})
+		}
+		if decimation == DIT {
+			if domain.PrecomputeReversedTable == 0 {
+				// no precomputed coset, we adjust the index of the coset table
+				n := uint64(len(a))
+				nn := uint64(64 - bits.TrailingZeros64(n))
+				parallel.Execute(len(a), func(start, end int) {
+					for i := start; i < end; i++ {
+						irev := bits.Reverse64(uint64(i)) >> nn
+						PT(&a[i]).Mul(&a[i], &domain.CosetTable[coset-1][int(irev)])
+					}
+				})
+			} else {
+				scale(domain.CosetTableReversed[coset-1])
+			}
+		} else {
+			scale(domain.CosetTable[coset-1])
+		}
+	}
+
+	// find the stage where we should stop spawning go routines in our recursive calls
+	// (ie when we have as many go routines running as we have available CPUs)
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(numCPU))
+	if numCPU <= 1 {
+		maxSplits = -1
+	}
+
+	switch decimation {
+	case DIF:
+		difFFT[T, PT](a, domain.Twiddles, 0, maxSplits, nil)
+	case DIT:
+		ditFFT[T, PT](a, domain.Twiddles, 0, maxSplits, nil)
+	default:
+		panic("not implemented")
+	}
+}
+
+// FFTInverse computes (recursively) the inverse discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// coset sets the shift of the fft (0 = no shift, standard fft)
+// len(a) must be a power of 2, and w must be a len(a)th root of unity in field F.
+func (domain *Domain[T, PT]) FFTInverse(a []T, decimation Decimation, coset uint64) {
+
+	numCPU := uint64(runtime.NumCPU())
+
+	// find the stage where we should stop spawning go routines in our recursive calls
+	// (ie when we have as many go routines running as we have available CPUs)
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(numCPU))
+	if numCPU <= 1 {
+		maxSplits = -1
+	}
+	switch decimation {
+	case DIF:
+		difFFT[T, PT](a, domain.TwiddlesInv, 0, maxSplits, nil)
+	case DIT:
+		ditFFT[T, PT](a, domain.TwiddlesInv, 0, maxSplits, nil)
+	default:
+		panic("not implemented")
+	}
+
+	// scale by CardinalityInv (+ cosetTableInv is coset!=0)
+	if coset == 0 {
+		parallel.Execute(len(a), func(start, end int) {
+			for i := start; i < end; i++ {
+				PT(&a[i]).Mul(&a[i], &domain.CardinalityInv)
+			}
+		})
+		return
+	}
+
+	scale := func(cosetTable []T) {
+		parallel.Execute(len(a), func(start, end int) {
+			for i := start; i < end; i++ {
+				PT(&a[i]).Mul(&a[i], &cosetTable[i])
+				PT(&a[i]).Mul(&a[i], &domain.CardinalityInv)
+			}
+		})
+	}
+	if decimation == DIT {
+		scale(domain.CosetTableInv[coset-1])
+		return
+	}
+
+	// decimation == DIF
+	if domain.PrecomputeReversedTable != 0 {
+		scale(domain.CosetTableInvReversed[coset-1])
+		return
+	}
+
+	// no precomputed coset, we adjust the index of the coset table
+	n := uint64(len(a))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			irev := bits.Reverse64(uint64(i)) >> nn
+			PT(&a[i]).Mul(&a[i], &domain.CosetTableInv[coset-1][int(irev)])
+			PT(&a[i]).Mul(&a[i], &domain.CardinalityInv)
+		}
+	})
+
+}
+
+func difFFT[T any, PT field.Element[T]](a []T, twiddles [][]T, stage, maxSplits int, chDone chan struct{}) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+
+	n := len(a)
+	if n == 1 {
+		return
+	} else if n == 8 {
+		kerDIF8[T, PT](a, twiddles, stage)
+		return
+	}
+	m := n >> 1
+
+	// if stage < maxSplits, we parallelize this butterfly
+	// but we have only numCPU / stage cpus available
+	if (m > butterflyThreshold) && (stage < maxSplits) {
+		// 1 << stage == estimated used CPUs
+		numCPU := runtime.NumCPU() / (1 << (stage))
+		parallel.Execute(m, func(start, end int) {
+			for i := start; i < end; i++ {
+				butterfly[T, PT](&a[i], &a[i+m])
+				PT(&a[i+m]).Mul(&a[i+m], &twiddles[stage][i])
+			}
+		}, numCPU)
+	} else {
+		// i == 0
+		butterfly[T, PT](&a[0], &a[m])
+		for i := 1; i < m; i++ {
+			butterfly[T, PT](&a[i], &a[i+m])
+			PT(&a[i+m]).Mul(&a[i+m], &twiddles[stage][i])
+		}
+	}
+
+	if m == 1 {
+		return
+	}
+
+	nextStage := stage + 1
+	if stage < maxSplits {
+		chDone := make(chan struct{}, 1)
+		go difFFT[T, PT](a[m:n], twiddles, nextStage, maxSplits, chDone)
+		difFFT[T, PT](a[0:m], twiddles, nextStage, maxSplits, nil)
+		<-chDone
+	} else {
+		difFFT[T, PT](a[0:m], twiddles, nextStage, maxSplits, nil)
+		difFFT[T, PT](a[m:n], twiddles, nextStage, maxSplits, nil)
+	}
+
+}
+
+func ditFFT[T any, PT field.Element[T]](a []T, twiddles [][]T, stage, maxSplits int, chDone chan struct{}) {
+	if chDone != nil {
+		defer close(chDone)
+	}
+	n := len(a)
+	if n == 1 {
+		return
+	} else if n == 8 {
+		kerDIT8[T, PT](a, twiddles, stage)
+		return
+	}
+	m := n >> 1
+
+	nextStage := stage + 1
+
+	if stage < maxSplits {
+		// that's the only time we fire go routines
+		chDone := make(chan struct{}, 1)
+		go ditFFT[T, PT](a[m:], twiddles, nextStage, maxSplits, chDone)
+		ditFFT[T, PT](a[0:m], twiddles, nextStage, maxSplits, nil)
+		<-chDone
+	} else {
+		ditFFT[T, PT](a[0:m], twiddles, nextStage, maxSplits, nil)
+		ditFFT[T, PT](a[m:n], twiddles, nextStage, maxSplits, nil)
+
+	}
+
+	// if stage < maxSplits, we parallelize this butterfly
+	// but we have only numCPU / stage cpus available
+	if (m > butterflyThreshold) && (stage < maxSplits) {
+		// 1 << stage == estimated used CPUs
+		numCPU := runtime.NumCPU() / (1 << (stage))
+		parallel.Execute(m, func(start, end int) {
+			for k := start; k < end; k++ {
+				PT(&a[k+m]).Mul(&a[k+m], &twiddles[stage][k])
+				butterfly[T, PT](&a[k], &a[k+m])
+			}
+		}, numCPU)
+
+	} else {
+		butterfly[T, PT](&a[0], &a[m])
+		for k := 1; k < m; k++ {
+			PT(&a[k+m]).Mul(&a[k+m], &twiddles[stage][k])
+			butterfly[T, PT](&a[k], &a[k+m])
+		}
+	}
+}
+
+// BitReverse applies the bit-reversal permutation to a.
+// len(a) must be a power of 2 (as in every single function in this file)
+func BitReverse[T any](a []T) {
+	n := uint64(len(a))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		irev := bits.Reverse64(i) >> nn
+		if irev > i {
+			a[i], a[irev] = a[irev], a[i]
+		}
+	}
+}
+
+// butterfly computes (a, b) <- (a + b, a - b)
+func butterfly[T any, PT field.Element[T]](a, b *T) {
+	PT(a).Butterfly(b)
+}
+
+// kerDIT8 is a kernel that process a FFT of size 8
+func kerDIT8[T any, PT field.Element[T]](a []T, twiddles [][]T, stage int) {
+
+	butterfly[T, PT](&a[0], &a[1])
+	butterfly[T, PT](&a[2], &a[3])
+	butterfly[T, PT](&a[4], &a[5])
+	butterfly[T, PT](&a[6], &a[7])
+	butterfly[T, PT](&a[0], &a[2])
+	PT(&a[3]).Mul(&a[3], &twiddles[stage+1][1])
+	butterfly[T, PT](&a[1], &a[3])
+	butterfly[T, PT](&a[4], &a[6])
+	PT(&a[7]).Mul(&a[7], &twiddles[stage+1][1])
+	butterfly[T, PT](&a[5], &a[7])
+	butterfly[T, PT](&a[0], &a[4])
+	PT(&a[5]).Mul(&a[5], &twiddles[stage+0][1])
+	butterfly[T, PT](&a[1], &a[5])
+	PT(&a[6]).Mul(&a[6], &twiddles[stage+0][2])
+	butterfly[T, PT](&a[2], &a[6])
+	PT(&a[7]).Mul(&a[7], &twiddles[stage+0][3])
+	butterfly[T, PT](&a[3], &a[7])
+}
+
+// kerDIF8 is a kernel that process a FFT of size 8
+func kerDIF8[T any, PT field.Element[T]](a []T, twiddles [][]T, stage int) {
+
+	butterfly[T, PT](&a[0], &a[4])
+	butterfly[T, PT](&a[1], &a[5])
+	butterfly[T, PT](&a[2], &a[6])
+	butterfly[T, PT](&a[3], &a[7])
+	PT(&a[5]).Mul(&a[5], &twiddles[stage+0][1])
+	PT(&a[6]).Mul(&a[6], &twiddles[stage+0][2])
+	PT(&a[7]).Mul(&a[7], &twiddles[stage+0][3])
+	butterfly[T, PT](&a[0], &a[2])
+	butterfly[T, PT](&a[1], &a[3])
+	butterfly[T, PT](&a[4], &a[6])
+	butterfly[T, PT](&a[5], &a[7])
+	PT(&a[3]).Mul(&a[3], &twiddles[stage+1][1])
+	PT(&a[7]).Mul(&a[7], &twiddles[stage+1][1])
+	butterfly[T, PT](&a[0], &a[1])
+	butterfly[T, PT](&a[2], &a[3])
+	butterfly[T, PT](&a[4], &a[5])
+	butterfly[T, PT](&a[6], &a[7])
+}
@@ -0,0 +1,155 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+import (
+	"strconv"
+	"testing"
+
+	frbls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	fftbn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	"github.com/consensys/gnark-crypto/field"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func bn254Domain(m, depth uint64, precomputeReversedTable bool) *Domain[fr.Element, *fr.Element] {
+	var root fr.Element
+	root.SetString("19103219067921713944291392827692070036145651957329286315305642004821462161904")
+	return NewDomain[fr.Element, *fr.Element](m, depth, root, 28, precomputeReversedTable)
+}
+
+func TestFFT(t *testing.T) {
+	const maxSize = 1 << 10
+	const depth = 2
+
+	domain := bn254Domain(maxSize, depth, false)
+	domainWithPrecompute := bn254Domain(maxSize, depth, true)
+	reference := fftbn254.NewDomain(maxSize, depth, false)
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 6
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("generic FFT should match the bn254 FFT on every coset", prop.ForAll(
+		func(coset uint64) bool {
+			for _, decimation := range []Decimation{DIT, DIF} {
+				pol := make([]fr.Element, maxSize)
+				for i := range pol {
+					pol[i].SetRandom()
+				}
+				expected := make([]fr.Element, maxSize)
+				copy(expected, pol)
+				withPrecompute := make([]fr.Element, maxSize)
+				copy(withPrecompute, pol)
+
+				domain.FFT(pol, decimation, coset)
+				domainWithPrecompute.FFT(withPrecompute, decimation, coset)
+				reference.FFT(expected, fftbn254.Decimation(decimation), coset)
+				for i := range pol {
+					if !pol[i].Equal(&expected[i]) || !withPrecompute[i].Equal(&expected[i]) {
+						return false
+					}
+				}
+			}
+			return true
+		},
+		gen.UInt64Range(0, (1<<depth)-1),
+	))
+
+	properties.Property("generic FFTInverse should match the bn254 FFTInverse on every coset", prop.ForAll(
+		func(coset uint64) bool {
+			for _, decimation := range []Decimation{DIT, DIF} {
+				pol := make([]fr.Element, maxSize)
+				for i := range pol {
+					pol[i].SetRandom()
+				}
+				expected := make([]fr.Element, maxSize)
+				copy(expected, pol)
+				withPrecompute := make([]fr.Element, maxSize)
+				copy(withPrecompute, pol)
+
+				domain.FFTInverse(pol, decimation, coset)
+				domainWithPrecompute.FFTInverse(withPrecompute, decimation, coset)
+				reference.FFTInverse(expected, fftbn254.Decimation(decimation), coset)
+				for i := range pol {
+					if !pol[i].Equal(&expected[i]) || !withPrecompute[i].Equal(&expected[i]) {
+						return false
+					}
+				}
+			}
+			return true
+		},
+		gen.UInt64Range(0, (1<<depth)-1),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// roundTrip checks FFTInverse(FFT(p)) == p on the field of T
+func roundTrip[T any, PT field.Element[T]](t *testing.T, domain *Domain[T, PT]) {
+	pol := make([]T, domain.Cardinality)
+	for i := range pol {
+		PT(&pol[i]).SetRandom()
+	}
+	backup := make([]T, len(pol))
+	copy(backup, pol)
+
+	domain.FFT(pol, DIF, 1)
+	domain.FFTInverse(pol, DIT, 1)
+
+	for i := range pol {
+		if !PT(&pol[i]).Equal(&backup[i]) {
+			t.Fatal("FFTInverse(FFT(p)) != p")
+		}
+	}
+}
+
+func TestFFTOtherField(t *testing.T) {
+	var root frbls24315.Element
+	root.SetString("1792993287828780812362846131493071959406149719416102105453370749552622525216")
+	domain := NewDomain[frbls24315.Element, *frbls24315.Element](1<<8, 1, root, 22, false)
+	roundTrip(t, domain)
+}
+
+func BenchmarkFFT(b *testing.B) {
+	const maxSize = 1 << 20
+
+	pol := make([]fr.Element, maxSize)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+
+	for i := 8; i < 20; i += 4 {
+		n := 1 << i
+		domain := bn254Domain(uint64(n), 0, false)
+		reference := fftbn254.NewDomain(uint64(n), 0, false)
+
+		b.Run("generic/fft 2**"+strconv.Itoa(i), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				domain.FFT(pol[:n], DIF, 0)
+			}
+		})
+		b.Run("bn254/fft 2**"+strconv.Itoa(i), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				reference.FFT(pol[:n], fftbn254.DIF, 0)
+			}
+		})
+	}
+}
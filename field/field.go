@@ -18,10 +18,12 @@ package field
 import (
 	"errors"
 	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/internal/addchain"
 )
 
 var (
-	errUnsupportedModulus = errors.New("unsupported modulus. goff only works for prime modulus w/ size > 64bits")
+	errUnsupportedModulus = errors.New("unsupported modulus. goff only works for odd prime modulus")
 	errParseModulus       = errors.New("can't parse modulus")
 )
 
@@ -40,6 +42,7 @@ type Field struct {
 	QInverse             []uint64
 	QMinusOneHalvedP     []uint64 // ((q-1) / 2 ) + 1
 	ASM                  bool
+	ASMVector            bool // vector kernels (AVX-512 IFMA) are only implemented for 4-word moduli
 	RSquare              []uint64
 	One                  []uint64
 	LegendreExponent     string // big.Int to base16 string
@@ -54,10 +57,41 @@ type Field struct {
 	SqrtSMinusOneOver2   string   // big.Int to base16 string
 	SqrtQ3Mod4Exponent   string   // big.Int to base16 string
 	SqrtG                []uint64 // NonResidue ^  SqrtR (montgomery form)
+	SqrtWindow           int      // Tonelli-Shanks discrete logarithms are computed SqrtWindow bits at a time
+	SqrtNbWindows        int      // number of windows of the discrete logarithm (e = SqrtE bits)
+
+	// fixed exponentiations (Legendre, Sqrt and, for single word fields, Inverse) unrolled from addition chains
+	ExpChains []ExpChain
 
 	NonResidue []uint64 // (montgomery form)
+
+	// constant time inversion (Bernstein-Yang safegcd) on signed 62-bit limbs
+	Signed62Limbs   int      // number of limbs holding values in (-2q, q)
+	DivstepsBatches int      // number of batches of 62 divsteps bringing g to 0
+	QSigned62       []uint64 // q on 62-bit limbs
+	QInv62          uint64   // q**-1 mod 2**62
+
+	// moduli of at most 64 bits are generated from a dedicated single word template
+	SingleWord bool
+	Word       string // uint32 if q < 2**32, uint64 otherwise
+	WordBits   int    // 32 or 64
+	Reduction  string // reduction of double word products: "goldilocks", "mersenne31" or "montgomery"
+	QInvNeg    uint64 // -q**-1 mod 2**WordBits, used by the montgomery reduction
+}
+
+// ExpChain is a fixed exponentiation, generated as the method Name from an addition chain
+type ExpChain struct {
+	Name         string
+	Exponent     string // big.Int to base16 string
+	ConstantTime bool   // use mulCT and squareCT instead of Mul and Square
+	*addchain.Chain
 }
 
+const (
+	goldilocksModulus = "18446744069414584321" // 2**64 - 2**32 + 1
+	mersenne31Modulus = "2147483647"           // 2**31 - 1
+)
+
 // NewField returns a data structure with needed informations to generate apis for field element
 //
 // See field/generator package
@@ -78,12 +112,35 @@ func NewField(packageName, elementName, modulus string) (*Field, error) {
 	// pre compute field constants
 	F.NbBits = bModulus.BitLen()
 	F.NbWords = len(bModulus.Bits())
-	if F.NbWords < 2 {
+	if bModulus.Cmp(big.NewInt(3)) < 0 || bModulus.Bit(0) == 0 {
 		return nil, errUnsupportedModulus
 	}
 
 	F.NbWordsLastIndex = F.NbWords - 1
 
+	// Montgomery constant R = 2**rBits; the elements are stored as x*R mod q
+	rBits := uint(F.NbWords) * 64
+	if F.NbWords == 1 {
+		F.SingleWord = true
+		F.Word, F.WordBits = "uint64", 64
+		if F.NbBits <= 32 {
+			F.Word, F.WordBits = "uint32", 32
+		}
+		switch modulus {
+		case goldilocksModulus:
+			F.Reduction = "goldilocks"
+		case mersenne31Modulus:
+			F.Reduction = "mersenne31"
+		default:
+			F.Reduction = "montgomery"
+		}
+		rBits = uint(F.WordBits)
+		if F.Reduction != "montgomery" {
+			// special primes have a fast reduction of the regular form
+			rBits = 0
+		}
+	}
+
 	// set q from big int repr
 	F.Q = toUint64Slice(&bModulus)
 	_qHalved := big.NewInt(0)
@@ -99,16 +156,20 @@ func NewField(packageName, elementName, modulus string) (*Field, error) {
 	extendedEuclideanAlgo(_r, &bModulus, _rInv, _qInv)
 	_qInv.Mod(_qInv, _r)
 	F.QInverse = toUint64Slice(_qInv, F.NbWords)
+	if F.SingleWord {
+		_qInv.Mod(_qInv, new(big.Int).Lsh(bOne, uint(F.WordBits)))
+		F.QInvNeg = _qInv.Uint64()
+	}
 
 	//  rsquare
 	_rSquare := big.NewInt(2)
-	exponent := big.NewInt(int64(F.NbWords) * 64 * 2)
+	exponent := big.NewInt(int64(rBits) * 2)
 	_rSquare.Exp(_rSquare, exponent, &bModulus)
 	F.RSquare = toUint64Slice(_rSquare, F.NbWords)
 
 	var one big.Int
 	one.SetUint64(1)
-	one.Lsh(&one, uint(F.NbWords)*64).Mod(&one, &bModulus)
+	one.Lsh(&one, rBits).Mod(&one, &bModulus)
 	F.One = toUint64Slice(&one, F.NbWords)
 
 	// indexes (template helpers)
@@ -151,7 +212,7 @@ func NewField(packageName, elementName, modulus string) (*Field, error) {
 	} else {
 		// q ≡ 1 (mod 4)
 		qMod.SetUint64(8)
-		if qMod.Mod(&bModulus, &qMod).Cmp(new(big.Int).SetUint64(5)) == 0 {
+		if qMod.Mod(&bModulus, &qMod).Cmp(new(big.Int).SetUint64(5)) == 0 && !F.SingleWord {
 			// q ≡ 5 (mod 8)
 			// use Atkin's algorithm
 			// see modSqrt5Mod8Prime in math/big/int.go
@@ -184,27 +245,106 @@ func NewField(packageName, elementName, modulus string) (*Field, error) {
 			var g big.Int
 			g.Exp(&nonResidue, &s, &bModulus)
 			// store g in montgomery form
-			g.Lsh(&g, uint(F.NbWords)*64).Mod(&g, &bModulus)
+			g.Lsh(&g, rBits).Mod(&g, &bModulus)
 			F.SqrtG = toUint64Slice(&g, F.NbWords)
 
 			// store non residue in montgomery form
-			nonResidue.Lsh(&nonResidue, uint(F.NbWords)*64).Mod(&nonResidue, &bModulus)
+			nonResidue.Lsh(&nonResidue, rBits).Mod(&nonResidue, &bModulus)
 			F.NonResidue = toUint64Slice(&nonResidue)
 
 			// (s+1) /2
 			s.Sub(&s, &one).Rsh(&s, 1)
 			F.SqrtSMinusOneOver2 = s.Text(16)
+
+			F.SqrtWindow, F.SqrtNbWindows = sqrtWindows(int(e))
 		}
 	}
 
+	// safegcd: 62-bit limbs of d, e in (-2q, q) and the number of divsteps from
+	// Bernstein-Yang, "Fast constant-time gcd computation and modular inversion", Theorem 11.2
+	F.Signed62Limbs = (F.NbBits + 2 + 61) / 62
+	nbDivsteps := (49*F.NbBits + 57 + 16) / 17
+	if F.NbBits < 46 {
+		nbDivsteps = (49*F.NbBits + 80 + 16) / 17
+	}
+	F.DivstepsBatches = (nbDivsteps + 61) / 62
+	F.QSigned62 = make([]uint64, F.Signed62Limbs)
+	mask62 := new(big.Int).SetUint64((1 << 62) - 1)
+	var limb big.Int
+	for i := range F.QSigned62 {
+		limb.Rsh(&bModulus, uint(62*i)).And(&limb, mask62)
+		F.QSigned62[i] = limb.Uint64()
+	}
+	var qInv62 big.Int
+	qInv62.ModInverse(&bModulus, new(big.Int).Lsh(bOne, 62))
+	F.QInv62 = qInv62.Uint64()
+
+	// addition chains of the fixed exponents
+	addExpChain := func(name, exponent string, constantTime bool) {
+		var e big.Int
+		e.SetString(exponent, 16)
+		F.ExpChains = append(F.ExpChains, ExpChain{Name: name, Exponent: exponent, ConstantTime: constantTime, Chain: addchain.New(&e)})
+	}
+	addExpChain("expByLegendreExp", F.LegendreExponent, false)
+	var sqrtExponent string
+	switch {
+	case F.SqrtQ3Mod4:
+		sqrtExponent = F.SqrtQ3Mod4Exponent
+	case F.SqrtAtkin:
+		sqrtExponent = F.SqrtAtkinExponent
+	case F.SqrtTonelliShanks:
+		sqrtExponent = F.SqrtSMinusOneOver2
+	}
+	if sqrtExponent != "" {
+		addExpChain("expBySqrtExp", sqrtExponent, false)
+		// SqrtCT (element_ct.go) doesn't use Mul and Square, whose reduction branches
+		addExpChain("expBySqrtExpCT", sqrtExponent, true)
+	}
+	if F.SingleWord {
+		var qMinusTwo big.Int
+		qMinusTwo.Sub(&bModulus, big.NewInt(2))
+		addExpChain("expByInverseExp", qMinusTwo.Text(16), false)
+		addExpChain("expByInverseExpCT", qMinusTwo.Text(16), true)
+	}
+
 	// note: to simplify output files generated, we generated ASM code only for
 	// moduli that meet the condition F.NoCarry
 	// asm code generation for moduli with more than 6 words can be optimized further
-	F.ASM = F.NoCarry && F.NbWords <= 12
+	F.ASM = F.NoCarry && F.NbWords <= 12 && !F.SingleWord
+	F.ASMVector = F.ASM && F.NbWords == 4
 
 	return F, nil
 }
 
+// sqrtWindows returns the window size and number of windows of the table-based Tonelli-Shanks
+// (P. Sarkar, "Computing square roots faster than the Tonelli-Shanks/Bernstein algorithm"),
+// minimizing the number of squarings, multiplications and lookups of a square root with at
+// most maxEntries precomputed elements
+func sqrtWindows(e int) (window, nbWindows int) {
+	const maxEntries = 2048
+	bestCost := -1
+	for w := 1; w <= 8 && w <= e; w++ {
+		n := (e + w - 1) / w
+		w0 := e - w*(n-1) // size of the least significant window
+
+		// the tables hold g**(-c * 2**shift) for shift in {w*r} U {w0 + w*a}, r, a < n-1, and shift = 0
+		shifts := map[int]bool{0: true}
+		for i := 0; i < n-1; i++ {
+			shifts[w*i] = true
+			shifts[w0+w*i] = true
+		}
+		if len(shifts)<<w > maxEntries {
+			continue
+		}
+
+		cost := w*(n-1) + n*(n+1)/2 + n
+		if bestCost == -1 || cost < bestCost {
+			bestCost, window, nbWindows = cost, w, n
+		}
+	}
+	return
+}
+
 func toUint64Slice(b *big.Int, nbWords ...int) (s []uint64) {
 	if len(nbWords) > 0 && nbWords[0] > len(b.Bits()) {
 		s = make([]uint64, nbWords[0])
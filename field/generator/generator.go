@@ -12,7 +12,9 @@ import (
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field"
 	"github.com/consensys/gnark-crypto/field/asm/amd64"
+	"github.com/consensys/gnark-crypto/field/asm/arm64"
 	"github.com/consensys/gnark-crypto/field/internal/templates/element"
+	"github.com/consensys/gnark-crypto/field/internal/templates/small"
 )
 
 // TODO @gbotrel --> pattern for code generation is different than gnark-crypto/internal because a binary like goff can generate
@@ -22,9 +24,13 @@ import (
 //
 // Example usage
 //
-// 	fp, _ = field.NewField("fp", "Element", fpModulus")
-// 	generator.GenerateFF(fp, filepath.Join(baseDir, "fp"))
+//	fp, _ = field.NewField("fp", "Element", fpModulus")
+//	generator.GenerateFF(fp, filepath.Join(baseDir, "fp"))
 func GenerateFF(F *field.Field, outputDir string) error {
+	if F.SingleWord {
+		return generateSingleWord(F, outputDir)
+	}
+
 	// source file templates
 	sourceFiles := []string{
 		element.Base,
@@ -35,6 +41,7 @@ func GenerateFF(F *field.Field, outputDir string) error {
 		element.MulNoCarry,
 		element.Sqrt,
 		element.Inverse,
+		element.ExpChains,
 	}
 
 	// test file templates
@@ -168,6 +175,52 @@ func GenerateFF(F *field.Field, outputDir string) error {
 			}
 		}
 
+		{
+			pathSrc := filepath.Join(outputDir, eName+"_ops_arm64.s")
+			fmt.Println("generating", pathSrc)
+			f, err := os.Create(pathSrc)
+			if err != nil {
+				return err
+			}
+
+			if err := arm64.Generate(f, F); err != nil {
+				_ = f.Close()
+				return err
+			}
+			_ = f.Close()
+
+			// run asmfmt
+			cmd := exec.Command("asmfmt", "-w", pathSrc)
+			cmd.Stdout = os.Stdout
+			cmd.Stderr = os.Stderr
+			if err := cmd.Run(); err != nil {
+				return err
+			}
+		}
+
+		if F.ASMVector {
+			pathSrc := filepath.Join(outputDir, "vector_amd64.s")
+			fmt.Println("generating", pathSrc)
+			f, err := os.Create(pathSrc)
+			if err != nil {
+				return err
+			}
+
+			if err := amd64.GenerateVector(f, F); err != nil {
+				_ = f.Close()
+				return err
+			}
+			_ = f.Close()
+
+			// run asmfmt
+			cmd := exec.Command("asmfmt", "-w", pathSrc)
+			cmd.Stdout = os.Stdout
+			cmd.Stderr = os.Stderr
+			if err := cmd.Run(); err != nil {
+				return err
+			}
+		}
+
 	}
 
 	{
@@ -181,6 +234,74 @@ func GenerateFF(F *field.Field, outputDir string) error {
 		}
 	}
 
+	if F.ASM {
+		// generate ops_arm64.go
+		src := []string{
+			element.OpsARM64,
+		}
+		pathSrc := filepath.Join(outputDir, eName+"_ops_arm64.go")
+		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOpts...); err != nil {
+			return err
+		}
+	} else {
+		// no arm64 assembly: element_ops_noasm.go is built on arm64
+		_ = os.Remove(filepath.Join(outputDir, eName+"_ops_arm64.go"))
+	}
+
+	{
+		// generate accumulator.go and accumulator_test.go
+		pathSrc := filepath.Join(outputDir, "accumulator.go")
+		if err := bavard.GenerateFromString(pathSrc, []string{element.Accumulator, element.Reduce}, F, bavardOpts...); err != nil {
+			return err
+		}
+
+		pathSrc = filepath.Join(outputDir, "accumulator_test.go")
+		if err := bavard.GenerateFromString(pathSrc, []string{element.AccumulatorTest}, F, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	{
+		// generate element_ct.go and element_ct_test.go
+		pathSrc := filepath.Join(outputDir, eName+"_ct.go")
+		if err := bavard.GenerateFromString(pathSrc, []string{element.ConstantTime}, F, bavardOpts...); err != nil {
+			return err
+		}
+
+		pathSrc = filepath.Join(outputDir, eName+"_ct_test.go")
+		if err := bavard.GenerateFromString(pathSrc, []string{element.ConstantTimeTest}, F, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	{
+		// generate vector.go, vector_amd64.go, vector_purego.go and vector_test.go
+		pathSrc := filepath.Join(outputDir, "vector.go")
+		if err := bavard.GenerateFromString(pathSrc, []string{element.Vector}, F, bavardOpts...); err != nil {
+			return err
+		}
+
+		pathSrc = filepath.Join(outputDir, "vector_amd64.go")
+		if err := bavard.GenerateFromString(pathSrc, []string{element.VectorOpsAMD64}, F, bavardOpts...); err != nil {
+			return err
+		}
+
+		pathSrc = filepath.Join(outputDir, "vector_test.go")
+		if err := bavard.GenerateFromString(pathSrc, []string{element.VectorTest}, F, bavardOpts...); err != nil {
+			return err
+		}
+
+		pathSrc = filepath.Join(outputDir, "vector_purego.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		if F.ASMVector {
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64"))
+		}
+		if err := bavard.GenerateFromString(pathSrc, []string{element.VectorOpsPureGo}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+
 	{
 		// generate ops.go
 		src := []string{
@@ -193,7 +314,7 @@ func GenerateFF(F *field.Field, outputDir string) error {
 		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
 		copy(bavardOptsCpy, bavardOpts)
 		if F.ASM {
-			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64"))
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64,!arm64"))
 		}
 		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOptsCpy...); err != nil {
 			return err
@@ -249,6 +370,45 @@ func GenerateFF(F *field.Field, outputDir string) error {
 	return nil
 }
 
+// generateSingleWord generates the element of a field of modulus q < 2**64;
+// the arithmetic is pure Go and fits in a single file
+func generateSingleWord(F *field.Field, outputDir string) error {
+	eName := strings.ToLower(F.ElementName)
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(F.PackageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+		bavard.Funcs(template.FuncMap{"toTitle": strings.Title, "shorten": shorten}),
+	}
+
+	entries := []struct {
+		path      string
+		templates []string
+	}{
+		{filepath.Join(outputDir, eName+".go"), []string{small.Base, element.Sqrt, element.ExpChains}},
+		{filepath.Join(outputDir, eName+"_test.go"), []string{small.Test}},
+		{filepath.Join(outputDir, "doc.go"), []string{small.Doc}},
+		{filepath.Join(outputDir, "accumulator.go"), []string{small.Accumulator}},
+		{filepath.Join(outputDir, "accumulator_test.go"), []string{small.AccumulatorTest}},
+		{filepath.Join(outputDir, eName+"_ct.go"), []string{small.ConstantTime}},
+		{filepath.Join(outputDir, eName+"_ct_test.go"), []string{small.ConstantTimeTest}},
+		{filepath.Join(outputDir, "vector.go"), []string{element.Vector, element.VectorOpsPureGo}},
+		{filepath.Join(outputDir, "vector_test.go"), []string{element.VectorTest}},
+	}
+	for _, e := range entries {
+		if err := bavard.GenerateFromString(e.path, e.templates, F, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
 func shorten(input string) string {
 	const maxLen = 15
 	if len(input) > maxLen {
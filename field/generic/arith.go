@@ -0,0 +1,109 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import "math/bits"
+
+// mul sets z = x * y * R^-1 mod q (Montgomery multiplication, CIOS variant)
+// see https://hackmd.io/@zkteam/modular_multiplication
+//
+// The moduli are not restricted to have a spare bit: t has two extra words.
+func (f *Field) mul(z, x, y *[MaxLimbs]uint64) {
+	n := f.nbWords
+	var t [MaxLimbs + 2]uint64
+	var c uint64
+
+	for i := 0; i < n; i++ {
+		// t = t + x * y[i]
+		var C uint64
+		for j := 0; j < n; j++ {
+			hi, lo := bits.Mul64(x[j], y[i])
+			lo, c = bits.Add64(lo, t[j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, C, 0)
+			hi += c
+			t[j] = lo
+			C = hi
+		}
+		t[n], c = bits.Add64(t[n], C, 0)
+		t[n+1] = c
+
+		// t = (t + m * q) / 2**64
+		m := t[0] * f.qInvNeg
+		hi, lo := bits.Mul64(m, f.q[0])
+		_, c = bits.Add64(lo, t[0], 0)
+		C = hi + c
+		for j := 1; j < n; j++ {
+			hi, lo = bits.Mul64(m, f.q[j])
+			lo, c = bits.Add64(lo, t[j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, C, 0)
+			hi += c
+			t[j-1] = lo
+			C = hi
+		}
+		t[n-1], c = bits.Add64(t[n], C, 0)
+		t[n] = t[n+1] + c
+	}
+
+	// t < 2q
+	copy(z[:n], t[:n])
+	if t[n] != 0 || !f.smallerThanModulus(z) {
+		f.subQ(z)
+	}
+}
+
+// add sets z = x + y mod q
+func (f *Field) add(z, x, y *[MaxLimbs]uint64) {
+	var c uint64
+	for i := 0; i < f.nbWords; i++ {
+		z[i], c = bits.Add64(x[i], y[i], c)
+	}
+	if c != 0 || !f.smallerThanModulus(z) {
+		f.subQ(z)
+	}
+}
+
+// sub sets z = x - y mod q
+func (f *Field) sub(z, x, y *[MaxLimbs]uint64) {
+	var b uint64
+	for i := 0; i < f.nbWords; i++ {
+		z[i], b = bits.Sub64(x[i], y[i], b)
+	}
+	if b != 0 {
+		var c uint64
+		for i := 0; i < f.nbWords; i++ {
+			z[i], c = bits.Add64(z[i], f.q[i], c)
+		}
+	}
+}
+
+// subQ sets z = z - q, ignoring the borrow
+func (f *Field) subQ(z *[MaxLimbs]uint64) {
+	var b uint64
+	for i := 0; i < f.nbWords; i++ {
+		z[i], b = bits.Sub64(z[i], f.q[i], b)
+	}
+}
+
+// smallerThanModulus returns true if z < q
+func (f *Field) smallerThanModulus(z *[MaxLimbs]uint64) bool {
+	for i := f.nbWords - 1; i >= 0; i-- {
+		if z[i] != f.q[i] {
+			return z[i] < f.q[i]
+		}
+	}
+	return false
+}
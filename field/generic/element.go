@@ -0,0 +1,462 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// /!\ WARNING /!\
+// this code has not been audited and is provided as-is. In particular,
+// there is no security guarantees such as constant time implementation
+// or side-channel attack resistance
+// /!\ WARNING /!\
+
+// Element represents an element of a Field, stored on Field.Limbs() words (uint64)
+// Element are assumed to be in Montgomery form in all methods
+type Element struct {
+	f *Field
+	v [MaxLimbs]uint64
+}
+
+// Field returns the Field of z
+func (z *Element) Field() *Field {
+	return z.f
+}
+
+// field returns the Field of z, and panics if z is not bound to a Field
+func (z *Element) field() *Field {
+	if z.f == nil {
+		panic("generic.Element is not bound to a Field, see Field.NewElement")
+	}
+	return z.f
+}
+
+// bind sets the Field of z to the Field of x
+func (z *Element) bind(x *Element) *Field {
+	z.f = x.field()
+	return z.f
+}
+
+// bind2 sets the Field of z to the Field of x and y, and panics if they differ
+func (z *Element) bind2(x, y *Element) *Field {
+	if x.field() != y.field() {
+		panic("generic.Element operands are bound to different Fields")
+	}
+	return z.bind(x)
+}
+
+// SetUint64 z = v, sets z LSB to v (non-Montgomery form) and convert z to Montgomery form
+func (z *Element) SetUint64(v uint64) *Element {
+	f := z.field()
+	if f.nbWords == 1 && v >= f.q[0] {
+		v %= f.q[0]
+	}
+	z.v = [MaxLimbs]uint64{v}
+	return z.ToMont()
+}
+
+// Set z = x
+func (z *Element) Set(x *Element) *Element {
+	*z = *x
+	return z
+}
+
+// SetInterface converts provided interface into Element
+// returns an error if provided type is not supported
+// supported types: Element, *Element, uint64, int, string (interpreted as base10 integer),
+// *big.Int, big.Int, []byte
+func (z *Element) SetInterface(i1 interface{}) (*Element, error) {
+	switch c1 := i1.(type) {
+	case Element:
+		return z.Set(&c1), nil
+	case *Element:
+		return z.Set(c1), nil
+	case uint64:
+		return z.SetUint64(c1), nil
+	case int:
+		return z.SetString(strconv.Itoa(c1)), nil
+	case string:
+		return z.SetString(c1), nil
+	case *big.Int:
+		return z.SetBigInt(c1), nil
+	case big.Int:
+		return z.SetBigInt(&c1), nil
+	case []byte:
+		return z.SetBytes(c1), nil
+	default:
+		return nil, errors.New("can't set generic.Element from type " + reflect.TypeOf(i1).String())
+	}
+}
+
+// SetZero z = 0
+func (z *Element) SetZero() *Element {
+	z.v = [MaxLimbs]uint64{}
+	return z
+}
+
+// SetOne z = 1 (in Montgomery form)
+func (z *Element) SetOne() *Element {
+	z.v = z.field().one
+	return z
+}
+
+// Div z = x*y^-1 mod q
+func (z *Element) Div(x, y *Element) *Element {
+	var yInv Element
+	yInv.Inverse(y)
+	z.Mul(x, &yInv)
+	return z
+}
+
+// Butterfly sets z = z + b, b = z - b (mod q); method form of the package level Butterfly
+func (z *Element) Butterfly(b *Element) {
+	Butterfly(z, b)
+}
+
+// Equal returns z == x
+func (z *Element) Equal(x *Element) bool {
+	return z.v == x.v
+}
+
+// IsZero returns z == 0
+func (z *Element) IsZero() bool {
+	return z.v == [MaxLimbs]uint64{}
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *Element) Cmp(x *Element) int {
+	_z := z.ToRegular()
+	_x := x.ToRegular()
+	for i := MaxLimbs - 1; i >= 0; i-- {
+		if _z.v[i] > _x.v[i] {
+			return 1
+		} else if _z.v[i] < _x.v[i] {
+			return -1
+		}
+	}
+	return 0
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *Element) LexicographicallyLargest() bool {
+	var neg Element
+	neg.Neg(z)
+	return z.Cmp(&neg) > 0
+}
+
+// SetRandom sets z to a random element < q
+func (z *Element) SetRandom() (*Element, error) {
+	f := z.field()
+	v, err := rand.Int(rand.Reader, &f.modulus)
+	if err != nil {
+		return nil, err
+	}
+	toLimbs(&z.v, v)
+	return z, nil
+}
+
+// Mul z = x * y mod q
+// see https://hackmd.io/@zkteam/modular_multiplication
+func (z *Element) Mul(x, y *Element) *Element {
+	z.bind2(x, y).mul(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Square z = x * x mod q
+func (z *Element) Square(x *Element) *Element {
+	z.bind(x).mul(&z.v, &x.v, &x.v)
+	return z
+}
+
+// FromMont converts z in place (i.e. mutates) from Montgomery to regular representation
+// sets and returns z = z * 1
+func (z *Element) FromMont() *Element {
+	one := [MaxLimbs]uint64{1}
+	z.field().mul(&z.v, &z.v, &one)
+	return z
+}
+
+// ToMont converts z to Montgomery form
+// sets and returns z = z * r^2
+func (z *Element) ToMont() *Element {
+	f := z.field()
+	f.mul(&z.v, &z.v, &f.rSquare)
+	return z
+}
+
+// ToRegular returns z in regular form (doesn't mutate z)
+func (z Element) ToRegular() Element {
+	return *z.FromMont()
+}
+
+// Add z = x + y mod q
+func (z *Element) Add(x, y *Element) *Element {
+	z.bind2(x, y).add(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Double z = x + x mod q, aka Lsh 1
+func (z *Element) Double(x *Element) *Element {
+	z.bind(x).add(&z.v, &x.v, &x.v)
+	return z
+}
+
+// Sub  z = x - y mod q
+func (z *Element) Sub(x, y *Element) *Element {
+	z.bind2(x, y).sub(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Neg z = q - x
+func (z *Element) Neg(x *Element) *Element {
+	var zero [MaxLimbs]uint64
+	z.bind(x).sub(&z.v, &zero, &x.v)
+	return z
+}
+
+// MulBy3 x *= 3
+func MulBy3(x *Element) {
+	_x := *x
+	x.Double(x).Add(x, &_x)
+}
+
+// MulBy5 x *= 5
+func MulBy5(x *Element) {
+	_x := *x
+	x.Double(x).Double(x).Add(x, &_x)
+}
+
+// MulBy13 x *= 13
+func MulBy13(x *Element) {
+	y := Element{f: x.field()}
+	y.SetUint64(13)
+	x.Mul(x, &y)
+}
+
+// Butterfly sets
+// a = a + b
+// b = a - b
+func Butterfly(a, b *Element) {
+	t := *a
+	a.Add(a, b)
+	b.Sub(&t, b)
+}
+
+// Exp z = x^exponent mod q
+func (z *Element) Exp(x Element, exponent *big.Int) *Element {
+	z.bind(&x)
+	if exponent.Sign() == 0 {
+		return z.SetOne()
+	}
+
+	z.Set(&x)
+
+	for i := exponent.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if exponent.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// String returns the string form of an Element in regular form
+func (z *Element) String() string {
+	var b big.Int
+	return z.ToBigIntRegular(&b).String()
+}
+
+// ToBigInt returns z as a big.Int in Montgomery form
+func (z *Element) ToBigInt(res *big.Int) *big.Int {
+	res.SetUint64(0)
+	var w big.Int
+	for i := MaxLimbs - 1; i >= 0; i-- {
+		res.Lsh(res, 64).Add(res, w.SetUint64(z.v[i]))
+	}
+	return res
+}
+
+// ToBigIntRegular returns z as a big.Int in regular form
+func (z Element) ToBigIntRegular(res *big.Int) *big.Int {
+	z.FromMont()
+	return z.ToBigInt(res)
+}
+
+// Bytes returns the regular (non montgomery) value
+// of z as a big-endian byte slice of Field.Bytes() bytes.
+func (z *Element) Bytes() []byte {
+	_z := z.ToRegular()
+	n := _z.f.nbWords
+	res := make([]byte, n*8)
+	for i := 0; i < n; i++ {
+		w := _z.v[i]
+		for j := 0; j < 8; j++ {
+			res[len(res)-1-(8*i+j)] = byte(w >> (8 * j))
+		}
+	}
+	return res
+}
+
+// Marshal returns the regular (non montgomery) value
+// of z as a big-endian byte slice.
+func (z *Element) Marshal() []byte {
+	return z.Bytes()
+}
+
+// SetBytes interprets e as the bytes of a big-endian unsigned integer,
+// sets z to that value (in Montgomery form), and returns z.
+func (z *Element) SetBytes(e []byte) *Element {
+	var v big.Int
+	return z.SetBigInt(v.SetBytes(e))
+}
+
+// SetBigInt sets z to v (regular form) and returns z in Montgomery form
+func (z *Element) SetBigInt(v *big.Int) *Element {
+	f := z.field()
+	if v.Sign() < 0 || v.Cmp(&f.modulus) >= 0 {
+		var vv big.Int
+		vv.Mod(v, &f.modulus)
+		toLimbs(&z.v, &vv)
+	} else {
+		toLimbs(&z.v, v)
+	}
+	return z.ToMont()
+}
+
+// SetString creates a big.Int with s (in base 10) and calls SetBigInt on z
+func (z *Element) SetString(s string) *Element {
+	var v big.Int
+	if _, ok := v.SetString(s, 10); !ok {
+		panic("Element.SetString failed -> can't parse number in base10 into a big.Int")
+	}
+	return z.SetBigInt(&v)
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *Element) Legendre() int {
+	var l Element
+	// z^((q-1)/2)
+	l.Exp(*z, &z.field().legendreExponent)
+
+	if l.IsZero() {
+		return 0
+	}
+
+	// if l == 1
+	if l.v == z.f.one {
+		return 1
+	}
+	return -1
+}
+
+// Sqrt z = √x mod q
+// if the square root doesn't exist (x is not a square mod q)
+// Sqrt leaves z unchanged and returns nil
+func (z *Element) Sqrt(x *Element) *Element {
+	f := x.field()
+	if f.sqrtE == 0 {
+		// q ≡ 3 (mod 4)
+		// using  z ≡ ± x^((p+1)/4) (mod q)
+		var y, square Element
+		y.Exp(*x, &f.sqrtQ3Mod4Exponent)
+		// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+		square.Square(&y)
+		if square.Equal(x) {
+			return z.Set(&y)
+		}
+		return nil
+	}
+
+	// q ≡ 1 (mod 4)
+	// see modSqrtTonelliShanks in math/big/int.go
+	// using https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
+
+	var y, b, t, w Element
+	// w = x^((s-1)/2))
+	w.Exp(*x, &f.sqrtSMinusOneOver2)
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, &w)
+
+	// b = x^s = w * w * x = y * x
+	b.Mul(&w, &y)
+
+	// g = nonResidue ^ s
+	g := Element{f: f, v: f.sqrtG}
+	r := f.sqrtE
+
+	// compute legendre symbol
+	// t = x^((q-1)/2) = r-1 squaring of x^s
+	t = b
+	for i := uint64(0); i < r-1; i++ {
+		t.Square(&t)
+	}
+	if t.IsZero() {
+		z.f = f
+		return z.SetZero()
+	}
+	if t.v != f.one {
+		// t != 1, we don't have a square root
+		return nil
+	}
+	for {
+		var m uint64
+		t = b
+
+		// for t != 1
+		for t.v != f.one {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1)) mod q
+		ge := int(r - m - 1)
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// Inverse z = x^-1 mod q
+// computed as x^(q-2) (Fermat's little theorem)
+// if x == 0, sets and returns z = x
+func (z *Element) Inverse(x *Element) *Element {
+	f := z.bind(x)
+	if x.IsZero() {
+		return z.SetZero()
+	}
+	return z.Exp(*x, &f.qMinusTwo)
+}
@@ -0,0 +1,256 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/field"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// *Element has the same API as the generated elements
+var _ = isFieldElement[Element]
+
+func isFieldElement[T any, PT field.Element[T]]() {}
+
+// testModuli covers 1 to 12 words, with and without a spare bit in the last word
+var testModuli = map[string]string{
+	"2**64-59":  "18446744073709551557",
+	"bn254 fr":  "21888242871839275222246405745257275088548364400416034343698204186575808495617",
+	"secp256k1": "115792089237316195423570985008687907853269984665640564039457584007908834671663",
+	"bls12-381": "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559787",
+}
+
+func init() {
+	// random primes of 12 words
+	for _, nbBits := range []int{767, 768} {
+		q, _ := rand.Prime(rand.Reader, nbBits)
+		testModuli[q.String()] = q.String()
+	}
+}
+
+func TestElementArithmetic(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 20
+	} else {
+		parameters.MinSuccessfulTests = 200
+	}
+
+	for name, modulus := range testModuli {
+		q, _ := new(big.Int).SetString(modulus, 10)
+		f, err := NewField(q)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+
+		properties := gopter.NewProperties(parameters)
+		genA := genElement(f)
+		genB := genElement(f)
+
+		type op struct {
+			name string
+			f    func(z, x, y *Element)
+			ref  func(z, x, y *big.Int)
+		}
+		ops := []op{
+			{"Add", func(z, x, y *Element) { z.Add(x, y) }, func(z, x, y *big.Int) { z.Add(x, y) }},
+			{"Sub", func(z, x, y *Element) { z.Sub(x, y) }, func(z, x, y *big.Int) { z.Sub(x, y) }},
+			{"Mul", func(z, x, y *Element) { z.Mul(x, y) }, func(z, x, y *big.Int) { z.Mul(x, y) }},
+			{"Square", func(z, x, _ *Element) { z.Square(x) }, func(z, x, _ *big.Int) { z.Mul(x, x) }},
+			{"Double", func(z, x, _ *Element) { z.Double(x) }, func(z, x, _ *big.Int) { z.Lsh(x, 1) }},
+			{"Neg", func(z, x, _ *Element) { z.Neg(x) }, func(z, x, _ *big.Int) { z.Neg(x) }},
+			{"Inverse", func(z, x, _ *Element) { z.Inverse(x) }, func(z, x, _ *big.Int) {
+				if x.Sign() != 0 {
+					z.ModInverse(x, q)
+				}
+			}},
+			{"Exp", func(z, x, y *Element) { z.Exp(*x, y.ToBigIntRegular(new(big.Int))) }, func(z, x, y *big.Int) { z.Exp(x, y, q) }},
+		}
+
+		for _, o := range ops {
+			o := o
+			properties.Property("["+name+"] "+o.name+": operation result must match big.Int result", prop.ForAll(
+				func(a, b testPairElement) bool {
+					var c Element
+					var d, e big.Int
+					o.f(&c, &a.element, &b.element)
+					o.ref(&d, &a.bigint, &b.bigint)
+					d.Mod(&d, q)
+					return c.ToBigIntRegular(&e).Cmp(&d) == 0
+				},
+				genA,
+				genB,
+			))
+		}
+
+		properties.Property("["+name+"] Legendre and Sqrt must match math/big", prop.ForAll(
+			func(a testPairElement) bool {
+				if a.element.Legendre() != big.Jacobi(&a.bigint, q) {
+					return false
+				}
+				var c Element
+				var d big.Int
+				if c.Sqrt(&a.element) == nil {
+					return d.ModSqrt(&a.bigint, q) == nil
+				}
+				c.ToBigIntRegular(&d)
+				d.Mul(&d, &d).Mod(&d, q)
+				return d.Cmp(&a.bigint) == 0
+			},
+			genA,
+		))
+
+		properties.Property("["+name+"] SetBytes(Bytes(x)) and SetString(String(x)) must be x", prop.ForAll(
+			func(a testPairElement) bool {
+				b := f.NewElement().SetBytes(a.element.Bytes())
+				c := f.NewElement().SetString(a.element.String())
+				return b.Equal(&a.element) && c.Equal(&a.element) && len(a.element.Bytes()) == f.Bytes()
+			},
+			genA,
+		))
+
+		properties.TestingRun(t, gopter.ConsoleReporter(false))
+	}
+}
+
+func TestElementMatchesGenerated(t *testing.T) {
+	f, err := NewField(fr.Modulus())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// same modulus and same R: the Montgomery representations must match
+	for i := 0; i < 100; i++ {
+		var a, b, c fr.Element
+		a.SetRandom()
+		b.SetRandom()
+		c.Mul(&a, &b)
+
+		x := f.NewElement().SetBytes(a.Marshal())
+		y := f.NewElement().SetBytes(b.Marshal())
+		z := f.NewElement().Mul(x, y)
+		for j := 0; j < fr.Limbs; j++ {
+			if x.v[j] != a[j] || z.v[j] != c[j] {
+				t.Fatal("Montgomery representation doesn't match the generated fr.Element")
+			}
+		}
+	}
+}
+
+func TestNewField(t *testing.T) {
+	for _, q := range []*big.Int{
+		big.NewInt(0),
+		big.NewInt(2),
+		big.NewInt(-7),
+		big.NewInt(15),
+		new(big.Int).Lsh(big.NewInt(1), 64*MaxLimbs+1),
+	} {
+		if _, err := NewField(q); err == nil {
+			t.Fatal("NewField should reject", q)
+		}
+	}
+}
+
+func TestElementNotBound(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("an Element without Field should panic")
+		}
+	}()
+	var a Element
+	a.SetOne()
+}
+
+func TestElementMixedFields(t *testing.T) {
+	f1, err := NewField(big.NewInt(101))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f2, err := NewField(big.NewInt(103))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, op := range map[string]func(z, x, y *Element){
+		"Mul":       func(z, x, y *Element) { z.Mul(x, y) },
+		"Add":       func(z, x, y *Element) { z.Add(x, y) },
+		"Sub":       func(z, x, y *Element) { z.Sub(x, y) },
+		"Butterfly": func(_, x, y *Element) { Butterfly(x, y) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatal(name + " of Elements of different Fields should panic")
+				}
+			}()
+			x, y := f1.NewElement().SetOne(), f2.NewElement().SetOne()
+			op(f1.NewElement(), x, y)
+		}()
+	}
+}
+
+type testPairElement struct {
+	element Element
+	bigint  big.Int
+}
+
+func genElement(f *Field) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var g testPairElement
+
+		// random bytes reduced mod q, with a few special values
+		b := make([]byte, f.Bytes()+8)
+		for i := range b {
+			b[i] = byte(genParams.NextUint64())
+		}
+		switch genParams.NextUint64() % 8 {
+		case 0:
+			b = nil // 0
+		case 1:
+			b = []byte{1}
+		case 2:
+			b = new(big.Int).Sub(&f.modulus, big.NewInt(1)).Bytes() // q - 1
+		}
+		g.bigint.SetBytes(b).Mod(&g.bigint, &f.modulus)
+		g.element = *f.NewElement().SetBigInt(&g.bigint)
+
+		return gopter.NewGenResult(g, gopter.NoShrinker)
+	}
+}
+
+func BenchmarkElementMul(b *testing.B) {
+	for name, modulus := range testModuli {
+		q, _ := new(big.Int).SetString(modulus, 10)
+		f, _ := NewField(q)
+		x := f.NewElement()
+		y := f.NewElement()
+		x.SetRandom()
+		y.SetRandom()
+		if len(name) > 16 {
+			name = "random prime"
+		}
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x.Mul(x, y)
+			}
+		})
+	}
+}
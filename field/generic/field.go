@@ -0,0 +1,201 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package generic provides prime field arithmetic for a modulus known at runtime.
+//
+// The API is the same as the one of the generated fields (e.g. ecc/bn254/fr), except that
+// the modulus and the Montgomery constants are held by a Field, to which every Element points:
+//
+//	f, _ := generic.NewField(q)
+//	a := f.NewElement().SetUint64(2)
+//	b := f.NewElement().SetString("984896738")
+//	a.Mul(a, b)
+//
+// The Montgomery multiplication loops over the words of the modulus, it is slower than the
+// generated code (which is unrolled, and in assembly on amd64) but much faster than math/big.
+// It is intended for prototyping, before generating a dedicated package with field/generator.
+//
+// Binary operations set the Field of the receiver to the Field of their operands. Setters
+// (SetOne, SetUint64, SetString, ...) need the receiver to be bound to a Field, by
+// Field.NewElement or a previous operation; they panic otherwise.
+package generic
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+// MaxLimbs is the maximum number of 64 bits words of a modulus
+const MaxLimbs = 12
+
+var (
+	errUnsupportedModulus = errors.New("unsupported modulus: q must be an odd prime of at most 12 words")
+	errNotPrime           = errors.New("modulus is not prime")
+)
+
+// Field holds the precomputed constants of the prime field of modulus q
+type Field struct {
+	nbWords int
+	nbBits  int
+	q       [MaxLimbs]uint64
+	qInvNeg uint64 // -q^-1 mod 2**64
+
+	// Montgomery constants, R = 2**(64*nbWords)
+	rSquare [MaxLimbs]uint64
+	one     [MaxLimbs]uint64
+
+	modulus          big.Int
+	qMinusTwo        big.Int // Fermat inverse exponent
+	legendreExponent big.Int // (q-1)/2
+
+	// square root: q-1 = 2**sqrtE * s, s odd
+	sqrtQ3Mod4Exponent big.Int // (q+1)/4 if q = 3 mod 4
+	sqrtE              uint64
+	sqrtSMinusOneOver2 big.Int
+	sqrtG              [MaxLimbs]uint64 // non residue ^ s (Montgomery form)
+}
+
+// NewField returns the Field of modulus q, which must be an odd prime of at most MaxLimbs words
+func NewField(q *big.Int) (*Field, error) {
+	if q.Sign() <= 0 || q.Bit(0) == 0 || q.Cmp(big.NewInt(3)) < 0 || len(q.Bits()) > MaxLimbs*64/bits.UintSize {
+		return nil, errUnsupportedModulus
+	}
+	if !q.ProbablyPrime(20) {
+		return nil, errNotPrime
+	}
+
+	f := &Field{}
+	f.modulus.Set(q)
+	f.nbBits = q.BitLen()
+	f.nbWords = (f.nbBits + 63) / 64
+	toLimbs(&f.q, q)
+
+	// -q^-1 mod 2**64, with Newton iterations: x = x * (2 - q*x) doubles the number of correct bits
+	inv := uint64(1)
+	for i := 0; i < 6; i++ {
+		inv *= 2 - f.q[0]*inv
+	}
+	f.qInvNeg = -inv
+
+	var r big.Int
+	r.Lsh(big.NewInt(1), uint(64*f.nbWords)).Mod(&r, q)
+	toLimbs(&f.one, &r)
+	r.Mul(&r, &r).Mod(&r, q)
+	toLimbs(&f.rSquare, &r)
+
+	bOne := big.NewInt(1)
+	f.qMinusTwo.Sub(q, big.NewInt(2))
+	f.legendreExponent.Sub(q, bOne).Rsh(&f.legendreExponent, 1)
+
+	if q.Bit(1) == 1 {
+		// q ≡ 3 (mod 4)
+		f.sqrtQ3Mod4Exponent.Add(q, bOne).Rsh(&f.sqrtQ3Mod4Exponent, 2)
+	} else {
+		// Tonelli-Shanks constants
+		var s big.Int
+		s.Sub(q, bOne)
+		f.sqrtE = uint64(s.TrailingZeroBits())
+		s.Rsh(&s, uint(f.sqrtE))
+		f.sqrtSMinusOneOver2.Rsh(&s, 1)
+
+		nonResidue := big.NewInt(2)
+		for big.Jacobi(nonResidue, q) != -1 {
+			nonResidue.Add(nonResidue, bOne)
+		}
+		var g big.Int
+		g.Exp(nonResidue, &s, q).Lsh(&g, uint(64*f.nbWords)).Mod(&g, q)
+		toLimbs(&f.sqrtG, &g)
+	}
+
+	return f, nil
+}
+
+// Modulus returns q as a big.Int
+func (f *Field) Modulus() *big.Int {
+	return new(big.Int).Set(&f.modulus)
+}
+
+// Limbs returns the number of 64 bits words needed to represent an Element
+func (f *Field) Limbs() int {
+	return f.nbWords
+}
+
+// Bits returns the number of bits needed to represent an Element
+func (f *Field) Bits() int {
+	return f.nbBits
+}
+
+// Bytes returns the number of bytes needed to represent an Element
+func (f *Field) Bytes() int {
+	return f.nbWords * 8
+}
+
+// NewElement returns a new Element of f, set to 0
+func (f *Field) NewElement() *Element {
+	return &Element{f: f}
+}
+
+// One returns 1 (in Montgomery form)
+func (f *Field) One() Element {
+	return Element{f: f, v: f.one}
+}
+
+// BatchInvert returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+func (f *Field) BatchInvert(a []Element) []Element {
+	res := make([]Element, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	accumulator := f.One()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			res[i].f = f
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// toLimbs sets res to the little endian 64 bits words of 0 <= x < 2**(64*MaxLimbs)
+func toLimbs(res *[MaxLimbs]uint64, x *big.Int) {
+	*res = [MaxLimbs]uint64{}
+	if bits.UintSize == 64 {
+		for i, w := range x.Bits() {
+			res[i] = uint64(w)
+		}
+		return
+	}
+	for i, w := range x.Bits() {
+		res[i/2] |= uint64(w) << (32 * (i % 2))
+	}
+}
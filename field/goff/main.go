@@ -0,0 +1,112 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// goff (go finite field) generates a standalone package implementing arithmetic in the
+// prime field of a given modulus, with the same code (and assembly) as the fields of gnark-crypto.
+//
+// Usage
+//
+//	go install github.com/consensys/gnark-crypto/field/goff
+//	goff -m 21888242871839275222246405745257275088548364400416034343698204186575808495617 -o ./fr -p fr -e Element
+//
+// Moduli of at most 64 bits are generated as a pure Go single word element. For larger moduli
+// with a spare bit, the amd64 and arm64 assembly files are formatted with asmfmt, which must
+// be in the PATH:
+//
+//	go install github.com/klauspost/asmfmt/cmd/asmfmt@latest
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/token"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/consensys/gnark-crypto/field"
+	"github.com/consensys/gnark-crypto/field/generator"
+)
+
+var (
+	fModulus     = flag.String("m", "", "field modulus (base 10)")
+	fOutputDir   = flag.String("o", "", "destination path of the generated package")
+	fPackageName = flag.String("p", "", "package name in the generated files (default: base name of the destination path)")
+	fElementName = flag.String("e", "Element", "name of the field element type")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: goff -m modulus -o outputDir [-p packageName] [-e elementName]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*fModulus, *fOutputDir, *fPackageName, *fElementName); err != nil {
+		fmt.Fprintln(os.Stderr, "goff:", err)
+		os.Exit(1)
+	}
+}
+
+func run(modulus, outputDir, packageName, elementName string) error {
+	if modulus == "" || outputDir == "" {
+		flag.Usage()
+		return errors.New("missing modulus or output directory")
+	}
+
+	outputDir, err := filepath.Abs(filepath.Clean(outputDir))
+	if err != nil {
+		return err
+	}
+	if packageName == "" {
+		packageName = filepath.Base(outputDir)
+	}
+	if !token.IsIdentifier(packageName) {
+		return fmt.Errorf("invalid package name %q", packageName)
+	}
+	if !token.IsIdentifier(elementName) || !token.IsExported(elementName) {
+		return fmt.Errorf("invalid element name %q: must be an exported identifier", elementName)
+	}
+
+	// the generated Sqrt, Inverse and Legendre assume a prime modulus
+	q, ok := new(big.Int).SetString(modulus, 10)
+	if !ok {
+		return fmt.Errorf("invalid modulus %q: must be a base 10 integer", modulus)
+	}
+	if !q.ProbablyPrime(20) {
+		return errors.New("modulus is not prime")
+	}
+
+	F, err := field.NewField(packageName, elementName, modulus)
+	if err != nil {
+		return err
+	}
+	if F.ASM {
+		if _, err := exec.LookPath("asmfmt"); err != nil {
+			return errors.New("asmfmt not found in PATH (go install github.com/klauspost/asmfmt/cmd/asmfmt@latest)")
+		}
+	}
+
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return err
+	}
+	if err := generator.GenerateFF(F, outputDir); err != nil {
+		return err
+	}
+
+	fmt.Printf("generated %s.%s (%d bits) in %s\n", packageName, elementName, F.NbBits, outputDir)
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestGoff generates a package in a temporary module (importing this repository through a
+// replace directive) and checks that it builds and vets
+func TestGoff(t *testing.T) {
+	repoRoot, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goSum, err := os.ReadFile(filepath.Join(repoRoot, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		modulus string
+		asm     bool
+	}{
+		{"bn254fr", "21888242871839275222246405745257275088548364400416034343698204186575808495617", true},
+		{"goldilocks", "18446744069414584321", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.asm {
+				if _, err := exec.LookPath("asmfmt"); err != nil {
+					t.Skip("asmfmt not found in PATH")
+				}
+			}
+
+			moduleDir := t.TempDir()
+			goMod := "module goffsmoke\n\ngo 1.20\n\nrequire github.com/consensys/gnark-crypto v0.0.0\n\nreplace github.com/consensys/gnark-crypto => " + repoRoot + "\n"
+			if err := os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte(goMod), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(moduleDir, "go.sum"), goSum, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := run(tc.modulus, filepath.Join(moduleDir, "fr"), "", "Element"); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, args := range [][]string{{"build", "./..."}, {"vet", "./..."}} {
+				cmd := exec.Command("go", args...)
+				cmd.Dir = moduleDir
+				cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("go %s: %v\n%s", args[0], err, out)
+				}
+			}
+		})
+	}
+}
+
+func TestGoffInvalidInput(t *testing.T) {
+	dir := t.TempDir()
+	testCases := []struct {
+		name                          string
+		modulus, packageName, element string
+	}{
+		{"missing modulus", "", "fr", "Element"},
+		{"not a number", "0x11", "fr", "Element"},
+		{"not prime", "21888242871839275222246405745257275088548364400416034343698204186575808495619", "fr", "Element"},
+		{"invalid package name", "101", "f-r", "Element"},
+		{"unexported element name", "101", "fr", "element"},
+	}
+	for _, tc := range testCases {
+		if err := run(tc.modulus, dir, tc.packageName, tc.element); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package goldilocks contains field arithmetic operations for modulus = 0xffffffff00000001.
+//
+// The API is the same as the one of the multi-word fields (e.g. ecc/bn254/fr), but field elements
+// fit in a single uint64 word and the operations don't allocate:
+//
+//	type Element [1]uint64
+//
+// The modulus is the Goldilocks prime 2**64 - 2**32 + 1. Elements are stored in regular form and the
+// 128-bit products are reduced with 2**64 = 2**32 - 1 (mod q) and 2**96 = -1 (mod q).
+//
+// Example API signature
+//
+//	// Mul z = x * y mod q
+//	func (z *Element) Mul(x, y *Element) *Element
+//
+// and can be used like so:
+//
+//	var a, b Element
+//	a.SetUint64(2)
+//	b.SetString("984896738")
+//	a.Mul(a, b)
+//	a.Sub(a, a)
+//	 .Add(a, b)
+//	 .Inv(a)
+//	b.Exp(b, new(big.Int).SetUint64(42))
+//
+// Modulus
+//
+//	0xffffffff00000001 // base 16
+//	18446744069414584321 // base 10
+package goldilocks
@@ -0,0 +1,120 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package goldilocks
+
+import (
+	"math/bits"
+)
+
+// InverseCT z = x^-1 mod q, in constant time
+// if x == 0, sets and returns z = 0
+//
+// It computes x^(q-2) (Fermat's little theorem) with a fixed addition chain (expByInverseExpCT)
+// of mulCT and squareCT.
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.expByInverseExpCT(*x)
+}
+
+// SqrtCT z = √x mod q, in constant time
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil; the running time only depends on whether x is a square
+//
+// Unlike Sqrt, it doesn't branch on intermediate values: Tonelli-Shanks does 32 - 1 fixed
+// iterations, with conditional selections (https://www.rfc-editor.org/rfc/rfc9380#appendix-I.4).
+// It uses mulCT and squareCT rather than Mul and Square, whose final subtraction is a branch.
+func (z *Element) SqrtCT(x *Element) *Element {
+	// q ≡ 1 (mod 4), q - 1 = 2**32 * s
+	var one, w, y, t, b, c, tmp Element
+	one.SetOne()
+
+	// w = x^((s-1)/2), y = x^((s+1)/2), t = x^s
+	w.expBySqrtExpCT(*x)
+	y.mulCT(x, &w)
+	t.mulCT(&w, &y)
+
+	// c = nonResidue ^ s
+	c = Element{1753635133440165772}
+
+	for i := 32; i >= 2; i-- {
+		// b = t^(2^(i-2)), y and t are updated if b != 1
+		b = t
+		for j := 1; j <= i-2; j++ {
+			b.squareCT(&b)
+		}
+		isOne := ctEqual(&b, &one)
+
+		tmp.mulCT(&y, &c)
+		y.ctSelect(isOne, &tmp, &y)
+		c.squareCT(&c)
+		tmp.mulCT(&t, &c)
+		t.ctSelect(isOne, &tmp, &t)
+	}
+
+	// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+	var square Element
+	square.squareCT(&y)
+	if ctEqual(&square, x) == 1 {
+		return z.Set(&y)
+	}
+	return nil
+}
+
+// mulCT z = x * y (mod q), in constant time
+func (z *Element) mulCT(x, y *Element) *Element {
+	z[0] = mulReduceCT(x[0], y[0])
+	return z
+}
+
+// squareCT z = x * x (mod q), in constant time
+func (z *Element) squareCT(x *Element) *Element {
+	z[0] = mulReduceCT(x[0], x[0])
+	return z
+}
+
+// ctEqual returns 1 if x == y, 0 otherwise, in constant time
+func ctEqual(x, y *Element) uint64 {
+	t := uint64(x[0] ^ y[0])
+	return 1 ^ ((t | -t) >> 63)
+}
+
+// ctSelect sets z = x0 if c == 0, z = x1 if c == 1, in constant time
+func (z *Element) ctSelect(c uint64, x0, x1 *Element) *Element {
+	mask := -uint64(c)
+	z[0] = x0[0] ^ (mask & (x0[0] ^ x1[0]))
+	return z
+}
+
+// mulReduceCT returns a * b mod q, as mulReduce, with the conditional corrections
+// of reduce128 replaced by masks
+func mulReduceCT(a, b uint64) uint64 {
+	const epsilon = 1<<32 - 1
+	hi, lo := bits.Mul64(a, b)
+	hiHi := hi >> 32
+	hiLo := hi & epsilon
+
+	// lo - hiHi * 2**96 = lo - hiHi
+	t0, borrow := bits.Sub64(lo, hiHi, 0)
+	t0 -= epsilon & -borrow
+
+	// hiLo * 2**64 = hiLo * epsilon < 2**64
+	t, carry := bits.Add64(t0, hiLo*epsilon, 0)
+	t += epsilon & -carry
+
+	// borrow == 1 if t < q
+	s, borrow := bits.Sub64(t, q, 0)
+	return s ^ (-borrow & (s ^ t))
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	generic "github.com/consensys/gnark-crypto/field/fft"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// Domain with a power of 2 cardinality, over goldilocks.Element
+type Domain = generic.Domain[goldilocks.Element, *goldilocks.Element]
+
+// Decimation is used in the FFT call to select decimation in time or in frequency
+type Decimation = generic.Decimation
+
+const (
+	DIT = generic.DIT
+	DIF = generic.DIF
+)
+
+// maxOrderRoot is the 2-adicity of q-1, the largest domain has 2**maxOrderRoot elements
+const maxOrderRoot = 32
+
+// rootOfUnity is a generator of the subgroup of order 2**maxOrderRoot (regular form)
+const rootOfUnity = 1753635133440165772
+
+// NewDomain returns a subgroup with a power of 2 cardinality
+// cardinality >= m
+// If depth>0, the Domain will also store a primitive (2**depth)*m root
+// of 1, with associated precomputed data. This allows to perform shifted
+// FFT/FFTInv.
+// If precomputeReversedCosetTable is set, the bit reversed cosetTable/cosetTableInv are precomputed.
+func NewDomain(m, depth uint64, precomputeReversedTable bool) *Domain {
+	var root goldilocks.Element
+	root.SetUint64(rootOfUnity)
+	return generic.NewDomain[goldilocks.Element](m, depth, root, maxOrderRoot, precomputeReversedTable)
+}
+
+// BitReverse applies the bit-reversal permutation to a.
+// len(a) must be a power of 2 (as in every single function in this file)
+func BitReverse(a []goldilocks.Element) {
+	generic.BitReverse(a)
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	generic "github.com/consensys/gnark-crypto/field/polynomial"
+)
+
+// Polynomial represented by coefficients in the field goldilocks
+//
+// The methods are the ones of the generic field/polynomial package, except Eval
+// which sums the coefficients with a goldilocks.WideAccumulator
+type Polynomial []goldilocks.Element
+
+// evalChunkSize is the number of coefficients of p summed with a WideAccumulator in Eval
+const evalChunkSize = 64
+
+// generic returns p as a generic polynomial, sharing the coefficients of p
+func (p *Polynomial) generic() *generic.Polynomial[goldilocks.Element, *goldilocks.Element] {
+	return (*generic.Polynomial[goldilocks.Element, *goldilocks.Element])(p)
+}
+
+// Degree returns the degree of the polynomial, which is the length of Data.
+func (p *Polynomial) Degree() uint64 {
+	return p.generic().Degree()
+}
+
+// Eval evaluates p at v
+// returns a goldilocks.Element
+func (p *Polynomial) Eval(v *goldilocks.Element) goldilocks.Element {
+	if len(*p) < 2*evalChunkSize {
+		return p.generic().Eval(v)
+	}
+
+	// p(v) = sum_c (sum_j p[c+j] * v**j) * (v**evalChunkSize)**(c/evalChunkSize): the inner sums
+	// are accumulated without reduction, the outer sum is evaluated with Horner's method
+	var powers [evalChunkSize]goldilocks.Element
+	powers[0].SetOne()
+	for j := 1; j < evalChunkSize; j++ {
+		powers[j].Mul(&powers[j-1], v)
+	}
+	var vChunk goldilocks.Element
+	vChunk.Mul(&powers[evalChunkSize-1], v)
+
+	var res goldilocks.Element
+	var acc goldilocks.WideAccumulator
+	for c := (len(*p) - 1) / evalChunkSize * evalChunkSize; c >= 0; c -= evalChunkSize {
+		chunk := (*p)[c:]
+		if len(chunk) > evalChunkSize {
+			chunk = chunk[:evalChunkSize]
+		}
+		acc.Reset()
+		for j := range chunk {
+			acc.MulAdd(&chunk[j], &powers[j])
+		}
+		sum := acc.Reduce()
+		res.Mul(&res, &vChunk)
+		res.Add(&res, &sum)
+	}
+
+	return res
+}
+
+// Clone returns a copy of the polynomial
+func (p *Polynomial) Clone() Polynomial {
+	return Polynomial(p.generic().Clone())
+}
+
+// AddConstantInPlace adds a constant to the polynomial, modifying p
+func (p *Polynomial) AddConstantInPlace(c *goldilocks.Element) {
+	p.generic().AddConstantInPlace(c)
+}
+
+// SubConstantInPlace subs a constant to the polynomial, modifying p
+func (p *Polynomial) SubConstantInPlace(c *goldilocks.Element) {
+	p.generic().SubConstantInPlace(c)
+}
+
+// ScaleInPlace multiplies p by v, modifying p
+func (p *Polynomial) ScaleInPlace(c *goldilocks.Element) {
+	p.generic().ScaleInPlace(c)
+}
+
+// Add adds p1 to p2
+// This function allocates a new slice unless p == p1 or p == p2
+func (p *Polynomial) Add(p1, p2 Polynomial) *Polynomial {
+	p.generic().Add(generic.Polynomial[goldilocks.Element, *goldilocks.Element](p1), generic.Polynomial[goldilocks.Element, *goldilocks.Element](p2))
+	return p
+}
+
+// Equal checks equality between two polynomials
+func (p *Polynomial) Equal(p1 Polynomial) bool {
+	return p.generic().Equal(generic.Polynomial[goldilocks.Element, *goldilocks.Element](p1))
+}
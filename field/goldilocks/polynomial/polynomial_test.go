@@ -0,0 +1,75 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestPolynomialEval(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Eval should match the math/big evaluation", prop.ForAll(
+		func(size int) bool {
+			p := make(Polynomial, size)
+			for i := range p {
+				p[i].SetRandom()
+			}
+			var x goldilocks.Element
+			x.SetRandom()
+			res := p.Eval(&x)
+
+			// Horner's method with math/big
+			var expected, bx, coeff big.Int
+			x.ToBigIntRegular(&bx)
+			for i := len(p) - 1; i >= 0; i-- {
+				expected.Mul(&expected, &bx).Add(&expected, p[i].ToBigIntRegular(&coeff)).Mod(&expected, goldilocks.Modulus())
+			}
+			return res.ToBigIntRegular(&coeff).Cmp(&expected) == 0
+		},
+		// Eval uses Horner's method below 2*evalChunkSize coefficients, and a WideAccumulator above
+		gen.IntRange(1, 4*evalChunkSize+1),
+	))
+
+	properties.Property("Add then Equal should be consistent", prop.ForAll(
+		func(size int) bool {
+			p1 := make(Polynomial, size)
+			p2 := make(Polynomial, size)
+			for i := range p1 {
+				p1[i].SetRandom()
+				p2[i].SetRandom()
+			}
+			var sum Polynomial
+			sum.Add(p1, p2)
+			for i := range sum {
+				p2[i].Add(&p1[i], &p2[i])
+			}
+			return sum.Equal(p2)
+		},
+		gen.IntRange(1, 64),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
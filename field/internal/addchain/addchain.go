@@ -0,0 +1,149 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package addchain computes addition chains for the fixed exponents of the field generator.
+//
+// The chains are sliding windows over the bits of the exponent, with the precomputed odd powers
+// and the window size minimizing the number of squarings and multiplications; the generated
+// code is unrolled, so the exponent is not needed at runtime.
+package addchain
+
+import (
+	"math/big"
+)
+
+// maxWindow bounds the window size, and the number of precomputed odd powers to 2**(maxWindow-1)
+const maxWindow = 8
+
+// Chain computes x**e as
+//
+//	t[i] = x**(2*i+1), i < NbOdd (x**0 = 1 if NbOdd == 0)
+//	z = t[First]
+//	for each step: z = z**(2**Squarings) * t[Mul] (no multiplication if Mul == -1)
+type Chain struct {
+	NbOdd int
+	First int
+	Steps []Step
+}
+
+// Step of a Chain
+type Step struct {
+	Squarings int
+	Mul       int
+}
+
+// New returns the shortest sliding window chain computing x**e; e must not be negative
+func New(e *big.Int) *Chain {
+	if e.Sign() < 0 {
+		panic("addchain: negative exponent")
+	}
+	if e.Sign() == 0 {
+		return &Chain{First: -1}
+	}
+	var best *Chain
+	for w := 1; w <= maxWindow; w++ {
+		c := slidingWindow(e, w)
+		if best == nil || c.NbOperations() < best.NbOperations() {
+			best = c
+		}
+	}
+	return best
+}
+
+// slidingWindow returns the left-to-right sliding window chain of x**e with windows of at most w bits
+func slidingWindow(e *big.Int, w int) *Chain {
+	c := &Chain{First: -1}
+	squarings := 0
+	for i := e.BitLen() - 1; i >= 0; {
+		if e.Bit(i) == 0 {
+			squarings++
+			i--
+			continue
+		}
+
+		// the window [j, i] ends with the least significant 1 bit within w bits
+		j := i - w + 1
+		if j < 0 {
+			j = 0
+		}
+		for e.Bit(j) == 0 {
+			j++
+		}
+		v := 0
+		for k := i; k >= j; k-- {
+			v = v<<1 | int(e.Bit(k))
+		}
+		if v/2+1 > c.NbOdd {
+			c.NbOdd = v/2 + 1
+		}
+
+		if c.First == -1 {
+			c.First = v / 2
+		} else {
+			c.Steps = append(c.Steps, Step{Squarings: squarings + i - j + 1, Mul: v / 2})
+		}
+		squarings = 0
+		i = j - 1
+	}
+	if squarings != 0 {
+		c.Steps = append(c.Steps, Step{Squarings: squarings, Mul: -1})
+	}
+	return c
+}
+
+// NbSquarings returns the number of squarings of the chain, including the precomputation
+func (c *Chain) NbSquarings() int {
+	n := 0
+	if c.NbOdd > 1 {
+		n++
+	}
+	for _, s := range c.Steps {
+		n += s.Squarings
+	}
+	return n
+}
+
+// NbMultiplications returns the number of multiplications of the chain, including the precomputation
+func (c *Chain) NbMultiplications() int {
+	n := 0
+	if c.NbOdd > 1 {
+		n = c.NbOdd - 1
+	}
+	for _, s := range c.Steps {
+		if s.Mul != -1 {
+			n++
+		}
+	}
+	return n
+}
+
+// NbOperations returns the number of squarings and multiplications of the chain
+func (c *Chain) NbOperations() int {
+	return c.NbSquarings() + c.NbMultiplications()
+}
+
+// Exponent returns the exponent computed by the chain
+func (c *Chain) Exponent() *big.Int {
+	if c.NbOdd == 0 {
+		return new(big.Int)
+	}
+	e := big.NewInt(int64(2*c.First + 1))
+	for _, s := range c.Steps {
+		e.Lsh(e, uint(s.Squarings))
+		if s.Mul != -1 {
+			e.Add(e, big.NewInt(int64(2*s.Mul+1)))
+		}
+	}
+	return e
+}
@@ -0,0 +1,71 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package addchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestChain(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 200
+	properties := gopter.NewProperties(parameters)
+
+	genExponent := gen.SliceOfN(12, gen.UInt64()).Map(func(words []uint64) *big.Int {
+		var e big.Int
+		for _, w := range words {
+			e.Lsh(&e, 64).Add(&e, new(big.Int).SetUint64(w))
+		}
+		e.Rsh(&e, uint(words[0]%700)) // from 68 to 768 bits
+		if e.Sign() == 0 {
+			e.SetUint64(1)
+		}
+		return &e
+	})
+
+	properties.Property("the chain should compute the exponent", prop.ForAll(
+		func(e *big.Int) bool {
+			return New(e).Exponent().Cmp(e) == 0
+		},
+		genExponent,
+	))
+
+	properties.Property("the chain should be shorter than square-and-multiply", prop.ForAll(
+		func(e *big.Int) bool {
+			binary := e.BitLen() - 1
+			for _, w := range e.Bits() {
+				for ; w != 0; w &= w - 1 {
+					binary++
+				}
+			}
+			binary--
+			return New(e).NbOperations() <= binary
+		},
+		genExponent,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for _, e := range []int64{0, 1, 2, 3, 4, 5, 7, 8, 255, 256, 257} {
+		if New(big.NewInt(e)).Exponent().Int64() != e {
+			t.Fatal("wrong chain for", e)
+		}
+	}
+}
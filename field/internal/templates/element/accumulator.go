@@ -0,0 +1,244 @@
+package element
+
+// Accumulator is the lazy-reduction accumulator of products of field elements
+const Accumulator = `
+
+import (
+	"math/bits"
+)
+
+// WideAccumulator accumulates products of {{.ElementName}} on {{mul 2 .NbWords}} + 1 words, without modular reduction.
+//
+// A sum of products costs one Montgomery reduction (Reduce) instead of one per product:
+//
+// 	var acc WideAccumulator
+// 	for i := range a {
+// 		acc.MulAdd(&a[i], &b[i])
+// 	}
+// 	res := acc.Reduce()
+//
+// The zero value is an empty accumulator; it can hold up to 2**63 products.
+type WideAccumulator struct {
+	t [{{add (mul 2 .NbWords) 1}}]uint64
+}
+
+// MulAdd sets acc = acc + x * y, without reduction
+func (acc *WideAccumulator) MulAdd(x, y *{{.ElementName}}) *WideAccumulator {
+	var p [{{mul 2 .NbWords}}]uint64
+	var C uint64
+
+	// p = x * y
+	{{- range $i := .NbWordsIndexesFull}}
+	{{- if eq $i 0}}
+	C, p[0] = bits.Mul64(x[0], y[0])
+	{{- range $j := $.NbWordsIndexesNoZero}}
+	C, p[{{$j}}] = madd1(x[0], y[{{$j}}], C)
+	{{- end}}
+	{{- else}}
+	C, p[{{$i}}] = madd1(x[{{$i}}], y[0], p[{{$i}}])
+	{{- range $j := $.NbWordsIndexesNoZero}}
+	C, p[{{add $i $j}}] = madd2(x[{{$i}}], y[{{$j}}], p[{{add $i $j}}], C)
+	{{- end}}
+	{{- end}}
+	p[{{add $i $.NbWords}}] = C
+	{{- end}}
+
+	// acc = acc + p
+	acc.t[0], C = bits.Add64(acc.t[0], p[0], 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	acc.t[{{$i}}], C = bits.Add64(acc.t[{{$i}}], p[{{$i}}], C)
+	{{- end}}
+	{{- range $i := .NbWordsIndexesFull}}
+	acc.t[{{add $i $.NbWords}}], C = bits.Add64(acc.t[{{add $i $.NbWords}}], p[{{add $i $.NbWords}}], C)
+	{{- end}}
+	acc.t[{{mul 2 .NbWords}}] += C
+
+	return acc
+}
+
+// Reset empties the accumulator
+func (acc *WideAccumulator) Reset() *WideAccumulator {
+	acc.t = [{{add (mul 2 .NbWords) 1}}]uint64{}
+	return acc
+}
+
+// Reduce returns the sum of the accumulated products, as a reduced {{.ElementName}};
+// the accumulator is left unchanged
+func (acc *WideAccumulator) Reduce() (z {{.ElementName}}) {
+	t := acc.t
+
+	// the products are in Montgomery form (x*y*R**2), the montgomery reduction
+	// of t gives v = t * R**-1 mod q on {{add .NbWords 1}} words: v < t / R + q
+	montReduceWide(&t)
+
+	// v = vLo + vHi * R with vLo < R, vHi < 2**64
+	// vLo * R**-1 * (R mod q) < 2q
+	var lo [{{add (mul 2 .NbWords) 1}}]uint64
+	var C uint64
+	{{- range $i := .NbWordsIndexesFull}}
+	{{- if eq $i 0}}
+	C, lo[0] = bits.Mul64(t[{{$.NbWords}}], {{index $.One 0}})
+	{{- range $j := $.NbWordsIndexesNoZero}}
+	C, lo[{{$j}}] = madd1(t[{{$.NbWords}}], {{index $.One $j}}, C)
+	{{- end}}
+	{{- else}}
+	C, lo[{{$i}}] = madd1(t[{{add $i $.NbWords}}], {{index $.One 0}}, lo[{{$i}}])
+	{{- range $j := $.NbWordsIndexesNoZero}}
+	C, lo[{{add $i $j}}] = madd2(t[{{add $i $.NbWords}}], {{index $.One $j}}, lo[{{add $i $j}}], C)
+	{{- end}}
+	{{- end}}
+	lo[{{add $i $.NbWords}}] = C
+	{{- end}}
+	montReduceWide(&lo)
+
+	copy(z[:], lo[{{.NbWords}}:{{mul 2 .NbWords}}])
+	if lo[{{mul 2 .NbWords}}] != 0 {
+		// z + 2**{{mul 64 .NbWords}} - q
+		var b uint64
+		z[0], b = bits.Sub64(z[0], {{index $.Q 0}}, 0)
+		{{- range $i := .NbWordsIndexesNoZero}}
+		z[{{$i}}], b = bits.Sub64(z[{{$i}}], {{index $.Q $i}}, b)
+		{{- end}}
+	} else {
+		{{ template "reduce" . }}
+	}
+
+	// vHi * R mod q is the Montgomery form of vHi
+	if t[{{mul 2 .NbWords}}] != 0 {
+		var hi {{.ElementName}}
+		hi.SetUint64(t[{{mul 2 .NbWords}}])
+		z.Add(&z, &hi)
+	}
+
+	return
+}
+
+// montReduceWide sets t[{{.NbWords}}:] = t * R**-1 mod q, with t[{{.NbWords}}:] < t / R + q;
+// t must be smaller than 2**{{mul 64 (mul 2 .NbWords)}} * 2**63 so that the result fits on {{add .NbWords 1}} words
+func montReduceWide(t *[{{add (mul 2 .NbWords) 1}}]uint64) {
+	for i := 0; i < {{.NbWords}}; i++ {
+		// m = t[i]n'[0] mod W
+		m := t[i] * {{index $.QInverse 0}}
+		C := madd0(m, {{index $.Q 0}}, t[i])
+		{{- range $j := .NbWordsIndexesNoZero}}
+		C, t[i+{{$j}}] = madd2(m, {{index $.Q $j}}, t[i+{{$j}}], C)
+		{{- end}}
+		for k := i + {{.NbWords}}; k < len(t); k++ {
+			t[k], C = bits.Add64(t[k], C, 0)
+		}
+	}
+}
+
+`
+
+// AccumulatorTest is the test file of WideAccumulator
+const AccumulatorTest = `
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	ggen "github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestWideAccumulator(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genN := ggen.IntRange(0, 50)
+
+	properties.Property("WideAccumulator should match a sum of reduced products", prop.ForAll(
+		func(n int) bool {
+			a, b := randomVector(n), randomVector(n)
+			var acc WideAccumulator
+			var expected, t {{.ElementName}}
+			for i := 0; i < n; i++ {
+				acc.MulAdd(&a[i], &b[i])
+				t.Mul(&a[i], &b[i])
+				expected.Add(&expected, &t)
+			}
+			got := acc.Reduce()
+			return got.Equal(&expected) && !got.biggerOrEqualModulus()
+		},
+		genN,
+	))
+
+	properties.Property("WideAccumulator should handle q-1 operands", prop.ForAll(
+		func(n int) bool {
+			var minusOne, expected, t {{.ElementName}}
+			minusOne.SetOne().Neg(&minusOne)
+			var acc WideAccumulator
+			for i := 0; i < n; i++ {
+				acc.MulAdd(&minusOne, &minusOne)
+				t.Mul(&minusOne, &minusOne)
+				expected.Add(&expected, &t)
+			}
+			got := acc.Reduce()
+			return got.Equal(&expected) && !got.biggerOrEqualModulus()
+		},
+		genN,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestWideAccumulatorLargeTopWord(t *testing.T) {
+	// fill the top word beyond what a test can accumulate, and check the reduction against math/big
+	var acc WideAccumulator
+	for i := range acc.t {
+		acc.t[i] = ^uint64(0)
+	}
+	acc.t[len(acc.t)-1] = (1 << 63) - 1
+
+	var v, r, rInv big.Int
+	for i := len(acc.t) - 1; i >= 0; i-- {
+		v.Lsh(&v, 64).Add(&v, new(big.Int).SetUint64(acc.t[i]))
+	}
+	r.Lsh(big.NewInt(1), Limbs*64)
+	rInv.ModInverse(&r, Modulus())
+	v.Mul(&v, &rInv).Mod(&v, Modulus())
+
+	var expected {{.ElementName}}
+	expected.SetBigInt(&v)
+	expected.FromMont()
+
+	got := acc.Reduce()
+	if !got.Equal(&expected) {
+		t.Fatal("WideAccumulator.Reduce doesn't match math/big")
+	}
+}
+
+func BenchmarkWideAccumulator(b *testing.B) {
+	const n = 256
+	x, y := randomVector(n), randomVector(n)
+
+	b.Run("mulAdd+reduce", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var acc WideAccumulator
+			for j := 0; j < n; j++ {
+				acc.MulAdd(&x[j], &y[j])
+			}
+			benchRes{{.ElementName}} = acc.Reduce()
+		}
+	})
+	b.Run("mul+add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var res, t {{.ElementName}}
+			for j := 0; j < n; j++ {
+				t.Mul(&x[j], &y[j])
+				res.Add(&res, &t)
+			}
+			benchRes{{.ElementName}} = res
+		}
+	})
+}
+
+`
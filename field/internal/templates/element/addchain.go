@@ -0,0 +1,51 @@
+package element
+
+// ExpChains are the fixed exponentiations unrolled from addition chains (see field/internal/addchain)
+const ExpChains = `
+{{- range $c := .ExpChains}}
+
+// {{$c.Name}} is equivalent to z.Exp(x, {{shorten $c.Exponent}}) (base 16);
+// it uses an addition chain of {{$c.NbSquarings}} squarings and {{$c.NbMultiplications}} multiplications
+{{- if $c.ConstantTime}}
+// (mulCT and squareCT, in constant time)
+{{- end}}
+func (z *{{$.ElementName}}) {{$c.Name}}(x {{$.ElementName}}) *{{$.ElementName}} {
+	{{- if eq $c.NbOdd 0}}
+	return z.SetOne()
+}
+	{{- else}}
+	{{- if eq $c.NbOdd 1}}
+	z.Set(&x)
+	{{- else}}
+	// t[i] = x^(2*i+1)
+	var t [{{$c.NbOdd}}]{{$.ElementName}}
+	var x2 {{$.ElementName}}
+	x2.{{template "chainSquare" $c}}(&x)
+	t[0] = x
+	for i := 1; i < len(t); i++ {
+		t[i].{{template "chainMul" $c}}(&t[i-1], &x2)
+	}
+
+	z.Set(&t[{{$c.First}}])
+	{{- end}}
+	{{- range $s := $c.Steps}}
+	{{- if eq $s.Squarings 1}}
+	z.{{template "chainSquare" $c}}(z)
+	{{- else}}
+	for s := 0; s < {{$s.Squarings}}; s++ {
+		z.{{template "chainSquare" $c}}(z)
+	}
+	{{- end}}
+	{{- if ne $s.Mul -1}}
+	z.{{template "chainMul" $c}}(z, {{if eq $c.NbOdd 1}}&x{{else}}&t[{{$s.Mul}}]{{end}})
+	{{- end}}
+	{{- end}}
+
+	return z
+}
+	{{- end}}
+{{- end}}
+
+{{- define "chainSquare"}}{{if .ConstantTime}}squareCT{{else}}Square{{end}}{{end}}
+{{- define "chainMul"}}{{if .ConstantTime}}mulCT{{else}}Mul{{end}}{{end}}
+`
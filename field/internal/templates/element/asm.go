@@ -4,7 +4,11 @@ package element
 const Asm = `
 import 	"golang.org/x/sys/cpu"
 
-var supportAdx = cpu.X86.HasADX && cpu.X86.HasBMI2
+var (
+	supportAdx    = cpu.X86.HasADX && cpu.X86.HasBMI2
+	supportAvx512 = supportAdx && cpu.X86.HasAVX512 && cpu.X86.HasAVX512DQ && cpu.X86.HasAVX512IFMA
+	supportAvx2   = cpu.X86.HasAVX2
+)
 `
 
 // AsmNoAdx ...
@@ -13,5 +17,9 @@ const AsmNoAdx = `
 // note: this is needed for test purposes, as dynamically changing supportAdx doesn't flag
 // certain errors (like fatal error: missing stackmap)
 // this ensures we test all asm path.
-var supportAdx = false
+var (
+	supportAdx    = false
+	supportAvx512 = false
+	supportAvx2   = false
+)
 `
@@ -137,6 +137,11 @@ func (z *{{.ElementName}}) Div( x, y *{{.ElementName}}) *{{.ElementName}} {
 	return z
 }
 
+// Butterfly sets z = z + b, b = z - b (mod q); method form of the package level Butterfly
+func (z *{{.ElementName}}) Butterfly(b *{{.ElementName}}) {
+	Butterfly(z, b)
+}
+
 // Equal returns z == x
 func (z *{{.ElementName}}) Equal(x *{{.ElementName}}) bool {
 	return {{- range $i :=  reverse .NbWordsIndexesNoZero}}(z[{{$i}}] == x[{{$i}}]) &&{{end}}(z[0] == x[0])
@@ -0,0 +1,583 @@
+package element
+
+// ConstantTime is the constant time inversion (Bernstein-Yang safegcd) and square root
+const ConstantTime = `
+
+import (
+	"math/bits"
+)
+
+// InverseCT z = x^-1 mod q, in constant time
+// if x == 0, sets and returns z = 0
+//
+// It implements the safegcd algorithm of Bernstein and Yang, "Fast constant-time gcd computation
+// and modular inversion" (https://eprint.iacr.org/2019/266), in batches of 62 divsteps on signed
+// 62-bit limbs, following the constant time modular inversion of libsecp256k1 (src/modinv64_impl.h).
+// The number of divsteps, {{mul 62 .DivstepsBatches}}, is fixed by the bit size of q.
+func (z *{{.ElementName}}) InverseCT(x *{{.ElementName}}) *{{.ElementName}} {
+	// f = q, g = x and the invariants f * R**2 = d * x, g * R**2 = e * x (mod q):
+	// when g reaches 0, f = ±1 and ±d = x**-1 * R**2 is the Montgomery form of x**-1
+	var f, g, d, e signed62
+	f = qSigned62
+	g.setWords(x)
+	e.setWords(&rSquare)
+
+	delta := int64(1)
+	for i := 0; i < divstepsBatches; i++ {
+		var t divstepsMatrix
+		delta = divsteps62(delta, uint64(f[0]), uint64(g[0]), &t)
+		updateDE(&d, &e, &t)
+		updateFG(&f, &g, &t)
+	}
+
+	d.normalize(f[signed62Limbs-1])
+	d.words(z)
+	return z
+}
+
+// SqrtCT z = √x mod q, in constant time
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil; the running time only depends on whether x is a square
+//
+// Unlike Sqrt, it doesn't branch on intermediate values{{if .SqrtTonelliShanks}}: Tonelli-Shanks does {{.SqrtE}} - 1 fixed
+// iterations, with conditional selections (https://www.rfc-editor.org/rfc/rfc9380#appendix-I.4){{end}}.
+// It uses mulCT, squareCT, addCT and subCT rather than Mul, Square, Add and Sub, whose final subtraction
+// is a branch in the generic Go code (moduli without assembly, targets other than amd64 and arm64);
+// SqrtCT is constant time on all targets.
+func (z *{{.ElementName}}) SqrtCT(x *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .SqrtQ3Mod4}}
+	// q ≡ 3 (mod 4)
+	// using  z ≡ ± x^((p+1)/4) (mod q)
+	var y {{.ElementName}}
+	y.expBySqrtExpCT(*x)
+	{{- else if .SqrtAtkin}}
+	// q ≡ 5 (mod 8)
+	// see modSqrt5Mod8Prime in math/big/int.go
+	var one, alpha, y, tx {{.ElementName}}
+	one.SetOne()
+	tx.addCT(x, x)
+	alpha.expBySqrtExpCT(tx)
+	y.squareCT(&alpha).
+		mulCT(&y, &tx).
+		subCT(&y, &one).
+		mulCT(&y, x).
+		mulCT(&y, &alpha)
+	{{- else if .SqrtTonelliShanks}}
+	// q ≡ 1 (mod 4), q - 1 = 2**{{.SqrtE}} * s
+	var one, w, y, t, b, c, tmp {{.ElementName}}
+	one.SetOne()
+
+	// w = x^((s-1)/2), y = x^((s+1)/2), t = x^s
+	w.expBySqrtExpCT(*x)
+	y.mulCT(x, &w)
+	t.mulCT(&w, &y)
+
+	// c = nonResidue ^ s
+	c = {{.ElementName}}{
+		{{- range $i := .SqrtG}}
+		{{$i}},{{end}}
+	}
+
+	for i := {{.SqrtE}}; i >= 2; i-- {
+		// b = t^(2^(i-2)), y and t are updated if b != 1
+		b = t
+		for j := 1; j <= i-2; j++ {
+			b.squareCT(&b)
+		}
+		isOne := ctEqual(&b, &one)
+
+		tmp.mulCT(&y, &c)
+		y.ctSelect(isOne, &tmp, &y)
+		c.squareCT(&c)
+		tmp.mulCT(&t, &c)
+		t.ctSelect(isOne, &tmp, &t)
+	}
+	{{- else}}
+	panic("not implemented")
+	{{- end}}
+
+	// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+	var square {{.ElementName}}
+	square.squareCT(&y)
+	if ctEqual(&square, x) == 1 {
+		return z.Set(&y)
+	}
+	return nil
+}
+
+// mulCT z = x * y (mod q), in constant time
+//
+// The product is reduced with montReduceWide: x * y < q * R, so the result is smaller than 2q
+// and the final subtraction of q is a conditional selection
+func (z *{{.ElementName}}) mulCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var acc WideAccumulator
+	acc.MulAdd(x, y)
+	montReduceWide(&acc.t)
+
+	var v, s {{.ElementName}}
+	copy(v[:], acc.t[{{.NbWords}}:{{mul 2 .NbWords}}])
+	var b uint64
+	s[0], b = bits.Sub64(v[0], {{index $.Q 0}}, 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	s[{{$i}}], b = bits.Sub64(v[{{$i}}], {{index $.Q $i}}, b)
+	{{- end}}
+	_, b = bits.Sub64(acc.t[{{mul 2 .NbWords}}], 0, b)
+
+	// b == 1 if v < q
+	return z.ctSelect(b, &s, &v)
+}
+
+// squareCT z = x * x (mod q), in constant time
+func (z *{{.ElementName}}) squareCT(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.mulCT(x, x)
+}
+
+// addCT z = x + y (mod q), in constant time
+func (z *{{.ElementName}}) addCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var v, s {{.ElementName}}
+	var c, b uint64
+	v[0], c = bits.Add64(x[0], y[0], 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	v[{{$i}}], c = bits.Add64(x[{{$i}}], y[{{$i}}], c)
+	{{- end}}
+
+	s[0], b = bits.Sub64(v[0], {{index $.Q 0}}, 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	s[{{$i}}], b = bits.Sub64(v[{{$i}}], {{index $.Q $i}}, b)
+	{{- end}}
+	_, b = bits.Sub64(c, 0, b)
+
+	// b == 1 if x + y < q
+	return z.ctSelect(b, &s, &v)
+}
+
+// subCT z = x - y (mod q), in constant time
+func (z *{{.ElementName}}) subCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var b, c uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	z[{{$i}}], b = bits.Sub64(x[{{$i}}], y[{{$i}}], b)
+	{{- end}}
+
+	// adds q if x < y
+	mask := -b
+	z[0], c = bits.Add64(z[0], {{index $.Q 0}}&mask, 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	{{- if eq $i $.NbWordsLastIndex}}
+	z[{{$i}}], _ = bits.Add64(z[{{$i}}], {{index $.Q $i}}&mask, c)
+	{{- else}}
+	z[{{$i}}], c = bits.Add64(z[{{$i}}], {{index $.Q $i}}&mask, c)
+	{{- end}}
+	{{- end}}
+	return z
+}
+
+// ctEqual returns 1 if x == y, 0 otherwise, in constant time
+func ctEqual(x, y *{{.ElementName}}) uint64 {
+	t := x[0] ^ y[0]
+	{{- range $i := .NbWordsIndexesNoZero}}
+	t |= x[{{$i}}] ^ y[{{$i}}]
+	{{- end}}
+	return 1 ^ ((t | -t) >> 63)
+}
+
+// ctSelect sets z = x0 if c == 0, z = x1 if c == 1, in constant time
+func (z *{{.ElementName}}) ctSelect(c uint64, x0, x1 *{{.ElementName}}) *{{.ElementName}} {
+	mask := -c
+	{{- range $i := .NbWordsIndexesFull}}
+	z[{{$i}}] = x0[{{$i}}] ^ (mask & (x0[{{$i}}] ^ x1[{{$i}}]))
+	{{- end}}
+	return z
+}
+
+const (
+	// signed62Limbs is the number of 62-bit limbs of the safegcd integers: f, g in [-q, q], d, e in (-2q, q)
+	signed62Limbs = {{.Signed62Limbs}}
+
+	// divstepsBatches is the number of batches of 62 divsteps; Bernstein-Yang, Theorem 11.2:
+	// {{mul 62 .DivstepsBatches}} divsteps bring g to 0 for {{.NbBits}}-bit inputs
+	divstepsBatches = {{.DivstepsBatches}}
+
+	mask62 = (1 << 62) - 1
+
+	// qInv62 = q**-1 mod 2**62
+	qInv62 uint64 = {{.QInv62}}
+)
+
+// signed62 is a signed integer sum(s[i] * 2**(62*i)); s[i] < 2**62 for i < signed62Limbs - 1
+// and the most significant limb holds the sign
+type signed62 [signed62Limbs]int64
+
+// qSigned62 is q on 62-bit limbs
+var qSigned62 = signed62{
+	{{- range $i := .QSigned62}}
+	{{$i}},{{end}}
+}
+
+// divstepsMatrix is the transition matrix of 62 divsteps, scaled by 2**62:
+// (f, g) * 2**62 = (u*f0 + v*g0, q*f0 + r*g0)
+type divstepsMatrix struct {
+	u, v, q, r int64
+}
+
+// divsteps62 applies 62 divsteps to (delta, f, g), from the 62 least significant bits of f and g,
+// stores the transition matrix in t and returns the new delta
+func divsteps62(delta int64, f, g uint64, t *divstepsMatrix) int64 {
+	// the matrix entries are in [-2**62, 2**62], computed mod 2**64
+	u, v, q, r := uint64(1), uint64(0), uint64(0), uint64(1)
+	for i := 0; i < 62; i++ {
+		// c1 = -1 if delta > 0, c2 = -1 if g is odd
+		c1 := uint64((-delta) >> 63)
+		c2 := -(g & 1)
+
+		// if g is odd: g = g - f if delta > 0, g = g + f otherwise (and q, r likewise)
+		x := (f ^ c1) - c1
+		y := (u ^ c1) - c1
+		w := (v ^ c1) - c1
+		g += x & c2
+		q += y & c2
+		r += w & c2
+
+		// if delta > 0 and g is odd: f = g (and u, v = q, r), delta = -delta
+		c1 &= c2
+		delta = (delta ^ int64(c1)) - int64(c1)
+		f += g & c1
+		u += q & c1
+		v += r & c1
+
+		delta++
+		g >>= 1
+		u <<= 1
+		v <<= 1
+	}
+	t.u, t.v, t.q, t.r = int64(u), int64(v), int64(q), int64(r)
+	return delta
+}
+
+// updateFG sets f, g = (u*f + v*g) / 2**62, (q*f + r*g) / 2**62
+func updateFG(f, g *signed62, t *divstepsMatrix) {
+	var cf, cg int128
+	cf.mulAdd(t.u, f[0])
+	cf.mulAdd(t.v, g[0])
+	cg.mulAdd(t.q, f[0])
+	cg.mulAdd(t.r, g[0])
+	// the 62 least significant bits are 0
+	cf.rsh62()
+	cg.rsh62()
+
+	for i := 1; i < signed62Limbs; i++ {
+		cf.mulAdd(t.u, f[i])
+		cf.mulAdd(t.v, g[i])
+		cg.mulAdd(t.q, f[i])
+		cg.mulAdd(t.r, g[i])
+		f[i-1] = int64(cf.lo & mask62)
+		g[i-1] = int64(cg.lo & mask62)
+		cf.rsh62()
+		cg.rsh62()
+	}
+	f[signed62Limbs-1] = int64(cf.lo)
+	g[signed62Limbs-1] = int64(cg.lo)
+}
+
+// updateDE sets d, e = (u*d + v*e) / 2**62, (q*d + r*e) / 2**62 mod q;
+// d and e stay in (-2q, q)
+func updateDE(d, e *signed62, t *divstepsMatrix) {
+	// md, me start as (u, q) if d < 0, plus (v, r) if e < 0
+	sd := d[signed62Limbs-1] >> 63
+	se := e[signed62Limbs-1] >> 63
+	md := (t.u & sd) + (t.v & se)
+	me := (t.q & sd) + (t.r & se)
+
+	var cd, ce int128
+	cd.mulAdd(t.u, d[0])
+	cd.mulAdd(t.v, e[0])
+	ce.mulAdd(t.q, d[0])
+	ce.mulAdd(t.r, e[0])
+
+	// correct md, me so that u*d + v*e + q*md and q*d + r*e + q*me are divisible by 2**62
+	md -= int64((qInv62*cd.lo + uint64(md)) & mask62)
+	me -= int64((qInv62*ce.lo + uint64(me)) & mask62)
+	cd.mulAdd(qSigned62[0], md)
+	ce.mulAdd(qSigned62[0], me)
+	cd.rsh62()
+	ce.rsh62()
+
+	for i := 1; i < signed62Limbs; i++ {
+		cd.mulAdd(t.u, d[i])
+		cd.mulAdd(t.v, e[i])
+		cd.mulAdd(qSigned62[i], md)
+		ce.mulAdd(t.q, d[i])
+		ce.mulAdd(t.r, e[i])
+		ce.mulAdd(qSigned62[i], me)
+		d[i-1] = int64(cd.lo & mask62)
+		e[i-1] = int64(ce.lo & mask62)
+		cd.rsh62()
+		ce.rsh62()
+	}
+	d[signed62Limbs-1] = int64(cd.lo)
+	e[signed62Limbs-1] = int64(ce.lo)
+}
+
+// normalize sets s in (-2q, q) to s mod q if sign >= 0, -s mod q otherwise, in [0, q)
+func (s *signed62) normalize(sign int64) {
+	// add q if s < 0, then negate if sign < 0: s in (-q, q)
+	c := s[signed62Limbs-1] >> 63
+	for i := range s {
+		s[i] += qSigned62[i] & c
+	}
+	c = sign >> 63
+	for i := range s {
+		s[i] = (s[i] ^ c) - c
+	}
+	s.carry()
+
+	// add q if s < 0
+	c = s[signed62Limbs-1] >> 63
+	for i := range s {
+		s[i] += qSigned62[i] & c
+	}
+	s.carry()
+}
+
+// carry brings the limbs of s back in [0, 2**62), except the most significant one
+func (s *signed62) carry() {
+	for i := 0; i < signed62Limbs-1; i++ {
+		s[i+1] += s[i] >> 62
+		s[i] &= mask62
+	}
+}
+
+// setWords sets s to the words of x (x is not converted from Montgomery form)
+func (s *signed62) setWords(x *{{.ElementName}}) {
+	for i := range s {
+		k, b := (62*i)/64, uint(62*i)%64
+		var l uint64
+		if k < Limbs {
+			l = x[k] >> b
+		}
+		if b > 2 && k+1 < Limbs {
+			l |= x[k+1] << (64 - b)
+		}
+		s[i] = int64(l & mask62)
+	}
+}
+
+// words sets z to the words of s, in [0, q)
+func (s *signed62) words(z *{{.ElementName}}) {
+	for i := range z {
+		k, b := (64*i)/62, uint(64*i)%62
+		w := uint64(s[k]) >> b
+		if k+1 < signed62Limbs {
+			w |= uint64(s[k+1]) << (62 - b)
+		}
+		if b > 60 && k+2 < signed62Limbs {
+			w |= uint64(s[k+2]) << (124 - b)
+		}
+		z[i] = w
+	}
+}
+
+// int128 is the signed integer hi * 2**64 + lo
+type int128 struct {
+	hi int64
+	lo uint64
+}
+
+// mulAdd sets x = x + a * b
+func (x *int128) mulAdd(a, b int64) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	// from the unsigned product, subtract b * 2**64 if a < 0 and a * 2**64 if b < 0
+	hi -= (uint64(b) & uint64(a>>63)) + (uint64(a) & uint64(b>>63))
+	var c uint64
+	x.lo, c = bits.Add64(x.lo, lo, 0)
+	x.hi += int64(hi + c)
+}
+
+// rsh62 sets x = x >> 62 (arithmetic shift)
+func (x *int128) rsh62() {
+	x.lo = x.lo>>62 | uint64(x.hi)<<2
+	x.hi >>= 62
+}
+
+`
+
+// ConstantTimeTest is the test file of InverseCT, SqrtCT and the constant time arithmetic they use
+const ConstantTimeTest = `
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func Test{{toTitle .ElementName}}InverseCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("InverseCT should output the same result as Inverse", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			c.InverseCT(&a.element)
+			d.Inverse(&a.element)
+			return c.Equal(&d) && !c.biggerOrEqualModulus()
+		},
+		genA,
+	))
+
+	properties.Property("InverseCT should output the same result as big.Int.ModInverse", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c {{.ElementName}}
+			c.InverseCT(&a.element)
+			var expected big.Int
+			if expected.ModInverse(&a.bigint, Modulus()) == nil {
+				expected.SetUint64(0)
+			}
+			var b big.Int
+			return c.ToBigIntRegular(&b).Cmp(&expected) == 0
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for _, a := range staticTestValues {
+		var c, d {{.ElementName}}
+		c.InverseCT(&a)
+		d.Inverse(&a)
+		if !c.Equal(&d) {
+			t.Fatal("InverseCT doesn't match Inverse on static test values")
+		}
+	}
+}
+
+func Test{{toTitle .ElementName}}SqrtCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("SqrtCT should output a square root iff Sqrt does", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, d, square {{.ElementName}}
+			r1 := c.SqrtCT(&a.element)
+			r2 := d.Sqrt(&a.element)
+			if (r1 == nil) != (r2 == nil) {
+				return false
+			}
+			if r1 == nil {
+				return true
+			}
+			square.Square(&c)
+			return square.Equal(&a.element) && !c.biggerOrEqualModulus()
+		},
+		genA,
+	))
+
+	properties.Property("SqrtCT of a square should be ± the root", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, square, neg {{.ElementName}}
+			square.Square(&a.element)
+			if c.SqrtCT(&square) == nil {
+				return false
+			}
+			neg.Neg(&a.element)
+			return c.Equal(&a.element) || c.Equal(&neg)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{toTitle .ElementName}}ArithmeticCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	properties.Property("mulCT, squareCT, addCT and subCT should match Mul, Square, Add and Sub", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			return checkArithmeticCT(&a.element, &b.element)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for i := range staticTestValues {
+		for j := range staticTestValues {
+			if !checkArithmeticCT(&staticTestValues[i], &staticTestValues[j]) {
+				t.Fatal("constant time arithmetic doesn't match on static test values")
+			}
+		}
+	}
+}
+
+func checkArithmeticCT(x, y *{{.ElementName}}) bool {
+	var c, d {{.ElementName}}
+	c.mulCT(x, y)
+	d.Mul(x, y)
+	if !c.Equal(&d) || c.biggerOrEqualModulus() {
+		return false
+	}
+	c.squareCT(x)
+	d.Square(x)
+	if !c.Equal(&d) || c.biggerOrEqualModulus() {
+		return false
+	}
+	c.addCT(x, y)
+	d.Add(x, y)
+	if !c.Equal(&d) || c.biggerOrEqualModulus() {
+		return false
+	}
+	c.subCT(x, y)
+	d.Sub(x, y)
+	return c.Equal(&d) && !c.biggerOrEqualModulus()
+}
+
+func Benchmark{{toTitle .ElementName}}InverseCT(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.InverseCT(&x)
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}SqrtCT(b *testing.B) {
+	var a {{.ElementName}}
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.SqrtCT(&a)
+	}
+}
+
+`
@@ -6,6 +6,9 @@ const MulCIOS = `
 	var D uint64
 	var m, C uint64
 
+	// the most significant word of q may be saturated, so the carry in t[{{.all.NbWords}}] is
+	// propagated explicitly (D) rather than folded in the high word of m*q[{{.all.NbWordsLastIndex}}]
+
 	{{- range $j := .all.NbWordsIndexesFull}}
 		// -----------------------------------
 		// First loop
@@ -14,13 +17,14 @@ const MulCIOS = `
 			{{- range $i := $.all.NbWordsIndexesNoZero}}
 				C, t[{{$i}}] = madd1({{$.V2}}[{{$j}}], {{$.V1}}[{{$i}}], C)
 			{{- end}}
+			t[{{$.all.NbWords}}], D = C, 0
 		{{ else }}
 			C, t[0] = madd1({{$.V2}}[{{$j}}], {{$.V1}}[0], t[0])
 			{{- range $i := $.all.NbWordsIndexesNoZero}}
 				C, t[{{$i}}] = madd2({{$.V2}}[{{$j}}], {{$.V1}}[{{$i}}], t[{{$i}}], C)
 			{{- end}}
+			t[{{$.all.NbWords}}], D = bits.Add64(t[{{$.all.NbWords}}], C, 0)
 		{{ end }}
-		D = C
 
 		// m = t[0]n'[0] mod W
 		m = t[0] * {{index $.all.QInverse 0}}
@@ -29,13 +33,10 @@ const MulCIOS = `
 		// Second loop
 		C = madd0(m, {{index $.all.Q 0}}, t[0])
 		{{- range $i := $.all.NbWordsIndexesNoZero}}
-			{{if eq $i $.all.NbWordsLastIndex}}
-				C, t[{{sub $i 1}}] = madd3(m, {{index $.all.Q $i}}, t[{{$i}}], C, t[{{$.all.NbWords}}])
-			{{else}}
-				C, t[{{sub $i 1}}] = madd2(m, {{index $.all.Q $i}}, t[{{$i}}], C)
-			{{- end}}
+			C, t[{{sub $i 1}}] = madd2(m, {{index $.all.Q $i}}, t[{{$i}}], C)
 		{{- end}}
-		t[{{sub $.all.NbWords 1}}], t[{{$.all.NbWords}}] = bits.Add64(D, C, 0)
+		t[{{sub $.all.NbWords 1}}], C = bits.Add64(t[{{$.all.NbWords}}], C, 0)
+		t[{{$.all.NbWords}}] = D + C
 	{{- end}}
 
 
@@ -42,4 +42,54 @@ func Butterfly(a, b *{{.ElementName}})
 
 
 
+`
+
+// OpsARM64 is included with ARM64 builds (regardless of if F.ASM is set)
+const OpsARM64 = `
+
+{{if .ASM}}
+
+// MulBy3 x *= 3
+func MulBy3(x *{{.ElementName}}) {
+	mulByConstant(x, 3)
+}
+
+// MulBy5 x *= 5
+func MulBy5(x *{{.ElementName}}) {
+	mulByConstant(x, 5)
+}
+
+// MulBy13 x *= 13
+func MulBy13(x *{{.ElementName}}) {
+	mulByConstant(x, 13)
+}
+
+//go:noescape
+func add(res,x,y *{{.ElementName}})
+
+//go:noescape
+func sub(res,x,y *{{.ElementName}})
+
+//go:noescape
+func neg(res,x *{{.ElementName}})
+
+//go:noescape
+func double(res,x *{{.ElementName}})
+
+//go:noescape
+func mul(res,x,y *{{.ElementName}})
+
+//go:noescape
+func fromMont(res *{{.ElementName}})
+
+//go:noescape
+func reduce(res *{{.ElementName}})
+
+//go:noescape
+func Butterfly(a, b *{{.ElementName}})
+
+{{end}}
+
+
+
 `
@@ -2,28 +2,88 @@ package element
 
 const Sqrt = `
 
+{{- if .SqrtTonelliShanks}}
+
+const (
+	// the discrete logarithms of the Tonelli-Shanks algorithm are computed in sqrtNbWindows{{.ElementName}}
+	// windows, the least significant one of sqrtFirstWindow{{.ElementName}} bits and the others of sqrtWindow{{.ElementName}} bits
+	sqrtWindow{{.ElementName}}      = {{.SqrtWindow}}
+	sqrtNbWindows{{.ElementName}}   = {{.SqrtNbWindows}}
+	sqrtFirstWindow{{.ElementName}} = {{.SqrtE}} - sqrtWindow{{.ElementName}}*(sqrtNbWindows{{.ElementName}}-1)
+)
+
 var (
-	_bLegendreExponent{{.ElementName}} *big.Int
-	_bSqrtExponent{{.ElementName}} *big.Int
+	_sqrtTables{{.ElementName}}     sqrtTables{{.ElementName}}
+	_sqrtTablesOnce{{.ElementName}} sync.Once
 )
 
-func init() {
-	_bLegendreExponent{{.ElementName}}, _ = new(big.Int).SetString("{{.LegendreExponent}}", 16)
-	{{- if .SqrtQ3Mod4}}
-		const sqrtExponent{{.ElementName}} = "{{.SqrtQ3Mod4Exponent}}"
-	{{- else if .SqrtAtkin}}
-		const sqrtExponent{{.ElementName}} = "{{.SqrtAtkinExponent}}"
-	{{- else if .SqrtTonelliShanks}}
-		const sqrtExponent{{.ElementName}} = "{{.SqrtSMinusOneOver2}}"
-	{{- end }}
-	_bSqrtExponent{{.ElementName}}, _ = new(big.Int).SetString(sqrtExponent{{.ElementName}}, 16)
+// sqrtTables{{.ElementName}} holds the precomputed tables of the Tonelli-Shanks discrete logarithms
+type sqrtTables{{.ElementName}} struct {
+	// g[shift][c] = g^(-c * 2^shift), g = nonResidue^s, for the shifts used by Sqrt
+	g [{{.SqrtE}}][]{{.ElementName}}
+
+	// dlog[g^(c * 2^({{.SqrtE}}-sqrtWindow))] = c
+	dlog map[{{.ElementName}}]uint64
+}
+
+// sqrtShift{{.ElementName}} returns the position of the least significant bit of the window i
+func sqrtShift{{.ElementName}}(i int) int {
+	if i == 0 {
+		return 0
+	}
+	return sqrtFirstWindow{{.ElementName}} + sqrtWindow{{.ElementName}}*(i-1)
+}
+
+func (tables *sqrtTables{{.ElementName}}) init() {
+	const w, n, e = sqrtWindow{{.ElementName}}, sqrtNbWindows{{.ElementName}}, {{.SqrtE}}
+
+	// shifts of the windows, and of the windows raised to 2^(w*r) in Sqrt
+	var shifts [e]bool
+	for i := 0; i < n; i++ {
+		for r := 0; r == 0 || r < n-1-i; r++ {
+			shifts[sqrtShift{{.ElementName}}(i)+w*r] = true
+		}
+	}
+
+	var g = {{.ElementName}}{
+		{{- range $i := .SqrtG}}
+		{{$i}},{{end}}
+	}
+
+	// base = g^(-2^shift)
+	var base {{.ElementName}}
+	base.Inverse(&g)
+	for shift := 0; shift < e; shift++ {
+		if shifts[shift] {
+			tables.g[shift] = make([]{{.ElementName}}, 1<<w)
+			tables.g[shift][0].SetOne()
+			for c := 1; c < 1<<w; c++ {
+				tables.g[shift][c].Mul(&tables.g[shift][c-1], &base)
+			}
+		}
+		base.Square(&base)
+	}
+
+	// zeta = g^(2^(e-w)) is of order 2^w
+	zeta := g
+	for i := 0; i < e-w; i++ {
+		zeta.Square(&zeta)
+	}
+	tables.dlog = make(map[{{.ElementName}}]uint64, 1<<w)
+	var a {{.ElementName}}
+	a.SetOne()
+	for c := uint64(0); c < 1<<w; c++ {
+		tables.dlog[a] = c
+		a.Mul(&a, &zeta)
+	}
 }
+{{- end}}
 
 // Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
 func (z *{{.ElementName}}) Legendre() int {
 	var l {{.ElementName}}
 	// z^((q-1)/2)
-	l.Exp(*z, _bLegendreExponent{{.ElementName}})
+	l.expByLegendreExp(*z)
 	
 	if l.IsZero() {
 		return 0
@@ -44,7 +104,7 @@ func (z *{{.ElementName}}) Sqrt(x *{{.ElementName}}) *{{.ElementName}} {
 		// q ≡ 3 (mod 4)
 		// using  z ≡ ± x^((p+1)/4) (mod q)
 		var y, square {{.ElementName}}
-		y.Exp(*x, _bSqrtExponent{{.ElementName}})
+		y.expBySqrtExp(*x)
 		// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
 		square.Square(&y)
 		if square.Equal(x) {
@@ -57,7 +117,7 @@ func (z *{{.ElementName}}) Sqrt(x *{{.ElementName}}) *{{.ElementName}} {
 		var one, alpha, beta, tx, square {{.ElementName}}
 		one.SetOne()
 		tx.Double(x)
-		alpha.Exp(tx, _bSqrtExponent{{.ElementName}})
+		alpha.expBySqrtExp(tx)
 		beta.Square(&alpha).
 			Mul(&beta, &tx).
 			Sub(&beta, &one).
@@ -71,66 +131,76 @@ func (z *{{.ElementName}}) Sqrt(x *{{.ElementName}}) *{{.ElementName}} {
 		}
 		return nil
 	{{- else if .SqrtTonelliShanks}}
-		// q ≡ 1 (mod 4)
-		// see modSqrtTonelliShanks in math/big/int.go
-		// using https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
+		// q ≡ 1 (mod 4), q - 1 = 2^{{.SqrtE}} * s with s odd
+		// Tonelli-Shanks, with the discrete logarithm computed from precomputed tables
+		// see P. Sarkar, "Computing square roots faster than the Tonelli-Shanks/Bernstein algorithm"
+		// https://eprint.iacr.org/2020/1407
+		if x.IsZero() {
+			return z.SetZero()
+		}
+		const win, n, win0 = sqrtWindow{{.ElementName}}, sqrtNbWindows{{.ElementName}}, sqrtFirstWindow{{.ElementName}}
+		_sqrtTablesOnce{{.ElementName}}.Do(_sqrtTables{{.ElementName}}.init)
+		tables := &_sqrtTables{{.ElementName}}
 
-		var y, b,t, w  {{.ElementName}}
+		var y, t, w {{.ElementName}}
 		// w = x^((s-1)/2))
-		w.Exp(*x, _bSqrtExponent{{.ElementName}})
+		w.expBySqrtExp(*x)
 
 		// y = x^((s+1)/2)) = w * x
 		y.Mul(x, &w)
 
-		// b = x^s = w * w * x = y * x
-		b.Mul(&w, &y)
+		// t = x^s = w * y = g^k, with g = nonResidue^s of order 2^{{.SqrtE}}:
+		// x is a square iff k is even, and then y * g^(-k/2) is a square root of x
+		t.Mul(&w, &y)
 
-		// g = nonResidue ^ s
-		var g = {{.ElementName}}{
-			{{- range $i := .SqrtG}}
-			{{$i}},{{end}}
+		// p[i] = t^(2^(win*i))
+		var p [n]{{.ElementName}}
+		p[0] = t
+		for i := 1; i < n; i++ {
+			p[i] = p[i-1]
+			for j := 0; j < win; j++ {
+				p[i].Square(&p[i])
+			}
 		}
-		r := uint64({{.SqrtE}})
 
-		// compute legendre symbol
-		// t = x^((q-1)/2) = r-1 squaring of x^s
-		t = b
-		for i:=uint64(0); i < r-1; i++ {
-			t.Square(&t)
-		}
-		if t.IsZero() {
-			return z.SetZero()
-		}
-		if !({{- range $i :=  reverse .NbWordsIndexesNoZero}}(t[{{$i}}] == {{index $.One $i}}) &&{{end}}(t[0] == {{index $.One 0}})) {
-			// t != 1, we don't have a square root
-			return nil
-		}
-		for {
-			var m uint64
-			t = b 
-
-			// for t != 1
-			for !({{- range $i :=  reverse .NbWordsIndexesNoZero}}(t[{{$i}}] == {{index $.One $i}}) &&{{end}}(t[0] == {{index $.One 0}})) {
-				t.Square(&t)
-				m++
+		// k is computed from the least significant window (win0 bits) to the most significant one (win bits):
+		// (t * g^(-k mod 2^sqrtShift(i)))^(2^(win*(n-1-i))) = p[n-1-i] * g^(-k[j] * 2^(sqrtShift(j)+win*(n-1-i))), j < i
+		// is of order 2^win, and its discrete logarithm gives k[i]
+		var k [n]uint64
+		for i := 0; i < n; i++ {
+			a := p[n-1-i]
+			for j := 0; j < i; j++ {
+				if k[j] != 0 {
+					a.Mul(&a, &tables.g[sqrtShift{{.ElementName}}(j)+win*(n-1-i)][k[j]])
+				}
 			}
+			k[i] = tables.dlog[a]
+			if i == 0 {
+				k[0] >>= win - win0
+				if k[0]&1 == 1 {
+					// k is odd, we don't have a square root
+					return nil
+				}
+			}
+		}
 
-			if m == 0 {
-				return z.Set(&y)
+		// y = y * g^(-k/2); the iterative Tonelli-Shanks (and math/big) returns y * g^(-k/2 mod 2^({{.SqrtE}}-1)),
+		// that is -y * g^(-k/2) if k != 0
+		isZero := true
+		for j := 0; j < n; j++ {
+			isZero = isZero && k[j] == 0
+			h := k[j] >> 1
+			if j+1 < n {
+				h |= (k[j+1] & 1) << (sqrtShift{{.ElementName}}(j+1) - sqrtShift{{.ElementName}}(j) - 1)
 			}
-			// t = g^(2^(r-m-1)) mod q
-			ge := int(r - m - 1)
-			t = g
-			for ge > 0 {
-				t.Square(&t)
-				ge--
+			if h != 0 {
+				y.Mul(&y, &tables.g[sqrtShift{{.ElementName}}(j)][h])
 			}
-
-			g.Square(&t)
-			y.Mul(&y, &t)
-			b.Mul(&b, &g)
-			r = m
 		}
+		if !isZero {
+			y.Neg(&y)
+		}
+		return z.Set(&y)
 
 	{{- else}}
 		panic("not implemented")	
@@ -0,0 +1,686 @@
+package element
+
+// Vector is included with all builds; the arithmetic methods are declared in the
+// architecture specific files (see VectorOpsAMD64 and VectorOpsPureGo)
+const Vector = `
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Vector represents a slice of {{.ElementName}}.
+//
+// The arithmetic methods operate on whole slices and panic if the lengths of the
+{{- if .SingleWord}}
+// operands don't match.
+{{- else}}
+// operands don't match; on amd64 they use assembly kernels when the CPU supports them.
+{{- end}}
+// The methods suffixed by Parallel split the work between runtime.NumCPU() goroutines.
+type Vector []{{.ElementName}}
+
+// AddParallel is the parallel variant of Add
+func (vector *Vector) AddParallel(a, b Vector) {
+	checkLengths("AddParallel", len(*vector), len(a), len(b))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.Add(a[start:end], b[start:end])
+	})
+}
+
+// SubParallel is the parallel variant of Sub
+func (vector *Vector) SubParallel(a, b Vector) {
+	checkLengths("SubParallel", len(*vector), len(a), len(b))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.Sub(a[start:end], b[start:end])
+	})
+}
+
+// MulParallel is the parallel variant of Mul
+func (vector *Vector) MulParallel(a, b Vector) {
+	checkLengths("MulParallel", len(*vector), len(a), len(b))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.Mul(a[start:end], b[start:end])
+	})
+}
+
+// ScalarMulParallel is the parallel variant of ScalarMul
+func (vector *Vector) ScalarMulParallel(a Vector, b *{{.ElementName}}) {
+	checkLengths("ScalarMulParallel", len(*vector), len(a))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.ScalarMul(a[start:end], b)
+	})
+}
+
+// MulAccumulateParallel is the parallel variant of MulAccumulate
+func (vector *Vector) MulAccumulateParallel(a, b Vector) {
+	checkLengths("MulAccumulateParallel", len(*vector), len(a), len(b))
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		chunk.MulAccumulate(a[start:end], b[start:end])
+	})
+}
+
+// SumParallel is the parallel variant of Sum
+func (vector *Vector) SumParallel() (res {{.ElementName}}) {
+	var lock sync.Mutex
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		partial := chunk.Sum()
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	})
+	return
+}
+
+// InnerProductParallel is the parallel variant of InnerProduct
+func (vector *Vector) InnerProductParallel(other Vector) (res {{.ElementName}}) {
+	checkLengths("InnerProductParallel", len(*vector), len(other))
+	var lock sync.Mutex
+	v := *vector
+	execute(len(v), func(start, end int) {
+		chunk := v[start:end]
+		partial := chunk.InnerProduct(other[start:end])
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	})
+	return
+}
+
+func addVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(res); i++ {
+		res[i].Add(&a[i], &b[i])
+	}
+}
+
+func subVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(res); i++ {
+		res[i].Sub(&a[i], &b[i])
+	}
+}
+
+func mulVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(res); i++ {
+		res[i].Mul(&a[i], &b[i])
+	}
+}
+
+func scalarMulVecGeneric(res, a Vector, b *{{.ElementName}}) {
+	for i := 0; i < len(res); i++ {
+		res[i].Mul(&a[i], b)
+	}
+}
+
+func mulAccVecGeneric(res, a, b Vector) {
+	var t {{.ElementName}}
+	for i := 0; i < len(res); i++ {
+		t.Mul(&a[i], &b[i])
+		res[i].Add(&res[i], &t)
+	}
+}
+
+func sumVecGeneric(res *{{.ElementName}}, a Vector) {
+	for i := 0; i < len(a); i++ {
+		res.Add(res, &a[i])
+	}
+}
+
+func innerProductVecGeneric(res *{{.ElementName}}, a, b Vector) {
+	var acc WideAccumulator
+	for i := 0; i < len(a); i++ {
+		acc.MulAdd(&a[i], &b[i])
+	}
+	t := acc.Reduce()
+	res.Add(res, &t)
+}
+
+func checkLengths(method string, n int, others ...int) {
+	for _, m := range others {
+		if m != n {
+			panic("vector." + method + ": vectors don't have the same length")
+		}
+	}
+}
+
+// minParallelChunk is the minimal number of elements processed by a goroutine
+// in the Parallel methods
+const minParallelChunk = 1 << 12
+
+// execute splits [0, n) in chunks of at least minParallelChunk elements (multiple of 8,
+// the block size of the vector kernels) and processes them concurrently with work
+func execute(n int, work func(start, end int)) {
+	nbTasks := runtime.NumCPU()
+	if max := n / minParallelChunk; nbTasks > max {
+		nbTasks = max
+	}
+	if nbTasks <= 1 {
+		work(0, n)
+		return
+	}
+	chunk := (n + nbTasks - 1) / nbTasks
+	chunk = (chunk + 7) &^ 7
+
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			work(start, end)
+			wg.Done()
+		}(start, end)
+	}
+	wg.Wait()
+}
+
+`
+
+// VectorOpsPureGo is included with non-amd64 builds, or all builds if F.ASMVector is not set
+const VectorOpsPureGo = `
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	checkLengths("Add", len(*vector), len(a), len(b))
+	addVecGeneric(*vector, a, b)
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	checkLengths("Sub", len(*vector), len(a), len(b))
+	subVecGeneric(*vector, a, b)
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	checkLengths("Mul", len(*vector), len(a), len(b))
+	mulVecGeneric(*vector, a, b)
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}) {
+	checkLengths("ScalarMul", len(*vector), len(a))
+	scalarMulVecGeneric(*vector, a, b)
+}
+
+// MulAccumulate sets self[i] += a[i] * b[i].
+// It panics if the vectors don't have the same length.
+func (vector *Vector) MulAccumulate(a, b Vector) {
+	checkLengths("MulAccumulate", len(*vector), len(a), len(b))
+	mulAccVecGeneric(*vector, a, b)
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res {{.ElementName}}) {
+	sumVecGeneric(&res, *vector)
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res {{.ElementName}}) {
+	checkLengths("InnerProduct", len(*vector), len(other))
+	innerProductVecGeneric(&res, *vector, other)
+	return
+}
+
+`
+
+// VectorOpsAMD64 is included with AMD64 builds (regardless of if F.ASMVector is set)
+const VectorOpsAMD64 = `
+{{if .ASMVector}}
+
+import (
+	"math/bits"
+)
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	checkLengths("Add", len(*vector), len(a), len(b))
+	if len(a) == 0 {
+		return
+	}
+	addVec(&(*vector)[0], &a[0], &b[0], uint64(len(a)))
+}
+
+//go:noescape
+func addVec(res, a, b *{{.ElementName}}, n uint64)
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	checkLengths("Sub", len(*vector), len(a), len(b))
+	if len(a) == 0 {
+		return
+	}
+	subVec(&(*vector)[0], &a[0], &b[0], uint64(len(a)))
+}
+
+//go:noescape
+func subVec(res, a, b *{{.ElementName}}, n uint64)
+
+// blockSize is the number of elements processed at once by the AVX-512 IFMA kernels
+const blockSize = 8
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	checkLengths("Mul", len(*vector), len(a), len(b))
+	n := len(a)
+	if !supportAvx512 || n < blockSize {
+		mulVecGeneric(*vector, a, b)
+		return
+	}
+	nbBlocks := n / blockSize
+	mulVec(&(*vector)[0], &a[0], &b[0], uint64(nbBlocks))
+	if r := nbBlocks * blockSize; r < n {
+		mulVecGeneric((*vector)[r:], a[r:], b[r:])
+	}
+}
+
+//go:noescape
+func mulVec(res, a, b *{{.ElementName}}, n uint64)
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}) {
+	checkLengths("ScalarMul", len(*vector), len(a))
+	n := len(a)
+	if !supportAvx512 || n < blockSize {
+		scalarMulVecGeneric(*vector, a, b)
+		return
+	}
+	nbBlocks := n / blockSize
+	scalarMulVec(&(*vector)[0], &a[0], b, uint64(nbBlocks))
+	if r := nbBlocks * blockSize; r < n {
+		scalarMulVecGeneric((*vector)[r:], a[r:], b)
+	}
+}
+
+//go:noescape
+func scalarMulVec(res, a, b *{{.ElementName}}, n uint64)
+
+// MulAccumulate sets self[i] += a[i] * b[i].
+// It panics if the vectors don't have the same length.
+func (vector *Vector) MulAccumulate(a, b Vector) {
+	checkLengths("MulAccumulate", len(*vector), len(a), len(b))
+	if !supportAvx512 {
+		mulAccVecGeneric(*vector, a, b)
+		return
+	}
+	// multiply by chunks in a buffer on the stack, then add
+	const chunkSize = 32 * blockSize
+	var buf [chunkSize]{{.ElementName}}
+	v := *vector
+	for start := 0; start < len(v); start += chunkSize {
+		end := start + chunkSize
+		if end > len(v) {
+			end = len(v)
+		}
+		t := Vector(buf[:end-start])
+		t.Mul(a[start:end], b[start:end])
+		addVec(&v[start], &v[start], &t[0], uint64(end-start))
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res {{.ElementName}}) {
+	if !supportAvx2 {
+		sumVecGeneric(&res, *vector)
+		return
+	}
+	// sumVec accumulates the 32-bit half words without carries, which is safe
+	// up to 2**32 - 1 elements per call
+	const maxN = (1 << 32) - 1
+	v := *vector
+	for len(v) != 0 {
+		n := len(v)
+		if n > maxN {
+			n = maxN
+		}
+		var acc [8]uint64
+		sumVec(&acc, &v[0], uint64(n))
+
+		var t [6]uint64
+		for j := 0; j < len(acc); j++ {
+			addShifted(t[:], 0, acc[j], 32*j)
+		}
+		var partial {{.ElementName}}
+		partial.setWide(t[:])
+		res.Add(&res, &partial)
+		v = v[n:]
+	}
+	return
+}
+
+//go:noescape
+func sumVec(res *[8]uint64, a *{{.ElementName}}, n uint64)
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res {{.ElementName}}) {
+	checkLengths("InnerProduct", len(*vector), len(other))
+	v := *vector
+	n := len(v)
+	if !supportAvx512 || n < blockSize {
+		innerProductVecGeneric(&res, v, other)
+		return
+	}
+	// innerProdVec accumulates 5 limbs of 52 bits without carries, which is safe
+	// up to 4096 blocks per call
+	const maxBlocks = 1 << 12
+	nbBlocks := n / blockSize
+	for start := 0; start < nbBlocks; start += maxBlocks {
+		end := start + maxBlocks
+		if end > nbBlocks {
+			end = nbBlocks
+		}
+		var acc [40]uint64
+		innerProdVec(&acc, &v[start*blockSize], &other[start*blockSize], uint64(end-start))
+
+		// acc[8k+j] holds the limb k of the lane j
+		var t [6]uint64
+		for k := 0; k < 5; k++ {
+			var hi, lo, c uint64
+			for j := 0; j < 8; j++ {
+				lo, c = bits.Add64(lo, acc[8*k+j], 0)
+				hi += c
+			}
+			addShifted(t[:], hi, lo, 52*k)
+		}
+		var partial {{.ElementName}}
+		partial.setWide(t[:])
+		res.Add(&res, &partial)
+	}
+	if r := nbBlocks * blockSize; r < n {
+		innerProductVecGeneric(&res, v[r:], other[r:])
+	}
+	return
+}
+
+//go:noescape
+func innerProdVec(res *[40]uint64, a, b *{{.ElementName}}, n uint64)
+
+// addShifted adds (hi || lo) << shift to the little endian words of t
+func addShifted(t []uint64, hi, lo uint64, shift int) {
+	w, s := shift/64, uint(shift%64)
+	v0, v1, v2 := lo<<s, hi<<s, uint64(0)
+	if s != 0 {
+		v1 |= lo >> (64 - s)
+		v2 = hi >> (64 - s)
+	}
+	var c uint64
+	t[w], c = bits.Add64(t[w], v0, 0)
+	t[w+1], c = bits.Add64(t[w+1], v1, c)
+	for i := w + 2; i < len(t); i++ {
+		t[i], c = bits.Add64(t[i], v2, c)
+		v2 = 0
+	}
+}
+
+// setWide sets z such that its montgomery representation is the integer in the
+// little endian words of t, mod q; this is how sums of montgomery representations
+// accumulated without reduction are brought back to a {{.ElementName}}
+func (z *{{.ElementName}}) setWide(t []uint64) {
+	// radix = 2**64
+	var radix {{.ElementName}}
+	radix.SetUint64(1 << 63)
+	radix.Double(&radix)
+
+	z.SetZero()
+	for i := len(t) - 1; i >= 0; i-- {
+		z.Mul(z, &radix)
+		w := {{.ElementName}}{t[i]}
+		z.Add(z, &w)
+	}
+}
+
+{{end}}
+`
+
+// VectorTest is the test file of the Vector type
+const VectorTest = `
+
+import (
+	"testing"
+
+	"github.com/leanovate/gopter"
+	ggen "github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestVectorOps(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	// lengths cover the tails of the kernels processing 8 elements at a time
+	genN := ggen.IntRange(0, 100)
+
+	properties.Property("vector operations should match element-wise operations", prop.ForAll(
+		func(n int) bool {
+			a, b, c := randomVector(n), randomVector(n), randomVector(n)
+			var s {{.ElementName}}
+			s.SetRandom()
+			return checkVectorOps(a, b, c, &s)
+		},
+		genN,
+	))
+
+	properties.Property("vector operations should handle q-1 operands", prop.ForAll(
+		func(n int) bool {
+			a, b, c := make(Vector, n), make(Vector, n), make(Vector, n)
+			for i := 0; i < n; i++ {
+				a[i].SetOne()
+				a[i].Neg(&a[i])
+				b[i] = a[i]
+				c[i] = a[i]
+			}
+			return checkVectorOps(a, b, c, &a[0])
+		},
+		ggen.IntRange(1, 100),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+	{{- if not .SingleWord}}
+	// if we have AVX instructions enabled, test the generic path
+	if supportAvx512 || supportAvx2 {
+		t.Log("disabling AVX")
+		avx512, avx2 := supportAvx512, supportAvx2
+		supportAvx512, supportAvx2 = false, false
+		properties.TestingRun(t, gopter.ConsoleReporter(false))
+		supportAvx512, supportAvx2 = avx512, avx2
+	}
+	{{- end}}
+}
+
+func TestVectorOpsParallel(t *testing.T) {
+	const n = 3*minParallelChunk + 5
+	a, b, c := randomVector(n), randomVector(n), randomVector(n)
+	var s {{.ElementName}}
+	s.SetRandom()
+
+	var expected, got Vector
+	expected, got = make(Vector, n), make(Vector, n)
+
+	expected.Add(a, b)
+	got.AddParallel(a, b)
+	assertVectorEqual(t, "AddParallel", expected, got)
+
+	expected.Sub(a, b)
+	got.SubParallel(a, b)
+	assertVectorEqual(t, "SubParallel", expected, got)
+
+	expected.Mul(a, b)
+	got.MulParallel(a, b)
+	assertVectorEqual(t, "MulParallel", expected, got)
+
+	expected.ScalarMul(a, &s)
+	got.ScalarMulParallel(a, &s)
+	assertVectorEqual(t, "ScalarMulParallel", expected, got)
+
+	copy(expected, c)
+	copy(got, c)
+	expected.MulAccumulate(a, b)
+	got.MulAccumulateParallel(a, b)
+	assertVectorEqual(t, "MulAccumulateParallel", expected, got)
+
+	if sum, sumParallel := a.Sum(), a.SumParallel(); !sum.Equal(&sumParallel) {
+		t.Fatal("SumParallel doesn't match Sum")
+	}
+	if ip, ipParallel := a.InnerProduct(b), a.InnerProductParallel(b); !ip.Equal(&ipParallel) {
+		t.Fatal("InnerProductParallel doesn't match InnerProduct")
+	}
+}
+
+func TestVectorLengthMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic on vectors of different lengths")
+		}
+	}()
+	v := make(Vector, 2)
+	v.Add(make(Vector, 2), make(Vector, 3))
+}
+
+func checkVectorOps(a, b, c Vector, s *{{.ElementName}}) bool {
+	n := len(a)
+	res := make(Vector, n)
+	ok := true
+	check := func(expected func(i int) {{.ElementName}}) {
+		for i := 0; i < n; i++ {
+			e := expected(i)
+			ok = ok && res[i].Equal(&e)
+		}
+	}
+
+	res.Add(a, b)
+	check(func(i int) (e {{.ElementName}}) { return *e.Add(&a[i], &b[i]) })
+
+	res.Sub(a, b)
+	check(func(i int) (e {{.ElementName}}) { return *e.Sub(&a[i], &b[i]) })
+
+	res.Mul(a, b)
+	check(func(i int) (e {{.ElementName}}) { return *e.Mul(&a[i], &b[i]) })
+
+	res.ScalarMul(a, s)
+	check(func(i int) (e {{.ElementName}}) { return *e.Mul(&a[i], s) })
+
+	copy(res, c)
+	res.MulAccumulate(a, b)
+	check(func(i int) (e {{.ElementName}}) {
+		e.Mul(&a[i], &b[i])
+		return *e.Add(&e, &c[i])
+	})
+
+	// in place
+	copy(res, a)
+	res.Mul(res, b)
+	check(func(i int) (e {{.ElementName}}) { return *e.Mul(&a[i], &b[i]) })
+
+	var sum, innerProduct, t {{.ElementName}}
+	for i := 0; i < n; i++ {
+		sum.Add(&sum, &a[i])
+		t.Mul(&a[i], &b[i])
+		innerProduct.Add(&innerProduct, &t)
+	}
+	gotSum := a.Sum()
+	gotInnerProduct := a.InnerProduct(b)
+
+	return ok && gotSum.Equal(&sum) && gotInnerProduct.Equal(&innerProduct)
+}
+
+func assertVectorEqual(t *testing.T, method string, expected, got Vector) {
+	for i := range expected {
+		if !expected[i].Equal(&got[i]) {
+			t.Fatalf("%s: mismatch at index %d", method, i)
+		}
+	}
+}
+
+func randomVector(n int) Vector {
+	v := make(Vector, n)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const n = 1 << 16
+	a, c := randomVector(n), randomVector(n)
+	res := make(Vector, n)
+	var s {{.ElementName}}
+	s.SetRandom()
+
+	b.Run("add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run("sub", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Sub(a, c)
+		}
+	})
+	b.Run("mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run("scalarMul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMul(a, &s)
+		}
+	})
+	b.Run("mulAccumulate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.MulAccumulate(a, c)
+		}
+	})
+	b.Run("sum", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchRes{{.ElementName}} = a.Sum()
+		}
+	})
+	b.Run("innerProduct", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchRes{{.ElementName}} = a.InnerProduct(c)
+		}
+	})
+	b.Run("mulParallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.MulParallel(a, c)
+		}
+	})
+	b.Run("innerProductParallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchRes{{.ElementName}} = a.InnerProductParallel(c)
+		}
+	})
+}
+
+`
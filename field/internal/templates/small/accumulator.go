@@ -0,0 +1,201 @@
+package small
+
+// Accumulator is the lazy-reduction accumulator of products of single word elements
+const Accumulator = `
+
+import (
+	"math/bits"
+)
+
+{{- if eq .Word "uint32"}}
+
+// WideAccumulator accumulates products of {{.ElementName}} on 2 uint64 words, without modular reduction.
+{{- else}}
+
+// WideAccumulator accumulates products of {{.ElementName}} on 2 words + 1 carry word, without modular reduction.
+{{- end}}
+//
+// A sum of products costs one reduction (Reduce) instead of one per product:
+//
+// 	var acc WideAccumulator
+// 	for i := range a {
+// 		acc.MulAdd(&a[i], &b[i])
+// 	}
+// 	res := acc.Reduce()
+//
+// The zero value is an empty accumulator; it can hold up to 2**63 products.
+type WideAccumulator struct {
+	{{- if eq .Word "uint32"}}
+	t [2]uint64
+	{{- else}}
+	t [3]uint64
+	{{- end}}
+}
+
+// MulAdd sets acc = acc + x * y, without reduction
+func (acc *WideAccumulator) MulAdd(x, y *{{.ElementName}}) *WideAccumulator {
+	{{- if eq .Word "uint32"}}
+	var c uint64
+	acc.t[0], c = bits.Add64(acc.t[0], uint64(x[0])*uint64(y[0]), 0)
+	acc.t[1] += c
+	{{- else}}
+	hi, lo := bits.Mul64(x[0], y[0])
+	var c uint64
+	acc.t[0], c = bits.Add64(acc.t[0], lo, 0)
+	acc.t[1], c = bits.Add64(acc.t[1], hi, c)
+	acc.t[2] += c
+	{{- end}}
+	return acc
+}
+
+// Reset empties the accumulator
+func (acc *WideAccumulator) Reset() *WideAccumulator {
+	acc.t = [{{if eq .Word "uint32"}}2{{else}}3{{end}}]uint64{}
+	return acc
+}
+
+// Reduce returns the sum of the accumulated products, as a reduced {{.ElementName}};
+// the accumulator is left unchanged
+func (acc *WideAccumulator) Reduce() (z {{.ElementName}}) {
+	// r = t mod q, with Horner's method on the words of t
+	{{- if eq .Word "uint32"}}
+	r := bits.Rem64(acc.t[1]%q, acc.t[0], q)
+	{{- else}}
+	r := acc.t[2] % q
+	r = bits.Rem64(r, acc.t[1], q)
+	r = bits.Rem64(r, acc.t[0], q)
+	{{- end}}
+	{{- if eq .Reduction "montgomery"}}
+
+	// the products are in Montgomery form (x*y*R**2), r * R**-1 is the Montgomery form of the sum
+	z[0] = mulReduce({{.Word}}(r), 1)
+	{{- else}}
+	z[0] = {{.Word}}(r)
+	{{- end}}
+	return
+}
+
+`
+
+// AccumulatorTest is the test file of WideAccumulator
+const AccumulatorTest = `
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	ggen "github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestWideAccumulator(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genN := ggen.IntRange(0, 50)
+
+	properties.Property("WideAccumulator should match a sum of reduced products", prop.ForAll(
+		func(n int) bool {
+			var acc WideAccumulator
+			var a, b, expected, t {{.ElementName}}
+			for i := 0; i < n; i++ {
+				a.SetRandom()
+				b.SetRandom()
+				acc.MulAdd(&a, &b)
+				t.Mul(&a, &b)
+				expected.Add(&expected, &t)
+			}
+			got := acc.Reduce()
+			return got.Equal(&expected) && !got.biggerOrEqualModulus()
+		},
+		genN,
+	))
+
+	properties.Property("WideAccumulator should handle q-1 operands", prop.ForAll(
+		func(n int) bool {
+			var minusOne, expected, t {{.ElementName}}
+			minusOne.SetOne().Neg(&minusOne)
+			var acc WideAccumulator
+			for i := 0; i < n; i++ {
+				acc.MulAdd(&minusOne, &minusOne)
+				t.Mul(&minusOne, &minusOne)
+				expected.Add(&expected, &t)
+			}
+			got := acc.Reduce()
+			return got.Equal(&expected) && !got.biggerOrEqualModulus()
+		},
+		genN,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestWideAccumulatorLargeTopWord(t *testing.T) {
+	// fill the top word beyond what a test can accumulate, and check the reduction against math/big
+	var acc WideAccumulator
+	for i := range acc.t {
+		acc.t[i] = ^uint64(0)
+	}
+	acc.t[len(acc.t)-1] = (1 << 63) - 1
+
+	var v big.Int
+	for i := len(acc.t) - 1; i >= 0; i-- {
+		v.Lsh(&v, 64).Add(&v, new(big.Int).SetUint64(acc.t[i]))
+	}
+	{{- if eq .Reduction "montgomery"}}
+	var r, rInv big.Int
+	r.Lsh(big.NewInt(1), {{.WordBits}})
+	rInv.ModInverse(&r, Modulus())
+	v.Mul(&v, &rInv)
+	{{- end}}
+	v.Mod(&v, Modulus())
+
+	var expected {{.ElementName}}
+	expected.SetBigInt(&v)
+	{{- if eq .Reduction "montgomery"}}
+	expected.FromMont()
+	{{- end}}
+
+	got := acc.Reduce()
+	if !got.Equal(&expected) {
+		t.Fatal("WideAccumulator.Reduce doesn't match math/big")
+	}
+}
+
+func BenchmarkWideAccumulator(b *testing.B) {
+	const n = 256
+	var x, y [n]{{.ElementName}}
+	for i := 0; i < n; i++ {
+		x[i].SetRandom()
+		y[i].SetRandom()
+	}
+
+	b.Run("mulAdd+reduce", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var acc WideAccumulator
+			for j := 0; j < n; j++ {
+				acc.MulAdd(&x[j], &y[j])
+			}
+			benchRes{{.ElementName}} = acc.Reduce()
+		}
+	})
+	b.Run("mul+add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var res, t {{.ElementName}}
+			for j := 0; j < n; j++ {
+				t.Mul(&x[j], &y[j])
+				res.Add(&res, &t)
+			}
+			benchRes{{.ElementName}} = res
+		}
+	})
+}
+
+`
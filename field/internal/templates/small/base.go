@@ -0,0 +1,563 @@
+package small
+
+// Base is the template of the element of a field of modulus q < 2**64, stored on a single word.
+// The arithmetic uses a dedicated reduction for the special primes (Goldilocks, Mersenne31)
+// and the Montgomery reduction with R = 2**WordBits otherwise.
+const Base = `
+// /!\ WARNING /!\
+// this code has not been audited and is provided as-is. In particular,
+// there is no security guarantees such as constant time implementation
+// or side-channel attack resistance
+// /!\ WARNING /!\
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// {{.ElementName}} represents a field element stored on a single word ({{.Word}})
+{{- if eq .Reduction "montgomery"}}
+// {{.ElementName}} are assumed to be in Montgomery form (x * 2**{{.WordBits}} mod q) in all methods
+{{- else}}
+// {{.ElementName}} are stored in regular form: the special form of q allows a fast reduction
+// of the products without the Montgomery representation
+{{- end}}
+// field modulus q =
+//
+// {{.Modulus}}
+type {{.ElementName}} [1]{{.Word}}
+
+// Limbs number of {{.WordBits}} bits words needed to represent {{.ElementName}}
+const Limbs = 1
+
+// Bits number bits needed to represent {{.ElementName}}
+const Bits = {{.NbBits}}
+
+// Bytes number bytes needed to represent {{.ElementName}}
+const Bytes = {{div .WordBits 8}}
+
+// q modulus, as an untyped constant
+const q = {{index .Q 0}}
+
+// field modulus stored as big.Int
+var _modulus big.Int
+
+// Modulus returns q as a big.Int
+// q =
+//
+// {{.Modulus}}
+func Modulus() *big.Int {
+	return new(big.Int).Set(&_modulus)
+}
+
+// q (modulus)
+var q{{.ElementName}} = {{.ElementName}}{q}
+
+{{- if eq .Reduction "montgomery"}}
+
+// rSquare
+var rSquare = {{.ElementName}}{ {{index .RSquare 0}} }
+
+// qInvNeg = -q**-1 mod 2**{{.WordBits}}
+const qInvNeg = {{.QInvNeg}}
+{{- end}}
+
+var bigIntPool = sync.Pool{
+	New: func() interface{} {
+		return new(big.Int)
+	},
+}
+
+func init() {
+	_modulus.SetString("{{.Modulus}}", 10)
+}
+
+// SetUint64 z = v mod q, sets z to v (non-Montgomery form){{if eq .Reduction "montgomery"}} and convert z to Montgomery form{{end}}
+func (z *{{.ElementName}}) SetUint64(v uint64) *{{.ElementName}} {
+	*z = {{.ElementName}}{ {{.Word}}(v % q)}
+	return z.ToMont()
+}
+
+// Set z = x
+func (z *{{.ElementName}}) Set(x *{{.ElementName}}) *{{.ElementName}} {
+	z[0] = x[0]
+	return z
+}
+
+// SetInterface converts provided interface into {{.ElementName}}
+// returns an error if provided type is not supported
+// supported types: {{.ElementName}}, *{{.ElementName}}, uint64, int, string (interpreted as base10 integer),
+// *big.Int, big.Int, []byte
+func (z *{{.ElementName}}) SetInterface(i1 interface{}) (*{{.ElementName}}, error) {
+	switch c1 := i1.(type) {
+	case {{.ElementName}}:
+		return z.Set(&c1), nil
+	case *{{.ElementName}}:
+		return z.Set(c1), nil
+	case uint64:
+		return z.SetUint64(c1), nil
+	case int:
+		return z.SetString(strconv.Itoa(c1)), nil
+	case string:
+		return z.SetString(c1), nil
+	case *big.Int:
+		return z.SetBigInt(c1), nil
+	case big.Int:
+		return z.SetBigInt(&c1), nil
+	case []byte:
+		return z.SetBytes(c1), nil
+	default:
+		return nil, errors.New("can't set {{.PackageName}}.{{.ElementName}} from type " + reflect.TypeOf(i1).String())
+	}
+}
+
+// SetZero z = 0
+func (z *{{.ElementName}}) SetZero() *{{.ElementName}} {
+	z[0] = 0
+	return z
+}
+
+// SetOne z = 1{{if eq .Reduction "montgomery"}} (in Montgomery form){{end}}
+func (z *{{.ElementName}}) SetOne() *{{.ElementName}} {
+	z[0] = {{index .One 0}}
+	return z
+}
+
+// Div z = x*y^-1 mod q
+func (z *{{.ElementName}}) Div(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var yInv {{.ElementName}}
+	yInv.Inverse(y)
+	z.Mul(x, &yInv)
+	return z
+}
+
+// Butterfly sets z = z + b, b = z - b (mod q); method form of the package level Butterfly
+func (z *{{.ElementName}}) Butterfly(b *{{.ElementName}}) {
+	Butterfly(z, b)
+}
+
+// Equal returns z == x
+func (z *{{.ElementName}}) Equal(x *{{.ElementName}}) bool {
+	return z[0] == x[0]
+}
+
+// IsZero returns z == 0
+func (z *{{.ElementName}}) IsZero() bool {
+	return z[0] == 0
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//   -1 if z <  x
+//    0 if z == x
+//   +1 if z >  x
+//
+func (z *{{.ElementName}}) Cmp(x *{{.ElementName}}) int {
+	_z := z.ToRegular()
+	_x := x.ToRegular()
+	if _z[0] > _x[0] {
+		return 1
+	} else if _z[0] < _x[0] {
+		return -1
+	}
+	return 0
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *{{.ElementName}}) LexicographicallyLargest() bool {
+	// we check if the element is larger than (q-1) / 2
+	_z := z.ToRegular()
+	return _z[0] > (q-1)/2
+}
+
+// SetRandom sets z to a random element < q
+func (z *{{.ElementName}}) SetRandom() (*{{.ElementName}}, error) {
+	var bytes [Bytes]byte
+	// rejection sampling on the {{.NbBits}} low bits
+	// note: this is NOT constant time
+	for {
+		if _, err := io.ReadFull(rand.Reader, bytes[:]); err != nil {
+			return nil, err
+		}
+		{{- if eq .Word "uint32"}}
+		v := binary.BigEndian.Uint32(bytes[:])
+		{{- else}}
+		v := binary.BigEndian.Uint64(bytes[:])
+		{{- end}}
+		{{- if ne .NbBits .WordBits}}
+		v &= (1 << Bits) - 1
+		{{- end}}
+		if v < q {
+			z[0] = v
+			return z, nil
+		}
+	}
+}
+
+// One returns 1{{if eq .Reduction "montgomery"}} (in montgommery form){{end}}
+func One() {{.ElementName}} {
+	var one {{.ElementName}}
+	one.SetOne()
+	return one
+}
+
+// Mul z = x * y mod q
+func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
+	z[0] = mulReduce(x[0], y[0])
+	return z
+}
+
+// Square z = x * x mod q
+func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
+	z[0] = mulReduce(x[0], x[0])
+	return z
+}
+
+// FromMont converts z in place (i.e. mutates) from Montgomery to regular representation
+// sets and returns z = z * 1
+{{- if ne .Reduction "montgomery"}}
+//
+// {{.ElementName}} are stored in regular form, FromMont is a no-op
+{{- end}}
+func (z *{{.ElementName}}) FromMont() *{{.ElementName}} {
+	{{- if eq .Reduction "montgomery"}}
+	z[0] = mulReduce(z[0], 1)
+	{{- end}}
+	return z
+}
+
+// ToMont converts z to Montgomery form
+// sets and returns z = z * r^2
+{{- if ne .Reduction "montgomery"}}
+//
+// {{.ElementName}} are stored in regular form, ToMont is a no-op
+{{- end}}
+func (z *{{.ElementName}}) ToMont() *{{.ElementName}} {
+	{{- if eq .Reduction "montgomery"}}
+	z[0] = mulReduce(z[0], rSquare[0])
+	{{- end}}
+	return z
+}
+
+// ToRegular returns z in regular form (doesn't mutate z)
+func (z {{.ElementName}}) ToRegular() {{.ElementName}} {
+	return *z.FromMont()
+}
+
+// Add z = x + y mod q
+func (z *{{.ElementName}}) Add(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{- if eq .Word "uint32"}}
+	s := uint64(x[0]) + uint64(y[0])
+	if s >= q {
+		s -= q
+	}
+	z[0] = uint32(s)
+	{{- else}}
+	s, c := bits.Add64(x[0], y[0], 0)
+	if c != 0 || s >= q {
+		s -= q
+	}
+	z[0] = s
+	{{- end}}
+	return z
+}
+
+// Double z = x + x mod q, aka Lsh 1
+func (z *{{.ElementName}}) Double(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.Add(x, x)
+}
+
+// Sub  z = x - y mod q
+func (z *{{.ElementName}}) Sub(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{- if eq .Word "uint32"}}
+	d, b := bits.Sub32(x[0], y[0], 0)
+	{{- else}}
+	d, b := bits.Sub64(x[0], y[0], 0)
+	{{- end}}
+	if b != 0 {
+		d += q
+	}
+	z[0] = d
+	return z
+}
+
+// Neg z = q - x
+func (z *{{.ElementName}}) Neg(x *{{.ElementName}}) *{{.ElementName}} {
+	if x[0] == 0 {
+		z[0] = 0
+		return z
+	}
+	z[0] = q - x[0]
+	return z
+}
+
+// MulBy3 x *= 3
+func MulBy3(x *{{.ElementName}}) {
+	_x := *x
+	x.Double(x).Add(x, &_x)
+}
+
+// MulBy5 x *= 5
+func MulBy5(x *{{.ElementName}}) {
+	_x := *x
+	x.Double(x).Double(x).Add(x, &_x)
+}
+
+// MulBy13 x *= 13
+func MulBy13(x *{{.ElementName}}) {
+	var y {{.ElementName}}
+	y.SetUint64(13)
+	x.Mul(x, &y)
+}
+
+// Butterfly sets
+// a = a + b
+// b = a - b
+func Butterfly(a, b *{{.ElementName}}) {
+	t := *a
+	a.Add(a, b)
+	b.Sub(&t, b)
+}
+
+// BatchInvert returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+func BatchInvert(a []{{.ElementName}}) []{{.ElementName}} {
+	res := make([]{{.ElementName}}, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	accumulator := One()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+{{- if eq .Reduction "goldilocks"}}
+
+// mulReduce returns a * b mod q
+func mulReduce(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return reduce128(hi, lo)
+}
+
+// reduce128 returns hi * 2**64 + lo mod q, for q = 2**64 - 2**32 + 1.
+// It uses 2**64 = 2**32 - 1 mod q and 2**96 = -1 mod q.
+func reduce128(hi, lo uint64) uint64 {
+	const epsilon = 1<<32 - 1
+	hiHi := hi >> 32
+	hiLo := hi & epsilon
+
+	// lo - hiHi * 2**96 = lo - hiHi
+	t0, borrow := bits.Sub64(lo, hiHi, 0)
+	if borrow != 0 {
+		t0 -= epsilon // t0 - 2**64 = t0 - epsilon mod q, no underflow since hiHi < 2**32
+	}
+
+	// hiLo * 2**64 = hiLo * epsilon < 2**64
+	t1 := hiLo * epsilon
+	t, carry := bits.Add64(t0, t1, 0)
+	if carry != 0 {
+		t += epsilon // no overflow since t < t1 <= 2**64 - 2**33 + 1
+	}
+	if t >= q {
+		t -= q
+	}
+	return t
+}
+{{- else if eq .Reduction "mersenne31"}}
+
+// mulReduce returns a * b mod q, for q = 2**31 - 1.
+// It uses 2**31 = 1 mod q to fold the high bits of the product.
+func mulReduce(a, b uint32) uint32 {
+	p := uint64(a) * uint64(b)
+	p = (p & q) + (p >> 31) // < 2**32
+	p = (p & q) + (p >> 31) // <= q + 1
+	if p >= q {
+		p -= q
+	}
+	return uint32(p)
+}
+{{- else if eq .Word "uint32"}}
+
+// mulReduce returns a * b * 2**-32 mod q (Montgomery multiplication)
+func mulReduce(a, b uint32) uint32 {
+	p := uint64(a) * uint64(b)
+	m := uint32(p) * qInvNeg
+
+	// p + m*q is divisible by 2**32
+	s, c := bits.Add64(p, uint64(m)*q, 0)
+	t := s>>32 | c<<32
+	if t >= q {
+		t -= q
+	}
+	return uint32(t)
+}
+{{- else}}
+
+// mulReduce returns a * b * 2**-64 mod q (Montgomery multiplication)
+func mulReduce(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	m := lo * qInvNeg
+
+	// (hi, lo) + m*q is divisible by 2**64
+	mHi, mLo := bits.Mul64(m, q)
+	_, c := bits.Add64(lo, mLo, 0)
+	t, c := bits.Add64(hi, mHi, c)
+	if c != 0 || t >= q {
+		t -= q
+	}
+	return t
+}
+{{- end}}
+
+// Exp z = x^exponent mod q
+func (z *{{.ElementName}}) Exp(x {{.ElementName}}, exponent *big.Int) *{{.ElementName}} {
+	var bZero big.Int
+	if exponent.Cmp(&bZero) == 0 {
+		return z.SetOne()
+	}
+
+	z.Set(&x)
+
+	for i := exponent.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if exponent.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// String returns the string form of an {{.ElementName}} in regular form
+func (z *{{.ElementName}}) String() string {
+	return strconv.FormatUint(uint64(z.ToRegular()[0]), 10)
+}
+
+// ToBigInt returns z as a big.Int{{if eq .Reduction "montgomery"}} in Montgomery form{{end}}
+func (z *{{.ElementName}}) ToBigInt(res *big.Int) *big.Int {
+	return res.SetUint64(uint64(z[0]))
+}
+
+// ToBigIntRegular returns z as a big.Int in regular form
+func (z {{.ElementName}}) ToBigIntRegular(res *big.Int) *big.Int {
+	z.FromMont()
+	return z.ToBigInt(res)
+}
+
+// Bytes returns the regular (non montgomery) value
+// of z as a big-endian byte array.
+func (z *{{.ElementName}}) Bytes() (res [Bytes]byte) {
+	_z := z.ToRegular()
+	{{- if eq .Word "uint32"}}
+	binary.BigEndian.PutUint32(res[:], _z[0])
+	{{- else}}
+	binary.BigEndian.PutUint64(res[:], _z[0])
+	{{- end}}
+	return
+}
+
+// Marshal returns the regular (non montgomery) value
+// of z as a big-endian byte slice.
+func (z *{{.ElementName}}) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytes interprets e as the bytes of a big-endian unsigned integer,
+// sets z to that value (mod q), and returns z.
+func (z *{{.ElementName}}) SetBytes(e []byte) *{{.ElementName}} {
+	if len(e) <= 8 {
+		// fast path
+		var b [8]byte
+		copy(b[8-len(e):], e)
+		return z.SetUint64(binary.BigEndian.Uint64(b[:]))
+	}
+
+	// get a big int from our pool
+	vv := bigIntPool.Get().(*big.Int)
+	vv.SetBytes(e)
+
+	// set big int
+	z.SetBigInt(vv)
+
+	// put temporary object back in pool
+	bigIntPool.Put(vv)
+
+	return z
+}
+
+// SetBigInt sets z to v (regular form) mod q and returns z
+func (z *{{.ElementName}}) SetBigInt(v *big.Int) *{{.ElementName}} {
+	if v.Sign() >= 0 && v.Cmp(&_modulus) < 0 {
+		// fast path, 0 <= v < q
+		return z.SetUint64(v.Uint64())
+	}
+
+	// get temporary big int from the pool
+	vv := bigIntPool.Get().(*big.Int)
+
+	// copy input + modular reduction
+	vv.Mod(v, &_modulus)
+	z.SetUint64(vv.Uint64())
+
+	// release object into pool
+	bigIntPool.Put(vv)
+	return z
+}
+
+// SetString creates a big.Int with s (in base 10) and calls SetBigInt on z
+func (z *{{.ElementName}}) SetString(s string) *{{.ElementName}} {
+	// get temporary big int from the pool
+	vv := bigIntPool.Get().(*big.Int)
+
+	if _, ok := vv.SetString(s, 10); !ok {
+		panic("{{.ElementName}}.SetString failed -> can't parse number in base10 into a big.Int")
+	}
+	z.SetBigInt(vv)
+
+	// release object into pool
+	bigIntPool.Put(vv)
+
+	return z
+}
+
+// Inverse z = x^-1 mod q
+// computed as x^(q-2) (Fermat's little theorem)
+// if x == 0, sets and returns z = x
+func (z *{{.ElementName}}) Inverse(x *{{.ElementName}}) *{{.ElementName}} {
+	if x.IsZero() {
+		z.SetZero()
+		return z
+	}
+	return z.expByInverseExp(*x)
+}
+`
@@ -0,0 +1,324 @@
+package small
+
+// ConstantTime is the constant time inversion and square root of a single word element
+const ConstantTime = `
+
+{{- if ne .Reduction "mersenne31"}}
+
+import (
+	"math/bits"
+)
+{{- end}}
+
+// InverseCT z = x^-1 mod q, in constant time
+// if x == 0, sets and returns z = 0
+//
+// It computes x^(q-2) (Fermat's little theorem) with a fixed addition chain (expByInverseExpCT)
+// of mulCT and squareCT.
+func (z *{{.ElementName}}) InverseCT(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.expByInverseExpCT(*x)
+}
+
+// SqrtCT z = √x mod q, in constant time
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil; the running time only depends on whether x is a square
+//
+// Unlike Sqrt, it doesn't branch on intermediate values{{if .SqrtTonelliShanks}}: Tonelli-Shanks does {{.SqrtE}} - 1 fixed
+// iterations, with conditional selections (https://www.rfc-editor.org/rfc/rfc9380#appendix-I.4){{end}}.
+// It uses mulCT and squareCT rather than Mul and Square, whose final subtraction is a branch.
+func (z *{{.ElementName}}) SqrtCT(x *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .SqrtQ3Mod4}}
+	// q ≡ 3 (mod 4)
+	// using  z ≡ ± x^((p+1)/4) (mod q)
+	var y {{.ElementName}}
+	y.expBySqrtExpCT(*x)
+	{{- else if .SqrtTonelliShanks}}
+	// q ≡ 1 (mod 4), q - 1 = 2**{{.SqrtE}} * s
+	var one, w, y, t, b, c, tmp {{.ElementName}}
+	one.SetOne()
+
+	// w = x^((s-1)/2), y = x^((s+1)/2), t = x^s
+	w.expBySqrtExpCT(*x)
+	y.mulCT(x, &w)
+	t.mulCT(&w, &y)
+
+	// c = nonResidue ^ s
+	c = {{.ElementName}}{ {{index .SqrtG 0}} }
+
+	for i := {{.SqrtE}}; i >= 2; i-- {
+		// b = t^(2^(i-2)), y and t are updated if b != 1
+		b = t
+		for j := 1; j <= i-2; j++ {
+			b.squareCT(&b)
+		}
+		isOne := ctEqual(&b, &one)
+
+		tmp.mulCT(&y, &c)
+		y.ctSelect(isOne, &tmp, &y)
+		c.squareCT(&c)
+		tmp.mulCT(&t, &c)
+		t.ctSelect(isOne, &tmp, &t)
+	}
+	{{- else}}
+	panic("not implemented")
+	{{- end}}
+
+	// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+	var square {{.ElementName}}
+	square.squareCT(&y)
+	if ctEqual(&square, x) == 1 {
+		return z.Set(&y)
+	}
+	return nil
+}
+
+// mulCT z = x * y (mod q), in constant time
+func (z *{{.ElementName}}) mulCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	z[0] = mulReduceCT(x[0], y[0])
+	return z
+}
+
+// squareCT z = x * x (mod q), in constant time
+func (z *{{.ElementName}}) squareCT(x *{{.ElementName}}) *{{.ElementName}} {
+	z[0] = mulReduceCT(x[0], x[0])
+	return z
+}
+
+// ctEqual returns 1 if x == y, 0 otherwise, in constant time
+func ctEqual(x, y *{{.ElementName}}) uint64 {
+	t := uint64(x[0] ^ y[0])
+	return 1 ^ ((t | -t) >> 63)
+}
+{{- if .SqrtTonelliShanks}}
+
+// ctSelect sets z = x0 if c == 0, z = x1 if c == 1, in constant time
+func (z *{{.ElementName}}) ctSelect(c uint64, x0, x1 *{{.ElementName}}) *{{.ElementName}} {
+	mask := -{{.Word}}(c)
+	z[0] = x0[0] ^ (mask & (x0[0] ^ x1[0]))
+	return z
+}
+{{- end}}
+
+{{- if eq .Reduction "goldilocks"}}
+
+// mulReduceCT returns a * b mod q, as mulReduce, with the conditional corrections
+// of reduce128 replaced by masks
+func mulReduceCT(a, b uint64) uint64 {
+	const epsilon = 1<<32 - 1
+	hi, lo := bits.Mul64(a, b)
+	hiHi := hi >> 32
+	hiLo := hi & epsilon
+
+	// lo - hiHi * 2**96 = lo - hiHi
+	t0, borrow := bits.Sub64(lo, hiHi, 0)
+	t0 -= epsilon & -borrow
+
+	// hiLo * 2**64 = hiLo * epsilon < 2**64
+	t, carry := bits.Add64(t0, hiLo*epsilon, 0)
+	t += epsilon & -carry
+
+	// borrow == 1 if t < q
+	s, borrow := bits.Sub64(t, q, 0)
+	return s ^ (-borrow & (s ^ t))
+}
+{{- else if eq .Reduction "mersenne31"}}
+
+// mulReduceCT returns a * b mod q, as mulReduce, with the final subtraction replaced by a mask
+func mulReduceCT(a, b uint32) uint32 {
+	p := uint64(a) * uint64(b)
+	p = (p & q) + (p >> 31) // < 2**32
+	p = (p & q) + (p >> 31) // <= q + 1
+
+	// s is negative if p < q
+	s := p - q
+	mask := -(s >> 63)
+	return uint32(s ^ (mask & (s ^ p)))
+}
+{{- else if eq .Word "uint32"}}
+
+// mulReduceCT returns a * b * 2**-32 mod q, as mulReduce, with the final subtraction replaced by a mask
+func mulReduceCT(a, b uint32) uint32 {
+	p := uint64(a) * uint64(b)
+	m := uint32(p) * qInvNeg
+
+	// p + m*q is divisible by 2**32
+	s, c := bits.Add64(p, uint64(m)*q, 0)
+	t := s>>32 | c<<32 // < 2q
+
+	// d is negative if t < q
+	d := t - q
+	mask := -(d >> 63)
+	return uint32(d ^ (mask & (d ^ t)))
+}
+{{- else}}
+
+// mulReduceCT returns a * b * 2**-64 mod q, as mulReduce, with the final subtraction replaced by a mask
+func mulReduceCT(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	m := lo * qInvNeg
+
+	// (hi, lo) + m*q is divisible by 2**64
+	mHi, mLo := bits.Mul64(m, q)
+	_, c := bits.Add64(lo, mLo, 0)
+	t, c := bits.Add64(hi, mHi, c)
+
+	// borrow == 1 if (c, t) < q
+	s, borrow := bits.Sub64(t, q, 0)
+	_, borrow = bits.Sub64(c, 0, borrow)
+	return s ^ (-borrow & (s ^ t))
+}
+{{- end}}
+
+`
+
+// ConstantTimeTest is the test file of InverseCT, SqrtCT and the constant time multiplication they use
+const ConstantTimeTest = `
+
+import (
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func Test{{toTitle .ElementName}}InverseCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("InverseCT should output the same result as Inverse", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			c.InverseCT(&a.element)
+			d.Inverse(&a.element)
+			return c.Equal(&d) && !c.biggerOrEqualModulus()
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for _, a := range staticTestValues {
+		var c, d {{.ElementName}}
+		c.InverseCT(&a)
+		d.Inverse(&a)
+		if !c.Equal(&d) {
+			t.Fatal("InverseCT doesn't match Inverse on static test values")
+		}
+	}
+}
+
+func Test{{toTitle .ElementName}}SqrtCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("SqrtCT should output the same result as Sqrt", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			for _, x := range genTestValues(a) {
+				var c, d {{.ElementName}}
+				r1 := c.SqrtCT(&x.element)
+				r2 := d.Sqrt(&x.element)
+				if (r1 == nil) != (r2 == nil) {
+					return false
+				}
+				if r1 != nil && (!c.Equal(&d) || c.biggerOrEqualModulus()) {
+					return false
+				}
+			}
+			return true
+		},
+		genA,
+	))
+
+	properties.Property("SqrtCT of a square should be ± the root", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, square, neg {{.ElementName}}
+			square.Square(&a.element)
+			if c.SqrtCT(&square) == nil {
+				return false
+			}
+			neg.Neg(&a.element)
+			return c.Equal(&a.element) || c.Equal(&neg)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{toTitle .ElementName}}MulCT(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	properties.Property("mulCT and squareCT should match Mul and Square", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			for _, x := range genTestValues(a) {
+				for _, y := range genTestValues(b) {
+					var c, d {{.ElementName}}
+					c.mulCT(&x.element, &y.element)
+					d.Mul(&x.element, &y.element)
+					if !c.Equal(&d) || c.biggerOrEqualModulus() {
+						return false
+					}
+					c.squareCT(&x.element)
+					d.Square(&x.element)
+					if !c.Equal(&d) || c.biggerOrEqualModulus() {
+						return false
+					}
+				}
+			}
+			return true
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Benchmark{{toTitle .ElementName}}InverseCT(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.InverseCT(&x)
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}SqrtCT(b *testing.B) {
+	var a {{.ElementName}}
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.SqrtCT(&a)
+	}
+}
+
+`
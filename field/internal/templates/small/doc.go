@@ -0,0 +1,40 @@
+package small
+
+const Doc = `
+// Package {{.PackageName}} contains field arithmetic operations for modulus = 0x{{.ModulusHex}}.
+//
+// The API is the same as the one of the multi-word fields (e.g. ecc/bn254/fr), but field elements
+// fit in a single {{.Word}} word and the operations don't allocate:
+// 	type {{.ElementName}} [1]{{.Word}}
+{{- if eq .Reduction "goldilocks"}}
+//
+// The modulus is the Goldilocks prime 2**64 - 2**32 + 1. Elements are stored in regular form and the
+// 128-bit products are reduced with 2**64 = 2**32 - 1 (mod q) and 2**96 = -1 (mod q).
+{{- else if eq .Reduction "mersenne31"}}
+//
+// The modulus is the Mersenne prime 2**31 - 1. Elements are stored in regular form and the
+// 62-bit products are reduced with 2**31 = 1 (mod q).
+{{- else}}
+//
+// Elements are stored in Montgomery form, with R = 2**{{.WordBits}}.
+{{- end}}
+//
+// Example API signature
+// 	// Mul z = x * y mod q
+// 	func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}}
+//
+// and can be used like so:
+// 	var a, b {{.ElementName}}
+// 	a.SetUint64(2)
+// 	b.SetString("984896738")
+// 	a.Mul(a, b)
+// 	a.Sub(a, a)
+// 	 .Add(a, b)
+// 	 .Inv(a)
+// 	b.Exp(b, new(big.Int).SetUint64(42))
+//
+// Modulus
+// 	0x{{.ModulusHex}} // base 16
+// 	{{.Modulus}} // base 10
+package {{.PackageName}}
+`
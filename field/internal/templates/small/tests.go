@@ -0,0 +1,382 @@
+package small
+
+const Test = `
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// -------------------------------------------------------------------------------------------------
+// benchmarks
+// most benchmarks are rudimentary and should sample a large number of random inputs
+// or be run multiple times to ensure it didn't measure the fastest path of the function
+
+var benchRes{{.ElementName}} {{.ElementName}}
+
+func Benchmark{{toTitle .ElementName}}Add(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Add(&x, &benchRes{{.ElementName}})
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Sub(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Sub(&x, &benchRes{{.ElementName}})
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Mul(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Mul(&benchRes{{.ElementName}}, &x)
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Square(b *testing.B) {
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Square(&benchRes{{.ElementName}})
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Inverse(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Inverse(&x)
+	}
+}
+
+func Benchmark{{toTitle .ElementName}}Sqrt(b *testing.B) {
+	var a {{.ElementName}}
+	a.SetUint64(4)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.Sqrt(&a)
+	}
+}
+
+func Test{{toTitle .ElementName}}IsRandom(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		var x, y {{.ElementName}}
+		x.SetRandom()
+		y.SetRandom()
+		if x.Equal(&y) {
+			t.Fatal("2 random numbers are unlikely to be equal")
+		}
+	}
+}
+
+// -------------------------------------------------------------------------------------------------
+// Gopter tests
+
+const (
+	nbFuzzShort = 200
+	nbFuzz      = 1000
+)
+
+// special values to be used in tests
+var staticTestValues []{{.ElementName}}
+
+func init() {
+	staticTestValues = append(staticTestValues, {{.ElementName}}{}) // zero
+	staticTestValues = append(staticTestValues, One())     // one
+	var e, one {{.ElementName}}
+	one.SetOne()
+	e.Sub(&q{{.ElementName}}, &one)
+	staticTestValues = append(staticTestValues, e) // q - 1
+	e.Double(&one)
+	staticTestValues = append(staticTestValues, e) // 2
+
+	for i := 0; i <= 3; i++ {
+		staticTestValues = append(staticTestValues, {{.ElementName}}{ {{.Word}}(uint64(i) % q)})
+		staticTestValues = append(staticTestValues, {{.ElementName}}{ {{.Word}}(q - 1 - uint64(i)%q)})
+	}
+	staticTestValues = append(staticTestValues, {{.ElementName}}{ q >> 1})
+	staticTestValues = append(staticTestValues, {{.ElementName}}{ 1 << (Bits - 1)})
+}
+
+func genTestValues(a testPair{{.ElementName}}) []testPair{{.ElementName}} {
+	res := []testPair{{.ElementName}}{a}
+	for _, s := range staticTestValues {
+		var p testPair{{.ElementName}}
+		p.element = s
+		p.element.ToBigIntRegular(&p.bigint)
+		res = append(res, p)
+	}
+	return res
+}
+
+func Test{{toTitle .ElementName}}Arithmetic(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	type op struct {
+		name string
+		f    func(z, x, y *{{.ElementName}})
+		ref  func(z, x, y *big.Int)
+	}
+	ops := []op{
+		{"Add", func(z, x, y *{{.ElementName}}) { z.Add(x, y) }, func(z, x, y *big.Int) { z.Add(x, y) }},
+		{"Sub", func(z, x, y *{{.ElementName}}) { z.Sub(x, y) }, func(z, x, y *big.Int) { z.Sub(x, y) }},
+		{"Mul", func(z, x, y *{{.ElementName}}) { z.Mul(x, y) }, func(z, x, y *big.Int) { z.Mul(x, y) }},
+		{"Square", func(z, x, _ *{{.ElementName}}) { z.Square(x) }, func(z, x, _ *big.Int) { z.Mul(x, x) }},
+		{"Double", func(z, x, _ *{{.ElementName}}) { z.Double(x) }, func(z, x, _ *big.Int) { z.Lsh(x, 1) }},
+		{"Neg", func(z, x, _ *{{.ElementName}}) { z.Neg(x) }, func(z, x, _ *big.Int) { z.Neg(x) }},
+		{"Inverse", func(z, x, _ *{{.ElementName}}) { z.Inverse(x) }, func(z, x, _ *big.Int) {
+			if x.Sign() != 0 {
+				z.ModInverse(x, Modulus())
+			}
+		}},
+	}
+
+	for _, o := range ops {
+		o := o
+		properties.Property(o.name+": operation result must match big.Int result", prop.ForAll(
+			func(a, b testPair{{.ElementName}}) bool {
+				for _, x := range genTestValues(a) {
+					for _, y := range genTestValues(b) {
+						var c {{.ElementName}}
+						var d, e big.Int
+						o.f(&c, &x.element, &y.element)
+						o.ref(&d, &x.bigint, &y.bigint)
+						d.Mod(&d, Modulus())
+
+						if c.biggerOrEqualModulus() || c.ToBigIntRegular(&e).Cmp(&d) != 0 {
+							return false
+						}
+					}
+				}
+				return true
+			},
+			genA,
+			genB,
+		))
+
+		properties.Property(o.name+": having the receiver as operand should output the same result", prop.ForAll(
+			func(a, b testPair{{.ElementName}}) bool {
+				var c {{.ElementName}}
+				o.f(&c, &a.element, &b.element)
+				x, y := a.element, b.element
+				o.f(&x, &x, &b.element)
+				o.f(&y, &a.element, &y)
+				return c.Equal(&x) && c.Equal(&y)
+			},
+			genA,
+			genB,
+		))
+	}
+
+	properties.Property("Exp: operation result must match big.Int result", prop.ForAll(
+		func(a, b testPair{{.ElementName}}) bool {
+			var c {{.ElementName}}
+			var d, e big.Int
+			c.Exp(a.element, &b.bigint)
+			d.Exp(&a.bigint, &b.bigint, Modulus())
+			return c.ToBigIntRegular(&e).Cmp(&d) == 0
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("Legendre: must match big.Jacobi", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			return a.element.Legendre() == big.Jacobi(&a.bigint, Modulus())
+		},
+		genA,
+	))
+
+	properties.Property("Sqrt: must match big.ModSqrt", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c {{.ElementName}}
+			var d, e big.Int
+			if c.Sqrt(&a.element) == nil {
+				return d.ModSqrt(&a.bigint, Modulus()) == nil
+			}
+			c.ToBigIntRegular(&d)
+			e.Mul(&d, &d).Mod(&e, Modulus())
+			return e.Cmp(&a.bigint) == 0
+		},
+		genA,
+	))
+
+	properties.Property("Sqrt: must find the square root of a square", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, s {{.ElementName}}
+			s.Square(&a.element)
+			if c.Sqrt(&s) == nil {
+				return false
+			}
+			return c.Square(&c).Equal(&s)
+		},
+		genA,
+	))
+
+	properties.Property("Bytes: SetBytes(Bytes(x)) == x and SetString(String(x)) == x", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var c, d {{.ElementName}}
+			b := a.element.Bytes()
+			c.SetBytes(b[:])
+			d.SetString(a.element.String())
+			return c.Equal(&a.element) && d.Equal(&a.element) && a.element.String() == a.bigint.String()
+		},
+		genA,
+	))
+
+	properties.Property("LexicographicallyLargest: must match big.Int comparison with -x", prop.ForAll(
+		func(a testPair{{.ElementName}}) bool {
+			var neg big.Int
+			neg.Neg(&a.bigint).Mod(&neg, Modulus())
+			return a.element.LexicographicallyLargest() == (a.bigint.Cmp(&neg) > 0)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{toTitle .ElementName}}MulByConstants(t *testing.T) {
+	for _, s := range staticTestValues {
+		for _, c := range []struct {
+			k uint64
+			f func(*{{.ElementName}})
+		}{ {3, MulBy3}, {5, MulBy5}, {13, MulBy13} } {
+			var expected, k {{.ElementName}}
+			k.SetUint64(c.k)
+			expected.Mul(&s, &k)
+			x := s
+			c.f(&x)
+			if !x.Equal(&expected) {
+				t.Fatal("MulBy", c.k, "failed")
+			}
+		}
+	}
+}
+
+func Test{{toTitle .ElementName}}BatchInvert(t *testing.T) {
+	a := make([]{{.ElementName}}, len(staticTestValues))
+	copy(a, staticTestValues)
+	res := BatchInvert(a)
+	for i := range a {
+		var expected {{.ElementName}}
+		expected.Inverse(&a[i])
+		if !res[i].Equal(&expected) {
+			t.Fatal("BatchInvert doesn't match Inverse")
+		}
+	}
+}
+
+func Fuzz{{toTitle .ElementName}}(f *testing.F) {
+	for i := range staticTestValues {
+		a := staticTestValues[i].Bytes()
+		b := staticTestValues[len(staticTestValues)-1-i].Bytes()
+		f.Add(a[:], b[:])
+	}
+	f.Add([]byte{}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		q := Modulus()
+
+		// SetBytes interprets its input as a big endian integer, reduced mod q
+		var x, y {{.ElementName}}
+		var ra, rb big.Int
+		x.SetBytes(a)
+		y.SetBytes(b)
+		ra.SetBytes(a).Mod(&ra, q)
+		rb.SetBytes(b).Mod(&rb, q)
+
+		check := func(op string, z *{{.ElementName}}, expected *big.Int) {
+			t.Helper()
+			var res big.Int
+			if z.biggerOrEqualModulus() {
+				t.Fatalf("%s: result is not reduced", op)
+			}
+			if z.ToBigIntRegular(&res).Cmp(expected) != 0 {
+				t.Fatalf("%s: %s != %s (math/big)", op, res.String(), expected.String())
+			}
+		}
+		check("SetBytes", &x, &ra)
+		check("SetBytes", &y, &rb)
+
+		var z {{.ElementName}}
+		var expected big.Int
+
+		expected.Add(&ra, &rb).Mod(&expected, q)
+		check("Add", z.Add(&x, &y), &expected)
+
+		expected.Sub(&ra, &rb).Mod(&expected, q)
+		check("Sub", z.Sub(&x, &y), &expected)
+
+		expected.Mul(&ra, &rb).Mod(&expected, q)
+		check("Mul", z.Mul(&x, &y), &expected)
+
+		expected.Exp(&ra, &rb, q)
+		check("Exp", z.Exp(x, &rb), &expected)
+
+		if rb.Sign() != 0 {
+			expected.ModInverse(&rb, q)
+			check("Div", z.Div(&x, &y), expected.Mul(&expected, &ra).Mod(&expected, q))
+		}
+
+		if z.Sqrt(&x) == nil {
+			if new(big.Int).ModSqrt(&ra, q) != nil {
+				t.Fatal("Sqrt failed on a square")
+			}
+		} else {
+			expected.Mul(z.ToBigIntRegular(&expected), &expected).Mod(&expected, q)
+			if expected.Cmp(&ra) != 0 {
+				t.Fatal("Sqrt(x)**2 != x")
+			}
+		}
+	})
+}
+
+type testPair{{.ElementName}} struct {
+	element {{.ElementName}}
+	bigint  big.Int
+}
+
+func (z *{{.ElementName}}) biggerOrEqualModulus() bool {
+	return z[0] >= q
+}
+
+func gen() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var g testPair{{.ElementName}}
+
+		// the generated values are reduced mod q, with a negligible bias
+		g.element[0] = {{.Word}}(genParams.NextUint64() % q)
+
+		g.element.ToBigIntRegular(&g.bigint)
+		genResult := gopter.NewGenResult(g, gopter.NoShrinker)
+		return genResult
+	}
+}
+`
@@ -0,0 +1,77 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package m31
+
+// InverseCT z = x^-1 mod q, in constant time
+// if x == 0, sets and returns z = 0
+//
+// It computes x^(q-2) (Fermat's little theorem) with a fixed addition chain (expByInverseExpCT)
+// of mulCT and squareCT.
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.expByInverseExpCT(*x)
+}
+
+// SqrtCT z = √x mod q, in constant time
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil; the running time only depends on whether x is a square
+//
+// Unlike Sqrt, it doesn't branch on intermediate values.
+// It uses mulCT and squareCT rather than Mul and Square, whose final subtraction is a branch.
+func (z *Element) SqrtCT(x *Element) *Element {
+	// q ≡ 3 (mod 4)
+	// using  z ≡ ± x^((p+1)/4) (mod q)
+	var y Element
+	y.expBySqrtExpCT(*x)
+
+	// as we didn't compute the legendre symbol, ensure we found y such that y * y = x
+	var square Element
+	square.squareCT(&y)
+	if ctEqual(&square, x) == 1 {
+		return z.Set(&y)
+	}
+	return nil
+}
+
+// mulCT z = x * y (mod q), in constant time
+func (z *Element) mulCT(x, y *Element) *Element {
+	z[0] = mulReduceCT(x[0], y[0])
+	return z
+}
+
+// squareCT z = x * x (mod q), in constant time
+func (z *Element) squareCT(x *Element) *Element {
+	z[0] = mulReduceCT(x[0], x[0])
+	return z
+}
+
+// ctEqual returns 1 if x == y, 0 otherwise, in constant time
+func ctEqual(x, y *Element) uint64 {
+	t := uint64(x[0] ^ y[0])
+	return 1 ^ ((t | -t) >> 63)
+}
+
+// mulReduceCT returns a * b mod q, as mulReduce, with the final subtraction replaced by a mask
+func mulReduceCT(a, b uint32) uint32 {
+	p := uint64(a) * uint64(b)
+	p = (p & q) + (p >> 31) // < 2**32
+	p = (p & q) + (p >> 31) // <= q + 1
+
+	// s is negative if p < q
+	s := p - q
+	mask := -(s >> 63)
+	return uint32(s ^ (mask & (s ^ p)))
+}
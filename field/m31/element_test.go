@@ -0,0 +1,395 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package m31
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// -------------------------------------------------------------------------------------------------
+// benchmarks
+// most benchmarks are rudimentary and should sample a large number of random inputs
+// or be run multiple times to ensure it didn't measure the fastest path of the function
+
+var benchResElement Element
+
+func BenchmarkElementAdd(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Add(&x, &benchResElement)
+	}
+}
+
+func BenchmarkElementSub(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Sub(&x, &benchResElement)
+	}
+}
+
+func BenchmarkElementMul(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Mul(&benchResElement, &x)
+	}
+}
+
+func BenchmarkElementSquare(b *testing.B) {
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Square(&benchResElement)
+	}
+}
+
+func BenchmarkElementInverse(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Inverse(&x)
+	}
+}
+
+func BenchmarkElementSqrt(b *testing.B) {
+	var a Element
+	a.SetUint64(4)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Sqrt(&a)
+	}
+}
+
+func TestElementIsRandom(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		var x, y Element
+		x.SetRandom()
+		y.SetRandom()
+		if x.Equal(&y) {
+			t.Fatal("2 random numbers are unlikely to be equal")
+		}
+	}
+}
+
+// -------------------------------------------------------------------------------------------------
+// Gopter tests
+
+const (
+	nbFuzzShort = 200
+	nbFuzz      = 1000
+)
+
+// special values to be used in tests
+var staticTestValues []Element
+
+func init() {
+	staticTestValues = append(staticTestValues, Element{}) // zero
+	staticTestValues = append(staticTestValues, One())     // one
+	var e, one Element
+	one.SetOne()
+	e.Sub(&qElement, &one)
+	staticTestValues = append(staticTestValues, e) // q - 1
+	e.Double(&one)
+	staticTestValues = append(staticTestValues, e) // 2
+
+	for i := 0; i <= 3; i++ {
+		staticTestValues = append(staticTestValues, Element{uint32(uint64(i) % q)})
+		staticTestValues = append(staticTestValues, Element{uint32(q - 1 - uint64(i)%q)})
+	}
+	staticTestValues = append(staticTestValues, Element{q >> 1})
+	staticTestValues = append(staticTestValues, Element{1 << (Bits - 1)})
+}
+
+func genTestValues(a testPairElement) []testPairElement {
+	res := []testPairElement{a}
+	for _, s := range staticTestValues {
+		var p testPairElement
+		p.element = s
+		p.element.ToBigIntRegular(&p.bigint)
+		res = append(res, p)
+	}
+	return res
+}
+
+func TestElementArithmetic(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	type op struct {
+		name string
+		f    func(z, x, y *Element)
+		ref  func(z, x, y *big.Int)
+	}
+	ops := []op{
+		{"Add", func(z, x, y *Element) { z.Add(x, y) }, func(z, x, y *big.Int) { z.Add(x, y) }},
+		{"Sub", func(z, x, y *Element) { z.Sub(x, y) }, func(z, x, y *big.Int) { z.Sub(x, y) }},
+		{"Mul", func(z, x, y *Element) { z.Mul(x, y) }, func(z, x, y *big.Int) { z.Mul(x, y) }},
+		{"Square", func(z, x, _ *Element) { z.Square(x) }, func(z, x, _ *big.Int) { z.Mul(x, x) }},
+		{"Double", func(z, x, _ *Element) { z.Double(x) }, func(z, x, _ *big.Int) { z.Lsh(x, 1) }},
+		{"Neg", func(z, x, _ *Element) { z.Neg(x) }, func(z, x, _ *big.Int) { z.Neg(x) }},
+		{"Inverse", func(z, x, _ *Element) { z.Inverse(x) }, func(z, x, _ *big.Int) {
+			if x.Sign() != 0 {
+				z.ModInverse(x, Modulus())
+			}
+		}},
+	}
+
+	for _, o := range ops {
+		o := o
+		properties.Property(o.name+": operation result must match big.Int result", prop.ForAll(
+			func(a, b testPairElement) bool {
+				for _, x := range genTestValues(a) {
+					for _, y := range genTestValues(b) {
+						var c Element
+						var d, e big.Int
+						o.f(&c, &x.element, &y.element)
+						o.ref(&d, &x.bigint, &y.bigint)
+						d.Mod(&d, Modulus())
+
+						if c.biggerOrEqualModulus() || c.ToBigIntRegular(&e).Cmp(&d) != 0 {
+							return false
+						}
+					}
+				}
+				return true
+			},
+			genA,
+			genB,
+		))
+
+		properties.Property(o.name+": having the receiver as operand should output the same result", prop.ForAll(
+			func(a, b testPairElement) bool {
+				var c Element
+				o.f(&c, &a.element, &b.element)
+				x, y := a.element, b.element
+				o.f(&x, &x, &b.element)
+				o.f(&y, &a.element, &y)
+				return c.Equal(&x) && c.Equal(&y)
+			},
+			genA,
+			genB,
+		))
+	}
+
+	properties.Property("Exp: operation result must match big.Int result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c Element
+			var d, e big.Int
+			c.Exp(a.element, &b.bigint)
+			d.Exp(&a.bigint, &b.bigint, Modulus())
+			return c.ToBigIntRegular(&e).Cmp(&d) == 0
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("Legendre: must match big.Jacobi", prop.ForAll(
+		func(a testPairElement) bool {
+			return a.element.Legendre() == big.Jacobi(&a.bigint, Modulus())
+		},
+		genA,
+	))
+
+	properties.Property("Sqrt: must match big.ModSqrt", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			var d, e big.Int
+			if c.Sqrt(&a.element) == nil {
+				return d.ModSqrt(&a.bigint, Modulus()) == nil
+			}
+			c.ToBigIntRegular(&d)
+			e.Mul(&d, &d).Mod(&e, Modulus())
+			return e.Cmp(&a.bigint) == 0
+		},
+		genA,
+	))
+
+	properties.Property("Sqrt: must find the square root of a square", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, s Element
+			s.Square(&a.element)
+			if c.Sqrt(&s) == nil {
+				return false
+			}
+			return c.Square(&c).Equal(&s)
+		},
+		genA,
+	))
+
+	properties.Property("Bytes: SetBytes(Bytes(x)) == x and SetString(String(x)) == x", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, d Element
+			b := a.element.Bytes()
+			c.SetBytes(b[:])
+			d.SetString(a.element.String())
+			return c.Equal(&a.element) && d.Equal(&a.element) && a.element.String() == a.bigint.String()
+		},
+		genA,
+	))
+
+	properties.Property("LexicographicallyLargest: must match big.Int comparison with -x", prop.ForAll(
+		func(a testPairElement) bool {
+			var neg big.Int
+			neg.Neg(&a.bigint).Mod(&neg, Modulus())
+			return a.element.LexicographicallyLargest() == (a.bigint.Cmp(&neg) > 0)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementMulByConstants(t *testing.T) {
+	for _, s := range staticTestValues {
+		for _, c := range []struct {
+			k uint64
+			f func(*Element)
+		}{{3, MulBy3}, {5, MulBy5}, {13, MulBy13}} {
+			var expected, k Element
+			k.SetUint64(c.k)
+			expected.Mul(&s, &k)
+			x := s
+			c.f(&x)
+			if !x.Equal(&expected) {
+				t.Fatal("MulBy", c.k, "failed")
+			}
+		}
+	}
+}
+
+func TestElementBatchInvert(t *testing.T) {
+	a := make([]Element, len(staticTestValues))
+	copy(a, staticTestValues)
+	res := BatchInvert(a)
+	for i := range a {
+		var expected Element
+		expected.Inverse(&a[i])
+		if !res[i].Equal(&expected) {
+			t.Fatal("BatchInvert doesn't match Inverse")
+		}
+	}
+}
+
+func FuzzElement(f *testing.F) {
+	for i := range staticTestValues {
+		a := staticTestValues[i].Bytes()
+		b := staticTestValues[len(staticTestValues)-1-i].Bytes()
+		f.Add(a[:], b[:])
+	}
+	f.Add([]byte{}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		q := Modulus()
+
+		// SetBytes interprets its input as a big endian integer, reduced mod q
+		var x, y Element
+		var ra, rb big.Int
+		x.SetBytes(a)
+		y.SetBytes(b)
+		ra.SetBytes(a).Mod(&ra, q)
+		rb.SetBytes(b).Mod(&rb, q)
+
+		check := func(op string, z *Element, expected *big.Int) {
+			t.Helper()
+			var res big.Int
+			if z.biggerOrEqualModulus() {
+				t.Fatalf("%s: result is not reduced", op)
+			}
+			if z.ToBigIntRegular(&res).Cmp(expected) != 0 {
+				t.Fatalf("%s: %s != %s (math/big)", op, res.String(), expected.String())
+			}
+		}
+		check("SetBytes", &x, &ra)
+		check("SetBytes", &y, &rb)
+
+		var z Element
+		var expected big.Int
+
+		expected.Add(&ra, &rb).Mod(&expected, q)
+		check("Add", z.Add(&x, &y), &expected)
+
+		expected.Sub(&ra, &rb).Mod(&expected, q)
+		check("Sub", z.Sub(&x, &y), &expected)
+
+		expected.Mul(&ra, &rb).Mod(&expected, q)
+		check("Mul", z.Mul(&x, &y), &expected)
+
+		expected.Exp(&ra, &rb, q)
+		check("Exp", z.Exp(x, &rb), &expected)
+
+		if rb.Sign() != 0 {
+			expected.ModInverse(&rb, q)
+			check("Div", z.Div(&x, &y), expected.Mul(&expected, &ra).Mod(&expected, q))
+		}
+
+		if z.Sqrt(&x) == nil {
+			if new(big.Int).ModSqrt(&ra, q) != nil {
+				t.Fatal("Sqrt failed on a square")
+			}
+		} else {
+			expected.Mul(z.ToBigIntRegular(&expected), &expected).Mod(&expected, q)
+			if expected.Cmp(&ra) != 0 {
+				t.Fatal("Sqrt(x)**2 != x")
+			}
+		}
+	})
+}
+
+type testPairElement struct {
+	element Element
+	bigint  big.Int
+}
+
+func (z *Element) biggerOrEqualModulus() bool {
+	return z[0] >= q
+}
+
+func gen() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var g testPairElement
+
+		// the generated values are reduced mod q, with a negligible bias
+		g.element[0] = uint32(genParams.NextUint64() % q)
+
+		g.element.ToBigIntRegular(&g.bigint)
+		genResult := gopter.NewGenResult(g, gopter.NoShrinker)
+		return genResult
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package polynomial provides polynomial methods over any field satisfying field.Element.
+//
+// It has the same API as the per-curve packages (e.g. ecc/bn254/fr/polynomial),
+// which remain the fastest option for a given field:
+//
+//	var p polynomial.Polynomial[fr.Element, *fr.Element]
+package polynomial
+
+import (
+	"github.com/consensys/gnark-crypto/field"
+)
+
+// Polynomial polynomial represented by coefficients in the field of T.
+type Polynomial[T any, PT field.Element[T]] []T
+
+// Degree returns the degree of the polynomial, which is the length of Data.
+func (p *Polynomial[T, PT]) Degree() uint64 {
+	return uint64(len(*p) - 1)
+}
+
+// Eval evaluates p at v
+// returns a T
+func (p *Polynomial[T, PT]) Eval(v *T) T {
+
+	res := (*p)[len(*p)-1]
+	for i := len(*p) - 2; i >= 0; i-- {
+		PT(&res).Mul(&res, v)
+		PT(&res).Add(&res, &(*p)[i])
+	}
+
+	return res
+}
+
+// Clone returns a copy of the polynomial
+func (p *Polynomial[T, PT]) Clone() Polynomial[T, PT] {
+	_p := make(Polynomial[T, PT], len(*p))
+	copy(_p, *p)
+	return _p
+}
+
+// AddConstantInPlace adds a constant to the polynomial, modifying p
+func (p *Polynomial[T, PT]) AddConstantInPlace(c *T) {
+	for i := 0; i < len(*p); i++ {
+		PT(&(*p)[i]).Add(&(*p)[i], c)
+	}
+}
+
+// SubConstantInPlace subs a constant to the polynomial, modifying p
+func (p *Polynomial[T, PT]) SubConstantInPlace(c *T) {
+	for i := 0; i < len(*p); i++ {
+		PT(&(*p)[i]).Sub(&(*p)[i], c)
+	}
+}
+
+// ScaleInPlace multiplies p by v, modifying p
+func (p *Polynomial[T, PT]) ScaleInPlace(c *T) {
+	for i := 0; i < len(*p); i++ {
+		PT(&(*p)[i]).Mul(&(*p)[i], c)
+	}
+}
+
+// Add adds p1 to p2
+// This function allocates a new slice unless p == p1 or p == p2
+func (p *Polynomial[T, PT]) Add(p1, p2 Polynomial[T, PT]) *Polynomial[T, PT] {
+
+	bigger := p1
+	smaller := p2
+	if len(bigger) < len(smaller) {
+		bigger, smaller = smaller, bigger
+	}
+
+	if len(*p) == len(bigger) && (&(*p)[0] == &bigger[0]) {
+		for i := 0; i < len(smaller); i++ {
+			PT(&(*p)[i]).Add(&(*p)[i], &smaller[i])
+		}
+		return p
+	}
+
+	if len(*p) == len(smaller) && (&(*p)[0] == &smaller[0]) {
+		for i := 0; i < len(smaller); i++ {
+			PT(&(*p)[i]).Add(&(*p)[i], &bigger[i])
+		}
+		*p = append(*p, bigger[len(smaller):]...)
+		return p
+	}
+
+	res := make(Polynomial[T, PT], len(bigger))
+	copy(res, bigger)
+	for i := 0; i < len(smaller); i++ {
+		PT(&res[i]).Add(&res[i], &smaller[i])
+	}
+	*p = res
+	return p
+}
+
+// Equal checks equality between two polynomials
+func (p *Polynomial[T, PT]) Equal(p1 Polynomial[T, PT]) bool {
+	if (*p == nil) != (p1 == nil) {
+		return false
+	}
+
+	if len(*p) != len(p1) {
+		return false
+	}
+
+	for i := range p1 {
+		if !PT(&(*p)[i]).Equal(&p1[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+
+	frbls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	polybn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/polynomial"
+)
+
+type bn254Polynomial = Polynomial[fr.Element, *fr.Element]
+
+func TestPolynomialEval(t *testing.T) {
+
+	// build polynomial
+	f := make(Polynomial[frbls12381.Element, *frbls12381.Element], 20)
+	for i := 0; i < 20; i++ {
+		f[i].SetOne()
+	}
+
+	// random value
+	var point frbls12381.Element
+	point.SetRandom()
+
+	// compute manually f(val)
+	var expectedEval, one, den frbls12381.Element
+	var expo big.Int
+	one.SetOne()
+	expo.SetUint64(20)
+	expectedEval.Exp(point, &expo).
+		Sub(&expectedEval, &one)
+	den.Sub(&point, &one)
+	expectedEval.Div(&expectedEval, &den)
+
+	// compute purported evaluation
+	purportedEval := f.Eval(&point)
+
+	// check
+	if !purportedEval.Equal(&expectedEval) {
+		t.Fatal("polynomial evaluation failed")
+	}
+}
+
+func TestPolynomialMatchesBN254(t *testing.T) {
+
+	f := make(bn254Polynomial, 20)
+	g := make(bn254Polynomial, 12)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	for i := range g {
+		g[i].SetRandom()
+	}
+	fRef := polybn254.Polynomial(f.Clone())
+	gRef := polybn254.Polynomial(g.Clone())
+
+	var c, point fr.Element
+	c.SetRandom()
+	point.SetRandom()
+
+	f.AddConstantInPlace(&c)
+	fRef.AddConstantInPlace(&c)
+	g.SubConstantInPlace(&c)
+	gRef.SubConstantInPlace(&c)
+	f.ScaleInPlace(&c)
+	fRef.ScaleInPlace(&c)
+
+	var sum bn254Polynomial
+	var sumRef polybn254.Polynomial
+	sum.Add(f, g)
+	sumRef.Add(fRef, gRef)
+
+	if !sum.Equal(bn254Polynomial(sumRef)) {
+		t.Fatal("Add doesn't match the bn254 polynomial package")
+	}
+	eval, evalRef := sum.Eval(&point), sumRef.Eval(&point)
+	if !eval.Equal(&evalRef) {
+		t.Fatal("Eval doesn't match the bn254 polynomial package")
+	}
+
+	// in place additions
+	f.Add(f, g)
+	if !f.Equal(sum) {
+		t.Fatal("p.Add(p, q) failed")
+	}
+	g.Add(g, f.Clone())
+	sum.Add(sum, g)
+	sum.Add(sum, bn254Polynomial(sumRef))
+	if f.Degree() != 19 || sum.Degree() != 19 {
+		t.Fatal("wrong degree")
+	}
+}
+
+func BenchmarkPolynomialEval(b *testing.B) {
+	const n = 1 << 16
+
+	f := make(bn254Polynomial, n)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	fRef := polybn254.Polynomial(f)
+	var point fr.Element
+	point.SetRandom()
+
+	b.Run("generic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			f.Eval(&point)
+		}
+	})
+	b.Run("bn254", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			fRef.Eval(&point)
+		}
+	})
+}
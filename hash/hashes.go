@@ -21,10 +21,12 @@ import (
 	"hash"
 
 	bls377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr/mimc"
+	bls378 "github.com/consensys/gnark-crypto/ecc/bls12-378/fr/mimc"
 	bls381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr/mimc"
 	bls315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr/mimc"
 	bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
 	bw633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fr/mimc"
+	bw756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fr/mimc"
 	bw761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr/mimc"
 	bw767 "github.com/consensys/gnark-crypto/ecc/bw6-767/fr/mimc"
 )
@@ -39,6 +41,8 @@ const (
 	MIMC_BLS24_315
 	MIMC_BW6_633
 	MIMC_BW6_767
+	MIMC_BLS12_378
+	MIMC_BW6_756
 )
 
 // size of digests in bytes
@@ -50,6 +54,8 @@ var digestSize = []uint8{
 	MIMC_BLS24_315: 48,
 	MIMC_BW6_633:   80,
 	MIMC_BW6_767:   96,
+	MIMC_BLS12_378: 48,
+	MIMC_BW6_756:   96,
 }
 
 // New creates the corresponding mimc hash function.
@@ -69,6 +75,10 @@ func (m Hash) New(seed string) hash.Hash {
 		return bw633.NewMiMC(seed)
 	case MIMC_BW6_767:
 		return bw767.NewMiMC(seed)
+	case MIMC_BLS12_378:
+		return bls378.NewMiMC(seed)
+	case MIMC_BW6_756:
+		return bw756.NewMiMC(seed)
 	default:
 		panic("Unknown mimc ID")
 	}
@@ -91,6 +101,10 @@ func (m Hash) String() string {
 		return "MIMC_BW633"
 	case MIMC_BW6_767:
 		return "MIMC_BW767"
+	case MIMC_BLS12_378:
+		return "MIMC_BLS378"
+	case MIMC_BW6_756:
+		return "MIMC_BW756"
 	default:
 		panic("Unknown mimc ID")
 	}
@@ -0,0 +1,286 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blake2 provides BLAKE2b and BLAKE2s with a personalization string,
+// which golang.org/x/crypto doesn't expose.
+//
+// It is a straightforward (non optimized) implementation of https://tools.ietf.org/html/rfc7693,
+// used by the Zcash compatible primitives of the library.
+package blake2
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/bits"
+)
+
+var errInvalidParameters = errors.New("invalid digest size or personalization length")
+
+var sigma = [10][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+}
+
+// -------------------------------------------------------------------------------------------------
+// BLAKE2b
+
+const blockSizeB = 128
+
+var ivB = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+type digestB struct {
+	h, h0  [8]uint64
+	t      [2]uint64
+	buf    [blockSizeB]byte
+	offset int
+	size   int
+}
+
+// NewBlake2b returns a BLAKE2b hash with a size bytes digest (1 <= size <= 64)
+// and the given personalization (at most 16 bytes, zero padded)
+func NewBlake2b(size int, personalization []byte) (hash.Hash, error) {
+	if size < 1 || size > 64 || len(personalization) > 16 {
+		return nil, errInvalidParameters
+	}
+	var p [16]byte
+	copy(p[:], personalization)
+
+	d := &digestB{size: size}
+	d.h0 = ivB
+	d.h0[0] ^= 0x01010000 ^ uint64(size)
+	d.h0[6] ^= binary.LittleEndian.Uint64(p[:8])
+	d.h0[7] ^= binary.LittleEndian.Uint64(p[8:])
+	d.Reset()
+	return d, nil
+}
+
+func (d *digestB) Reset() {
+	d.h = d.h0
+	d.t = [2]uint64{}
+	d.offset = 0
+}
+
+func (d *digestB) Size() int { return d.size }
+
+func (d *digestB) BlockSize() int { return blockSizeB }
+
+func (d *digestB) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// the last block is compressed in Sum, with the final flag set
+		if d.offset == blockSizeB {
+			d.compress(blockSizeB, false)
+			d.offset = 0
+		}
+		c := copy(d.buf[d.offset:], p)
+		d.offset += c
+		p = p[c:]
+	}
+	return n, nil
+}
+
+func (d *digestB) Sum(b []byte) []byte {
+	_d := *d
+	for i := _d.offset; i < blockSizeB; i++ {
+		_d.buf[i] = 0
+	}
+	_d.compress(_d.offset, true)
+
+	var out [64]byte
+	for i, v := range _d.h {
+		binary.LittleEndian.PutUint64(out[8*i:], v)
+	}
+	return append(b, out[:d.size]...)
+}
+
+func (d *digestB) compress(n int, last bool) {
+	var m [16]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(d.buf[8*i:])
+	}
+
+	var c uint64
+	d.t[0], c = bits.Add64(d.t[0], uint64(n), 0)
+	d.t[1] += c
+
+	var v [16]uint64
+	copy(v[:8], d.h[:])
+	copy(v[8:], ivB[:])
+	v[12] ^= d.t[0]
+	v[13] ^= d.t[1]
+	if last {
+		v[14] = ^v[14]
+	}
+
+	g := func(a, b, c, d int, x, y uint64) {
+		v[a] += v[b] + x
+		v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+		v[a] += v[b] + y
+		v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+	}
+
+	for r := 0; r < 12; r++ {
+		s := &sigma[r%10]
+		g(0, 4, 8, 12, m[s[0]], m[s[1]])
+		g(1, 5, 9, 13, m[s[2]], m[s[3]])
+		g(2, 6, 10, 14, m[s[4]], m[s[5]])
+		g(3, 7, 11, 15, m[s[6]], m[s[7]])
+		g(0, 5, 10, 15, m[s[8]], m[s[9]])
+		g(1, 6, 11, 12, m[s[10]], m[s[11]])
+		g(2, 7, 8, 13, m[s[12]], m[s[13]])
+		g(3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+
+	for i := range d.h {
+		d.h[i] ^= v[i] ^ v[i+8]
+	}
+}
+
+// -------------------------------------------------------------------------------------------------
+// BLAKE2s
+
+const blockSizeS = 64
+
+var ivS = [8]uint32{
+	0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a,
+	0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19,
+}
+
+type digestS struct {
+	h, h0  [8]uint32
+	t      [2]uint32
+	buf    [blockSizeS]byte
+	offset int
+	size   int
+}
+
+// NewBlake2s returns a BLAKE2s hash with a size bytes digest (1 <= size <= 32)
+// and the given personalization (at most 8 bytes, zero padded)
+func NewBlake2s(size int, personalization []byte) (hash.Hash, error) {
+	if size < 1 || size > 32 || len(personalization) > 8 {
+		return nil, errInvalidParameters
+	}
+	var p [8]byte
+	copy(p[:], personalization)
+
+	d := &digestS{size: size}
+	d.h0 = ivS
+	d.h0[0] ^= 0x01010000 ^ uint32(size)
+	d.h0[6] ^= binary.LittleEndian.Uint32(p[:4])
+	d.h0[7] ^= binary.LittleEndian.Uint32(p[4:])
+	d.Reset()
+	return d, nil
+}
+
+func (d *digestS) Reset() {
+	d.h = d.h0
+	d.t = [2]uint32{}
+	d.offset = 0
+}
+
+func (d *digestS) Size() int { return d.size }
+
+func (d *digestS) BlockSize() int { return blockSizeS }
+
+func (d *digestS) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// the last block is compressed in Sum, with the final flag set
+		if d.offset == blockSizeS {
+			d.compress(blockSizeS, false)
+			d.offset = 0
+		}
+		c := copy(d.buf[d.offset:], p)
+		d.offset += c
+		p = p[c:]
+	}
+	return n, nil
+}
+
+func (d *digestS) Sum(b []byte) []byte {
+	_d := *d
+	for i := _d.offset; i < blockSizeS; i++ {
+		_d.buf[i] = 0
+	}
+	_d.compress(_d.offset, true)
+
+	var out [32]byte
+	for i, v := range _d.h {
+		binary.LittleEndian.PutUint32(out[4*i:], v)
+	}
+	return append(b, out[:d.size]...)
+}
+
+func (d *digestS) compress(n int, last bool) {
+	var m [16]uint32
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint32(d.buf[4*i:])
+	}
+
+	var c uint32
+	d.t[0], c = bits.Add32(d.t[0], uint32(n), 0)
+	d.t[1] += c
+
+	var v [16]uint32
+	copy(v[:8], d.h[:])
+	copy(v[8:], ivS[:])
+	v[12] ^= d.t[0]
+	v[13] ^= d.t[1]
+	if last {
+		v[14] = ^v[14]
+	}
+
+	g := func(a, b, c, d int, x, y uint32) {
+		v[a] += v[b] + x
+		v[d] = bits.RotateLeft32(v[d]^v[a], -16)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft32(v[b]^v[c], -12)
+		v[a] += v[b] + y
+		v[d] = bits.RotateLeft32(v[d]^v[a], -8)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft32(v[b]^v[c], -7)
+	}
+
+	for r := 0; r < 10; r++ {
+		s := &sigma[r]
+		g(0, 4, 8, 12, m[s[0]], m[s[1]])
+		g(1, 5, 9, 13, m[s[2]], m[s[3]])
+		g(2, 6, 10, 14, m[s[4]], m[s[5]])
+		g(3, 7, 11, 15, m[s[6]], m[s[7]])
+		g(0, 5, 10, 15, m[s[8]], m[s[9]])
+		g(1, 6, 11, 12, m[s[10]], m[s[11]])
+		g(2, 7, 8, 13, m[s[12]], m[s[13]])
+		g(3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+
+	for i := range d.h {
+		d.h[i] ^= v[i] ^ v[i+8]
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blake2
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/blake2s"
+)
+
+// message length, BLAKE2b-512 with personalization "Zcash_RedJubjubH", BLAKE2s-256 with personalization "Zcash_G_"
+var personalizedVectors = []struct {
+	n    int
+	b, s string
+}{
+	{0, "fd871feb5e5a07be52944fd7f3af7ed6848a4f515e1125ab6f158b4b9eb6fdc3640611eacb46754c891541e1b1a2a658e99a932e5de3f24a55fb066f8bf8d84a", "44019295aee03358a9b681963889dd8c45022e9248e2f6e960166660a99447b7"},
+	{1, "f190c362eada3a6654201bbde8e83ca78e05ab8197cfc096f3cde8093fac7478262e7021b888cf1927ad93658d6774cce29956f8361e6b1fa57201b9cbcb9abd", "61393078cb9af557263be81a737920318c41dc013fc53e989c5c803a5335f7b2"},
+	{63, "771c62f458bbfa3fe599557500a720bc73a567e0ef88b8ba45df4618a80bbc3b4177ebc57e1a23c6cd5c29501e8ebf86d4db8893d8b238abb50f9d73d32ebf24", "38cd13e24db9dbbc76cce38b49232f019396383a2c3ed926d71619d7ee76d80f"},
+	{64, "be7b0410cdc3b744eb90c8975fe0e1cba6f76bf3bf4c9392458257d1c0ee163e563e0c9df6e58e4960f93ccf8d62d28d4cb0e92888240904ec5d476d75922a91", "c9b7e817c462ef156f8eec144eefa8234010ac70b5a3f4134147602577740958"},
+	{65, "e3905a4a26be2b151e4add8f16d25b1fc2cadc8d663e99d8e704317c0c63113a8560773a14c8d327be3aeb51a4dd70ceebc4bf7578f5a463638062aa50e24efe", "d73b44c8df7ebb7444972f5d43e684e70abfc9340944a0938164c4df5a06d528"},
+	{127, "a9e58fc384f7630dcc528a9390c51da8336146b61a4a00576de93356aae5bacd95198ea13fc71877d87e6a5673f9d1da8d98476e2371f208bf6ace83aabea3e0", "14f5b04420ed122cb99318810f621188aa2138d527fcf7cde9b8074d509ca53c"},
+	{128, "7ae2cc30e5ffd51bf4f86dbef6d6d670acebaaecab28344dc9e0a0d9ba9f58a3242bf9a93ed51c27590df9d098bbfd12da9c2b6c1c647f3793e7773b95888adf", "abba00128a1816e20ee1c9add08a37b66ade95b56e2be2a26c305d90db5ac750"},
+	{129, "9042844ce47853c7d0d3de263ef3e8c713294f837a7fb30676d4064b031a7d1dcb928316bcf4b9a8af6ab08c59088a7b527302f13b53148338254cb28f682765", "b4b94cb33724c11884bf399a9dc967bf1530cda6e69afe1557dd46d24e6816e3"},
+	{300, "5aa431fa38ae13480baf571c331661065c9aa457577e6887f4b77bd6e3233cfa48223d597aeb6848e2d976184aee9522f5b0bbe66aa4649b62f832e2bdce468f", "57dafd4ea6078688c03a3a092bdb256f7da3c3ffd38a2836a4c3e065114a3824"},
+}
+
+func message(n int) []byte {
+	m := make([]byte, n)
+	for i := range m {
+		m[i] = byte((i*7 + 3) % 256)
+	}
+	return m
+}
+
+func TestPersonalizedVectors(t *testing.T) {
+	hb, err := NewBlake2b(64, []byte("Zcash_RedJubjubH"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hs, err := NewBlake2s(32, []byte("Zcash_G_"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range personalizedVectors {
+		m := message(v.n)
+
+		hb.Reset()
+		hb.Write(m)
+		if hex.EncodeToString(hb.Sum(nil)) != v.b {
+			t.Fatalf("wrong BLAKE2b digest for a message of %d bytes", v.n)
+		}
+
+		// write byte per byte, and check that Sum doesn't modify the state
+		hs.Reset()
+		for i := range m {
+			hs.Write(m[i : i+1])
+			hs.Sum(nil)
+		}
+		if hex.EncodeToString(hs.Sum(nil)) != v.s {
+			t.Fatalf("wrong BLAKE2s digest for a message of %d bytes", v.n)
+		}
+	}
+}
+
+func TestNoPersonalization(t *testing.T) {
+	hb, _ := NewBlake2b(64, nil)
+	hs, _ := NewBlake2s(32, nil)
+	for _, n := range []int{0, 1, 64, 128, 1000} {
+		m := message(n)
+
+		hb.Reset()
+		hb.Write(m)
+		expectedB := blake2b.Sum512(m)
+		if !bytes.Equal(hb.Sum(nil), expectedB[:]) {
+			t.Fatal("BLAKE2b without personalization doesn't match x/crypto")
+		}
+
+		hs.Reset()
+		hs.Write(m)
+		expectedS := blake2s.Sum256(m)
+		if !bytes.Equal(hs.Sum(nil), expectedS[:]) {
+			t.Fatal("BLAKE2s without personalization doesn't match x/crypto")
+		}
+	}
+}
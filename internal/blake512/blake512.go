@@ -0,0 +1,184 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blake512 provides BLAKE-512, the SHA-3 finalist BLAKE (not BLAKE2).
+//
+// It is a straightforward (non optimized) implementation of the submission to the
+// SHA-3 competition (https://www.aumasson.jp/blake/blake.pdf, version 1.3), used by the
+// circomlib compatible primitives of the library to derive EdDSA keys and nonces.
+package blake512
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+// Size size in byte of a BLAKE-512 digest
+const Size = 64
+
+// BlockSize block size in byte of BLAKE-512
+const BlockSize = 128
+
+var iv = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+// u are the first 1024 bits of the fractional part of pi
+var u = [16]uint64{
+	0x243f6a8885a308d3, 0x13198a2e03707344, 0xa4093822299f31d0, 0x082efa98ec4e6c89,
+	0x452821e638d01377, 0xbe5466cf34e90c6c, 0xc0ac29b7c97c50dd, 0x3f84d5b5b5470917,
+	0x9216d5d98979fb1b, 0xd1310ba698dfb5ac, 0x2ffd72dbd01adfb7, 0xb8e1afed6a267e96,
+	0xba7c9045f12c7f99, 0x24a19947b3916cf7, 0x0801f2e2858efc16, 0x636920d871574e69,
+}
+
+var sigma = [10][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+}
+
+type digest struct {
+	h      [8]uint64
+	length uint64 // number of bytes written
+	buf    [BlockSize]byte
+	offset int
+}
+
+// New returns a BLAKE-512 hash (with a zero salt)
+func New() hash.Hash {
+	d := new(digest)
+	d.Reset()
+	return d
+}
+
+// Sum512 returns the BLAKE-512 digest of data
+func Sum512(data []byte) [Size]byte {
+	var res [Size]byte
+	d := New()
+	d.Write(data)
+	copy(res[:], d.Sum(nil))
+	return res
+}
+
+func (d *digest) Reset() {
+	d.h = iv
+	d.length = 0
+	d.offset = 0
+}
+
+func (d *digest) Size() int { return Size }
+
+func (d *digest) BlockSize() int { return BlockSize }
+
+func (d *digest) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		c := copy(d.buf[d.offset:], p)
+		d.offset += c
+		d.length += uint64(c)
+		p = p[c:]
+		// the block is compressed as soon as it is full, the counter is the number
+		// of message bits hashed so far
+		if d.offset == BlockSize {
+			d.compress(&d.buf, d.length<<3)
+			d.offset = 0
+		}
+	}
+	return n, nil
+}
+
+func (d *digest) Sum(b []byte) []byte {
+	dd := *d
+	bitLength := dd.length << 3
+
+	// the message is padded with a 1 bit, zeros, a 1 bit and the 128 bits length,
+	// such that the padded length is a multiple of 1024 bits.
+	// A block containing no message bit is compressed with a counter equal to 0.
+	var tail [2 * BlockSize]byte
+	copy(tail[:], dd.buf[:dd.offset])
+	tail[dd.offset] = 0x80
+	nbBlocks := 1
+	if dd.offset >= BlockSize-16-1 {
+		nbBlocks = 2
+	}
+	end := nbBlocks * BlockSize
+	tail[end-17] |= 0x01
+	binary.BigEndian.PutUint64(tail[end-8:], bitLength)
+
+	var block [BlockSize]byte
+	copy(block[:], tail[:BlockSize])
+	if dd.offset == 0 {
+		dd.compress(&block, 0)
+	} else {
+		dd.compress(&block, bitLength)
+	}
+	if nbBlocks == 2 {
+		copy(block[:], tail[BlockSize:])
+		dd.compress(&block, 0)
+	}
+
+	var res [Size]byte
+	for i := 0; i < 8; i++ {
+		binary.BigEndian.PutUint64(res[8*i:], dd.h[i])
+	}
+	return append(b, res[:]...)
+}
+
+// compress processes a block, t being the (low 64 bits of the) counter
+func (d *digest) compress(block *[BlockSize]byte, t uint64) {
+	var m, v [16]uint64
+	for i := 0; i < 16; i++ {
+		m[i] = binary.BigEndian.Uint64(block[8*i:])
+	}
+	copy(v[:8], d.h[:])
+	copy(v[8:], u[:8])
+	v[12] ^= t
+	v[13] ^= t
+
+	g := func(s *[16]byte, i, a, b, c, dd int) {
+		v[a] += v[b] + (m[s[2*i]] ^ u[s[2*i+1]])
+		v[dd] = bits.RotateLeft64(v[dd]^v[a], -32)
+		v[c] += v[dd]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -25)
+		v[a] += v[b] + (m[s[2*i+1]] ^ u[s[2*i]])
+		v[dd] = bits.RotateLeft64(v[dd]^v[a], -16)
+		v[c] += v[dd]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -11)
+	}
+
+	for r := 0; r < 16; r++ {
+		s := &sigma[r%10]
+		g(s, 0, 0, 4, 8, 12)
+		g(s, 1, 1, 5, 9, 13)
+		g(s, 2, 2, 6, 10, 14)
+		g(s, 3, 3, 7, 11, 15)
+		g(s, 4, 0, 5, 10, 15)
+		g(s, 5, 1, 6, 11, 12)
+		g(s, 6, 2, 7, 8, 13)
+		g(s, 7, 3, 4, 9, 14)
+	}
+
+	for i := 0; i < 8; i++ {
+		d.h[i] ^= v[i] ^ v[i+8]
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blake512
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// test vectors from the BLAKE submission to the SHA-3 competition (appendix A.4)
+// and digest of the empty message
+var vectors = []struct {
+	msg    []byte
+	digest string
+}{
+	{[]byte{}, "a8cfbbd73726062df0c6864dda65defe58ef0cc52a5625090fa17601e1eecd1b628e94f396ae402a00acc9eab77b4d4c2e852aaaa25a636d80af3fc7913ef5b8"},
+	{[]byte{0}, "97961587f6d970faba6d2478045de6d1fabd09b61ae50932054d52bc29d31be4ff9102b9f69e2bbdb83be13d4b9c06091e5fa0b48bd081b634058be0ec49beb3"},
+	{make([]byte, 144), "313717d608e9cf758dcb1eb0f0c3cf9fc150b2d500fb33f51c52afc99d358a2f1374b8a38bba7974e7f6ef79cab16f22ce1e649d6e01ad9589c213045d545dde"},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		d := Sum512(v.msg)
+		if hex.EncodeToString(d[:]) != v.digest {
+			t.Fatalf("wrong digest for a message of %d bytes", len(v.msg))
+		}
+	}
+}
+
+func TestIncrementalWrites(t *testing.T) {
+	// lengths around the padding boundaries
+	for _, n := range []int{110, 111, 112, 127, 128, 129, 239, 240, 256, 300} {
+		msg := make([]byte, n)
+		for i := range msg {
+			msg[i] = byte(i*7 + 3)
+		}
+		expected := Sum512(msg)
+
+		h := New()
+		for i := range msg {
+			h.Write(msg[i : i+1])
+		}
+		if hex.EncodeToString(h.Sum(nil)) != hex.EncodeToString(expected[:]) {
+			t.Fatalf("incremental writes of %d bytes don't match Sum512", n)
+		}
+	}
+}
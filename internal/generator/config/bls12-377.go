@@ -7,6 +7,7 @@ func init() {
 		EnumID:       "BLS12_377",
 		FrModulus:    "8444461749428370424248824938781546531375899335154063827935233455917409239041",
 		FpModulus:    "258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458177",
+		LambdaGLV:    "91893752504881257701523279626832445440",
 		G1: Point{
 			CoordType:        "fp.Element",
 			PointName:        "g1",
@@ -7,6 +7,7 @@ func init() {
 		EnumID:       "BLS24_315",
 		FrModulus:    "11502027791375260645628074404575422495959608200132055716665986169834464870401",
 		FpModulus:    "39705142709513438335025689890408969744933502416914749335064285505637884093126342347073617133569",
+		LambdaGLV:    "11502027791375260645628074404575422496066855707288983427913398978447461580801",
 		G1: Point{
 			CoordType:        "fp.Element",
 			PointName:        "g1",
@@ -7,6 +7,7 @@ func init() {
 		EnumID:       "BN254",
 		FrModulus:    "21888242871839275222246405745257275088548364400416034343698204186575808495617",
 		FpModulus:    "21888242871839275222246405745257275088696311157297823662689037894645226208583",
+		LambdaGLV:    "4407920970296243842393367215006156084916469457145843978461",
 		G1: Point{
 			CoordType:        "fp.Element",
 			PointName:        "g1",
@@ -7,6 +7,7 @@ func init() {
 		EnumID:       "BW6_633",
 		FrModulus:    "39705142709513438335025689890408969744933502416914749335064285505637884093126342347073617133569",
 		FpModulus:    "20494478644167774678813387386538961497669590920908778075528754551012016751717791778743535050360001387419576570244406805463255765034468441182772056330021723098661967429339971741066259394985997",
+		LambdaGLV:    "39705142672498995661671850106945620852186608752525090699191017895721506694646055668218723303426",
 		G1: Point{
 			CoordType:        "fp.Element",
 			PointName:        "g1",
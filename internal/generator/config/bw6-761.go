@@ -7,6 +7,7 @@ func init() {
 		EnumID:       "BW6_761",
 		FrModulus:    "258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458177",
 		FpModulus:    "6891450384315732539396789682275657542479668912536150109513790160209623422243491736087683183289411687640864567753786613451161759120554247759349511699125301598951605099378508850372543631423596795951899700429969112842764913119068299",
+		LambdaGLV:    "80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410945",
 		G1: Point{
 			CoordType:        "fp.Element",
 			PointName:        "g1",
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"math/big"
+
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/field"
 )
@@ -14,6 +16,14 @@ type Curve struct {
 	FpModulus    string
 	FrModulus    string
 
+	// LambdaGLV is the eigenvalue (base 10) of the GLV endomorphism on the r-torsion
+	LambdaGLV string
+
+	// FpPackage and FrPackage are the import paths of the base and scalar fields,
+	// when they are not generated in the curve package (grumpkin reuses the bn254 fields)
+	FpPackage string
+	FrPackage string
+
 	Fp           *field.Field
 	Fr           *field.Field
 	FpUnusedBits int
@@ -35,11 +45,75 @@ func (c *Curve) ID() ecc.ID {
 		return ecc.BW6_633
 	case "bls24-315":
 		return ecc.BLS24_315
+	case "bw6-767":
+		return ecc.BW6_767
+	case "bls12-378":
+		return ecc.BLS12_378
+	case "bw6-756":
+		return ecc.BW6_756
+	case "secp256k1":
+		return ecc.SECP256K1
+	case "grumpkin":
+		return ecc.GRUMPKIN
+	case "pallas":
+		return ecc.PALLAS
+	case "vesta":
+		return ecc.VESTA
 	default:
 		panic("not implemented")
 	}
 }
 
+// HasPairing returns true if the curve has a G2 group and a pairing.
+// Curves without (for example secp256k1) only have G1 arithmetic.
+func (c Curve) HasPairing() bool {
+	return c.G2.PointName != ""
+}
+
+// HasFFT returns true if the fft and polynomial packages are generated on fr.
+// This is always the case with a pairing, otherwise fr must have a large 2-adic
+// subgroup (for example pallas and vesta)
+func (c Curve) HasFFT() bool {
+	if c.HasPairing() {
+		return true
+	}
+	if c.FrPackage != "" {
+		return false
+	}
+	return c.frTwoAdicity() >= 16
+}
+
+// HasKZG returns true if the kzg package is generated on fr: the curve must have a pairing,
+// and fr a 2-adic subgroup large enough for the fft.Domain of the polynomials (bw6-767 has
+// 2-adicity 1)
+func (c Curve) HasKZG() bool {
+	return c.HasPairing() && c.frTwoAdicity() >= 16
+}
+
+// frTwoAdicity returns the 2-adicity of r - 1
+func (c Curve) frTwoAdicity() uint {
+	var rMinusOne big.Int
+	rMinusOne.SetString(c.FrModulus, 10)
+	rMinusOne.Sub(&rMinusOne, big.NewInt(1))
+	return rMinusOne.TrailingZeroBits()
+}
+
+// FpImport returns the import of the base field package, aliased to fp if needed
+func (c Curve) FpImport() string {
+	if c.FpPackage != "" {
+		return "fp \"" + c.FpPackage + "\""
+	}
+	return "\"github.com/consensys/gnark-crypto/ecc/" + c.Name + "/fp\""
+}
+
+// FrImport returns the import of the scalar field package, aliased to fr if needed
+func (c Curve) FrImport() string {
+	if c.FrPackage != "" {
+		return "fr \"" + c.FrPackage + "\""
+	}
+	return "\"github.com/consensys/gnark-crypto/ecc/" + c.Name + "/fr\""
+}
+
 type Point struct {
 	CoordType        string
 	PointName        string
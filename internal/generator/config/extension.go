@@ -0,0 +1,49 @@
+package config
+
+import "strconv"
+
+// Extension describes the binomial extension Fq[X]/(X**Degree - NonResidue) of a base field Fq,
+// generated in the package Package, with an Ext type of Degree coefficients
+type Extension struct {
+	Package      string // name of the generated package
+	FieldImport  string // import path of the base field package
+	FieldPackage string // name of the base field package
+	Modulus      string // modulus q of the base field, base 10
+	Degree       int    // 2, 3, 4 or 5
+	NonResidue   int64  // X**Degree - NonResidue must be irreducible over Fq
+
+	// FrobeniusCoefficients[i] = NonResidue**(i*(q-1)/Degree) (base 10), such that
+	// the Frobenius map sends the i-th coefficient a_i to a_i * FrobeniusCoefficients[i]
+	FrobeniusCoefficients []string
+
+	// q**Degree - 1 = 2**SqrtE * s with s odd, SqrtSMinusOneOver2 = (s-1)/2 (base 16),
+	// and SqrtG (base 10 coefficients) is a quadratic non-residue to the power s
+	SqrtE              uint64
+	SqrtSMinusOneOver2 string
+	SqrtG              []string
+}
+
+func smallFieldExtension(name string, degree int, nonResidue int64) Extension {
+	for _, f := range SmallFields {
+		if f.Name == name {
+			return Extension{
+				Package:      "ext" + strconv.Itoa(degree),
+				FieldImport:  "github.com/consensys/gnark-crypto/field/" + name,
+				FieldPackage: name,
+				Modulus:      f.Modulus,
+				Degree:       degree,
+				NonResidue:   nonResidue,
+			}
+		}
+	}
+	panic("unknown small field " + name)
+}
+
+// Extensions lists the generated extensions of the single word fields, see SmallFields
+var Extensions = []Extension{
+	smallFieldExtension("goldilocks", 2, 7),
+	smallFieldExtension("goldilocks", 3, 2),
+	smallFieldExtension("babybear", 4, 11),
+	smallFieldExtension("babybear", 5, 2),
+	smallFieldExtension("m31", 2, -1),
+}
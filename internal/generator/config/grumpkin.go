@@ -0,0 +1,21 @@
+package config
+
+func init() {
+	Curves = append(Curves, Curve{
+		Name:         "grumpkin",
+		CurvePackage: "grumpkin",
+		EnumID:       "GRUMPKIN",
+		FrModulus:    "21888242871839275222246405745257275088696311157297823662689037894645226208583",
+		FpModulus:    "21888242871839275222246405745257275088548364400416034343698204186575808495617",
+		LambdaGLV:    "2203960485148121921418603742825762020974279258880205651966",
+		FrPackage:    "github.com/consensys/gnark-crypto/ecc/bn254/fp",
+		FpPackage:    "github.com/consensys/gnark-crypto/ecc/bn254/fr",
+		G1: Point{
+			CoordType:        "fp.Element",
+			PointName:        "g1",
+			GLV:              true,
+			CofactorCleaning: false,
+			CRange:           defaultCRange(),
+		},
+	})
+}
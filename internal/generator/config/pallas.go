@@ -0,0 +1,19 @@
+package config
+
+func init() {
+	Curves = append(Curves, Curve{
+		Name:         "pallas",
+		CurvePackage: "pallas",
+		EnumID:       "PALLAS",
+		FrModulus:    "28948022309329048855892746252171976963363056481941647379679742748393362948097",
+		FpModulus:    "28948022309329048855892746252171976963363056481941560715954676764349967630337",
+		LambdaGLV:    "26005156700822196841419187675678338661165322343552424574062261873906994770353",
+		G1: Point{
+			CoordType:        "fp.Element",
+			PointName:        "g1",
+			GLV:              true,
+			CofactorCleaning: false,
+			CRange:           defaultCRange(),
+		},
+	})
+}
@@ -0,0 +1,19 @@
+package config
+
+func init() {
+	Curves = append(Curves, Curve{
+		Name:         "secp256k1",
+		CurvePackage: "secp256k1",
+		EnumID:       "SECP256K1",
+		FrModulus:    "115792089237316195423570985008687907852837564279074904382605163141518161494337",
+		FpModulus:    "115792089237316195423570985008687907853269984665640564039457584007908834671663",
+		LambdaGLV:    "37718080363155996902926221483475020450927657555482586988616620542887997980018",
+		G1: Point{
+			CoordType:        "fp.Element",
+			PointName:        "g1",
+			GLV:              true,
+			CofactorCleaning: false,
+			CRange:           []int{4, 8, 16},
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package config
+
+import "github.com/consensys/gnark-crypto/field"
+
+// SmallField describes a prime field of modulus q < 2**64, generated in field/<Name>
+// along with its fft and polynomial packages
+type SmallField struct {
+	Name    string // name of the package of the field element
+	Package string // current package being generated
+	Modulus string // base 10
+
+	F *field.Field
+
+	// TwoAdicity is the largest s such that 2**s divides q-1, and RootOfUnity (regular form)
+	// a generator of the multiplicative subgroup of order 2**s
+	TwoAdicity  uint64
+	RootOfUnity uint64
+}
+
+// HasFFT returns true if the fft package is generated, i.e. q-1 has a large 2-adic subgroup.
+// This is not the case of Mersenne31 (q-1 = 2 * (2**30 - 1)), whose FFT-friendly
+// domains live in the circle group instead.
+func (f SmallField) HasFFT() bool {
+	return f.TwoAdicity >= 16
+}
+
+// SmallFields lists the single word fields popular in STARK-based proof systems
+var SmallFields = []SmallField{
+	{Name: "goldilocks", Modulus: "18446744069414584321"}, // 2**64 - 2**32 + 1
+	{Name: "babybear", Modulus: "2013265921"},             // 15 * 2**27 + 1
+	{Name: "m31", Modulus: "2147483647"},                  // 2**31 - 1
+}
@@ -0,0 +1,19 @@
+package config
+
+func init() {
+	Curves = append(Curves, Curve{
+		Name:         "vesta",
+		CurvePackage: "vesta",
+		EnumID:       "VESTA",
+		FrModulus:    "28948022309329048855892746252171976963363056481941560715954676764349967630337",
+		FpModulus:    "28948022309329048855892746252171976963363056481941647379679742748393362948097",
+		LambdaGLV:    "20444556541222657078399132219657928148671392403212669005631716460534733845831",
+		G1: Point{
+			CoordType:        "fp.Element",
+			PointName:        "g1",
+			GLV:              true,
+			CofactorCleaning: false,
+			CRange:           defaultCRange(),
+		},
+	})
+}
@@ -20,18 +20,23 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "multiexp_test.go"), Templates: []string{"tests/multiexp.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "reference_test.go"), Templates: []string{"tests/reference.go.tmpl"}},
 	}
 	conf.Package = packageName
 	if err := bgen.Generate(conf, packageName, "./ecc/template", entries...); err != nil {
 		return err
 	}
 
-	// fuzz testing
+	// allocation free GLV scalar decomposition
+	glv, err := newGLVConf(conf)
+	if err != nil {
+		return err
+	}
 	entries = []bavard.Entry{
-		{File: filepath.Join(baseDir, "fuzz.go"), Templates: []string{"fuzz.go.tmpl"}, BuildTag: "gofuzz"},
-		{File: filepath.Join(baseDir, "fuzz_test.go"), Templates: []string{"tests/fuzz.go.tmpl"}, BuildTag: "gofuzz"},
+		{File: filepath.Join(baseDir, "glv.go"), Templates: []string{"glv.go.tmpl"}},
+		{File: filepath.Join(baseDir, "glv_test.go"), Templates: []string{"tests/glv.go.tmpl"}},
 	}
-	if err := bgen.Generate(conf, packageName, "./ecc/template", entries...); err != nil {
+	if err := bgen.Generate(glv, packageName, "./ecc/template", entries...); err != nil {
 		return err
 	}
 
@@ -44,6 +49,20 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		return err
 	}
 
+	if !conf.HasPairing() {
+		// fuzz tests and G2 only make sense with a pairing
+		return nil
+	}
+
+	// fuzz testing
+	entries = []bavard.Entry{
+		{File: filepath.Join(baseDir, "fuzz.go"), Templates: []string{"fuzz.go.tmpl"}, BuildTag: "gofuzz"},
+		{File: filepath.Join(baseDir, "fuzz_test.go"), Templates: []string{"tests/fuzz.go.tmpl"}, BuildTag: "gofuzz"},
+	}
+	if err := bgen.Generate(conf, packageName, "./ecc/template", entries...); err != nil {
+		return err
+	}
+
 	// G2
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "g2.go"), Templates: []string{"point.go.tmpl"}},
@@ -0,0 +1,130 @@
+package ecc
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// glvConf is the template data of glv.go. The GLV lattice basis (a1, b1), (a2, b2)
+// and the rounding constants are precomputed as little endian uint64 limbs.
+type glvConf struct {
+	config.Curve
+
+	NbLimbs      int // number of words of fr
+	NbHalfLimbs  int // number of words of the absolute values of the half-scalars
+	NbWideLimbs  int // number of words of the intermediate values (two's complement)
+	NbShiftLimbs int // the rounding constants are scaled by 2**(64*NbShiftLimbs)
+	NbProdLimbs  int // number of words of s*g
+
+	// G1 = round(2**(64*NbShiftLimbs) * |b2| / |det|), G2 = round(2**(64*NbShiftLimbs) * |b1| / |det|)
+	G1, G2 []uint64
+
+	// absolute values of the basis vectors coordinates
+	A1, B1, A2, B2 []uint64
+
+	// SubX is true if the term |cX| * |X| is subtracted in splitScalar
+	SubA1, SubB1, SubA2, SubB2 bool
+}
+
+func newGLVConf(conf config.Curve) (glvConf, error) {
+	res := glvConf{Curve: conf}
+
+	r, ok := new(big.Int).SetString(conf.FrModulus, 10)
+	if !ok {
+		return res, errors.New("invalid fr modulus")
+	}
+	lambda, ok := new(big.Int).SetString(conf.LambdaGLV, 10)
+	if !ok {
+		return res, errors.New("invalid GLV eigenvalue")
+	}
+	var l ecc.Lattice
+	ecc.PrecomputeLattice(r, lambda, &l)
+
+	res.NbLimbs = len(r.Bits())
+	res.NbShiftLimbs = res.NbLimbs + 1
+
+	// rounding constants, with the sign of c1 = s*b2/det and c2 = -s*b1/det
+	var b1, b2, det big.Int
+	b1.Abs(&l.V1[1])
+	b2.Abs(&l.V2[1])
+	det.Abs(&l.Det)
+	g1 := roundedRatio(&b2, &det, 64*res.NbShiftLimbs)
+	g2 := roundedRatio(&b1, &det, 64*res.NbShiftLimbs)
+	signC1 := l.V2[1].Sign() * l.Det.Sign()
+	signC2 := -l.V1[1].Sign() * l.Det.Sign()
+
+	// the half-scalars are bounded by |a1|+|a2| and |b1|+|b2|,
+	// and the rounded coordinates by (r-1) * g / 2**(64*NbShiftLimbs) + 1
+	var bound, tmp big.Int
+	bound.Abs(&l.V1[0])
+	tmp.Abs(&l.V2[0])
+	bound.Add(&bound, &tmp)
+	tmp.Add(&b1, &b2)
+	if tmp.Cmp(&bound) > 0 {
+		bound.Set(&tmp)
+	}
+	rMinusOne := new(big.Int).Sub(r, big.NewInt(1))
+	for _, g := range []*big.Int{g1, g2} {
+		tmp.Mul(rMinusOne, g).Rsh(&tmp, uint(64*res.NbShiftLimbs)).Add(&tmp, big.NewInt(1))
+		if tmp.Cmp(&bound) > 0 {
+			bound.Set(&tmp)
+		}
+	}
+	res.NbHalfLimbs = (res.NbLimbs + 1) / 2
+	for bound.BitLen() > 64*res.NbHalfLimbs {
+		res.NbHalfLimbs++
+	}
+	res.NbWideLimbs = res.NbHalfLimbs + 1
+
+	nbG := len(g1.Bits())
+	if len(g2.Bits()) > nbG {
+		nbG = len(g2.Bits())
+	}
+	res.G1 = toLimbs(g1, nbG)
+	res.G2 = toLimbs(g2, nbG)
+	res.NbProdLimbs = res.NbLimbs + nbG
+	if res.NbProdLimbs < res.NbShiftLimbs+res.NbHalfLimbs {
+		res.NbProdLimbs = res.NbShiftLimbs + res.NbHalfLimbs
+	}
+
+	coordinates := []*big.Int{&l.V1[0], &l.V1[1], &l.V2[0], &l.V2[1]}
+	limbs := []*[]uint64{&res.A1, &res.B1, &res.A2, &res.B2}
+	for i, c := range coordinates {
+		if c.BitLen() > 64*res.NbWideLimbs {
+			return res, errors.New("GLV basis too large")
+		}
+		*limbs[i] = toLimbs(new(big.Int).Abs(c), res.NbWideLimbs)
+	}
+
+	// u0 = s - c1*a1 - c2*a2 and u1 = -c1*b1 - c2*b2
+	res.SubA1 = signC1*l.V1[0].Sign() > 0
+	res.SubB1 = signC1*l.V1[1].Sign() > 0
+	res.SubA2 = signC2*l.V2[0].Sign() > 0
+	res.SubB2 = signC2*l.V2[1].Sign() > 0
+
+	return res, nil
+}
+
+// roundedRatio returns round(2**shift * n / d)
+func roundedRatio(n, d *big.Int, shift int) *big.Int {
+	var res, halfD big.Int
+	halfD.Rsh(d, 1)
+	res.Lsh(n, uint(shift)).Add(&res, &halfD).Div(&res, d)
+	return &res
+}
+
+// toLimbs returns the nbLimbs little endian 64-bit words of x >= 0
+func toLimbs(x *big.Int, nbLimbs int) []uint64 {
+	res := make([]uint64, nbLimbs)
+	var t big.Int
+	mask := new(big.Int).SetUint64(^uint64(0))
+	t.Set(x)
+	for i := range res {
+		res[i] = new(big.Int).And(&t, mask).Uint64()
+		t.Rsh(&t, 64)
+	}
+	return res
+}
@@ -0,0 +1,157 @@
+package extension
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// Generate generates the binomial extension of conf in baseDir
+func Generate(conf config.Extension, baseDir string, bgen *bavard.BatchGenerator) error {
+	if err := setConstants(&conf); err != nil {
+		return err
+	}
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ext.go"), Templates: []string{"ext.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ext_test.go"), Templates: []string{"tests/ext.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./extension/template/", entries...)
+}
+
+// setConstants checks that X**Degree - NonResidue is irreducible and sets the Frobenius
+// and square root constants of conf
+func setConstants(conf *config.Extension) error {
+	q, ok := new(big.Int).SetString(conf.Modulus, 10)
+	if !ok {
+		return errors.New("invalid modulus")
+	}
+	e := extField{q: q, d: conf.Degree, w: big.NewInt(conf.NonResidue)}
+	e.w.Mod(e.w, q)
+
+	if err := e.checkIrreducible(); err != nil {
+		return err
+	}
+
+	// Frobenius: X**q = X * NonResidue**((q-1)/Degree)
+	var exp big.Int
+	exp.Sub(q, big.NewInt(1)).Div(&exp, big.NewInt(int64(e.d)))
+	e.frobenius = make([]*big.Int, e.d)
+	conf.FrobeniusCoefficients = make([]string, e.d)
+	for i := range e.frobenius {
+		var k big.Int
+		k.Mul(&exp, big.NewInt(int64(i)))
+		e.frobenius[i] = new(big.Int).Exp(e.w, &k, q)
+		conf.FrobeniusCoefficients[i] = e.frobenius[i].String()
+	}
+
+	// q**Degree - 1 = 2**SqrtE * s
+	var s big.Int
+	s.Exp(q, big.NewInt(int64(e.d)), nil).Sub(&s, big.NewInt(1))
+	conf.SqrtE = uint64(s.TrailingZeroBits())
+	s.Rsh(&s, uint(conf.SqrtE))
+	conf.SqrtSMinusOneOver2 = new(big.Int).Rsh(&s, 1).Text(16)
+
+	// find a quadratic non-residue X + c
+	z := make([]*big.Int, e.d)
+	for i := range z {
+		z[i] = new(big.Int)
+	}
+	z[1].SetUint64(1)
+	for e.isSquare(z) {
+		z[0].Add(z[0], big.NewInt(1))
+	}
+	g := e.exp(z, &s)
+	conf.SqrtG = make([]string, e.d)
+	for i := range g {
+		conf.SqrtG[i] = g[i].String()
+	}
+	return nil
+}
+
+// extField is a slow math/big implementation of Fq[X]/(X**d - w)
+type extField struct {
+	q, w      *big.Int
+	d         int
+	frobenius []*big.Int
+}
+
+// checkIrreducible returns an error if X**d - w is reducible over Fq: for each prime l | d,
+// w must not be an l-th power (and l | q-1), and q = 1 mod 4 if 4 | d
+func (e *extField) checkIrreducible() error {
+	if e.d < 2 || e.d > 5 {
+		return errors.New("unsupported extension degree")
+	}
+	var qMinusOne, exp, r big.Int
+	qMinusOne.Sub(e.q, big.NewInt(1))
+	for _, l := range []int64{2, 3, 5} {
+		if int64(e.d)%l != 0 {
+			continue
+		}
+		if r.Mod(&qMinusOne, big.NewInt(l)).Sign() != 0 {
+			return errors.New("X**d - w is reducible: the degree doesn't divide q-1")
+		}
+		exp.Div(&qMinusOne, big.NewInt(l))
+		if r.Exp(e.w, &exp, e.q).Cmp(big.NewInt(1)) == 0 {
+			return errors.New("X**d - w is reducible: w is a power")
+		}
+	}
+	if e.d == 4 && r.Mod(&qMinusOne, big.NewInt(4)).Sign() != 0 {
+		return errors.New("X**d - w is reducible: q != 1 mod 4")
+	}
+	return nil
+}
+
+func (e *extField) mul(a, b []*big.Int) []*big.Int {
+	res := make([]*big.Int, e.d)
+	for i := range res {
+		res[i] = new(big.Int)
+	}
+	var t big.Int
+	for i := 0; i < e.d; i++ {
+		for j := 0; j < e.d; j++ {
+			t.Mul(a[i], b[j])
+			if i+j >= e.d {
+				t.Mul(&t, e.w)
+			}
+			k := (i + j) % e.d
+			res[k].Add(res[k], &t).Mod(res[k], e.q)
+		}
+	}
+	return res
+}
+
+func (e *extField) exp(a []*big.Int, k *big.Int) []*big.Int {
+	res := make([]*big.Int, e.d)
+	for i := range res {
+		res[i] = new(big.Int)
+	}
+	res[0].SetUint64(1)
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		res = e.mul(res, res)
+		if k.Bit(i) == 1 {
+			res = e.mul(res, a)
+		}
+	}
+	return res
+}
+
+// isSquare returns true if the norm of a, in Fq, is a square
+func (e *extField) isSquare(a []*big.Int) bool {
+	norm := a
+	conjugate := a
+	for j := 1; j < e.d; j++ {
+		next := make([]*big.Int, e.d)
+		for i := range next {
+			next[i] = new(big.Int).Mul(conjugate[i], e.frobenius[i])
+			next[i].Mod(next[i], e.q)
+		}
+		conjugate = next
+		norm = e.mul(norm, conjugate)
+	}
+	return big.Jacobi(norm[0], e.q) != -1
+}
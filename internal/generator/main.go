@@ -15,10 +15,12 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/signature/eddsa"
 	"github.com/consensys/gnark-crypto/internal/generator/ecc"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards"
+	"github.com/consensys/gnark-crypto/internal/generator/extension"
 	"github.com/consensys/gnark-crypto/internal/generator/fft"
 	"github.com/consensys/gnark-crypto/internal/generator/kzg"
 	"github.com/consensys/gnark-crypto/internal/generator/pairing"
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
+	"github.com/consensys/gnark-crypto/internal/generator/smallfield"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
 )
 
@@ -41,24 +43,40 @@ func main() {
 			defer wg.Done()
 			conf.Fp, _ = field.NewField("fp", "Element", conf.FpModulus)
 			conf.Fr, _ = field.NewField("fr", "Element", conf.FrModulus)
-			conf.FpUnusedBits = 64 - (conf.Fp.NbBits % 64)
+			conf.FpUnusedBits = (64 - (conf.Fp.NbBits % 64)) % 64
 			curveDir := filepath.Join(baseDir, "ecc", conf.Name)
 
 			// generate base field
-			assertNoError(generator.GenerateFF(conf.Fr, filepath.Join(curveDir, "fr")))
-			assertNoError(generator.GenerateFF(conf.Fp, filepath.Join(curveDir, "fp")))
+			if conf.FrPackage == "" {
+				assertNoError(generator.GenerateFF(conf.Fr, filepath.Join(curveDir, "fr")))
+			}
+			if conf.FpPackage == "" {
+				assertNoError(generator.GenerateFF(conf.Fp, filepath.Join(curveDir, "fp")))
+			}
 
-			// generate tower of extension
-			assertNoError(tower.Generate(conf, filepath.Join(curveDir, "internal", "fptower"), bgen))
+			// generate G1, G2, multiExp, ...
+			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
-			// generate fft on fr
-			assertNoError(fft.Generate(conf, filepath.Join(curveDir, "fr", "fft"), bgen))
+			if conf.HasFFT() {
+				// generate fft on fr
+				assertNoError(fft.Generate(conf, filepath.Join(curveDir, "fr", "fft"), bgen))
 
-			// generate polynomial on fr
-			assertNoError(polynomial.Generate(conf, filepath.Join(curveDir, "fr", "polynomial"), bgen))
+				// generate polynomial on fr
+				assertNoError(polynomial.Generate(conf, filepath.Join(curveDir, "fr", "polynomial"), bgen))
+			}
+
+			if !conf.HasPairing() {
+				// no tower, pairing, kzg or companion curve for G1-only curves
+				return
+			}
+
+			// generate tower of extension
+			assertNoError(tower.Generate(conf, filepath.Join(curveDir, "internal", "fptower"), bgen))
 
 			// generate kzg on fr
-			assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "fr", "kzg"), bgen))
+			if conf.HasKZG() {
+				assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "fr", "kzg"), bgen))
+			}
 
 			// generate mimc on fr
 			assertNoError(mimc.Generate(conf, filepath.Join(curveDir, "fr", "mimc"), bgen))
@@ -69,9 +87,6 @@ func main() {
 			// generate eddsa on companion curves
 			assertNoError(eddsa.Generate(conf, filepath.Join(curveDir, "twistededwards", "eddsa"), bgen))
 
-			// generate G1, G2, multiExp, ...
-			assertNoError(ecc.Generate(conf, curveDir, bgen))
-
 			// generate pairing tests
 			assertNoError(pairing.Generate(conf, curveDir, bgen))
 
@@ -80,6 +95,23 @@ func main() {
 	}
 	wg.Wait()
 
+	// generate the scalar field of bandersnatch, defined over bls12-381's fr
+	frBandersnatch, _ := field.NewField("fr", "Element", "13108968793781547619861935127046491459309155893440570251786403306729687672801")
+	assertNoError(generator.GenerateFF(frBandersnatch, filepath.Join(baseDir, "ecc", "bls12-381", "bandersnatch", "fr")))
+
+	// generate the single word fields, with their fft and polynomial packages
+	for _, conf := range config.SmallFields {
+		conf.F, _ = field.NewField(conf.Name, "Element", conf.Modulus)
+		fieldDir := filepath.Join(baseDir, "field", conf.Name)
+		assertNoError(generator.GenerateFF(conf.F, fieldDir))
+		assertNoError(smallfield.Generate(conf, fieldDir, bgen))
+	}
+
+	// generate the extensions of the single word fields
+	for _, conf := range config.Extensions {
+		assertNoError(extension.Generate(conf, filepath.Join(baseDir, "field", conf.FieldPackage, conf.Package), bgen))
+	}
+
 	// run go fmt on whole directory
 	cmd := exec.Command("gofmt", "-s", "-w", baseDir)
 	cmd.Stdout = os.Stdout
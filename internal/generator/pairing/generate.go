@@ -10,8 +10,10 @@ import (
 
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 	packageName := strings.ReplaceAll(conf.Name, "-", "")
-	return bgen.Generate(conf, packageName, "./pairing/template", bavard.Entry{
-		File: filepath.Join(baseDir, "pairing_test.go"), Templates: []string{"tests/pairing.go.tmpl"},
-	})
+	return bgen.Generate(conf, packageName, "./pairing/template", []bavard.Entry{
+		{File: filepath.Join(baseDir, "curve.go"), Templates: []string{"curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "curve_test.go"), Templates: []string{"tests/curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pairing_test.go"), Templates: []string{"tests/pairing.go.tmpl"}},
+	}...)
 
 }
@@ -0,0 +1,58 @@
+package smallfield
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// Generate generates the fft (if conf.HasFFT()) and polynomial packages of a single word field,
+// as instances of the generic field/fft and field/polynomial packages
+func Generate(conf config.SmallField, baseDir string, bgen *bavard.BatchGenerator) error {
+	if err := setRootOfUnity(&conf); err != nil {
+		return err
+	}
+
+	if conf.HasFFT() {
+		conf.Package = "fft"
+		entries := []bavard.Entry{
+			{File: filepath.Join(baseDir, "fft", "doc.go"), Templates: []string{"fft.doc.go.tmpl"}},
+			{File: filepath.Join(baseDir, "fft", "domain.go"), Templates: []string{"domain.go.tmpl"}},
+			{File: filepath.Join(baseDir, "fft", "fft_test.go"), Templates: []string{"tests/fft.go.tmpl"}},
+		}
+		if err := bgen.Generate(conf, conf.Package, "./smallfield/template/", entries...); err != nil {
+			return err
+		}
+	}
+
+	conf.Package = "polynomial"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "polynomial", "doc.go"), Templates: []string{"polynomial.doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "polynomial", "polynomial.go"), Templates: []string{"polynomial.go.tmpl"}},
+		{File: filepath.Join(baseDir, "polynomial", "polynomial_test.go"), Templates: []string{"tests/polynomial.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./smallfield/template/", entries...)
+}
+
+// setRootOfUnity sets conf.TwoAdicity and conf.RootOfUnity = g**((q-1) / 2**TwoAdicity),
+// where g is the smallest quadratic non-residue
+func setRootOfUnity(conf *config.SmallField) error {
+	q, ok := new(big.Int).SetString(conf.Modulus, 10)
+	if !ok || q.BitLen() > 64 {
+		return errors.New("invalid single word modulus")
+	}
+	var s, g big.Int
+	s.Sub(q, big.NewInt(1))
+	conf.TwoAdicity = uint64(s.TrailingZeroBits())
+	s.Rsh(&s, uint(conf.TwoAdicity))
+
+	g.SetUint64(2)
+	for big.Jacobi(&g, q) != -1 {
+		g.Add(&g, big.NewInt(1))
+	}
+	conf.RootOfUnity = g.Exp(&g, &s, q).Uint64()
+	return nil
+}
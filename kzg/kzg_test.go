@@ -0,0 +1,41 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+func TestNewSRS(t *testing.T) {
+	for _, id := range ecc.Implemented() {
+		hasKZG := id.Info().HasKZG
+		func() {
+			defer func() {
+				r := recover()
+				if hasKZG && r != nil {
+					t.Errorf("%s: NewSRS panics: %v", id, r)
+				}
+				if !hasKZG && r == nil {
+					t.Errorf("%s: NewSRS doesn't panic but the curve has no kzg", id)
+				}
+			}()
+			if NewSRS(id) == nil {
+				t.Errorf("%s: NewSRS returns nil", id)
+			}
+		}()
+	}
+}
@@ -75,7 +75,7 @@ type Signer interface {
 
 type SignatureScheme uint
 
-const maxSignatures = 6
+const maxSignatures = 12
 
 const (
 	EDDSA_BN254 SignatureScheme = iota
@@ -84,6 +84,12 @@ const (
 	EDDSA_BW6_761
 	EDDSA_BLS24_315
 	EDDSA_BW6_633
+	EDDSA_BW6_767
+	ECDSA_SECP256K1
+	REDJUBJUB
+	EDDSA_BABYJUBJUB
+	EDDSA_BLS12_378
+	EDDSA_BW6_756
 )
 
 var signatures = make([]func(io.Reader) (Signer, error), maxSignatures)